@@ -6,7 +6,9 @@ package api // import "miniflux.app/api"
 
 import (
 	"net/http"
+	"time"
 
+	"miniflux.app/config"
 	"miniflux.app/reader/feed"
 	"miniflux.app/storage"
 	"miniflux.app/worker"
@@ -16,7 +18,13 @@ import (
 
 // Serve declares API routes for the application.
 func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHandler *feed.Handler) {
-	handler := &handler{store, pool, feedHandler}
+	handler := &handler{
+		store:               store,
+		pool:                pool,
+		feedHandler:         feedHandler,
+		fetchContentLimiter: newRateLimiter(config.Opts.FetchContentRateLimit(), time.Minute),
+		refreshIconLimiter:  newRateLimiter(config.Opts.IconRefreshRateLimit(), time.Minute),
+	}
 
 	sr := router.PathPrefix("/v1").Subrouter()
 	middleware := newMiddleware(store)
@@ -33,23 +41,45 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	sr.HandleFunc("/me", handler.currentUser).Methods(http.MethodGet)
 	sr.HandleFunc("/categories", handler.createCategory).Methods(http.MethodPost)
 	sr.HandleFunc("/categories", handler.getCategories).Methods(http.MethodGet)
+	sr.HandleFunc("/categories/order", handler.reorderCategories).Methods(http.MethodPut)
 	sr.HandleFunc("/categories/{categoryID}", handler.updateCategory).Methods(http.MethodPut)
 	sr.HandleFunc("/categories/{categoryID}", handler.removeCategory).Methods(http.MethodDelete)
+	sr.HandleFunc("/saved-searches", handler.createSavedSearch).Methods(http.MethodPost)
+	sr.HandleFunc("/saved-searches", handler.getSavedSearches).Methods(http.MethodGet)
+	sr.HandleFunc("/saved-searches/{searchID}", handler.getSavedSearch).Methods(http.MethodGet)
+	sr.HandleFunc("/saved-searches/{searchID}", handler.updateSavedSearch).Methods(http.MethodPut)
+	sr.HandleFunc("/saved-searches/{searchID}", handler.removeSavedSearch).Methods(http.MethodDelete)
+	sr.HandleFunc("/saved-searches/{searchID}/entries", handler.getSavedSearchEntries).Methods(http.MethodGet)
 	sr.HandleFunc("/discover", handler.getSubscriptions).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds", handler.createFeed).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds", handler.getFeeds).Methods(http.MethodGet)
+	sr.HandleFunc("/feeds/order", handler.reorderFeeds).Methods(http.MethodPut)
 	sr.HandleFunc("/feeds/refresh", handler.refreshAllFeeds).Methods(http.MethodPut)
+	sr.HandleFunc("/feeds/refresh/events", handler.streamRefreshAllFeeds).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/{feedID}/refresh", handler.refreshFeed).Methods(http.MethodPut)
+	sr.HandleFunc("/feeds/{feedID}/reprocess", handler.reprocessFeedEntries).Methods(http.MethodPut)
 	sr.HandleFunc("/feeds/{feedID}", handler.getFeed).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/{feedID}", handler.updateFeed).Methods(http.MethodPut)
 	sr.HandleFunc("/feeds/{feedID}", handler.removeFeed).Methods(http.MethodDelete)
 	sr.HandleFunc("/feeds/{feedID}/icon", handler.feedIcon).Methods(http.MethodGet)
+	sr.HandleFunc("/feeds/{feedID}/icon/refresh", handler.refreshFeedIcon).Methods(http.MethodPost)
 	sr.HandleFunc("/export", handler.exportFeeds).Methods(http.MethodGet)
 	sr.HandleFunc("/import", handler.importFeeds).Methods(http.MethodPost)
+	sr.HandleFunc("/import/google-reader", handler.importGoogleReader).Methods(http.MethodPost)
+	sr.HandleFunc("/import/jobs", handler.createImportJob).Methods(http.MethodPost)
+	sr.HandleFunc("/import/jobs/{jobID}", handler.getImportJob).Methods(http.MethodGet)
+	sr.HandleFunc("/integrations/{provider}/test", handler.testIntegration).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds/{feedID}/entries", handler.getFeedEntries).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/{feedID}/entries/{entryID}", handler.getFeedEntry).Methods(http.MethodGet)
 	sr.HandleFunc("/entries", handler.getEntries).Methods(http.MethodGet)
 	sr.HandleFunc("/entries", handler.setEntryStatus).Methods(http.MethodPut)
+	sr.HandleFunc("/entries/read", handler.markEntriesAsRead).Methods(http.MethodPut)
 	sr.HandleFunc("/entries/{entryID}", handler.getEntry).Methods(http.MethodGet)
 	sr.HandleFunc("/entries/{entryID}/bookmark", handler.toggleBookmark).Methods(http.MethodPut)
+	sr.HandleFunc("/entries/{entryID}/tags", handler.getEntryTags).Methods(http.MethodGet)
+	sr.HandleFunc("/entries/{entryID}/tags", handler.addEntryTags).Methods(http.MethodPost)
+	sr.HandleFunc("/entries/{entryID}/tags/{tagID}", handler.removeEntryTag).Methods(http.MethodDelete)
+	sr.HandleFunc("/tags", handler.getTags).Methods(http.MethodGet)
+	sr.HandleFunc("/tags/{tagID}", handler.removeTag).Methods(http.MethodDelete)
+	sr.HandleFunc("/entries/{entryID}/fetch-content", handler.fetchContent).Methods(http.MethodGet)
 }
@@ -10,6 +10,7 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/model"
 )
 
 func (h *handler) createCategory(w http.ResponseWriter, r *http.Request) {
@@ -64,6 +65,26 @@ func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
 	json.Created(w, r, category)
 }
 
+func (h *handler) reorderCategories(w http.ResponseWriter, r *http.Request) {
+	categoryIDs, err := decodeOrderingPayload(r.Body, "category_ids")
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateOrdering(categoryIDs); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.ReorderCategories(request.UserID(r), categoryIDs); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
 func (h *handler) getCategories(w http.ResponseWriter, r *http.Request) {
 	categories, err := h.store.Categories(request.UserID(r))
 	if err != nil {
@@ -9,9 +9,12 @@ import (
 	"net/http"
 	"time"
 
+	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/reader/processor"
 	"miniflux.app/storage"
 )
 
@@ -56,6 +59,52 @@ func (h *handler) getEntry(w http.ResponseWriter, r *http.Request) {
 	json.OK(w, r, entry)
 }
 
+func (h *handler) fetchContent(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	userID := request.UserID(r)
+
+	if !h.fetchContentLimiter.allow(userID) {
+		json.TooManyRequests(w, r)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithEntryID(entryID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	// The entry query does not load the feed credentials.
+	feed, err := h.store.FeedByID(userID, entry.FeedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	entry.Feed = feed
+	content, err := processor.FetchEntryContent(entry)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, map[string]string{"content": content})
+}
+
 func (h *handler) getFeedEntries(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 
@@ -93,6 +142,19 @@ func (h *handler) getFeedEntries(w http.ResponseWriter, r *http.Request) {
 	builder.WithDirection(direction)
 	builder.WithOffset(offset)
 	builder.WithLimit(limit)
+
+	if !request.HasQueryParam(r, "order") && !request.HasQueryParam(r, "direction") {
+		feed, err := h.store.FeedByID(request.UserID(r), feedID)
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if feed != nil {
+			builder.WithFeedSortOrder(feed.SortOrder)
+		}
+	}
+
 	configureFilters(builder, r)
 
 	entries, err := builder.GetEntries()
@@ -181,6 +243,52 @@ func (h *handler) setEntryStatus(w http.ResponseWriter, r *http.Request) {
 	json.NoContent(w, r)
 }
 
+func (h *handler) markEntriesAsRead(w http.ResponseWriter, r *http.Request) {
+	payload, err := decodeEntriesReadPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, errors.New("Invalid JSON payload"))
+		return
+	}
+
+	if len(payload.EntryIDs) == 0 {
+		json.BadRequest(w, r, errors.New("The list of entries is empty"))
+		return
+	}
+
+	if err := model.ValidateReadSource(payload.Source); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	if payload.Source == model.ReadSourceScroll {
+		user, err := h.store.UserByID(userID)
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		if user == nil {
+			json.NotFound(w, r)
+			return
+		}
+
+		if !user.MarkReadOnScroll {
+			json.BadRequest(w, r, errors.New("Marking entries as read while scrolling is disabled for this user"))
+			return
+		}
+	}
+
+	count, err := h.store.MarkEntriesAsRead(userID, payload.EntryIDs)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	logger.Debug("[API:MarkEntriesAsRead] userID=%d source=%s: %d entries marked as read", userID, payload.Source, count)
+	json.OK(w, r, map[string]int64{"updated": count})
+}
+
 func (h *handler) toggleBookmark(w http.ResponseWriter, r *http.Request) {
 	entryID := request.RouteInt64Param(r, "entryID")
 	if err := h.store.ToggleBookmark(request.UserID(r), entryID); err != nil {
@@ -212,6 +320,11 @@ func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
 		builder.AfterDate(time.Unix(afterTimestamp, 0))
 	}
 
+	feedID := request.QueryInt64Param(r, "feed_id", 0)
+	if feedID > 0 {
+		builder.WithFeedID(feedID)
+	}
+
 	categoryID := request.QueryInt64Param(r, "category_id", 0)
 	if categoryID > 0 {
 		builder.WithCategoryID(categoryID)
@@ -221,8 +334,22 @@ func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
 		builder.WithStarred()
 	}
 
+	tag := model.NormalizeTagName(request.QueryStringParam(r, "tag", ""))
+	if tag != "" {
+		builder.WithTag(tag)
+	}
+
 	searchQuery := request.QueryStringParam(r, "search", "")
 	if searchQuery != "" {
 		builder.WithSearchQuery(searchQuery)
+		builder.WithSearchSnippet(config.Opts.SearchSnippetLength())
+
+		if maxResults := config.Opts.SearchResultsLimit(); maxResults > 0 {
+			limit := request.QueryIntParam(r, "limit", maxResults)
+			if limit == 0 || limit > maxResults {
+				limit = maxResults
+			}
+			builder.WithLimit(limit)
+		}
 	}
 }
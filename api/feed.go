@@ -6,10 +6,13 @@ package api // import "miniflux.app/api"
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/model"
 )
 
 func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
@@ -41,12 +44,20 @@ func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	feed, err := h.feedHandler.CreateFeed(
+	if !model.IsValidProxyURL(feedInfo.ProxyURL) {
+		json.BadRequest(w, r, errors.New("The proxy_url is invalid"))
+		return
+	}
+
+	feed, subscriptions, err := h.feedHandler.CreateFeed(
+		r.Context(),
 		userID,
 		feedInfo.CategoryID,
 		feedInfo.FeedURL,
 		feedInfo.Crawler,
 		feedInfo.UserAgent,
+		feedInfo.Cookie,
+		feedInfo.ProxyURL,
 		feedInfo.Username,
 		feedInfo.Password,
 		feedInfo.ScraperRules,
@@ -57,6 +68,16 @@ func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if feed == nil {
+		var urls []string
+		for _, subscription := range subscriptions {
+			urls = append(urls, subscription.URL)
+		}
+
+		json.BadRequest(w, r, fmt.Errorf("Multiple feeds found, use one of these URLs: %s", strings.Join(urls, ", ")))
+		return
+	}
+
 	type result struct {
 		FeedID int64 `json:"feed_id"`
 	}
@@ -73,7 +94,13 @@ func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.feedHandler.RefreshFeed(userID, feedID)
+	var err error
+	if request.QueryBoolParam(r, "force", false) {
+		err = h.feedHandler.ForceRefreshFeed(r.Context(), userID, feedID)
+	} else {
+		err = h.feedHandler.RefreshFeed(r.Context(), userID, feedID)
+	}
+
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -82,6 +109,23 @@ func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
 	json.NoContent(w, r)
 }
 
+func (h *handler) reprocessFeedEntries(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	userID := request.UserID(r)
+
+	if !h.store.FeedExists(userID, feedID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.feedHandler.ReprocessFeedEntries(r.Context(), userID, feedID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
 func (h *handler) refreshAllFeeds(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	jobs, err := h.store.NewUserBatch(userID, h.store.CountFeeds(userID))
@@ -118,6 +162,42 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if feedChanges.ProxyURL != nil && !model.IsValidProxyURL(*feedChanges.ProxyURL) {
+		json.BadRequest(w, r, errors.New("The proxy_url is invalid"))
+		return
+	}
+
+	if feedChanges.IconURL != nil && !model.IsValidIconURL(*feedChanges.IconURL) {
+		json.BadRequest(w, r, errors.New("The icon_url is invalid"))
+		return
+	}
+
+	if feedChanges.AuthScheme != nil && !model.IsValidAuthScheme(*feedChanges.AuthScheme) {
+		json.BadRequest(w, r, errors.New("The auth_scheme is invalid"))
+		return
+	}
+
+	if feedChanges.SortOrder != nil && !model.IsValidFeedSortOrder(*feedChanges.SortOrder) {
+		json.BadRequest(w, r, errors.New("The sort_order is invalid"))
+		return
+	}
+
+	if feedChanges.AuthorMatchMode != nil && !model.IsValidAuthorMatchMode(*feedChanges.AuthorMatchMode) {
+		json.BadRequest(w, r, errors.New("The author_match_mode is invalid"))
+		return
+	}
+
+	if feedChanges.AuthorKeeplistRules != nil && !model.IsValidAuthorKeeplistRules(*feedChanges.AuthorKeeplistRules) {
+		json.BadRequest(w, r, errors.New(`The author_keeplist_rules can't contain the empty author rule ""`))
+		return
+	}
+
+	if feedChanges.CrawlerMode != nil && !model.IsValidCrawlerMode(*feedChanges.CrawlerMode) {
+		json.BadRequest(w, r, errors.New("The crawler_mode is invalid"))
+		return
+	}
+
+	originalIconURL := originalFeed.IconURL
 	feedChanges.Update(originalFeed)
 
 	if !h.store.CategoryExists(userID, originalFeed.Category.ID) {
@@ -130,6 +210,13 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if originalFeed.IconURL != originalIconURL {
+		if err := h.store.ExpireFeedIcon(feedID); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+	}
+
 	originalFeed, err = h.store.FeedByID(userID, feedID)
 	if err != nil {
 		json.ServerError(w, r, err)
@@ -149,6 +236,26 @@ func (h *handler) getFeeds(w http.ResponseWriter, r *http.Request) {
 	json.OK(w, r, feeds)
 }
 
+func (h *handler) reorderFeeds(w http.ResponseWriter, r *http.Request) {
+	feedIDs, err := decodeOrderingPayload(r.Body, "feed_ids")
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := model.ValidateOrdering(feedIDs); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.ReorderFeeds(request.UserID(r), feedIDs); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
 func (h *handler) getFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	feed, err := h.store.FeedByID(request.UserID(r), feedID)
@@ -0,0 +1,30 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/reader/greader"
+)
+
+type googleReaderImportResponse struct {
+	Message string `json:"message"`
+	*greader.ImportSummary
+}
+
+func (h *handler) importGoogleReader(w http.ResponseWriter, r *http.Request) {
+	greaderHandler := greader.NewHandler(h.store)
+	summary, err := greaderHandler.Import(request.UserID(r), r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, &googleReaderImportResponse{Message: "Feeds imported successfully", ImportSummary: summary})
+}
@@ -14,4 +14,7 @@ type handler struct {
 	store       *storage.Storage
 	pool        *worker.Pool
 	feedHandler *feed.Handler
+
+	fetchContentLimiter *rateLimiter
+	refreshIconLimiter  *rateLimiter
 }
@@ -36,3 +36,25 @@ func (h *handler) feedIcon(w http.ResponseWriter, r *http.Request) {
 		Data:     icon.DataURL(),
 	})
 }
+
+func (h *handler) refreshFeedIcon(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	userID := request.UserID(r)
+
+	if !h.store.FeedExists(userID, feedID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	if !h.refreshIconLimiter.allow(userID) {
+		json.TooManyRequests(w, r)
+		return
+	}
+
+	if err := h.feedHandler.RefreshFeedIcon(userID, feedID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	h.feedIcon(w, r)
+}
@@ -0,0 +1,36 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/integration"
+)
+
+func (h *handler) testIntegration(w http.ResponseWriter, r *http.Request) {
+	provider := request.RouteStringParam(r, "provider")
+	if !integration.IsValidNotificationProvider(provider) {
+		json.BadRequest(w, r, errors.New("This integration cannot be tested"))
+		return
+	}
+
+	settings, err := h.store.Integration(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	// The error is returned to the user: it usually comes from wrong settings, not from Miniflux.
+	if err := integration.SendTestNotification(settings, provider); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
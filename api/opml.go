@@ -13,6 +13,11 @@ import (
 	"miniflux.app/reader/opml"
 )
 
+type importResponse struct {
+	Message string `json:"message"`
+	*opml.ImportSummary
+}
+
 func (h *handler) exportFeeds(w http.ResponseWriter, r *http.Request) {
 	opmlHandler := opml.NewHandler(h.store)
 	opml, err := opmlHandler.Export(request.UserID(r))
@@ -26,12 +31,39 @@ func (h *handler) exportFeeds(w http.ResponseWriter, r *http.Request) {
 
 func (h *handler) importFeeds(w http.ResponseWriter, r *http.Request) {
 	opmlHandler := opml.NewHandler(h.store)
-	err := opmlHandler.Import(request.UserID(r), r.Body)
+	summary, err := opmlHandler.Import(request.UserID(r), r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, &importResponse{Message: "Feeds imported successfully", ImportSummary: summary})
+}
+
+func (h *handler) createImportJob(w http.ResponseWriter, r *http.Request) {
+	opmlHandler := opml.NewHandler(h.store)
+	job, err := opmlHandler.CreateImportJob(request.UserID(r), r.Body)
 	defer r.Body.Close()
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
-	json.Created(w, r, map[string]string{"message": "Feeds imported successfully"})
+	json.Created(w, r, job)
+}
+
+func (h *handler) getImportJob(w http.ResponseWriter, r *http.Request) {
+	job, err := h.store.ImportJob(request.UserID(r), request.RouteInt64Param(r, "jobID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if job == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, job)
 }
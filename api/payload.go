@@ -10,6 +10,7 @@ import (
 	"io"
 
 	"miniflux.app/model"
+	"miniflux.app/reader/language"
 )
 
 type feedIcon struct {
@@ -27,6 +28,8 @@ type feedCreation struct {
 	FeedURL      string `json:"feed_url"`
 	CategoryID   int64  `json:"category_id"`
 	UserAgent    string `json:"user_agent"`
+	Cookie       string `json:"cookie"`
+	ProxyURL     string `json:"proxy_url"`
 	Username     string `json:"username"`
 	Password     string `json:"password"`
 	Crawler      bool   `json:"crawler"`
@@ -37,22 +40,42 @@ type feedCreation struct {
 type subscriptionDiscovery struct {
 	URL       string `json:"url"`
 	UserAgent string `json:"user_agent"`
+	Cookie    string `json:"cookie"`
 	Username  string `json:"username"`
 	Password  string `json:"password"`
 }
 
 type feedModification struct {
-	FeedURL      *string `json:"feed_url"`
-	SiteURL      *string `json:"site_url"`
-	Title        *string `json:"title"`
-	ScraperRules *string `json:"scraper_rules"`
-	RewriteRules *string `json:"rewrite_rules"`
-	Crawler      *bool   `json:"crawler"`
-	UserAgent    *string `json:"user_agent"`
-	Username     *string `json:"username"`
-	Password     *string `json:"password"`
-	CategoryID   *int64  `json:"category_id"`
-	Disabled     *bool   `json:"disabled"`
+	FeedURL              *string `json:"feed_url"`
+	SiteURL              *string `json:"site_url"`
+	Title                *string `json:"title"`
+	ScraperRules         *string `json:"scraper_rules"`
+	RewriteRules         *string `json:"rewrite_rules"`
+	BlocklistRules       *string `json:"blocklist_rules"`
+	KeeplistRules        *string `json:"keeplist_rules"`
+	AuthorBlocklistRules *string `json:"author_blocklist_rules"`
+	AuthorKeeplistRules  *string `json:"author_keeplist_rules"`
+	AuthorMatchMode      *string `json:"author_match_mode"`
+	DateLayouts          *string `json:"date_layouts"`
+	MaxEntries           *int    `json:"max_entries"`
+	SkipDuplicateGUIDs   *bool   `json:"skip_duplicate_guids"`
+	RequestTimeout       *int    `json:"request_timeout"`
+	Crawler              *bool   `json:"crawler"`
+	CrawlerMode          *string `json:"crawler_mode"`
+	UserAgent            *string `json:"user_agent"`
+	Cookie               *string `json:"cookie"`
+	ProxyURL             *string `json:"proxy_url"`
+	TranslationLanguage  *string `json:"translation_language"`
+	Username             *string `json:"username"`
+	Password             *string `json:"password"`
+	AuthScheme           *string `json:"auth_scheme"`
+	AuthToken            *string `json:"auth_token"`
+	IconURL              *string `json:"icon_url"`
+	SortOrder            *string `json:"sort_order"`
+	MarkReadAfterDays    *int    `json:"mark_read_after_days"`
+	RefreshInterval      *int    `json:"refresh_interval"`
+	CategoryID           *int64  `json:"category_id"`
+	Disabled             *bool   `json:"disabled"`
 }
 
 func (f *feedModification) Update(feed *model.Feed) {
@@ -76,14 +99,71 @@ func (f *feedModification) Update(feed *model.Feed) {
 		feed.RewriteRules = *f.RewriteRules
 	}
 
+	if f.BlocklistRules != nil {
+		feed.BlocklistRules = *f.BlocklistRules
+	}
+
+	if f.KeeplistRules != nil {
+		feed.KeeplistRules = *f.KeeplistRules
+	}
+
+	if f.AuthorBlocklistRules != nil {
+		feed.AuthorBlocklistRules = *f.AuthorBlocklistRules
+	}
+
+	if f.AuthorKeeplistRules != nil {
+		feed.AuthorKeeplistRules = *f.AuthorKeeplistRules
+	}
+
+	if f.AuthorMatchMode != nil && model.IsValidAuthorMatchMode(*f.AuthorMatchMode) {
+		feed.AuthorMatchMode = *f.AuthorMatchMode
+	}
+
+	if f.DateLayouts != nil {
+		feed.DateLayouts = *f.DateLayouts
+	}
+
+	if f.MaxEntries != nil && *f.MaxEntries >= 0 {
+		feed.MaxEntries = *f.MaxEntries
+	}
+
+	if f.RequestTimeout != nil && *f.RequestTimeout >= 0 {
+		feed.RequestTimeout = *f.RequestTimeout
+	}
+
+	if f.SkipDuplicateGUIDs != nil {
+		feed.SkipDuplicateGUIDs = *f.SkipDuplicateGUIDs
+	}
+
 	if f.Crawler != nil {
-		feed.Crawler = *f.Crawler
+		// The legacy crawler flag enables or disables the crawler regardless of the category setting.
+		if *f.Crawler {
+			feed.CrawlerMode = model.CrawlerModeEnabled
+		} else {
+			feed.CrawlerMode = model.CrawlerModeDisabled
+		}
+	}
+
+	if f.CrawlerMode != nil {
+		feed.CrawlerMode = *f.CrawlerMode
 	}
 
 	if f.UserAgent != nil {
 		feed.UserAgent = *f.UserAgent
 	}
 
+	if f.Cookie != nil {
+		feed.Cookie = *f.Cookie
+	}
+
+	if f.ProxyURL != nil && model.IsValidProxyURL(*f.ProxyURL) {
+		feed.ProxyURL = *f.ProxyURL
+	}
+
+	if f.TranslationLanguage != nil {
+		feed.TranslationLanguage = language.Normalize(*f.TranslationLanguage)
+	}
+
 	if f.Username != nil {
 		feed.Username = *f.Username
 	}
@@ -92,24 +172,53 @@ func (f *feedModification) Update(feed *model.Feed) {
 		feed.Password = *f.Password
 	}
 
+	if f.AuthScheme != nil && model.IsValidAuthScheme(*f.AuthScheme) {
+		feed.AuthScheme = *f.AuthScheme
+	}
+
+	if f.AuthToken != nil {
+		feed.AuthToken = *f.AuthToken
+	}
+
+	if f.IconURL != nil && model.IsValidIconURL(*f.IconURL) {
+		feed.IconURL = *f.IconURL
+	}
+
+	if f.SortOrder != nil && model.IsValidFeedSortOrder(*f.SortOrder) {
+		feed.SortOrder = *f.SortOrder
+	}
+
+	if f.MarkReadAfterDays != nil && *f.MarkReadAfterDays >= 0 {
+		feed.MarkReadAfterDays = *f.MarkReadAfterDays
+	}
+
+	if f.RefreshInterval != nil && *f.RefreshInterval >= 0 {
+		feed.RefreshInterval = *f.RefreshInterval
+	}
+
 	if f.CategoryID != nil && *f.CategoryID > 0 {
 		feed.Category.ID = *f.CategoryID
 	}
 
 	if f.Disabled != nil {
-		feed.Disabled = *f.Disabled
+		if *f.Disabled {
+			feed.Disabled = true
+		} else {
+			feed.Enable()
+		}
 	}
 }
 
 type userModification struct {
-	Username       *string `json:"username"`
-	Password       *string `json:"password"`
-	IsAdmin        *bool   `json:"is_admin"`
-	Theme          *string `json:"theme"`
-	Language       *string `json:"language"`
-	Timezone       *string `json:"timezone"`
-	EntryDirection *string `json:"entry_sorting_direction"`
-	EntriesPerPage *int    `json:"entries_per_page"`
+	Username         *string `json:"username"`
+	Password         *string `json:"password"`
+	IsAdmin          *bool   `json:"is_admin"`
+	Theme            *string `json:"theme"`
+	Language         *string `json:"language"`
+	Timezone         *string `json:"timezone"`
+	EntryDirection   *string `json:"entry_sorting_direction"`
+	EntriesPerPage   *int    `json:"entries_per_page"`
+	MarkReadOnScroll *bool   `json:"mark_read_on_scroll"`
 }
 
 func (u *userModification) Update(user *model.User) {
@@ -144,6 +253,10 @@ func (u *userModification) Update(user *model.User) {
 	if u.EntriesPerPage != nil {
 		user.EntriesPerPage = *u.EntriesPerPage
 	}
+
+	if u.MarkReadOnScroll != nil {
+		user.MarkReadOnScroll = *u.MarkReadOnScroll
+	}
 }
 
 func decodeUserModificationPayload(r io.ReadCloser) (*userModification, error) {
@@ -198,6 +311,61 @@ func decodeEntryStatusPayload(r io.ReadCloser) ([]int64, string, error) {
 	return p.EntryIDs, p.Status, nil
 }
 
+type entriesReadRequest struct {
+	EntryIDs []int64 `json:"entry_ids"`
+	Source   string  `json:"source"`
+}
+
+func decodeEntriesReadPayload(r io.ReadCloser) (*entriesReadRequest, error) {
+	defer r.Close()
+
+	var p entriesReadRequest
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return &p, nil
+}
+
+func decodeSavedSearchPayload(r io.ReadCloser) (*model.SavedSearch, error) {
+	defer r.Close()
+
+	var search model.SavedSearch
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&search); err != nil {
+		return nil, fmt.Errorf("Unable to decode saved search JSON object: %v", err)
+	}
+
+	return &search, nil
+}
+
+func decodeOrderingPayload(r io.ReadCloser, key string) ([]int64, error) {
+	var p map[string][]int64
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return p[key], nil
+}
+
+func decodeEntryTagsPayload(r io.ReadCloser) ([]string, error) {
+	type payload struct {
+		Tags []string `json:"tags"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return p.Tags, nil
+}
+
 func decodeFeedCreationPayload(r io.ReadCloser) (*feedCreation, error) {
 	defer r.Close()
 
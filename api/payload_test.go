@@ -217,3 +217,146 @@ func TestUserThemeWhenNotSet(t *testing.T) {
 		t.Fatal(`The user Theme should not be modified`)
 	}
 }
+
+func TestUpdateFeedMaxEntries(t *testing.T) {
+	maxEntries := 100
+	changes := &feedModification{MaxEntries: &maxEntries}
+	feed := &model.Feed{MaxEntries: 0}
+	changes.Update(feed)
+
+	if feed.MaxEntries != maxEntries {
+		t.Fatalf(`Unexpected value, got %d instead of %d`, feed.MaxEntries, maxEntries)
+	}
+}
+
+func TestUpdateFeedMaxEntriesWithNegativeValue(t *testing.T) {
+	maxEntries := -1
+	changes := &feedModification{MaxEntries: &maxEntries}
+	feed := &model.Feed{MaxEntries: 100}
+	changes.Update(feed)
+
+	if feed.MaxEntries != 100 {
+		t.Fatal(`The MaxEntries should not be modified`)
+	}
+}
+
+func TestUpdateFeedCookie(t *testing.T) {
+	cookie := "session=value"
+	changes := &feedModification{Cookie: &cookie}
+	feed := &model.Feed{Cookie: "session=old"}
+	changes.Update(feed)
+
+	if feed.Cookie != cookie {
+		t.Fatalf(`Unexpected value, got %q instead of %q`, feed.Cookie, cookie)
+	}
+}
+
+func TestUpdateFeedCookieWhenNotSet(t *testing.T) {
+	changes := &feedModification{}
+	feed := &model.Feed{Cookie: "session=old"}
+	changes.Update(feed)
+
+	if feed.Cookie != "session=old" {
+		t.Fatal(`The Cookie should not be modified`)
+	}
+}
+
+func TestUpdateFeedRequestTimeout(t *testing.T) {
+	requestTimeout := 60
+	changes := &feedModification{RequestTimeout: &requestTimeout}
+	feed := &model.Feed{RequestTimeout: 0}
+	changes.Update(feed)
+
+	if feed.RequestTimeout != requestTimeout {
+		t.Fatalf(`Unexpected value, got %d instead of %d`, feed.RequestTimeout, requestTimeout)
+	}
+}
+
+func TestUpdateFeedRequestTimeoutWithNegativeValue(t *testing.T) {
+	requestTimeout := -1
+	changes := &feedModification{RequestTimeout: &requestTimeout}
+	feed := &model.Feed{RequestTimeout: 30}
+	changes.Update(feed)
+
+	if feed.RequestTimeout != 30 {
+		t.Fatal(`The RequestTimeout should not be modified`)
+	}
+}
+
+func TestUpdateFeedDateLayouts(t *testing.T) {
+	dateLayouts := "02.01.2006 um 15:04"
+	changes := &feedModification{DateLayouts: &dateLayouts}
+	feed := &model.Feed{DateLayouts: "2006"}
+	changes.Update(feed)
+
+	if feed.DateLayouts != dateLayouts {
+		t.Fatalf(`Unexpected value, got %q instead of %q`, feed.DateLayouts, dateLayouts)
+	}
+}
+
+func TestUpdateFeedAuthScheme(t *testing.T) {
+	authScheme := model.AuthSchemeBearer
+	authToken := "secret"
+	changes := &feedModification{AuthScheme: &authScheme, AuthToken: &authToken}
+	feed := &model.Feed{AuthScheme: model.AuthSchemeBasic}
+	changes.Update(feed)
+
+	if feed.AuthScheme != model.AuthSchemeBearer {
+		t.Fatalf(`Unexpected value, got %q instead of %q`, feed.AuthScheme, model.AuthSchemeBearer)
+	}
+
+	if feed.AuthToken != authToken {
+		t.Fatalf(`Unexpected value, got %q instead of %q`, feed.AuthToken, authToken)
+	}
+}
+
+func TestUpdateFeedWithInvalidAuthScheme(t *testing.T) {
+	authScheme := "digest"
+	changes := &feedModification{AuthScheme: &authScheme}
+	feed := &model.Feed{AuthScheme: model.AuthSchemeBasic}
+	changes.Update(feed)
+
+	if feed.AuthScheme != model.AuthSchemeBasic {
+		t.Fatalf(`An invalid scheme should be ignored, got %q`, feed.AuthScheme)
+	}
+}
+
+func TestUpdateFeedIconURL(t *testing.T) {
+	iconURL := "https://example.org/favicon.ico"
+	changes := &feedModification{IconURL: &iconURL}
+	feed := &model.Feed{}
+	changes.Update(feed)
+
+	if feed.IconURL != iconURL {
+		t.Fatalf(`Unexpected value, got %q instead of %q`, feed.IconURL, iconURL)
+	}
+
+	iconURL = ""
+	changes.Update(feed)
+
+	if feed.IconURL != "" {
+		t.Fatalf(`An empty value should restore the icon discovery, got %q`, feed.IconURL)
+	}
+}
+
+func TestUpdateFeedWithInvalidIconURL(t *testing.T) {
+	iconURL := "/favicon.ico"
+	changes := &feedModification{IconURL: &iconURL}
+	feed := &model.Feed{IconURL: "https://example.org/favicon.ico"}
+	changes.Update(feed)
+
+	if feed.IconURL != "https://example.org/favicon.ico" {
+		t.Fatalf(`An invalid icon URL should be ignored, got %q`, feed.IconURL)
+	}
+}
+
+func TestUpdateFeedSkipDuplicateGUIDs(t *testing.T) {
+	skipDuplicateGUIDs := true
+	changes := &feedModification{SkipDuplicateGUIDs: &skipDuplicateGUIDs}
+	feed := &model.Feed{}
+	changes.Update(feed)
+
+	if !feed.SkipDuplicateGUIDs {
+		t.Fatal(`The SkipDuplicateGUIDs option should be enabled`)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiter allows a fixed number of requests per user during each time window.
+type rateLimiter struct {
+	mu      sync.Mutex
+	limit   int
+	window  time.Duration
+	windows map[int64]*rateLimitWindow
+}
+
+type rateLimitWindow struct {
+	start time.Time
+	count int
+}
+
+// newRateLimiter returns a rate limiter, a limit of 0 means unlimited.
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:   limit,
+		window:  window,
+		windows: make(map[int64]*rateLimitWindow),
+	}
+}
+
+func (l *rateLimiter) allow(userID int64) bool {
+	return l.allowAt(userID, time.Now())
+}
+
+func (l *rateLimiter) allowAt(userID int64, now time.Time) bool {
+	if l.limit <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w, found := l.windows[userID]
+	if !found || now.Sub(w.start) >= l.window {
+		l.windows[userID] = &rateLimitWindow{start: now, count: 1}
+		return true
+	}
+
+	if w.count >= l.limit {
+		return false
+	}
+
+	w.count++
+	return true
+}
@@ -0,0 +1,40 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(2, time.Minute)
+	now := time.Now()
+
+	if !limiter.allowAt(1, now) || !limiter.allowAt(1, now.Add(time.Second)) {
+		t.Fatal(`The first requests should be allowed`)
+	}
+
+	if limiter.allowAt(1, now.Add(2*time.Second)) {
+		t.Error(`The request should be rejected once the limit is reached`)
+	}
+
+	if !limiter.allowAt(2, now.Add(2*time.Second)) {
+		t.Error(`The limit should be applied per user`)
+	}
+
+	if !limiter.allowAt(1, now.Add(time.Minute)) {
+		t.Error(`The request should be allowed in a new time window`)
+	}
+}
+
+func TestRateLimiterWithoutLimit(t *testing.T) {
+	limiter := newRateLimiter(0, time.Minute)
+	for i := 0; i < 100; i++ {
+		if !limiter.allow(1) {
+			t.Fatal(`A limit of 0 should not reject any request`)
+		}
+	}
+}
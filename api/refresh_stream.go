@@ -0,0 +1,100 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/http/response/sse"
+	"miniflux.app/logger"
+	"miniflux.app/worker"
+)
+
+// Events sent while refreshing the feeds.
+const (
+	refreshStartEvent  = "start"
+	refreshFinishEvent = "finish"
+	refreshDoneEvent   = "done"
+)
+
+type refreshEvent struct {
+	FeedID int64  `json:"feed_id"`
+	Error  string `json:"error,omitempty"`
+}
+
+type refreshSummary struct {
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+}
+
+// streamRefreshAllFeeds refreshes the feeds of the user with the worker pool and reports the progress with Server-Sent Events.
+// The feeds not refreshed yet are skipped when the client disconnects.
+func (h *handler) streamRefreshAllFeeds(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	jobs, err := h.store.NewUserBatch(userID, h.store.CountFeeds(userID))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	// The refresh of all the feeds may take longer than the write timeout of the server.
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+		logger.Debug("[API:StreamRefreshAllFeeds] User #%d: unable to clear the write deadline: %v", userID, err)
+	}
+
+	stream, err := sse.NewStream(w)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if err := streamRefresh(r.Context(), len(jobs), h.pool.Refresh(r.Context(), jobs), stream.Send); err != nil {
+		logger.Debug("[API:StreamRefreshAllFeeds] User #%d: refresh interrupted: %v", userID, err)
+	}
+}
+
+// streamRefresh sends the progress events of the given number of jobs, in the order the workers report them.
+func streamRefresh(ctx context.Context, count int, events <-chan worker.RefreshEvent, send func(event string, data interface{}) error) error {
+	summary := &refreshSummary{}
+
+	for count > 0 {
+		var event worker.RefreshEvent
+		select {
+		case event = <-events:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if event.Started {
+			if err := send(refreshStartEvent, &refreshEvent{FeedID: event.Job.FeedID}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		count--
+		finishEvent := &refreshEvent{FeedID: event.Job.FeedID}
+		if event.Err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+
+			finishEvent.Error = event.Err.Error()
+			summary.Failed++
+		} else {
+			summary.Succeeded++
+		}
+
+		if err := send(refreshFinishEvent, finishEvent); err != nil {
+			return err
+		}
+	}
+
+	return send(refreshDoneEvent, summary)
+}
@@ -0,0 +1,107 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"miniflux.app/model"
+	"miniflux.app/worker"
+)
+
+type recordedEvent struct {
+	name string
+	data interface{}
+}
+
+func newRefreshEvents(events ...worker.RefreshEvent) <-chan worker.RefreshEvent {
+	c := make(chan worker.RefreshEvent, len(events))
+	for _, event := range events {
+		c <- event
+	}
+	return c
+}
+
+func TestStreamRefresh(t *testing.T) {
+	job10 := model.Job{UserID: 1, FeedID: 10}
+	job20 := model.Job{UserID: 1, FeedID: 20}
+
+	// The feeds are refreshed concurrently, the events are sent in the order the workers report them.
+	events := newRefreshEvents(
+		worker.RefreshEvent{Job: job10, Started: true},
+		worker.RefreshEvent{Job: job20, Started: true},
+		worker.RefreshEvent{Job: job20, Err: errors.New("feed not found")},
+		worker.RefreshEvent{Job: job10},
+	)
+
+	var recorded []recordedEvent
+	send := func(event string, data interface{}) error {
+		recorded = append(recorded, recordedEvent{event, data})
+		return nil
+	}
+
+	if err := streamRefresh(context.Background(), 2, events, send); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []recordedEvent{
+		{refreshStartEvent, &refreshEvent{FeedID: 10}},
+		{refreshStartEvent, &refreshEvent{FeedID: 20}},
+		{refreshFinishEvent, &refreshEvent{FeedID: 20, Error: "feed not found"}},
+		{refreshFinishEvent, &refreshEvent{FeedID: 10}},
+		{refreshDoneEvent, &refreshSummary{Succeeded: 1, Failed: 1}},
+	}
+
+	if !reflect.DeepEqual(recorded, expected) {
+		t.Errorf(`Unexpected events, got %+v instead of %+v`, recorded, expected)
+	}
+}
+
+func TestStreamRefreshStopsWhenContextIsCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	job := model.Job{UserID: 1, FeedID: 10}
+	events := newRefreshEvents(worker.RefreshEvent{Job: job, Err: context.Canceled})
+
+	var recorded []string
+	send := func(event string, data interface{}) error {
+		recorded = append(recorded, event)
+		return nil
+	}
+
+	if err := streamRefresh(ctx, 2, events, send); err != context.Canceled {
+		t.Errorf(`The cancellation error should be returned, got %v`, err)
+	}
+
+	if len(recorded) != 0 {
+		t.Errorf(`No event should be sent after the cancellation: %v`, recorded)
+	}
+}
+
+func TestStreamRefreshStopsWhenClientIsGone(t *testing.T) {
+	events := newRefreshEvents(
+		worker.RefreshEvent{Job: model.Job{UserID: 1, FeedID: 10}, Started: true},
+		worker.RefreshEvent{Job: model.Job{UserID: 1, FeedID: 10}},
+	)
+
+	sendCount := 0
+	sendErr := errors.New("broken pipe")
+	send := func(event string, data interface{}) error {
+		sendCount++
+		return sendErr
+	}
+
+	if err := streamRefresh(context.Background(), 1, events, send); err != sendErr {
+		t.Errorf(`The write error should be returned, got %v`, err)
+	}
+
+	if sendCount != 1 {
+		t.Errorf(`The stream should stop after the first write error, got %d writes`, sendCount)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) createSavedSearch(w http.ResponseWriter, r *http.Request) {
+	search, err := decodeSavedSearchPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	search.UserID = request.UserID(r)
+	if err := h.validateSavedSearch(search); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.CreateSavedSearch(search); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, search)
+}
+
+func (h *handler) updateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	searchID := request.RouteInt64Param(r, "searchID")
+
+	originalSearch, err := h.store.SavedSearch(userID, searchID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if originalSearch == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	search, err := decodeSavedSearchPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	search.ID = searchID
+	search.UserID = userID
+	if err := h.validateSavedSearch(search); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.UpdateSavedSearch(search); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, search)
+}
+
+func (h *handler) getSavedSearches(w http.ResponseWriter, r *http.Request) {
+	searches, err := h.store.SavedSearches(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, searches)
+}
+
+func (h *handler) getSavedSearch(w http.ResponseWriter, r *http.Request) {
+	search, err := h.store.SavedSearch(request.UserID(r), request.RouteInt64Param(r, "searchID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if search == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, search)
+}
+
+func (h *handler) removeSavedSearch(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	searchID := request.RouteInt64Param(r, "searchID")
+
+	search, err := h.store.SavedSearch(userID, searchID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if search == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveSavedSearch(userID, searchID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) getSavedSearchEntries(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	search, err := h.store.SavedSearch(userID, request.RouteInt64Param(r, "searchID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if search == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	order := request.QueryStringParam(r, "order", model.DefaultSortingOrder)
+	if err := model.ValidateEntryOrder(order); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	direction := request.QueryStringParam(r, "direction", model.DefaultSortingDirection)
+	if err := model.ValidateDirection(direction); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+	if err := model.ValidateRange(offset, limit); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithOrder(order)
+	builder.WithDirection(direction)
+	builder.WithOffset(offset)
+	builder.WithLimit(limit)
+	builder.WithSavedSearch(search)
+	builder.WithSearchSnippet(config.Opts.SearchSnippetLength())
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	count, err := builder.CountEntries()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &entriesResponse{Total: count, Entries: entries})
+}
+
+func (h *handler) validateSavedSearch(search *model.SavedSearch) error {
+	if err := search.ValidateSavedSearch(); err != nil {
+		return err
+	}
+
+	if h.store.AnotherSavedSearchExists(search.UserID, search.ID, search.Title) {
+		return errors.New("This saved search already exists")
+	}
+
+	if search.FeedID > 0 && !h.store.FeedExists(search.UserID, search.FeedID) {
+		return errors.New("This feed does not exist")
+	}
+
+	if search.CategoryID > 0 && !h.store.CategoryExists(search.UserID, search.CategoryID) {
+		return errors.New("This category does not exist")
+	}
+
+	return nil
+}
@@ -21,6 +21,7 @@ func (h *handler) getSubscriptions(w http.ResponseWriter, r *http.Request) {
 	subscriptions, finderErr := subscription.FindSubscriptions(
 		subscriptionInfo.URL,
 		subscriptionInfo.UserAgent,
+		subscriptionInfo.Cookie,
 		subscriptionInfo.Username,
 		subscriptionInfo.Password,
 	)
@@ -0,0 +1,120 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) getTags(w http.ResponseWriter, r *http.Request) {
+	tags, err := h.store.Tags(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, tags)
+}
+
+func (h *handler) removeTag(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	tagID := request.RouteInt64Param(r, "tagID")
+
+	if !h.store.TagExists(userID, tagID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveTag(userID, tagID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) getEntryTags(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	if !h.entryExists(userID, entryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	tags, err := h.store.EntryTags(userID, entryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, tags)
+}
+
+func (h *handler) addEntryTags(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+
+	names, err := decodeEntryTagsPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	names, err = model.NormalizeTagNames(names)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if !h.entryExists(userID, entryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.AddEntryTags(userID, entryID, names); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	tags, err := h.store.EntryTags(userID, entryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, tags)
+}
+
+func (h *handler) removeEntryTag(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+	tagID := request.RouteInt64Param(r, "tagID")
+
+	if !h.entryExists(userID, entryID) || !h.store.TagExists(userID, tagID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveEntryTag(userID, entryID, tagID); err != nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) entryExists(userID, entryID int64) bool {
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithEntryID(entryID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	count, err := builder.CountEntries()
+	return err == nil && count == 1
+}
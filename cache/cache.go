@@ -0,0 +1,351 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import (
+	"bufio"
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/logger"
+)
+
+var keyRegex = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+// keyLocks is the number of locks shared by the keys to serialize the access to the files.
+const keyLocks = 256
+
+// Media is a cached response of the proxy.
+type Media struct {
+	ContentType string    `json:"content_type"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	Body        []byte    `json:"-"`
+}
+
+type item struct {
+	key       string
+	size      int64
+	expiresAt time.Time
+}
+
+// Cache is an on-disk cache that removes the least recently used media when its size exceeds the limit.
+// Each media is stored in a file named after its key: a line of JSON metadata followed by the body.
+//
+// The mutex of the cache only protects the index, the files are read and written under the lock of their key.
+// The lock of a key is always acquired before the mutex of the cache.
+type Cache struct {
+	mu        sync.Mutex
+	keyLocks  [keyLocks]sync.Mutex
+	directory string
+	maxSize   int64
+	size      int64
+	items     map[string]*list.Element
+	lru       *list.List
+}
+
+// New returns a cache stored in the given directory, the media already stored are loaded.
+func New(directory string, maxSize int64) (*Cache, error) {
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, fmt.Errorf("cache: unable to create directory: %v", err)
+	}
+
+	c := &Cache{
+		directory: directory,
+		maxSize:   maxSize,
+		items:     make(map[string]*list.Element),
+		lru:       list.New(),
+	}
+
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Get returns the media stored with this key, expired media are removed.
+func (c *Cache) Get(key string) (*Media, bool) {
+	lock := c.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	c.mu.Lock()
+	element, found := c.items[key]
+	expired := found && time.Now().After(element.Value.(*item).expiresAt)
+	if expired {
+		c.unindex(element)
+	}
+	c.mu.Unlock()
+
+	if !found {
+		return nil, false
+	}
+
+	if expired {
+		c.deleteFile(key)
+		return nil, false
+	}
+
+	media, err := c.read(key)
+
+	c.mu.Lock()
+	if err != nil {
+		c.unindex(element)
+	} else {
+		c.lru.MoveToFront(element)
+	}
+	c.mu.Unlock()
+
+	if err != nil {
+		logger.Error("[Cache] %v", err)
+		c.deleteFile(key)
+		return nil, false
+	}
+
+	return media, true
+}
+
+// Set stores a media for the given duration.
+// The least recently used media are removed when the cache is full.
+func (c *Cache) Set(key, contentType string, body []byte, ttl time.Duration) error {
+	if !keyRegex.MatchString(key) {
+		return fmt.Errorf("cache: invalid key %q", key)
+	}
+
+	metadata, err := json.Marshal(&Media{ContentType: contentType, ExpiresAt: time.Now().Add(ttl)})
+	if err != nil {
+		return fmt.Errorf("cache: unable to encode metadata: %v", err)
+	}
+
+	size := int64(len(metadata) + 1 + len(body))
+	if size > c.maxSize {
+		return nil
+	}
+
+	evictedKeys, err := c.write(key, append(append(metadata, '\n'), body...), &item{key: key, size: size, expiresAt: time.Now().Add(ttl)})
+	if err != nil {
+		return err
+	}
+
+	// The evicted files are removed after releasing the lock of the key because they may share the same lock.
+	for _, evictedKey := range evictedKeys {
+		c.removeFile(evictedKey)
+	}
+
+	return nil
+}
+
+// Size returns the number of bytes used by the cache.
+func (c *Cache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// write stores the file of the media and indexes it, the keys of the evicted media are returned.
+func (c *Cache) write(key string, data []byte, i *item) ([]string, error) {
+	lock := c.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	// The media is written to a temporary file to never serve a partial file.
+	tmpFile, err := ioutil.TempFile(c.directory, "tmp-")
+	if err != nil {
+		return nil, fmt.Errorf("cache: unable to create file: %v", err)
+	}
+
+	_, err = tmpFile.Write(data)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(tmpFile.Name(), c.path(key))
+	}
+
+	if err != nil {
+		os.Remove(tmpFile.Name())
+		return nil, fmt.Errorf("cache: unable to write media: %v", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, found := c.items[key]; found {
+		c.unindex(element)
+	}
+
+	c.add(i)
+	return c.evict(), nil
+}
+
+func (c *Cache) keyLock(key string) *sync.Mutex {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return &c.keyLocks[h.Sum32()%keyLocks]
+}
+
+func (c *Cache) add(i *item) {
+	c.items[i.key] = c.lru.PushFront(i)
+	c.size += i.size
+}
+
+func (c *Cache) unindex(element *list.Element) {
+	i := element.Value.(*item)
+	c.lru.Remove(element)
+	delete(c.items, i.key)
+	c.size -= i.size
+}
+
+// evict removes the least recently used media from the index until the cache fits in its maximum size.
+// The keys are returned so their files can be deleted without holding the mutex of the cache.
+func (c *Cache) evict() []string {
+	var keys []string
+	for c.size > c.maxSize && c.lru.Len() > 0 {
+		element := c.lru.Back()
+		keys = append(keys, element.Value.(*item).key)
+		c.unindex(element)
+	}
+	return keys
+}
+
+// removeFile deletes the file of an evicted media, unless the media has been stored again in the meantime.
+func (c *Cache) removeFile(key string) {
+	lock := c.keyLock(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	c.mu.Lock()
+	_, indexed := c.items[key]
+	c.mu.Unlock()
+
+	if !indexed {
+		c.deleteFile(key)
+	}
+}
+
+// deleteFile must be called with the lock of the key.
+func (c *Cache) deleteFile(key string) {
+	if err := os.Remove(c.path(key)); err != nil && !os.IsNotExist(err) {
+		logger.Error("[Cache] Unable to remove %q: %v", key, err)
+	}
+}
+
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.directory, key)
+}
+
+func (c *Cache) read(key string) (*Media, error) {
+	data, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return nil, fmt.Errorf("cache: unable to read media: %v", err)
+	}
+
+	index := strings.IndexByte(string(data), '\n')
+	if index == -1 {
+		return nil, fmt.Errorf("cache: invalid media file %q", key)
+	}
+
+	var media Media
+	if err := json.Unmarshal(data[:index], &media); err != nil {
+		return nil, fmt.Errorf("cache: invalid media metadata %q: %v", key, err)
+	}
+
+	media.Body = data[index+1:]
+	return &media, nil
+}
+
+// load indexes the media stored in the directory, the most recently modified files are considered as the most recently used.
+func (c *Cache) load() error {
+	files, err := ioutil.ReadDir(c.directory)
+	if err != nil {
+		return fmt.Errorf("cache: unable to read directory: %v", err)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+
+	for _, file := range files {
+		name := file.Name()
+		if !keyRegex.MatchString(name) {
+			if strings.HasPrefix(name, "tmp-") {
+				os.Remove(c.path(name))
+			}
+			continue
+		}
+
+		expiresAt, err := readExpiration(c.path(name))
+		if err != nil || time.Now().After(expiresAt) {
+			os.Remove(c.path(name))
+			continue
+		}
+
+		c.add(&item{key: name, size: file.Size(), expiresAt: expiresAt})
+	}
+
+	for _, key := range c.evict() {
+		c.deleteFile(key)
+	}
+
+	return nil
+}
+
+func readExpiration(filename string) (time.Time, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer f.Close()
+
+	line, err := bufio.NewReader(f).ReadBytes('\n')
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var media Media
+	if err := json.Unmarshal(line, &media); err != nil {
+		return time.Time{}, err
+	}
+
+	return media.ExpiresAt, nil
+}
+
+// TTL returns how long a response can be cached according to its Cache-Control header,
+// the duration is never longer than the maximum TTL. The response must not be cached when the duration is zero.
+func TTL(cacheControl string, maxTTL time.Duration) time.Duration {
+	ttl := maxTTL
+
+	for _, directive := range strings.Split(cacheControl, ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+
+		switch {
+		case directive == "no-store", directive == "no-cache", directive == "private":
+			return 0
+		case strings.HasPrefix(directive, "max-age="):
+			seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+			if err != nil || seconds <= 0 {
+				return 0
+			}
+
+			if maxAge := time.Duration(seconds) * time.Second; maxAge < ttl {
+				ttl = maxAge
+			}
+		}
+	}
+
+	return ttl
+}
@@ -0,0 +1,210 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cache // import "miniflux.app/cache"
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"miniflux.app/crypto"
+)
+
+func newTestCache(t *testing.T, maxSize int64) (*Cache, string) {
+	directory, err := ioutil.TempDir("", "miniflux-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := New(directory, maxSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c, directory
+}
+
+func TestSetAndGet(t *testing.T) {
+	c, directory := newTestCache(t, 1024*1024)
+	defer os.RemoveAll(directory)
+
+	key := crypto.Hash("https://example.org/image.png")
+	if err := c.Set(key, "image/png", []byte("image"), time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	media, found := c.Get(key)
+	if !found {
+		t.Fatal(`The media should be cached`)
+	}
+
+	if media.ContentType != "image/png" || string(media.Body) != "image" {
+		t.Errorf(`Unexpected media, got %q with body %q`, media.ContentType, media.Body)
+	}
+
+	if _, found := c.Get(crypto.Hash("https://example.org/other.png")); found {
+		t.Error(`An unknown key should not be found`)
+	}
+}
+
+func TestSetWithInvalidKey(t *testing.T) {
+	c, directory := newTestCache(t, 1024*1024)
+	defer os.RemoveAll(directory)
+
+	if err := c.Set("../passwd", "image/png", []byte("image"), time.Hour); err == nil {
+		t.Error(`An invalid key should return an error`)
+	}
+}
+
+func TestExpiredMedia(t *testing.T) {
+	c, directory := newTestCache(t, 1024*1024)
+	defer os.RemoveAll(directory)
+
+	key := crypto.Hash("https://example.org/image.png")
+	if err := c.Set(key, "image/png", []byte("image"), -time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, found := c.Get(key); found {
+		t.Error(`An expired media should not be returned`)
+	}
+
+	if c.Size() != 0 {
+		t.Errorf(`An expired media should be removed, the size is %d`, c.Size())
+	}
+}
+
+func TestLeastRecentlyUsedMediaAreRemoved(t *testing.T) {
+	c, directory := newTestCache(t, 250)
+	defer os.RemoveAll(directory)
+
+	body := make([]byte, 50)
+	keys := []string{crypto.Hash("a"), crypto.Hash("b"), crypto.Hash("c")}
+
+	c.Set(keys[0], "image/png", body, time.Hour)
+	c.Set(keys[1], "image/png", body, time.Hour)
+
+	// The first media becomes the most recently used.
+	c.Get(keys[0])
+	c.Set(keys[2], "image/png", body, time.Hour)
+
+	if _, found := c.Get(keys[1]); found {
+		t.Error(`The least recently used media should be removed`)
+	}
+
+	for _, key := range []string{keys[0], keys[2]} {
+		if _, found := c.Get(key); !found {
+			t.Errorf(`The media %q should be cached`, key)
+		}
+	}
+
+	if c.Size() > 250 {
+		t.Errorf(`The cache should not exceed its maximum size, got %d`, c.Size())
+	}
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	c, directory := newTestCache(t, 2000)
+	defer os.RemoveAll(directory)
+
+	body := make([]byte, 100)
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				key := crypto.Hash(fmt.Sprintf("%d", (i+j)%30))
+				c.Set(key, "image/png", body, time.Hour)
+				if media, found := c.Get(key); found && len(media.Body) != len(body) {
+					t.Errorf(`Unexpected body length %d`, len(media.Body))
+				}
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	files, err := ioutil.ReadDir(directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var size int64
+	for _, file := range files {
+		size += file.Size()
+	}
+
+	if size != c.Size() {
+		t.Errorf(`The size of the files should be %d, got %d`, c.Size(), size)
+	}
+
+	if c.Size() > 2000 {
+		t.Errorf(`The cache should not exceed its maximum size, got %d`, c.Size())
+	}
+}
+
+func TestMediaLargerThanCacheAreIgnored(t *testing.T) {
+	c, directory := newTestCache(t, 10)
+	defer os.RemoveAll(directory)
+
+	key := crypto.Hash("https://example.org/image.png")
+	if err := c.Set(key, "image/png", make([]byte, 100), time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, found := c.Get(key); found {
+		t.Error(`A media larger than the cache should not be stored`)
+	}
+}
+
+func TestLoadExistingMedia(t *testing.T) {
+	c, directory := newTestCache(t, 1024*1024)
+	defer os.RemoveAll(directory)
+
+	key := crypto.Hash("https://example.org/image.png")
+	c.Set(key, "image/png", []byte("image"), time.Hour)
+	c.Set(crypto.Hash("expired"), "image/png", []byte("image"), -time.Second)
+
+	c, err := New(directory, 1024*1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	media, found := c.Get(key)
+	if !found || string(media.Body) != "image" {
+		t.Fatal(`The media stored on disk should be loaded`)
+	}
+
+	if _, found := c.Get(crypto.Hash("expired")); found {
+		t.Error(`The expired media should not be loaded`)
+	}
+}
+
+func TestTTL(t *testing.T) {
+	maxTTL := 72 * time.Hour
+	scenarios := map[string]time.Duration{
+		"":                                 maxTTL,
+		"public":                           maxTTL,
+		"public, max-age=3600":             time.Hour,
+		"max-age=31536000":                 maxTTL,
+		"max-age=0":                        0,
+		"max-age=invalid":                  0,
+		"no-store":                         0,
+		"No-Cache":                         0,
+		"private, max-age=3600":            0,
+		"public, s-maxage=60, max-age=120": 2 * time.Minute,
+	}
+
+	for cacheControl, expected := range scenarios {
+		if result := TTL(cacheControl, maxTTL); result != expected {
+			t.Errorf(`Unexpected TTL for %q, got %v instead of %v`, cacheControl, result, expected)
+		}
+	}
+}
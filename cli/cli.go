@@ -11,6 +11,7 @@ import (
 	"miniflux.app/config"
 	"miniflux.app/database"
 	"miniflux.app/logger"
+	"miniflux.app/reader/scraper"
 	"miniflux.app/storage"
 	"miniflux.app/version"
 )
@@ -82,6 +83,10 @@ func Parse() {
 		logger.EnableDateTime()
 	}
 
+	if config.Opts.LogFormat() == "json" {
+		logger.EnableJSONFormat()
+	}
+
 	if flagDebugMode || config.Opts.HasDebugMode() {
 		logger.EnableDebug()
 	}
@@ -151,5 +156,11 @@ func Parse() {
 		createAdmin(store)
 	}
 
+	if config.Opts.ScraperRulesFile() != "" {
+		if err := scraper.LoadRules(config.Opts.ScraperRulesFile()); err != nil {
+			logger.Fatal("%v", err)
+		}
+	}
+
 	startDaemon(store)
 }
@@ -30,7 +30,7 @@ func startDaemon(store *storage.Storage) {
 	signal.Notify(stop, syscall.SIGTERM)
 
 	feedHandler := feed.NewFeedHandler(store)
-	pool := worker.NewPool(feedHandler, config.Opts.WorkerPoolSize())
+	pool := worker.NewPool(feedHandler, config.Opts.WorkerPoolSize(), config.Opts.PollingPerHostLimit())
 
 	go showProcessStatistics()
 
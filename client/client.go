@@ -207,11 +207,130 @@ func (c *Client) UpdateCategory(categoryID int64, title string) (*Category, erro
 	return category, nil
 }
 
+// UpdateCategorySettings replaces all the settings of a category, including its notification targets.
+func (c *Client) UpdateCategorySettings(categoryID int64, category *Category) (*Category, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/categories/%d", categoryID), category)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result *Category
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result, nil
+}
+
 // DeleteCategory removes a category.
 func (c *Client) DeleteCategory(categoryID int64) error {
 	return c.request.Delete(fmt.Sprintf("/v1/categories/%d", categoryID))
 }
 
+// ReorderCategories sets the position of the categories, the other categories are placed after.
+func (c *Client) ReorderCategories(categoryIDs []int64) error {
+	type payload struct {
+		CategoryIDs []int64 `json:"category_ids"`
+	}
+
+	_, err := c.request.Put("/v1/categories/order", &payload{CategoryIDs: categoryIDs})
+	return err
+}
+
+// SavedSearches gets the list of saved searches.
+func (c *Client) SavedSearches() (SavedSearches, error) {
+	body, err := c.request.Get("/v1/saved-searches")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var searches SavedSearches
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&searches); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return searches, nil
+}
+
+// SavedSearch gets a saved search.
+func (c *Client) SavedSearch(searchID int64) (*SavedSearch, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/saved-searches/%d", searchID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var search *SavedSearch
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&search); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return search, nil
+}
+
+// CreateSavedSearch saves a new search.
+func (c *Client) CreateSavedSearch(search *SavedSearch) (*SavedSearch, error) {
+	body, err := c.request.Post("/v1/saved-searches", search)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result *SavedSearch
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result, nil
+}
+
+// UpdateSavedSearch replaces the title and the filters of a saved search.
+func (c *Client) UpdateSavedSearch(searchID int64, search *SavedSearch) (*SavedSearch, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/saved-searches/%d", searchID), search)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result *SavedSearch
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result, nil
+}
+
+// DeleteSavedSearch removes a saved search.
+func (c *Client) DeleteSavedSearch(searchID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/saved-searches/%d", searchID))
+}
+
+// SavedSearchEntries runs a saved search and returns the matching entries.
+func (c *Client) SavedSearchEntries(searchID int64, filter *Filter) (*EntryResultSet, error) {
+	path := buildFilterQueryString(fmt.Sprintf("/v1/saved-searches/%d/entries", searchID), filter)
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
 // Feeds gets all feeds.
 func (c *Client) Feeds() (Feeds, error) {
 	body, err := c.request.Get("/v1/feeds")
@@ -251,6 +370,70 @@ func (c *Client) Import(f io.ReadCloser) error {
 	return err
 }
 
+// ImportWithSummary imports an OPML file and returns the subscriptions created, skipped and failed.
+func (c *Client) ImportWithSummary(f io.ReadCloser) (*ImportSummary, error) {
+	body, err := c.request.PostFile("/v1/import", f)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var summary *ImportSummary
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&summary); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return summary, nil
+}
+
+// CreateImportJob imports an OPML file in the background, use ImportJob to follow the progress.
+func (c *Client) CreateImportJob(f io.ReadCloser) (*ImportJob, error) {
+	body, err := c.request.PostFile("/v1/import/jobs", f)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var job *ImportJob
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&job); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return job, nil
+}
+
+// ImportJob gets the progress of a background import.
+func (c *Client) ImportJob(jobID int64) (*ImportJob, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/import/jobs/%d", jobID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var job *ImportJob
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&job); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return job, nil
+}
+
+// ImportGoogleReader creates the feeds of a Google Reader JSON export and applies the read and starred state of the items.
+func (c *Client) ImportGoogleReader(f io.ReadCloser) error {
+	_, err := c.request.PostFile("/v1/import/google-reader", f)
+	return err
+}
+
+// SendTestNotification sends a sample message with the integration settings of the user.
+// The provider is "telegram", "discord", "webhook", "gotify", "ntfy" or "apprise", the error explains why the message was not sent.
+func (c *Client) SendTestNotification(provider string) error {
+	_, err := c.request.Post(fmt.Sprintf("/v1/integrations/%s/test", provider), nil)
+	return err
+}
+
 // Feed gets a feed.
 func (c *Client) Feed(feedID int64) (*Feed, error) {
 	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d", feedID))
@@ -321,6 +504,28 @@ func (c *Client) RefreshFeed(feedID int64) error {
 	return err
 }
 
+// ForceRefreshFeed refreshes a feed without using the HTTP cache.
+func (c *Client) ForceRefreshFeed(feedID int64) error {
+	_, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/refresh?force=true", feedID), nil)
+	return err
+}
+
+// ReprocessFeedEntries applies the current scraper and rewrite rules of a feed to its existing entries.
+func (c *Client) ReprocessFeedEntries(feedID int64) error {
+	_, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d/reprocess", feedID), nil)
+	return err
+}
+
+// ReorderFeeds sets the position of the feeds, the other feeds are placed after.
+func (c *Client) ReorderFeeds(feedIDs []int64) error {
+	type payload struct {
+		FeedIDs []int64 `json:"feed_ids"`
+	}
+
+	_, err := c.request.Put("/v1/feeds/order", &payload{FeedIDs: feedIDs})
+	return err
+}
+
 // DeleteFeed removes a feed.
 func (c *Client) DeleteFeed(feedID int64) error {
 	return c.request.Delete(fmt.Sprintf("/v1/feeds/%d", feedID))
@@ -343,6 +548,23 @@ func (c *Client) FeedIcon(feedID int64) (*FeedIcon, error) {
 	return feedIcon, nil
 }
 
+// RefreshFeedIcon downloads the icon of a feed again and returns the new icon.
+func (c *Client) RefreshFeedIcon(feedID int64) (*FeedIcon, error) {
+	body, err := c.request.Post(fmt.Sprintf("/v1/feeds/%d/icon/refresh", feedID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var feedIcon *FeedIcon
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&feedIcon); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return feedIcon, nil
+}
+
 // FeedEntry gets a single feed entry.
 func (c *Client) FeedEntry(feedID, entryID int64) (*Entry, error) {
 	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/entries/%d", feedID, entryID))
@@ -426,12 +648,121 @@ func (c *Client) UpdateEntries(entryIDs []int64, status string) error {
 	return err
 }
 
+// MarkEntriesAsRead marks a list of entries as read and returns the number of entries that were unread.
+// The source must be one of ReadSourceManual, ReadSourceView or ReadSourceScroll.
+func (c *Client) MarkEntriesAsRead(entryIDs []int64, source string) (int64, error) {
+	type payload struct {
+		EntryIDs []int64 `json:"entry_ids"`
+		Source   string  `json:"source"`
+	}
+
+	body, err := c.request.Put("/v1/entries/read", &payload{EntryIDs: entryIDs, Source: source})
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	var response struct {
+		Updated int64 `json:"updated"`
+	}
+
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&response); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return response.Updated, nil
+}
+
+// Tags gets the list of tags.
+func (c *Client) Tags() (Tags, error) {
+	body, err := c.request.Get("/v1/tags")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var tags Tags
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&tags); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return tags, nil
+}
+
+// DeleteTag removes a tag from all the entries.
+func (c *Client) DeleteTag(tagID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/tags/%d", tagID))
+}
+
+// EntryTags gets the tags of an entry.
+func (c *Client) EntryTags(entryID int64) (Tags, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/tags", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var tags Tags
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&tags); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return tags, nil
+}
+
+// AddEntryTags labels an entry with the given tags and returns all the tags of the entry.
+func (c *Client) AddEntryTags(entryID int64, names ...string) (Tags, error) {
+	body, err := c.request.Post(fmt.Sprintf("/v1/entries/%d/tags", entryID), map[string]interface{}{
+		"tags": names,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var tags Tags
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&tags); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return tags, nil
+}
+
+// RemoveEntryTag removes a tag from an entry.
+func (c *Client) RemoveEntryTag(entryID, tagID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/entries/%d/tags/%d", entryID, tagID))
+}
+
 // ToggleBookmark toggles entry bookmark value.
 func (c *Client) ToggleBookmark(entryID int64) error {
 	_, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/bookmark", entryID), nil)
 	return err
 }
 
+// FetchEntryContent downloads the original web page of an entry and returns its content without saving it.
+func (c *Client) FetchEntryContent(entryID int64) (string, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/fetch-content", entryID))
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	var result struct {
+		Content string `json:"content"`
+	}
+
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return "", fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result.Content, nil
+}
+
 func buildFilterQueryString(path string, filter *Filter) string {
 	if filter != nil {
 		values := url.Values{}
@@ -480,6 +811,18 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("search", filter.Search)
 		}
 
+		if filter.FeedID > 0 {
+			values.Set("feed_id", strconv.FormatInt(filter.FeedID, 10))
+		}
+
+		if filter.CategoryID > 0 {
+			values.Set("category_id", strconv.FormatInt(filter.CategoryID, 10))
+		}
+
+		if filter.Tag != "" {
+			values.Set("tag", filter.Tag)
+		}
+
 		path = fmt.Sprintf("%s?%s", path, values.Encode())
 	}
 
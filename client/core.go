@@ -16,19 +16,39 @@ const (
 	EntryStatusRemoved = "removed"
 )
 
+// Read sources, they describe why entries are marked as read.
+const (
+	ReadSourceManual = "manual"
+	ReadSourceView   = "view"
+	ReadSourceScroll = "scroll"
+)
+
+// Feed sort orders, an empty sort order uses the user preferences.
+const (
+	FeedSortOrderPublishedAsc  = "published-asc"
+	FeedSortOrderPublishedDesc = "published-desc"
+)
+
+// Author match modes of the feed author rules.
+const (
+	AuthorMatchExact     = "exact"
+	AuthorMatchSubstring = "substring"
+)
+
 // User represents a user in the system.
 type User struct {
-	ID             int64             `json:"id"`
-	Username       string            `json:"username"`
-	Password       string            `json:"password,omitempty"`
-	IsAdmin        bool              `json:"is_admin"`
-	Theme          string            `json:"theme"`
-	Language       string            `json:"language"`
-	Timezone       string            `json:"timezone"`
-	EntryDirection string            `json:"entry_sorting_direction"`
-	EntriesPerPage int               `json:"entries_per_page"`
-	LastLoginAt    *time.Time        `json:"last_login_at"`
-	Extra          map[string]string `json:"extra"`
+	ID               int64             `json:"id"`
+	Username         string            `json:"username"`
+	Password         string            `json:"password,omitempty"`
+	IsAdmin          bool              `json:"is_admin"`
+	Theme            string            `json:"theme"`
+	Language         string            `json:"language"`
+	Timezone         string            `json:"timezone"`
+	EntryDirection   string            `json:"entry_sorting_direction"`
+	EntriesPerPage   int               `json:"entries_per_page"`
+	MarkReadOnScroll bool              `json:"mark_read_on_scroll"`
+	LastLoginAt      *time.Time        `json:"last_login_at"`
+	Extra            map[string]string `json:"extra"`
 }
 
 func (u User) String() string {
@@ -37,14 +57,15 @@ func (u User) String() string {
 
 // UserModification is used to update a user.
 type UserModification struct {
-	Username       *string `json:"username"`
-	Password       *string `json:"password"`
-	IsAdmin        *bool   `json:"is_admin"`
-	Theme          *string `json:"theme"`
-	Language       *string `json:"language"`
-	Timezone       *string `json:"timezone"`
-	EntryDirection *string `json:"entry_sorting_direction"`
-	EntriesPerPage *int    `json:"entries_per_page"`
+	Username         *string `json:"username"`
+	Password         *string `json:"password"`
+	IsAdmin          *bool   `json:"is_admin"`
+	Theme            *string `json:"theme"`
+	Language         *string `json:"language"`
+	Timezone         *string `json:"timezone"`
+	EntryDirection   *string `json:"entry_sorting_direction"`
+	EntriesPerPage   *int    `json:"entries_per_page"`
+	MarkReadOnScroll *bool   `json:"mark_read_on_scroll"`
 }
 
 // Users represents a list of users.
@@ -52,9 +73,14 @@ type Users []User
 
 // Category represents a feed category.
 type Category struct {
-	ID     int64  `json:"id,omitempty"`
-	Title  string `json:"title,omitempty"`
-	UserID int64  `json:"user_id,omitempty"`
+	ID                int64  `json:"id,omitempty"`
+	Title             string `json:"title,omitempty"`
+	UserID            int64  `json:"user_id,omitempty"`
+	Crawler           bool   `json:"crawler"`
+	MarkReadAfterDays int    `json:"mark_read_after_days"`
+	TelegramChatID    string `json:"telegram_chat_id"`
+	WebhookURL        string `json:"webhook_url"`
+	Position          int    `json:"position"`
 }
 
 func (c Category) String() string {
@@ -64,6 +90,41 @@ func (c Category) String() string {
 // Categories represents a list of categories.
 type Categories []*Category
 
+// SavedSearch represents a named entry query.
+type SavedSearch struct {
+	ID          int64     `json:"id,omitempty"`
+	UserID      int64     `json:"user_id,omitempty"`
+	Title       string    `json:"title"`
+	SearchQuery string    `json:"search_query"`
+	FeedID      int64     `json:"feed_id,omitempty"`
+	CategoryID  int64     `json:"category_id,omitempty"`
+	Status      string    `json:"status"`
+	Starred     bool      `json:"starred"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (s SavedSearch) String() string {
+	return fmt.Sprintf("#%d %s", s.ID, s.Title)
+}
+
+// SavedSearches represents a list of saved searches.
+type SavedSearches []*SavedSearch
+
+// Tag represents a user-defined label attached to entries.
+type Tag struct {
+	ID         int64  `json:"id"`
+	UserID     int64  `json:"user_id"`
+	Name       string `json:"name"`
+	EntryCount int    `json:"nb_entries"`
+}
+
+func (t Tag) String() string {
+	return fmt.Sprintf("#%d %s", t.ID, t.Name)
+}
+
+// Tags represents a list of tags.
+type Tags []*Tag
+
 // Subscription represents a feed subscription.
 type Subscription struct {
 	Title string `json:"title"`
@@ -80,37 +141,80 @@ type Subscriptions []*Subscription
 
 // Feed represents a Miniflux feed.
 type Feed struct {
-	ID                 int64     `json:"id"`
-	UserID             int64     `json:"user_id"`
-	FeedURL            string    `json:"feed_url"`
-	SiteURL            string    `json:"site_url"`
-	Title              string    `json:"title"`
-	CheckedAt          time.Time `json:"checked_at,omitempty"`
-	EtagHeader         string    `json:"etag_header,omitempty"`
-	LastModifiedHeader string    `json:"last_modified_header,omitempty"`
-	ParsingErrorMsg    string    `json:"parsing_error_message,omitempty"`
-	ParsingErrorCount  int       `json:"parsing_error_count,omitempty"`
-	ScraperRules       string    `json:"scraper_rules"`
-	RewriteRules       string    `json:"rewrite_rules"`
-	Crawler            bool      `json:"crawler"`
-	UserAgent          string    `json:"user_agent"`
-	Username           string    `json:"username"`
-	Password           string    `json:"password"`
-	Category           *Category `json:"category,omitempty"`
+	ID                   int64     `json:"id"`
+	UserID               int64     `json:"user_id"`
+	FeedURL              string    `json:"feed_url"`
+	SiteURL              string    `json:"site_url"`
+	Title                string    `json:"title"`
+	CheckedAt            time.Time `json:"checked_at,omitempty"`
+	EtagHeader           string    `json:"etag_header,omitempty"`
+	LastModifiedHeader   string    `json:"last_modified_header,omitempty"`
+	ParsingErrorMsg      string    `json:"parsing_error_message,omitempty"`
+	ParsingErrorCount    int       `json:"parsing_error_count,omitempty"`
+	LastStatusCode       int       `json:"last_status_code,omitempty"`
+	ScraperRules         string    `json:"scraper_rules"`
+	RewriteRules         string    `json:"rewrite_rules"`
+	BlocklistRules       string    `json:"blocklist_rules"`
+	KeeplistRules        string    `json:"keeplist_rules"`
+	AuthorBlocklistRules string    `json:"author_blocklist_rules"`
+	AuthorKeeplistRules  string    `json:"author_keeplist_rules"`
+	AuthorMatchMode      string    `json:"author_match_mode"`
+	DateLayouts          string    `json:"date_layouts"`
+	MaxEntries           int       `json:"max_entries"`
+	SkipDuplicateGUIDs   bool      `json:"skip_duplicate_guids"`
+	Disabled             bool      `json:"disabled"`
+	DisabledReason       string    `json:"disabled_reason"`
+	RequestTimeout       int       `json:"request_timeout"`
+	Crawler              bool      `json:"crawler"`
+	CrawlerMode          string    `json:"crawler_mode"`
+	UserAgent            string    `json:"user_agent"`
+	Cookie               string    `json:"cookie"`
+	ProxyURL             string    `json:"proxy_url"`
+	TranslationLanguage  string    `json:"translation_language"`
+	Language             string    `json:"language"`
+	Username             string    `json:"username"`
+	Password             string    `json:"password"`
+	AuthScheme           string    `json:"auth_scheme"`
+	IconURL              string    `json:"icon_url"`
+	SortOrder            string    `json:"sort_order"`
+	MarkReadAfterDays    int       `json:"mark_read_after_days"`
+	RefreshInterval      int       `json:"refresh_interval"`
+	Position             int       `json:"position"`
+	Category             *Category `json:"category,omitempty"`
 }
 
 // FeedModification represents changes for a feed.
 type FeedModification struct {
-	FeedURL      *string `json:"feed_url"`
-	SiteURL      *string `json:"site_url"`
-	Title        *string `json:"title"`
-	ScraperRules *string `json:"scraper_rules"`
-	RewriteRules *string `json:"rewrite_rules"`
-	Crawler      *bool   `json:"crawler"`
-	UserAgent    *string `json:"user_agent"`
-	Username     *string `json:"username"`
-	Password     *string `json:"password"`
-	CategoryID   *int64  `json:"category_id"`
+	FeedURL              *string `json:"feed_url"`
+	SiteURL              *string `json:"site_url"`
+	Title                *string `json:"title"`
+	ScraperRules         *string `json:"scraper_rules"`
+	RewriteRules         *string `json:"rewrite_rules"`
+	BlocklistRules       *string `json:"blocklist_rules"`
+	KeeplistRules        *string `json:"keeplist_rules"`
+	AuthorBlocklistRules *string `json:"author_blocklist_rules"`
+	AuthorKeeplistRules  *string `json:"author_keeplist_rules"`
+	AuthorMatchMode      *string `json:"author_match_mode"`
+	DateLayouts          *string `json:"date_layouts"`
+	MaxEntries           *int    `json:"max_entries"`
+	SkipDuplicateGUIDs   *bool   `json:"skip_duplicate_guids"`
+	Disabled             *bool   `json:"disabled"`
+	RequestTimeout       *int    `json:"request_timeout"`
+	Crawler              *bool   `json:"crawler"`
+	CrawlerMode          *string `json:"crawler_mode"`
+	UserAgent            *string `json:"user_agent"`
+	Cookie               *string `json:"cookie"`
+	ProxyURL             *string `json:"proxy_url"`
+	TranslationLanguage  *string `json:"translation_language"`
+	Username             *string `json:"username"`
+	Password             *string `json:"password"`
+	AuthScheme           *string `json:"auth_scheme"`
+	AuthToken            *string `json:"auth_token"`
+	IconURL              *string `json:"icon_url"`
+	SortOrder            *string `json:"sort_order"`
+	MarkReadAfterDays    *int    `json:"mark_read_after_days"`
+	RefreshInterval      *int    `json:"refresh_interval"`
+	CategoryID           *int64  `json:"category_id"`
 }
 
 // FeedIcon represents the feed icon.
@@ -123,22 +227,71 @@ type FeedIcon struct {
 // Feeds represents a list of feeds.
 type Feeds []*Feed
 
+// Import job statuses.
+const (
+	ImportJobStatusPending   = "pending"
+	ImportJobStatusRunning   = "running"
+	ImportJobStatusCompleted = "completed"
+	ImportJobStatusFailed    = "failed"
+)
+
+// ImportJob represents an OPML import running in the background.
+type ImportJob struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Status    string    `json:"status"`
+	Total     int       `json:"total"`
+	Created   int       `json:"created"`
+	Skipped   int       `json:"skipped"`
+	Failed    int       `json:"failed"`
+	ErrorMsg  string    `json:"error_message"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ImportSummary reports the subscriptions created, skipped and failed during an OPML import.
+type ImportSummary struct {
+	Message string                `json:"message"`
+	Created []*ImportSubscription `json:"created"`
+	Skipped []*ImportSubscription `json:"skipped"`
+	Failed  []*ImportSubscription `json:"failed"`
+}
+
+// ImportSubscription represents a subscription of an OPML file.
+type ImportSubscription struct {
+	Title    string `json:"title"`
+	SiteURL  string `json:"site_url"`
+	FeedURL  string `json:"feed_url"`
+	Category string `json:"category"`
+}
+
 // Entry represents a subscription item in the system.
 type Entry struct {
-	ID         int64      `json:"id"`
-	UserID     int64      `json:"user_id"`
-	FeedID     int64      `json:"feed_id"`
-	Status     string     `json:"status"`
-	Hash       string     `json:"hash"`
-	Title      string     `json:"title"`
-	URL        string     `json:"url"`
-	Date       time.Time  `json:"published_at"`
-	Content    string     `json:"content"`
-	Author     string     `json:"author"`
-	ShareCode  string     `json:"share_code"`
-	Starred    bool       `json:"starred"`
-	Enclosures Enclosures `json:"enclosures,omitempty"`
-	Feed       *Feed      `json:"feed,omitempty"`
+	ID                  int64       `json:"id"`
+	UserID              int64       `json:"user_id"`
+	FeedID              int64       `json:"feed_id"`
+	Status              string      `json:"status"`
+	Hash                string      `json:"hash"`
+	Title               string      `json:"title"`
+	URL                 string      `json:"url"`
+	Date                time.Time   `json:"published_at"`
+	UpdatedAt           time.Time   `json:"updated_at"`
+	Content             string      `json:"content"`
+	Summary             string      `json:"summary"`
+	Language            string      `json:"language"`
+	TranslatedContent   string      `json:"translated_content,omitempty"`
+	TranslationLanguage string      `json:"translation_language,omitempty"`
+	ReadingTime         int         `json:"reading_time"`
+	ThumbnailURL        string      `json:"thumbnail_url"`
+	Explicit            bool        `json:"explicit"`
+	Author              string      `json:"author"`
+	ShareCode           string      `json:"share_code"`
+	Starred             bool        `json:"starred"`
+	Snippet             string      `json:"snippet,omitempty"`
+	Tags                []string    `json:"tags,omitempty"`
+	Enclosures          Enclosures  `json:"enclosures,omitempty"`
+	Transcripts         Transcripts `json:"transcripts,omitempty"`
+	Feed                *Feed       `json:"feed,omitempty"`
 }
 
 // Entries represents a list of entries.
@@ -157,6 +310,19 @@ type Enclosure struct {
 // Enclosures represents a list of attachments.
 type Enclosures []*Enclosure
 
+// Transcript represents a podcast episode transcript.
+type Transcript struct {
+	ID       int64  `json:"id"`
+	UserID   int64  `json:"user_id"`
+	EntryID  int64  `json:"entry_id"`
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type"`
+	Language string `json:"language"`
+}
+
+// Transcripts represents a list of transcripts.
+type Transcripts []*Transcript
+
 // Filter is used to filter entries.
 type Filter struct {
 	Status        string
@@ -170,7 +336,9 @@ type Filter struct {
 	BeforeEntryID int64
 	AfterEntryID  int64
 	Search        string
+	FeedID        int64
 	CategoryID    int64
+	Tag           string
 }
 
 // EntryResultSet represents the response when fetching entries.
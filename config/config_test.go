@@ -7,6 +7,7 @@ package config // import "miniflux.app/config"
 import (
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -1467,3 +1468,1292 @@ func TestAuthProxyUserCreationAdmin(t *testing.T) {
 		t.Fatalf(`Unexpected AUTH_PROXY_USER_CREATION value, got %v instead of %v`, result, expected)
 	}
 }
+
+func TestDefaultPollingPerHostLimitValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultPollingPerHostLimit
+	result := opts.PollingPerHostLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_PER_HOST_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestPollingPerHostLimit(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_PER_HOST_LIMIT", "3")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 3
+	result := opts.PollingPerHostLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_PER_HOST_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultPollingRetryCountValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultPollingRetryCount
+	result := opts.PollingRetryCount()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_RETRY_COUNT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestPollingRetryCount(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_RETRY_COUNT", "5")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 5
+	result := opts.PollingRetryCount()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_RETRY_COUNT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultPollingRetryDelayValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultPollingRetryDelay
+	result := opts.PollingRetryDelay()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_RETRY_DELAY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestPollingRetryDelay(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_RETRY_DELAY", "3")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 3
+	result := opts.PollingRetryDelay()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_RETRY_DELAY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultProxyMediaTypesValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultProxyMediaTypes
+	result := strings.Join(opts.ProxyMediaTypes(), ",")
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_MEDIA_TYPES value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestProxyMediaTypes(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_MEDIA_TYPES", "image, Audio,,video")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "image,audio,video"
+	result := strings.Join(opts.ProxyMediaTypes(), ",")
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_MEDIA_TYPES value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultScraperRulesFileValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultScraperRulesFile
+	result := opts.ScraperRulesFile()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SCRAPER_RULES_FILE value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestScraperRulesFile(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SCRAPER_RULES_FILE", "/tmp/scraper_rules.txt")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "/tmp/scraper_rules.txt"
+	result := opts.ScraperRulesFile()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SCRAPER_RULES_FILE value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultTrackingParametersValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultTrackingParameters
+	result := strings.Join(opts.TrackingParameters(), ",")
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRACKING_PARAMETERS value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestTrackingParameters(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("TRACKING_PARAMETERS", "utm_*, FBCLID,,ref")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "utm_*,fbclid,ref"
+	result := strings.Join(opts.TrackingParameters(), ",")
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRACKING_PARAMETERS value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestWebSubDisabledByDefault(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasWebSub() {
+		t.Fatalf(`Unexpected WEBSUB value, got true instead of false`)
+	}
+}
+
+func TestWebSub(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("WEBSUB", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasWebSub() {
+		t.Fatalf(`Unexpected WEBSUB value, got false instead of true`)
+	}
+}
+
+func TestDefaultUpdateUnchangedEntriesValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultUpdateUnchangedEntries
+	result := opts.UpdateUnchangedEntries()
+
+	if result != expected {
+		t.Fatalf(`Unexpected UPDATE_UNCHANGED_ENTRIES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestUpdateUnchangedEntries(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("UPDATE_UNCHANGED_ENTRIES", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := true
+	result := opts.UpdateUnchangedEntries()
+
+	if result != expected {
+		t.Fatalf(`Unexpected UPDATE_UNCHANGED_ENTRIES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestLanguageDetectionWhenUnset(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := true
+	result := opts.HasLanguageDetection()
+
+	if result != expected {
+		t.Fatalf(`Unexpected DISABLE_LANGUAGE_DETECTION value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDisableLanguageDetection(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("DISABLE_LANGUAGE_DETECTION", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := false
+	result := opts.HasLanguageDetection()
+
+	if result != expected {
+		t.Fatalf(`Unexpected DISABLE_LANGUAGE_DETECTION value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultReadingTimeWordsPerMinuteValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultReadingTimeWordsPerMinute
+	result := opts.ReadingTimeWordsPerMinute()
+
+	if result != expected {
+		t.Fatalf(`Unexpected READING_TIME_WORDS_PER_MINUTE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestReadingTimeWordsPerMinute(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("READING_TIME_WORDS_PER_MINUTE", "200")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 200
+	result := opts.ReadingTimeWordsPerMinute()
+
+	if result != expected {
+		t.Fatalf(`Unexpected READING_TIME_WORDS_PER_MINUTE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultLogFormatValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultLogFormat
+	result := opts.LogFormat()
+
+	if result != expected {
+		t.Fatalf(`Unexpected LOG_FORMAT value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestLogFormat(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("LOG_FORMAT", "json")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "json"
+	result := opts.LogFormat()
+
+	if result != expected {
+		t.Fatalf(`Unexpected LOG_FORMAT value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultHasMetricsCollectorValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultMetricsCollector
+	result := opts.HasMetricsCollector()
+
+	if result != expected {
+		t.Fatalf(`Unexpected METRICS_COLLECTOR value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestHasMetricsCollector(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("METRICS_COLLECTOR", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := true
+	result := opts.HasMetricsCollector()
+
+	if result != expected {
+		t.Fatalf(`Unexpected METRICS_COLLECTOR value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultMetricsUsernameValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultMetricsUsername
+	result := opts.MetricsUsername()
+
+	if result != expected {
+		t.Fatalf(`Unexpected METRICS_USERNAME value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestMetricsUsername(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("METRICS_USERNAME", "metrics")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "metrics"
+	result := opts.MetricsUsername()
+
+	if result != expected {
+		t.Fatalf(`Unexpected METRICS_USERNAME value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultMetricsPasswordValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultMetricsPassword
+	result := opts.MetricsPassword()
+
+	if result != expected {
+		t.Fatalf(`Unexpected METRICS_PASSWORD value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestMetricsPassword(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("METRICS_PASSWORD", "secret")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "secret"
+	result := opts.MetricsPassword()
+
+	if result != expected {
+		t.Fatalf(`Unexpected METRICS_PASSWORD value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultPollingParsingErrorLimitValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultPollingParsingErrorLimit
+	result := opts.PollingParsingErrorLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_PARSING_ERROR_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestPollingParsingErrorLimit(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_PARSING_ERROR_LIMIT", "20")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 20
+	result := opts.PollingParsingErrorLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_PARSING_ERROR_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultPollingDisableErrorLimitValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultPollingDisableErrorLimit
+	result := opts.PollingDisableErrorLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_DISABLE_ERROR_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestPollingDisableErrorLimit(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_DISABLE_ERROR_LIMIT", "30")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 30
+	result := opts.PollingDisableErrorLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected POLLING_DISABLE_ERROR_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultFetchContentRateLimitValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultFetchContentRateLimit
+	result := opts.FetchContentRateLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected FETCH_CONTENT_RATE_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestFetchContentRateLimit(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("FETCH_CONTENT_RATE_LIMIT", "30")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 30
+	result := opts.FetchContentRateLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected FETCH_CONTENT_RATE_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultYouTubeEmbedURLValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultYoutubeEmbedURL
+	result := opts.YouTubeEmbedURL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected YOUTUBE_EMBED_URL value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestYouTubeEmbedURL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("YOUTUBE_EMBED_URL", "https://www.youtube.com/embed/")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "https://www.youtube.com/embed/"
+	result := opts.YouTubeEmbedURL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected YOUTUBE_EMBED_URL value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultYouTubeFrontendURLValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultYoutubeFrontendURL
+	result := opts.YouTubeFrontendURL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected YOUTUBE_FRONTEND_URL value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestYouTubeFrontendURL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("YOUTUBE_FRONTEND_URL", "https://invidious.example.org")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "https://invidious.example.org"
+	result := opts.YouTubeFrontendURL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected YOUTUBE_FRONTEND_URL value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultRemoveTrackingPixelsValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultRemoveTrackingPixels
+	result := opts.RemoveTrackingPixels()
+
+	if result != expected {
+		t.Fatalf(`Unexpected REMOVE_TRACKING_PIXELS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestRemoveTrackingPixels(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("REMOVE_TRACKING_PIXELS", "0")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := false
+	result := opts.RemoveTrackingPixels()
+
+	if result != expected {
+		t.Fatalf(`Unexpected REMOVE_TRACKING_PIXELS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultAllowMathMLValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultAllowMathML
+	result := opts.AllowMathML()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ALLOW_MATHML value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestAllowMathML(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ALLOW_MATHML", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := true
+	result := opts.AllowMathML()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ALLOW_MATHML value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultMediaCacheDirValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultMediaCacheDir
+	result := opts.MediaCacheDir()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MEDIA_CACHE_DIR value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestMediaCacheDir(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("MEDIA_CACHE_DIR", "/var/cache/miniflux")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "/var/cache/miniflux"
+	result := opts.MediaCacheDir()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MEDIA_CACHE_DIR value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultMediaCacheSizeValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := int64(defaultMediaCacheSize * 1024 * 1024)
+	result := opts.MediaCacheSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MEDIA_CACHE_SIZE value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestMediaCacheSize(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("MEDIA_CACHE_SIZE", "10")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := int64(10 * 1024 * 1024)
+	result := opts.MediaCacheSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MEDIA_CACHE_SIZE value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestDefaultMediaCacheTTLValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultMediaCacheTTL
+	result := opts.MediaCacheTTL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MEDIA_CACHE_TTL value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestMediaCacheTTL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("MEDIA_CACHE_TTL", "24")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 24
+	result := opts.MediaCacheTTL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected MEDIA_CACHE_TTL value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultConvertIconsToWebPValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultConvertIconsToWebP
+	result := opts.ConvertIconsToWebP()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CONVERT_ICONS_TO_WEBP value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestConvertIconsToWebP(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("CONVERT_ICONS_TO_WEBP", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := true
+	result := opts.ConvertIconsToWebP()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CONVERT_ICONS_TO_WEBP value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultIconMaxSizeValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultIconMaxSize
+	result := opts.IconMaxSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ICON_MAX_SIZE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestIconMaxSize(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ICON_MAX_SIZE", "32")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 32
+	result := opts.IconMaxSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ICON_MAX_SIZE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultIconRefreshRateLimitValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultIconRefreshRateLimit
+	result := opts.IconRefreshRateLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ICON_REFRESH_RATE_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestIconRefreshRateLimit(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ICON_REFRESH_RATE_LIMIT", "30")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 30
+	result := opts.IconRefreshRateLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected ICON_REFRESH_RATE_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultSearchResultsLimitValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultSearchResultsLimit
+	result := opts.SearchResultsLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SEARCH_RESULTS_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSearchResultsLimit(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SEARCH_RESULTS_LIMIT", "25")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 25
+	result := opts.SearchResultsLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SEARCH_RESULTS_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultSearchSnippetLengthValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultSearchSnippetLength
+	result := opts.SearchSnippetLength()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SEARCH_SNIPPET_LENGTH value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSearchSnippetLength(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SEARCH_SNIPPET_LENGTH", "20")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 20
+	result := opts.SearchSnippetLength()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SEARCH_SNIPPET_LENGTH value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultCleanupMarkReadDaysValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultCleanupMarkReadDays
+	result := opts.CleanupMarkReadDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLEANUP_MARK_READ_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestCleanupMarkReadDays(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("CLEANUP_MARK_READ_DAYS", "14")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 14
+	result := opts.CleanupMarkReadDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLEANUP_MARK_READ_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultSchedulerFeedMinIntervalValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultSchedulerFeedMinInterval
+	result := opts.SchedulerFeedMinInterval()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SCHEDULER_FEED_MIN_INTERVAL value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSchedulerFeedMinInterval(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SCHEDULER_FEED_MIN_INTERVAL", "15")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 15
+	result := opts.SchedulerFeedMinInterval()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SCHEDULER_FEED_MIN_INTERVAL value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultSanitizerExtraAllowedTagsValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultSanitizerExtraAllowedTags
+	result := opts.SanitizerExtraAllowedTags()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SANITIZER_EXTRA_ALLOWED_TAGS value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestSanitizerExtraAllowedTags(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SANITIZER_EXTRA_ALLOWED_TAGS", "mark;span:lang")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "mark;span:lang"
+	result := opts.SanitizerExtraAllowedTags()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SANITIZER_EXTRA_ALLOWED_TAGS value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultImportRateLimitValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultImportRateLimit
+	result := opts.ImportRateLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected IMPORT_RATE_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestImportRateLimit(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("IMPORT_RATE_LIMIT", "10")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 10
+	result := opts.ImportRateLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected IMPORT_RATE_LIMIT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultHTTPClientTorProxyValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultHTTPClientTorProxy
+	result := opts.HTTPClientTorProxy()
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_CLIENT_TOR_PROXY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestHTTPClientTorProxy(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_TOR_PROXY", "socks5://127.0.0.1:9050")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "socks5://127.0.0.1:9050"
+	result := opts.HTTPClientTorProxy()
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_CLIENT_TOR_PROXY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultTranslationAPIURLValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultTranslationAPIURL
+	result := opts.TranslationAPIURL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRANSLATION_API_URL value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestTranslationAPIURL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("TRANSLATION_API_URL", "http://localhost:5000")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "http://localhost:5000"
+	result := opts.TranslationAPIURL()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRANSLATION_API_URL value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultTranslationAPIKeyValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultTranslationAPIKey
+	result := opts.TranslationAPIKey()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRANSLATION_API_KEY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestTranslationAPIKey(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("TRANSLATION_API_KEY", "secret")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "secret"
+	result := opts.TranslationAPIKey()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRANSLATION_API_KEY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultTranslationRequestsPerMinuteValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultTranslationRequestsPerMinute
+	result := opts.TranslationRequestsPerMinute()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRANSLATION_REQUESTS_PER_MINUTE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestTranslationRequestsPerMinute(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("TRANSLATION_REQUESTS_PER_MINUTE", "10")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 10
+	result := opts.TranslationRequestsPerMinute()
+
+	if result != expected {
+		t.Fatalf(`Unexpected TRANSLATION_REQUESTS_PER_MINUTE value, got %v instead of %v`, result, expected)
+	}
+}
@@ -7,11 +7,14 @@ package config // import "miniflux.app/config"
 import (
 	"fmt"
 	"strings"
+
+	"miniflux.app/url"
 )
 
 const (
 	defaultHTTPS                              = false
 	defaultLogDateTime                        = false
+	defaultLogFormat                          = "text"
 	defaultHSTS                               = true
 	defaultHTTPService                        = true
 	defaultSchedulerService                   = true
@@ -23,7 +26,14 @@ const (
 	defaultPollingFrequency                   = 60
 	defaultBatchSize                          = 10
 	defaultPollingScheduler                   = "round_robin"
+	defaultPollingPerHostLimit                = 1
+	defaultImportRateLimit                    = 30
+	defaultPollingRetryCount                  = 2
+	defaultPollingRetryDelay                  = 1
+	defaultPollingParsingErrorLimit           = 3
+	defaultPollingDisableErrorLimit           = 0
 	defaultSchedulerEntryFrequencyMinInterval = 5
+	defaultSchedulerFeedMinInterval           = 5
 	defaultSchedulerEntryFrequencyMaxInterval = 24 * 60
 	defaultRunMigrations                      = false
 	defaultDatabaseURL                        = "user=postgres password=postgres dbname=miniflux2 sslmode=disable"
@@ -36,8 +46,13 @@ const (
 	defaultCertCache                          = "/tmp/cert_cache"
 	defaultCleanupFrequencyHours              = 24
 	defaultCleanupArchiveReadDays             = 60
+	defaultCleanupMarkReadDays                = 0
 	defaultCleanupRemoveSessionsDays          = 30
 	defaultProxyImages                        = "http-only"
+	defaultMediaCacheDir                      = ""
+	defaultMediaCacheSize                     = 100
+	defaultMediaCacheTTL                      = 72
+	defaultProxyMediaTypes                    = "image"
 	defaultCreateAdmin                        = false
 	defaultAdminUsername                      = ""
 	defaultAdminPassword                      = ""
@@ -50,14 +65,39 @@ const (
 	defaultPocketConsumerKey                  = ""
 	defaultHTTPClientTimeout                  = 20
 	defaultHTTPClientMaxBodySize              = 15
+	defaultHTTPClientTorProxy                 = ""
 	defaultAuthProxyHeader                    = ""
 	defaultAuthProxyUserCreation              = false
+	defaultScraperRulesFile                   = ""
+	defaultWebSub                             = false
+	defaultUpdateUnchangedEntries             = false
+	defaultLanguageDetection                  = true
+	defaultTranslationAPIURL                  = ""
+	defaultTranslationAPIKey                  = ""
+	defaultTranslationRequestsPerMinute       = 30
+	defaultReadingTimeWordsPerMinute          = 265
+	defaultMetricsCollector                   = false
+	defaultMetricsUsername                    = ""
+	defaultMetricsPassword                    = ""
+	defaultFetchContentRateLimit              = 10
+	defaultIconRefreshRateLimit               = 10
+	defaultSearchResultsLimit                 = 100
+	defaultSearchSnippetLength                = 35
+	defaultYoutubeEmbedURL                    = "https://www.youtube-nocookie.com/embed/"
+	defaultYoutubeFrontendURL                 = ""
+	defaultRemoveTrackingPixels               = true
+	defaultAllowMathML                        = false
+	defaultSanitizerExtraAllowedTags          = ""
+	defaultConvertIconsToWebP                 = false
+	defaultIconMaxSize                        = 64
+	defaultTrackingParameters                 = url.DefaultTrackingParameters
 )
 
 // Options contains configuration options.
 type Options struct {
 	HTTPS                              bool
 	logDateTime                        bool
+	logFormat                          string
 	hsts                               bool
 	httpService                        bool
 	schedulerService                   bool
@@ -76,17 +116,29 @@ type Options struct {
 	certKeyFile                        string
 	cleanupFrequencyHours              int
 	cleanupArchiveReadDays             int
+	cleanupMarkReadDays                int
 	cleanupRemoveSessionsDays          int
 	pollingFrequency                   int
 	batchSize                          int
 	pollingScheduler                   string
+	pollingPerHostLimit                int
+	importRateLimit                    int
+	pollingRetryCount                  int
+	pollingRetryDelay                  int
+	pollingParsingErrorLimit           int
+	pollingDisableErrorLimit           int
 	schedulerEntryFrequencyMinInterval int
+	schedulerFeedMinInterval           int
 	schedulerEntryFrequencyMaxInterval int
 	workerPoolSize                     int
 	createAdmin                        bool
 	adminUsername                      string
 	adminPassword                      string
 	proxyImages                        string
+	mediaCacheDir                      string
+	mediaCacheSize                     int64
+	mediaCacheTTL                      int
+	proxyMediaTypes                    []string
 	oauth2UserCreationAllowed          bool
 	oauth2ClientID                     string
 	oauth2ClientSecret                 string
@@ -96,8 +148,32 @@ type Options struct {
 	pocketConsumerKey                  string
 	httpClientTimeout                  int
 	httpClientMaxBodySize              int64
+	httpClientTorProxy                 string
 	authProxyHeader                    string
 	authProxyUserCreation              bool
+	scraperRulesFile                   string
+	webSub                             bool
+	updateUnchangedEntries             bool
+	languageDetection                  bool
+	translationAPIURL                  string
+	translationAPIKey                  string
+	translationRequestsPerMinute       int
+	readingTimeWordsPerMinute          int
+	metricsCollector                   bool
+	metricsUsername                    string
+	metricsPassword                    string
+	fetchContentRateLimit              int
+	iconRefreshRateLimit               int
+	searchResultsLimit                 int
+	searchSnippetLength                int
+	youtubeEmbedURL                    string
+	youtubeFrontendURL                 string
+	removeTrackingPixels               bool
+	allowMathML                        bool
+	sanitizerExtraAllowedTags          string
+	convertIconsToWebP                 bool
+	iconMaxSize                        int
+	trackingParameters                 []string
 }
 
 // NewOptions returns Options with default values.
@@ -105,6 +181,7 @@ func NewOptions() *Options {
 	return &Options{
 		HTTPS:                              defaultHTTPS,
 		logDateTime:                        defaultLogDateTime,
+		logFormat:                          defaultLogFormat,
 		hsts:                               defaultHSTS,
 		httpService:                        defaultHTTPService,
 		schedulerService:                   defaultSchedulerService,
@@ -123,15 +200,27 @@ func NewOptions() *Options {
 		certKeyFile:                        defaultKeyFile,
 		cleanupFrequencyHours:              defaultCleanupFrequencyHours,
 		cleanupArchiveReadDays:             defaultCleanupArchiveReadDays,
+		cleanupMarkReadDays:                defaultCleanupMarkReadDays,
 		cleanupRemoveSessionsDays:          defaultCleanupRemoveSessionsDays,
 		pollingFrequency:                   defaultPollingFrequency,
 		batchSize:                          defaultBatchSize,
 		pollingScheduler:                   defaultPollingScheduler,
+		pollingPerHostLimit:                defaultPollingPerHostLimit,
+		importRateLimit:                    defaultImportRateLimit,
+		pollingRetryCount:                  defaultPollingRetryCount,
+		pollingRetryDelay:                  defaultPollingRetryDelay,
+		pollingParsingErrorLimit:           defaultPollingParsingErrorLimit,
+		pollingDisableErrorLimit:           defaultPollingDisableErrorLimit,
 		schedulerEntryFrequencyMinInterval: defaultSchedulerEntryFrequencyMinInterval,
+		schedulerFeedMinInterval:           defaultSchedulerFeedMinInterval,
 		schedulerEntryFrequencyMaxInterval: defaultSchedulerEntryFrequencyMaxInterval,
 		workerPoolSize:                     defaultWorkerPoolSize,
 		createAdmin:                        defaultCreateAdmin,
 		proxyImages:                        defaultProxyImages,
+		mediaCacheDir:                      defaultMediaCacheDir,
+		mediaCacheSize:                     defaultMediaCacheSize * 1024 * 1024,
+		mediaCacheTTL:                      defaultMediaCacheTTL,
+		proxyMediaTypes:                    []string{defaultProxyMediaTypes},
 		oauth2UserCreationAllowed:          defaultOAuth2UserCreation,
 		oauth2ClientID:                     defaultOAuth2ClientID,
 		oauth2ClientSecret:                 defaultOAuth2ClientSecret,
@@ -141,8 +230,32 @@ func NewOptions() *Options {
 		pocketConsumerKey:                  defaultPocketConsumerKey,
 		httpClientTimeout:                  defaultHTTPClientTimeout,
 		httpClientMaxBodySize:              defaultHTTPClientMaxBodySize * 1024 * 1024,
+		httpClientTorProxy:                 defaultHTTPClientTorProxy,
 		authProxyHeader:                    defaultAuthProxyHeader,
 		authProxyUserCreation:              defaultAuthProxyUserCreation,
+		scraperRulesFile:                   defaultScraperRulesFile,
+		webSub:                             defaultWebSub,
+		updateUnchangedEntries:             defaultUpdateUnchangedEntries,
+		languageDetection:                  defaultLanguageDetection,
+		translationAPIURL:                  defaultTranslationAPIURL,
+		translationAPIKey:                  defaultTranslationAPIKey,
+		translationRequestsPerMinute:       defaultTranslationRequestsPerMinute,
+		readingTimeWordsPerMinute:          defaultReadingTimeWordsPerMinute,
+		metricsCollector:                   defaultMetricsCollector,
+		metricsUsername:                    defaultMetricsUsername,
+		metricsPassword:                    defaultMetricsPassword,
+		fetchContentRateLimit:              defaultFetchContentRateLimit,
+		iconRefreshRateLimit:               defaultIconRefreshRateLimit,
+		searchResultsLimit:                 defaultSearchResultsLimit,
+		searchSnippetLength:                defaultSearchSnippetLength,
+		youtubeEmbedURL:                    defaultYoutubeEmbedURL,
+		youtubeFrontendURL:                 defaultYoutubeFrontendURL,
+		removeTrackingPixels:               defaultRemoveTrackingPixels,
+		allowMathML:                        defaultAllowMathML,
+		sanitizerExtraAllowedTags:          defaultSanitizerExtraAllowedTags,
+		convertIconsToWebP:                 defaultConvertIconsToWebP,
+		iconMaxSize:                        defaultIconMaxSize,
+		trackingParameters:                 strings.Split(defaultTrackingParameters, ","),
 	}
 }
 
@@ -151,6 +264,11 @@ func (o *Options) LogDateTime() bool {
 	return o.logDateTime
 }
 
+// LogFormat returns the format of log messages, "text" or "json".
+func (o *Options) LogFormat() string {
+	return o.logFormat
+}
+
 // HasDebugMode returns true if debug mode is enabled.
 func (o *Options) HasDebugMode() bool {
 	return o.debug
@@ -226,6 +344,11 @@ func (o *Options) CleanupArchiveReadDays() int {
 	return o.cleanupArchiveReadDays
 }
 
+// CleanupMarkReadDays returns the default number of days after which unread items are marked as read, 0 disables it.
+func (o *Options) CleanupMarkReadDays() int {
+	return o.cleanupMarkReadDays
+}
+
 // CleanupRemoveSessionsDays returns the number of days after which to remove sessions.
 func (o *Options) CleanupRemoveSessionsDays() int {
 	return o.cleanupRemoveSessionsDays
@@ -251,6 +374,36 @@ func (o *Options) PollingScheduler() string {
 	return o.pollingScheduler
 }
 
+// PollingPerHostLimit returns the maximum number of feeds refreshed concurrently for the same host.
+func (o *Options) PollingPerHostLimit() int {
+	return o.pollingPerHostLimit
+}
+
+// ImportRateLimit returns the maximum number of subscriptions imported per minute by the background imports.
+func (o *Options) ImportRateLimit() int {
+	return o.importRateLimit
+}
+
+// PollingRetryCount returns the number of times a transient feed download failure is retried.
+func (o *Options) PollingRetryCount() int {
+	return o.pollingRetryCount
+}
+
+// PollingRetryDelay returns the base delay in seconds between two retries, doubled after each attempt.
+func (o *Options) PollingRetryDelay() int {
+	return o.pollingRetryDelay
+}
+
+// PollingParsingErrorLimit returns the number of consecutive errors after which a feed is no longer refreshed by the scheduler, 0 means no limit.
+func (o *Options) PollingParsingErrorLimit() int {
+	return o.pollingParsingErrorLimit
+}
+
+// PollingDisableErrorLimit returns the number of consecutive errors after which a feed is disabled, 0 means never.
+func (o *Options) PollingDisableErrorLimit() int {
+	return o.pollingDisableErrorLimit
+}
+
 // SchedulerEntryFrequencyMaxInterval returns the maximum interval in minutes for the entry frequency scheduler.
 func (o *Options) SchedulerEntryFrequencyMaxInterval() int {
 	return o.schedulerEntryFrequencyMaxInterval
@@ -261,6 +414,11 @@ func (o *Options) SchedulerEntryFrequencyMinInterval() int {
 	return o.schedulerEntryFrequencyMinInterval
 }
 
+// SchedulerFeedMinInterval returns the minimum refresh interval in minutes that can be defined for a feed.
+func (o *Options) SchedulerFeedMinInterval() int {
+	return o.schedulerFeedMinInterval
+}
+
 // IsOAuth2UserCreationAllowed returns true if user creation is allowed for OAuth2 users.
 func (o *Options) IsOAuth2UserCreationAllowed() bool {
 	return o.oauth2UserCreationAllowed
@@ -316,11 +474,31 @@ func (o *Options) AdminPassword() string {
 	return o.adminPassword
 }
 
-// ProxyImages returns "none" to never proxy, "http-only" to proxy non-HTTPS, "all" to always proxy.
+// ProxyImages returns "none" to never proxy, "http-only" to proxy only plain HTTP URLs, "all" to always proxy.
 func (o *Options) ProxyImages() string {
 	return o.proxyImages
 }
 
+// MediaCacheDir returns the directory where the media served by the proxy are cached, the cache is disabled when empty.
+func (o *Options) MediaCacheDir() string {
+	return o.mediaCacheDir
+}
+
+// MediaCacheSize returns the maximum number of bytes used by the media cache.
+func (o *Options) MediaCacheSize() int64 {
+	return o.mediaCacheSize
+}
+
+// MediaCacheTTL returns the maximum number of hours a media is kept in the cache.
+func (o *Options) MediaCacheTTL() int {
+	return o.mediaCacheTTL
+}
+
+// ProxyMediaTypes returns the list of media types proxied according to PROXY_IMAGES: image, audio or video.
+func (o *Options) ProxyMediaTypes() []string {
+	return o.proxyMediaTypes
+}
+
 // HasHTTPService returns true if the HTTP service is enabled.
 func (o *Options) HasHTTPService() bool {
 	return o.httpService
@@ -349,6 +527,11 @@ func (o *Options) HTTPClientMaxBodySize() int64 {
 	return o.httpClientMaxBodySize
 }
 
+// HTTPClientTorProxy returns the SOCKS5 proxy used to fetch the .onion addresses.
+func (o *Options) HTTPClientTorProxy() string {
+	return o.httpClientTorProxy
+}
+
 // AuthProxyHeader returns an HTTP header name that contains username for
 // authentication using auth proxy.
 func (o *Options) AuthProxyHeader() string {
@@ -361,9 +544,125 @@ func (o *Options) IsAuthProxyUserCreationAllowed() bool {
 	return o.authProxyUserCreation
 }
 
+// ScraperRulesFile returns the path of the file that contains additional scraper rules.
+func (o *Options) ScraperRulesFile() string {
+	return o.scraperRulesFile
+}
+
+// HasWebSub returns true if feeds with a WebSub hub should receive push notifications.
+func (o *Options) HasWebSub() bool {
+	return o.webSub
+}
+
+// UpdateUnchangedEntries returns true if entries must be updated even when their title and content did not change.
+func (o *Options) UpdateUnchangedEntries() bool {
+	return o.updateUnchangedEntries
+}
+
+// HasLanguageDetection returns true if the language of the entries is detected when the feed does not declare it.
+func (o *Options) HasLanguageDetection() bool {
+	return o.languageDetection
+}
+
+// TranslationAPIURL returns the URL of the LibreTranslate compatible API used to translate the entries.
+func (o *Options) TranslationAPIURL() string {
+	return o.translationAPIURL
+}
+
+// TranslationAPIKey returns the key sent to the translation API.
+func (o *Options) TranslationAPIKey() string {
+	return o.translationAPIKey
+}
+
+// TranslationRequestsPerMinute returns the maximum number of requests sent to the translation API per minute.
+func (o *Options) TranslationRequestsPerMinute() int {
+	return o.translationRequestsPerMinute
+}
+
+// ReadingTimeWordsPerMinute returns the reading speed used to estimate the reading time of entries.
+func (o *Options) ReadingTimeWordsPerMinute() int {
+	return o.readingTimeWordsPerMinute
+}
+
+// HasMetricsCollector returns true if the /metrics endpoint is enabled.
+func (o *Options) HasMetricsCollector() bool {
+	return o.metricsCollector
+}
+
+// MetricsUsername returns the username required to access the /metrics endpoint.
+func (o *Options) MetricsUsername() string {
+	return o.metricsUsername
+}
+
+// MetricsPassword returns the password required to access the /metrics endpoint.
+func (o *Options) MetricsPassword() string {
+	return o.metricsPassword
+}
+
+// FetchContentRateLimit returns the maximum number of original contents a user can fetch per minute through the API.
+func (o *Options) FetchContentRateLimit() int {
+	return o.fetchContentRateLimit
+}
+
+// IconRefreshRateLimit returns the maximum number of feed icons a user can refresh per minute through the API.
+func (o *Options) IconRefreshRateLimit() int {
+	return o.iconRefreshRateLimit
+}
+
+// SearchResultsLimit returns the maximum number of entries returned by a full-text search through the API.
+func (o *Options) SearchResultsLimit() int {
+	return o.searchResultsLimit
+}
+
+// SearchSnippetLength returns the maximum number of words of the highlighted snippets returned by a full-text search.
+func (o *Options) SearchSnippetLength() int {
+	return o.searchSnippetLength
+}
+
+// YouTubeEmbedURL returns the URL used to embed YouTube videos.
+func (o *Options) YouTubeEmbedURL() string {
+	return o.youtubeEmbedURL
+}
+
+// YouTubeFrontendURL returns the base URL of the Invidious or Piped instance used by the "rewrite_youtube_links" rewrite rule.
+func (o *Options) YouTubeFrontendURL() string {
+	return o.youtubeFrontendURL
+}
+
+// RemoveTrackingPixels returns true if the images used to track the readers are removed from the entries.
+func (o *Options) RemoveTrackingPixels() bool {
+	return o.removeTrackingPixels
+}
+
+// AllowMathML returns true if a safe subset of MathML is kept in the entries.
+func (o *Options) AllowMathML() bool {
+	return o.allowMathML
+}
+
+// SanitizerExtraAllowedTags returns the additional HTML elements and attributes kept by the sanitizer.
+func (o *Options) SanitizerExtraAllowedTags() string {
+	return o.sanitizerExtraAllowedTags
+}
+
+// ConvertIconsToWebP returns true if the feed icons are stored as WebP images.
+func (o *Options) ConvertIconsToWebP() bool {
+	return o.convertIconsToWebP
+}
+
+// IconMaxSize returns the maximum width and height in pixels of the icons converted to WebP.
+func (o *Options) IconMaxSize() int {
+	return o.iconMaxSize
+}
+
+// TrackingParameters returns the list of query parameters removed by the "remove_tracking_parameters" rewrite rule.
+func (o *Options) TrackingParameters() []string {
+	return o.trackingParameters
+}
+
 func (o *Options) String() string {
 	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("LOG_DATE_TIME: %v\n", o.logDateTime))
+	builder.WriteString(fmt.Sprintf("LOG_FORMAT: %v\n", o.logFormat))
 	builder.WriteString(fmt.Sprintf("DEBUG: %v\n", o.debug))
 	builder.WriteString(fmt.Sprintf("HTTP_SERVICE: %v\n", o.httpService))
 	builder.WriteString(fmt.Sprintf("SCHEDULER_SERVICE: %v\n", o.schedulerService))
@@ -383,14 +682,26 @@ func (o *Options) String() string {
 	builder.WriteString(fmt.Sprintf("CERT_CACHE: %v\n", o.certCache))
 	builder.WriteString(fmt.Sprintf("CLEANUP_FREQUENCY_HOURS: %v\n", o.cleanupFrequencyHours))
 	builder.WriteString(fmt.Sprintf("CLEANUP_ARCHIVE_READ_DAYS: %v\n", o.cleanupArchiveReadDays))
+	builder.WriteString(fmt.Sprintf("CLEANUP_MARK_READ_DAYS: %v\n", o.cleanupMarkReadDays))
 	builder.WriteString(fmt.Sprintf("CLEANUP_REMOVE_SESSIONS_DAYS: %v\n", o.cleanupRemoveSessionsDays))
 	builder.WriteString(fmt.Sprintf("WORKER_POOL_SIZE: %v\n", o.workerPoolSize))
 	builder.WriteString(fmt.Sprintf("POLLING_FREQUENCY: %v\n", o.pollingFrequency))
 	builder.WriteString(fmt.Sprintf("BATCH_SIZE: %v\n", o.batchSize))
 	builder.WriteString(fmt.Sprintf("POLLING_SCHEDULER: %v\n", o.pollingScheduler))
+	builder.WriteString(fmt.Sprintf("POLLING_PER_HOST_LIMIT: %v\n", o.pollingPerHostLimit))
+	builder.WriteString(fmt.Sprintf("IMPORT_RATE_LIMIT: %v\n", o.importRateLimit))
+	builder.WriteString(fmt.Sprintf("POLLING_RETRY_COUNT: %v\n", o.pollingRetryCount))
+	builder.WriteString(fmt.Sprintf("POLLING_RETRY_DELAY: %v\n", o.pollingRetryDelay))
+	builder.WriteString(fmt.Sprintf("POLLING_PARSING_ERROR_LIMIT: %v\n", o.pollingParsingErrorLimit))
+	builder.WriteString(fmt.Sprintf("POLLING_DISABLE_ERROR_LIMIT: %v\n", o.pollingDisableErrorLimit))
 	builder.WriteString(fmt.Sprintf("SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL: %v\n", o.schedulerEntryFrequencyMaxInterval))
 	builder.WriteString(fmt.Sprintf("SCHEDULER_ENTRY_FREQUENCY_MIN_INTERVAL: %v\n", o.schedulerEntryFrequencyMinInterval))
+	builder.WriteString(fmt.Sprintf("SCHEDULER_FEED_MIN_INTERVAL: %v\n", o.schedulerFeedMinInterval))
 	builder.WriteString(fmt.Sprintf("PROXY_IMAGES: %v\n", o.proxyImages))
+	builder.WriteString(fmt.Sprintf("MEDIA_CACHE_DIR: %v\n", o.mediaCacheDir))
+	builder.WriteString(fmt.Sprintf("MEDIA_CACHE_SIZE: %v\n", o.mediaCacheSize))
+	builder.WriteString(fmt.Sprintf("MEDIA_CACHE_TTL: %v\n", o.mediaCacheTTL))
+	builder.WriteString(fmt.Sprintf("PROXY_MEDIA_TYPES: %v\n", strings.Join(o.proxyMediaTypes, ",")))
 	builder.WriteString(fmt.Sprintf("CREATE_ADMIN: %v\n", o.createAdmin))
 	builder.WriteString(fmt.Sprintf("ADMIN_USERNAME: %v\n", o.adminUsername))
 	builder.WriteString(fmt.Sprintf("ADMIN_PASSWORD: %v\n", o.adminPassword))
@@ -403,7 +714,31 @@ func (o *Options) String() string {
 	builder.WriteString(fmt.Sprintf("OAUTH2_PROVIDER: %v\n", o.oauth2Provider))
 	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_TIMEOUT: %v\n", o.httpClientTimeout))
 	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_MAX_BODY_SIZE: %v\n", o.httpClientMaxBodySize))
+	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_TOR_PROXY: %v\n", o.httpClientTorProxy))
 	builder.WriteString(fmt.Sprintf("AUTH_PROXY_HEADER: %v\n", o.authProxyHeader))
 	builder.WriteString(fmt.Sprintf("AUTH_PROXY_USER_CREATION: %v\n", o.authProxyUserCreation))
+	builder.WriteString(fmt.Sprintf("SCRAPER_RULES_FILE: %v\n", o.scraperRulesFile))
+	builder.WriteString(fmt.Sprintf("WEBSUB: %v\n", o.webSub))
+	builder.WriteString(fmt.Sprintf("UPDATE_UNCHANGED_ENTRIES: %v\n", o.updateUnchangedEntries))
+	builder.WriteString(fmt.Sprintf("LANGUAGE_DETECTION: %v\n", o.languageDetection))
+	builder.WriteString(fmt.Sprintf("TRANSLATION_API_URL: %v\n", o.translationAPIURL))
+	builder.WriteString(fmt.Sprintf("TRANSLATION_API_KEY: %v\n", o.translationAPIKey))
+	builder.WriteString(fmt.Sprintf("TRANSLATION_REQUESTS_PER_MINUTE: %v\n", o.translationRequestsPerMinute))
+	builder.WriteString(fmt.Sprintf("READING_TIME_WORDS_PER_MINUTE: %v\n", o.readingTimeWordsPerMinute))
+	builder.WriteString(fmt.Sprintf("METRICS_COLLECTOR: %v\n", o.metricsCollector))
+	builder.WriteString(fmt.Sprintf("METRICS_USERNAME: %v\n", o.metricsUsername))
+	builder.WriteString(fmt.Sprintf("METRICS_PASSWORD: %v\n", o.metricsPassword))
+	builder.WriteString(fmt.Sprintf("FETCH_CONTENT_RATE_LIMIT: %v\n", o.fetchContentRateLimit))
+	builder.WriteString(fmt.Sprintf("ICON_REFRESH_RATE_LIMIT: %v\n", o.iconRefreshRateLimit))
+	builder.WriteString(fmt.Sprintf("SEARCH_RESULTS_LIMIT: %v\n", o.searchResultsLimit))
+	builder.WriteString(fmt.Sprintf("SEARCH_SNIPPET_LENGTH: %v\n", o.searchSnippetLength))
+	builder.WriteString(fmt.Sprintf("YOUTUBE_EMBED_URL: %v\n", o.youtubeEmbedURL))
+	builder.WriteString(fmt.Sprintf("YOUTUBE_FRONTEND_URL: %v\n", o.youtubeFrontendURL))
+	builder.WriteString(fmt.Sprintf("REMOVE_TRACKING_PIXELS: %v\n", o.removeTrackingPixels))
+	builder.WriteString(fmt.Sprintf("ALLOW_MATHML: %v\n", o.allowMathML))
+	builder.WriteString(fmt.Sprintf("SANITIZER_EXTRA_ALLOWED_TAGS: %v\n", o.sanitizerExtraAllowedTags))
+	builder.WriteString(fmt.Sprintf("CONVERT_ICONS_TO_WEBP: %v\n", o.convertIconsToWebP))
+	builder.WriteString(fmt.Sprintf("ICON_MAX_SIZE: %v\n", o.iconMaxSize))
+	builder.WriteString(fmt.Sprintf("TRACKING_PARAMETERS: %v\n", strings.Join(o.trackingParameters, ",")))
 	return builder.String()
 }
@@ -77,6 +77,8 @@ func (p *Parser) parseLines(lines []string) (err error) {
 		switch key {
 		case "LOG_DATE_TIME":
 			p.opts.logDateTime = parseBool(value, defaultLogDateTime)
+		case "LOG_FORMAT":
+			p.opts.logFormat = parseString(value, defaultLogFormat)
 		case "DEBUG":
 			p.opts.debug = parseBool(value, defaultDebug)
 		case "BASE_URL":
@@ -118,6 +120,8 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.cleanupFrequencyHours = parseInt(value, defaultCleanupFrequencyHours)
 		case "CLEANUP_ARCHIVE_READ_DAYS":
 			p.opts.cleanupArchiveReadDays = parseInt(value, defaultCleanupArchiveReadDays)
+		case "CLEANUP_MARK_READ_DAYS":
+			p.opts.cleanupMarkReadDays = parseInt(value, defaultCleanupMarkReadDays)
 		case "CLEANUP_REMOVE_SESSIONS_DAYS":
 			p.opts.cleanupRemoveSessionsDays = parseInt(value, defaultCleanupRemoveSessionsDays)
 		case "CLEANUP_FREQUENCY":
@@ -144,12 +148,34 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.batchSize = parseInt(value, defaultBatchSize)
 		case "POLLING_SCHEDULER":
 			p.opts.pollingScheduler = strings.ToLower(parseString(value, defaultPollingScheduler))
+		case "POLLING_PER_HOST_LIMIT":
+			p.opts.pollingPerHostLimit = parseInt(value, defaultPollingPerHostLimit)
+		case "IMPORT_RATE_LIMIT":
+			p.opts.importRateLimit = parseInt(value, defaultImportRateLimit)
+		case "POLLING_RETRY_COUNT":
+			p.opts.pollingRetryCount = parseInt(value, defaultPollingRetryCount)
+		case "POLLING_RETRY_DELAY":
+			p.opts.pollingRetryDelay = parseInt(value, defaultPollingRetryDelay)
+		case "POLLING_PARSING_ERROR_LIMIT":
+			p.opts.pollingParsingErrorLimit = parseInt(value, defaultPollingParsingErrorLimit)
+		case "POLLING_DISABLE_ERROR_LIMIT":
+			p.opts.pollingDisableErrorLimit = parseInt(value, defaultPollingDisableErrorLimit)
 		case "SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL":
 			p.opts.schedulerEntryFrequencyMaxInterval = parseInt(value, defaultSchedulerEntryFrequencyMaxInterval)
 		case "SCHEDULER_ENTRY_FREQUENCY_MIN_INTERVAL":
 			p.opts.schedulerEntryFrequencyMinInterval = parseInt(value, defaultSchedulerEntryFrequencyMinInterval)
+		case "SCHEDULER_FEED_MIN_INTERVAL":
+			p.opts.schedulerFeedMinInterval = parseInt(value, defaultSchedulerFeedMinInterval)
 		case "PROXY_IMAGES":
 			p.opts.proxyImages = parseString(value, defaultProxyImages)
+		case "MEDIA_CACHE_DIR":
+			p.opts.mediaCacheDir = parseString(value, defaultMediaCacheDir)
+		case "MEDIA_CACHE_SIZE":
+			p.opts.mediaCacheSize = int64(parseInt(value, defaultMediaCacheSize) * 1024 * 1024)
+		case "MEDIA_CACHE_TTL":
+			p.opts.mediaCacheTTL = parseInt(value, defaultMediaCacheTTL)
+		case "PROXY_MEDIA_TYPES":
+			p.opts.proxyMediaTypes = parseStringList(value, []string{defaultProxyMediaTypes})
 		case "CREATE_ADMIN":
 			p.opts.createAdmin = parseBool(value, defaultCreateAdmin)
 		case "ADMIN_USERNAME":
@@ -184,10 +210,58 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.httpClientTimeout = parseInt(value, defaultHTTPClientTimeout)
 		case "HTTP_CLIENT_MAX_BODY_SIZE":
 			p.opts.httpClientMaxBodySize = int64(parseInt(value, defaultHTTPClientMaxBodySize) * 1024 * 1024)
+		case "HTTP_CLIENT_TOR_PROXY":
+			p.opts.httpClientTorProxy = parseString(value, defaultHTTPClientTorProxy)
 		case "AUTH_PROXY_HEADER":
 			p.opts.authProxyHeader = parseString(value, defaultAuthProxyHeader)
 		case "AUTH_PROXY_USER_CREATION":
 			p.opts.authProxyUserCreation = parseBool(value, defaultAuthProxyUserCreation)
+		case "SCRAPER_RULES_FILE":
+			p.opts.scraperRulesFile = parseString(value, defaultScraperRulesFile)
+		case "WEBSUB":
+			p.opts.webSub = parseBool(value, defaultWebSub)
+		case "UPDATE_UNCHANGED_ENTRIES":
+			p.opts.updateUnchangedEntries = parseBool(value, defaultUpdateUnchangedEntries)
+		case "DISABLE_LANGUAGE_DETECTION":
+			p.opts.languageDetection = !parseBool(value, defaultLanguageDetection)
+		case "TRANSLATION_API_URL":
+			p.opts.translationAPIURL = parseString(value, defaultTranslationAPIURL)
+		case "TRANSLATION_API_KEY":
+			p.opts.translationAPIKey = parseString(value, defaultTranslationAPIKey)
+		case "TRANSLATION_REQUESTS_PER_MINUTE":
+			p.opts.translationRequestsPerMinute = parseInt(value, defaultTranslationRequestsPerMinute)
+		case "READING_TIME_WORDS_PER_MINUTE":
+			p.opts.readingTimeWordsPerMinute = parseInt(value, defaultReadingTimeWordsPerMinute)
+		case "METRICS_COLLECTOR":
+			p.opts.metricsCollector = parseBool(value, defaultMetricsCollector)
+		case "METRICS_USERNAME":
+			p.opts.metricsUsername = parseString(value, defaultMetricsUsername)
+		case "METRICS_PASSWORD":
+			p.opts.metricsPassword = parseString(value, defaultMetricsPassword)
+		case "FETCH_CONTENT_RATE_LIMIT":
+			p.opts.fetchContentRateLimit = parseInt(value, defaultFetchContentRateLimit)
+		case "ICON_REFRESH_RATE_LIMIT":
+			p.opts.iconRefreshRateLimit = parseInt(value, defaultIconRefreshRateLimit)
+		case "SEARCH_RESULTS_LIMIT":
+			p.opts.searchResultsLimit = parseInt(value, defaultSearchResultsLimit)
+		case "SEARCH_SNIPPET_LENGTH":
+			p.opts.searchSnippetLength = parseInt(value, defaultSearchSnippetLength)
+		case "YOUTUBE_EMBED_URL":
+			p.opts.youtubeEmbedURL = parseString(value, defaultYoutubeEmbedURL)
+		case "YOUTUBE_FRONTEND_URL":
+			p.opts.youtubeFrontendURL = parseString(value, defaultYoutubeFrontendURL)
+		case "REMOVE_TRACKING_PIXELS":
+			p.opts.removeTrackingPixels = parseBool(value, defaultRemoveTrackingPixels)
+		case "ALLOW_MATHML":
+			p.opts.allowMathML = parseBool(value, defaultAllowMathML)
+		case "SANITIZER_EXTRA_ALLOWED_TAGS":
+			p.opts.sanitizerExtraAllowedTags = parseString(value, defaultSanitizerExtraAllowedTags)
+		case "CONVERT_ICONS_TO_WEBP":
+			p.opts.convertIconsToWebP = parseBool(value, defaultConvertIconsToWebP)
+		case "ICON_MAX_SIZE":
+			p.opts.iconMaxSize = parseInt(value, defaultIconMaxSize)
+		case "TRACKING_PARAMETERS":
+			p.opts.trackingParameters = parseStringList(value, strings.Split(defaultTrackingParameters, ","))
 		}
 	}
 
@@ -254,6 +328,25 @@ func parseString(value string, fallback string) string {
 	return value
 }
 
+func parseStringList(value string, fallback []string) []string {
+	if value == "" {
+		return fallback
+	}
+
+	var strList []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.ToLower(strings.TrimSpace(item)); item != "" {
+			strList = append(strList, item)
+		}
+	}
+
+	if len(strList) == 0 {
+		return fallback
+	}
+
+	return strList
+}
+
 func readSecretFile(filename, fallback string) string {
 	data, err := ioutil.ReadFile(filename)
 	if err != nil {
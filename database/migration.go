@@ -12,7 +12,7 @@ import (
 	"miniflux.app/logger"
 )
 
-const schemaVersion = 35
+const schemaVersion = 85
 
 // Migrate executes database migrations.
 func Migrate(db *sql.DB) {
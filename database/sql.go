@@ -190,8 +190,65 @@ create index entries_user_feed_idx on entries (user_id, feed_id);
 	"schema_version_35": `alter table integrations add column telegram_enabled bool default 'f';
 alter table integrations add column telegram_token text default '';
 alter table integrations add column telegram_chat_id text default '';`,
+	"schema_version_36": `alter table feed_icons add column etag_header text default '';
+alter table feed_icons add column last_modified_header text default '';
+alter table feed_icons add column checked_at timestamp with time zone default now();
+`,
+	"schema_version_37": `alter table feeds add column notify_telegram bool not null default 't';
+`,
+	"schema_version_38": `alter table integrations add column telegram_topic_id text default '';
+`,
+	"schema_version_39": `alter table integrations add column discord_enabled bool default 'f';
+alter table integrations add column discord_webhook_url text default '';
+`,
 	"schema_version_4": `create type entry_sorting_direction as enum('asc', 'desc');
 alter table users add column entry_direction entry_sorting_direction default 'asc';
+`,
+	"schema_version_40": `alter table users add column entry_deduplication text default 'disabled';
+alter table entries add column url_hash text default '';
+create index entries_user_url_hash_idx on entries(user_id, url_hash);
+`,
+	"schema_version_41": `alter table feeds add column update_interval int default 0;
+`,
+	"schema_version_42": `alter table feeds add column ttl int default 0;
+`,
+	"schema_version_43": `create table entry_transcripts (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    url text not null,
+    mime_type text default '',
+    language text default '',
+    primary key (id),
+    unique (entry_id, url),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+`,
+	"schema_version_44": `alter table feeds add column blocklist_rules text default '';
+alter table feeds add column keeplist_rules text default '';
+`,
+	"schema_version_45": `alter table categories add column crawler bool default 'f';
+`,
+	"schema_version_46": `alter table feeds add column hub_url text default '';
+alter table feeds add column topic_url text default '';
+`,
+	"schema_version_47": `create table websub_subscriptions (
+    feed_id bigint not null,
+    user_id int not null,
+    secret text not null,
+    token text not null,
+    pending bool not null default 'f',
+    lease_seconds int not null default 0,
+    expires_at timestamp with time zone not null default now(),
+    primary key (feed_id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+`,
+	"schema_version_48": `alter table feeds add column max_entries int default 0;
+`,
+	"schema_version_49": `alter table feeds add column cookie text default '';
 `,
 	"schema_version_5": `create table integrations (
     user_id int not null,
@@ -208,12 +265,198 @@ alter table users add column entry_direction entry_sorting_direction default 'as
     fever_token text default '',
     primary key(user_id)
 )
+`,
+	"schema_version_50": `alter table feeds add column request_timeout int default 0;
+`,
+	"schema_version_51": `alter table feeds add column last_status_code int default 0;
+`,
+	"schema_version_52": `alter table entries add column content_hash text not null default '';
+`,
+	"schema_version_53": `alter table integrations add column webhook_enabled bool default 'f';
+alter table integrations add column webhook_url text default '';
+alter table integrations add column webhook_secret text default '';
+`,
+	"schema_version_54": `alter table integrations add column pinboard_mark_read_on_save bool default 'f';
+alter table integrations add column instapaper_mark_read_on_save bool default 'f';
+alter table integrations add column wallabag_mark_read_on_save bool default 'f';
+alter table integrations add column nunux_keeper_mark_read_on_save bool default 'f';
+alter table integrations add column pocket_mark_read_on_save bool default 'f';
+`,
+	"schema_version_55": `alter table integrations add column shaarli_enabled bool default 'f';
+alter table integrations add column shaarli_url text default '';
+alter table integrations add column shaarli_api_secret text default '';
+alter table integrations add column shaarli_mark_read_on_save bool default 'f';
+`,
+	"schema_version_56": `alter table entries add column summary text not null default '';
+`,
+	"schema_version_57": `alter table entries add column language text not null default '';
+`,
+	"schema_version_58": `alter table entries add column reading_time int not null default 0;
+`,
+	"schema_version_59": `alter table feeds add column skip_duplicate_guids bool default 'f';
+create index entries_user_hash_idx on entries(user_id, hash);
 `,
 	"schema_version_6": `alter table feeds add column scraper_rules text default '';
+`,
+	"schema_version_60": `alter table feeds add column disabled_reason text not null default '';
+`,
+	"schema_version_61": `alter table integrations add column imap_enabled bool default 'f';
+alter table integrations add column imap_server text default '';
+alter table integrations add column imap_username text default '';
+alter table integrations add column imap_password text default '';
+alter table integrations add column imap_mailbox text default 'INBOX';
+alter table integrations add column imap_sender_filter text default '';
+alter table integrations add column imap_feed_id bigint default 0;
+`,
+	"schema_version_62": `alter table feeds add column date_layouts text not null default '';
+`,
+	"schema_version_63": `alter table entries add column updated_at timestamp with time zone not null default now();
+update entries set updated_at = published_at;
+`,
+	"schema_version_64": `alter table feeds add column auth_scheme text not null default 'basic';
+alter table feeds add column auth_token text not null default '';
+`,
+	"schema_version_65": `alter table feeds add column icon_url text not null default '';
+`,
+	"schema_version_66": `alter table users add column mark_read_on_scroll bool default 'f';
+`,
+	"schema_version_67": `create table saved_searches (
+    id bigserial not null,
+    user_id int not null,
+    title text not null,
+    search_query text not null default '',
+    feed_id bigint,
+    category_id int,
+    status text not null default '',
+    starred bool not null default 'f',
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    unique (user_id, title),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade,
+    foreign key (category_id) references categories(id) on delete cascade
+);
+`,
+	"schema_version_68": `create table tags (
+    id bigserial not null,
+    user_id int not null,
+    name text not null,
+    primary key (id),
+    unique (user_id, name),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table entry_tags (
+    entry_id bigint not null,
+    tag_id bigint not null,
+    primary key (entry_id, tag_id),
+    foreign key (entry_id) references entries(id) on delete cascade,
+    foreign key (tag_id) references tags(id) on delete cascade
+);
+
+create index entry_tags_tag_id_idx on entry_tags(tag_id);
+`,
+	"schema_version_69": `alter table feeds add column sort_order text not null default '';
 `,
 	"schema_version_7": `alter table feeds add column rewrite_rules text default '';
+`,
+	"schema_version_70": `alter table feeds add column mark_read_after_days int not null default 0;
+alter table categories add column mark_read_after_days int not null default 0;
+`,
+	"schema_version_71": `alter table feeds add column refresh_interval int not null default 0;
+`,
+	"schema_version_72": `alter table entries add column thumbnail_url text not null default '';
+`,
+	"schema_version_73": `alter table entries add column explicit bool not null default 'f';
+`,
+	"schema_version_74": `alter table categories add column position int not null default 0;
+alter table feeds add column position int not null default 0;
+`,
+	"schema_version_75": `create table pending_entry_states (
+    user_id int not null,
+    feed_id bigint not null,
+    url_hash text not null,
+    read bool not null default 'f',
+    starred bool not null default 'f',
+    created_at timestamp with time zone not null default now(),
+    primary key (user_id, feed_id, url_hash),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+`,
+	"schema_version_76": `create table import_jobs (
+    id bigserial not null,
+    user_id int not null,
+    status text not null default 'pending',
+    total int not null default 0,
+    created int not null default 0,
+    skipped int not null default 0,
+    failed int not null default 0,
+    error_msg text not null default '',
+    created_at timestamp with time zone not null default now(),
+    updated_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index import_jobs_status_idx on import_jobs(status);
+
+create table import_job_items (
+    id bigserial not null,
+    job_id bigint not null,
+    title text not null default '',
+    feed_url text not null,
+    site_url text not null default '',
+    category text not null default '',
+    scraper_rules text not null default '',
+    rewrite_rules text not null default '',
+    crawler bool not null default 'f',
+    user_agent text not null default '',
+    status text not null default 'pending',
+    primary key (id),
+    foreign key (job_id) references import_jobs(id) on delete cascade
+);
+
+create index import_job_items_job_id_idx on import_job_items(job_id, status);
+`,
+	"schema_version_77": `alter table feeds add column proxy_url text not null default '';
+`,
+	"schema_version_78": `alter table integrations add column gotify_enabled bool default 'f';
+alter table integrations add column gotify_url text default '';
+alter table integrations add column gotify_token text default '';
+alter table integrations add column gotify_priority int default 5;
+alter table integrations add column ntfy_enabled bool default 'f';
+alter table integrations add column ntfy_url text default 'https://ntfy.sh';
+alter table integrations add column ntfy_topic text default '';
+alter table integrations add column ntfy_token text default '';
+alter table integrations add column ntfy_priority int default 3;
+`,
+	"schema_version_79": `alter table integrations add column apprise_enabled bool default 'f';
+alter table integrations add column apprise_url text default '';
+alter table integrations add column apprise_services_url text default '';
+alter table integrations add column apprise_config_key text default '';
+alter table integrations add column apprise_tag text default '';
 `,
 	"schema_version_8": `alter table feeds add column crawler boolean default 'f';
+`,
+	"schema_version_80": `alter table categories add column telegram_chat_id text default '';
+alter table categories add column webhook_url text default '';
+`,
+	"schema_version_81": `alter table feeds add column translation_language text default '';
+alter table entries add column translated_content text default '';
+alter table entries add column translation_language text default '';
+`,
+	"schema_version_82": `alter table feeds add column author_blocklist_rules text default '';
+alter table feeds add column author_keeplist_rules text default '';
+alter table feeds add column author_match_mode text default 'exact';
+`,
+	"schema_version_83": `alter table feeds add column crawler_mode text not null default '';
+update feeds set crawler_mode=case when crawler then 'enabled' else 'disabled' end;
+alter table feeds drop column crawler;
+`,
+	"schema_version_84": `alter table feeds add column language text not null default '';
+`,
+	"schema_version_85": `create index entries_missing_url_hash_idx on entries(id) where url_hash='';
 `,
 	"schema_version_9": `alter table sessions rename to user_sessions;`,
 }
@@ -248,10 +491,60 @@ var SqlMapChecksums = map[string]string{
 	"schema_version_33": "bf38514efeb6c12511f41b1cc484f92722240b0a6ae874c32a958dfea3433d02",
 	"schema_version_34": "1a3e036f652fc98b7564a27013f04e1eb36dd0d68893c723168f134dc1065822",
 	"schema_version_35": "a1676504a735532d6e6315d6c0cb4cd933f654d33aaefe713503f976c9c4987b",
+	"schema_version_36": "087e5db53e09ed156a42139007e5267e3bb4a8fda145d4f809341503305f5c1b",
+	"schema_version_37": "c25b6751a3b1fd9217fb356566daf80c2633af6e1d08d952e44b6ff348a6506c",
+	"schema_version_38": "62192079726b233b8e866eacbe2e81a8777ab865804699c37e19e895848db657",
+	"schema_version_39": "3de01b8fa948d19f061c77083c6d34c29cb1943ed365604c1f634fabab24f1d8",
 	"schema_version_4":  "216ea3a7d3e1704e40c797b5dc47456517c27dbb6ca98bf88812f4f63d74b5d9",
+	"schema_version_40": "a2bfe9d20a89da7150690cbfc05c97edc252d272a8b80c24f2760e6b480ab9d9",
+	"schema_version_41": "c8e68d83858d9647611d1fe8a1a69d080a39c5c1c49184a5c35bdee1e73a80d1",
+	"schema_version_42": "4d9e94337322f0ef7cc37d691d0798d56aa04a452f2a64497638bb4b168858e7",
+	"schema_version_43": "fc2d74b9494f3f793daae043094b11f31e8c6f24ba14fc8bc3d2bbc47dc37f52",
+	"schema_version_44": "f550f7efbd507bc460959eb7c63c179a2dff00cb71942fb5983ce125c568ab49",
+	"schema_version_45": "4f5e13a9b15026dbf2bc2af6e8f5e367ca2016bdf314b71ca228cbade8d2b27c",
+	"schema_version_46": "60030f61fb15491cc0a4bbcc68ff5f683cd93ed894d0166c8c9304bdb2595b68",
+	"schema_version_47": "168f6e74922bcbb7b137e75d5a8440ec613213d216586bc5819e3c18dea2a5b1",
+	"schema_version_48": "e74cb0a352256aa25ae8d653c09f5576f218053b5fe0eb391d7bfa6c8f4b1ceb",
+	"schema_version_49": "71199c7a9216925646bf5d10a04cd8577a3dff3a9a782dc3604e580c110376f6",
 	"schema_version_5":  "46397e2f5f2c82116786127e9f6a403e975b14d2ca7b652a48cd1ba843e6a27c",
+	"schema_version_50": "f8588a43453049c9357172af21b848b5e14ffedb610a75d10d2dab096f4ac078",
+	"schema_version_51": "d827de6a6442030a6640728b890867cd576193fde3458d1166cef6e3799d6a20",
+	"schema_version_52": "fc58f5c011f3aa9eb72d80254c5b4c1fba53cf28e7efd44e191ad61803da3ec9",
+	"schema_version_53": "5d89d2591ecefc9e1b419ba63cd85a87a805e6ac3b6975294ea5499baa693351",
+	"schema_version_54": "5ad58480d83dac5a494601c53f26a30baff25807532ea354bcf6a3ccbeb57bc0",
+	"schema_version_55": "12927eea75367e41e8a2e51dc34f4271f9245ddcb6fa3c9404e21fb62e8206da",
+	"schema_version_56": "051cfce4727edb49025f25ab3c933db3c26c1de39288cedbaa4989b3ed3e4947",
+	"schema_version_57": "fb606bde0e273f23cd295e64e719cd5c6f058df1903ac3a2c71d0a7e740c8d60",
+	"schema_version_58": "1a8e2e1a85a56c4d3f49d9ddf1a72b7ffe7ed8ae4b45f6588b8edae90589e950",
+	"schema_version_59": "6df1fe7882fa6ed687818593e01a94a977f2c68a074a8228a0605f01210656a1",
 	"schema_version_6":  "9d05b4fb223f0e60efc716add5048b0ca9c37511cf2041721e20505d6d798ce4",
+	"schema_version_60": "befb9f06c12ba64c12a8330626816303c896a3cf53904974e81be24baf822fc8",
+	"schema_version_61": "47c7febd01798fd60fdfa5af2a25913a41f768f2c56580f362e7eb1f873e59a9",
+	"schema_version_62": "471fd35901d8e93e4967b094f12e3763a3e137f481feac17c3fb5755cded9422",
+	"schema_version_63": "d578a1e15848991eea0e372b351c49d4557b4b581a1e516cf0af09d06003e437",
+	"schema_version_64": "10cc1c1a55ad95d9fa40dcab4ca39de7d7eca1b74a006e7ee700253b4f4bab0c",
+	"schema_version_65": "75d2e8ff0025bde75fc4f3657a6e126ec5a4cc29d56f19073acd6c7ea33febf9",
+	"schema_version_66": "1ae83c1947481a6f70074b37b9467868587e9dc87c8dd26bd101d217aa6ef56d",
+	"schema_version_67": "450b3f23fe56e6719ee7670200a22ff75585bad9edd1f0f4f5aa5321518d827c",
+	"schema_version_68": "3ea1b96b5b3b40792cd6738dca08bdd19ebc25566647890e5ec440e7a3db1b04",
+	"schema_version_69": "0109c46a35168a3793ed3c641c08c39e80117dddd2b441dcb59a14f06ccd8986",
 	"schema_version_7":  "33f298c9aa30d6de3ca28e1270df51c2884d7596f1283a75716e2aeb634cd05c",
+	"schema_version_70": "60e6d59066117b79c3d2fa465b18226a3fd41131dc040fa697d18e467792531a",
+	"schema_version_71": "3d4cd53baead09a6844f4d9b3396163029713c1a50ab1416ed1b3ba08d8bd91f",
+	"schema_version_72": "5a66e417a4df2f79c76eb0abc2b6258b41b5831b5466ce21401e96fb8b5e96f3",
+	"schema_version_73": "1a729dcbb344bee3bfb3afa1010338d998574225f4256e55243ed9f8de31c593",
+	"schema_version_74": "f552123714b3706aa2f3ba510bd3b05b846d1680eb7fc6b6d23c011d7e13ba2c",
+	"schema_version_75": "205a2aa7e2d0ae2eaab224663dec91fd7def65d229c5eaf54015a8165a0dbba2",
+	"schema_version_76": "201ad17b68961b8c0ff8c7c72ef1cd05b6e12a1dfdc0024884950644a27be2cb",
+	"schema_version_77": "42e09bed45607b9666a69b03b263a6073bb19bbdbd653312618cbec8a9a4e5ed",
+	"schema_version_78": "d1dd98452ed616ee2f073be384199b6618f341471dc2e1198d33bdc6ff486235",
+	"schema_version_79": "00ec79db5c620f3c40ae1f866cc8092eaab37b6bf3972627d650bb9a94c3a8cf",
 	"schema_version_8":  "9922073fc4032d8922617ec6a6a07ae8d4817846c138760fb96cb5608ab83bfc",
+	"schema_version_80": "bfdf3f57e537b0c75a8c98a12c373a1dce8dc11a4481b63993ccf2658ff402d5",
+	"schema_version_81": "a1c9ac3feb9e47763c460fcfad29b2467a5dbb2b371051a7a7589e440098a0d3",
+	"schema_version_82": "1e5984f4f1f30447966ce5c9d4696b1974814647100626ee8be63ea92840974b",
+	"schema_version_83": "7a0c6985bb6ef97337a8d79a64f0cbcef3986f4bdd7121b022485b439095be08",
+	"schema_version_84": "8dad8b1c97ec3a86e4314aef6338901b19838d6d91d8960fa44c8a4ae11d5fd7",
+	"schema_version_85": "edeccbafaa5dde89c9a8efb76c17316eda6b04a20adf3e88005892851404c0eb",
 	"schema_version_9":  "de5ba954752fe808a993feef5bf0c6f808e0a4ced5379de8bec8342678150892",
 }
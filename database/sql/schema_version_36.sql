@@ -0,0 +1,3 @@
+alter table feed_icons add column etag_header text default '';
+alter table feed_icons add column last_modified_header text default '';
+alter table feed_icons add column checked_at timestamp with time zone default now();
@@ -0,0 +1 @@
+alter table feeds add column notify_telegram bool not null default 't';
@@ -0,0 +1 @@
+alter table integrations add column telegram_topic_id text default '';
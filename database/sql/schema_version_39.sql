@@ -0,0 +1,2 @@
+alter table integrations add column discord_enabled bool default 'f';
+alter table integrations add column discord_webhook_url text default '';
@@ -0,0 +1,3 @@
+alter table users add column entry_deduplication text default 'disabled';
+alter table entries add column url_hash text default '';
+create index entries_user_url_hash_idx on entries(user_id, url_hash);
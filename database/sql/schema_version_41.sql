@@ -0,0 +1 @@
+alter table feeds add column update_interval int default 0;
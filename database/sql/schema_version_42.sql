@@ -0,0 +1 @@
+alter table feeds add column ttl int default 0;
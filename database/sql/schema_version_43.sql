@@ -0,0 +1,12 @@
+create table entry_transcripts (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    url text not null,
+    mime_type text default '',
+    language text default '',
+    primary key (id),
+    unique (entry_id, url),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
@@ -0,0 +1,2 @@
+alter table feeds add column blocklist_rules text default '';
+alter table feeds add column keeplist_rules text default '';
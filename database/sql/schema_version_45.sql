@@ -0,0 +1 @@
+alter table categories add column crawler bool default 'f';
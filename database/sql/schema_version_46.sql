@@ -0,0 +1,2 @@
+alter table feeds add column hub_url text default '';
+alter table feeds add column topic_url text default '';
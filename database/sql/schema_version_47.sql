@@ -0,0 +1,12 @@
+create table websub_subscriptions (
+    feed_id bigint not null,
+    user_id int not null,
+    secret text not null,
+    token text not null,
+    pending bool not null default 'f',
+    lease_seconds int not null default 0,
+    expires_at timestamp with time zone not null default now(),
+    primary key (feed_id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
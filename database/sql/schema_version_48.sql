@@ -0,0 +1 @@
+alter table feeds add column max_entries int default 0;
@@ -0,0 +1 @@
+alter table feeds add column cookie text default '';
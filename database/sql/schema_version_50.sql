@@ -0,0 +1 @@
+alter table feeds add column request_timeout int default 0;
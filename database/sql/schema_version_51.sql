@@ -0,0 +1 @@
+alter table feeds add column last_status_code int default 0;
@@ -0,0 +1 @@
+alter table entries add column content_hash text not null default '';
@@ -0,0 +1,3 @@
+alter table integrations add column webhook_enabled bool default 'f';
+alter table integrations add column webhook_url text default '';
+alter table integrations add column webhook_secret text default '';
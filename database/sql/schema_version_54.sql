@@ -0,0 +1,5 @@
+alter table integrations add column pinboard_mark_read_on_save bool default 'f';
+alter table integrations add column instapaper_mark_read_on_save bool default 'f';
+alter table integrations add column wallabag_mark_read_on_save bool default 'f';
+alter table integrations add column nunux_keeper_mark_read_on_save bool default 'f';
+alter table integrations add column pocket_mark_read_on_save bool default 'f';
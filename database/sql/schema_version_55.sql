@@ -0,0 +1,4 @@
+alter table integrations add column shaarli_enabled bool default 'f';
+alter table integrations add column shaarli_url text default '';
+alter table integrations add column shaarli_api_secret text default '';
+alter table integrations add column shaarli_mark_read_on_save bool default 'f';
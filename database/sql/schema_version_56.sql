@@ -0,0 +1 @@
+alter table entries add column summary text not null default '';
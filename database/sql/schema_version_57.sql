@@ -0,0 +1 @@
+alter table entries add column language text not null default '';
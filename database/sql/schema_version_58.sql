@@ -0,0 +1 @@
+alter table entries add column reading_time int not null default 0;
@@ -0,0 +1,2 @@
+alter table feeds add column skip_duplicate_guids bool default 'f';
+create index entries_user_hash_idx on entries(user_id, hash);
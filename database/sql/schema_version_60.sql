@@ -0,0 +1 @@
+alter table feeds add column disabled_reason text not null default '';
@@ -0,0 +1,7 @@
+alter table integrations add column imap_enabled bool default 'f';
+alter table integrations add column imap_server text default '';
+alter table integrations add column imap_username text default '';
+alter table integrations add column imap_password text default '';
+alter table integrations add column imap_mailbox text default 'INBOX';
+alter table integrations add column imap_sender_filter text default '';
+alter table integrations add column imap_feed_id bigint default 0;
@@ -0,0 +1 @@
+alter table feeds add column date_layouts text not null default '';
@@ -0,0 +1,2 @@
+alter table entries add column updated_at timestamp with time zone not null default now();
+update entries set updated_at = published_at;
@@ -0,0 +1,2 @@
+alter table feeds add column auth_scheme text not null default 'basic';
+alter table feeds add column auth_token text not null default '';
@@ -0,0 +1 @@
+alter table feeds add column icon_url text not null default '';
@@ -0,0 +1 @@
+alter table users add column mark_read_on_scroll bool default 'f';
@@ -0,0 +1,16 @@
+create table saved_searches (
+    id bigserial not null,
+    user_id int not null,
+    title text not null,
+    search_query text not null default '',
+    feed_id bigint,
+    category_id int,
+    status text not null default '',
+    starred bool not null default 'f',
+    created_at timestamp with time zone not null default now(),
+    primary key (id),
+    unique (user_id, title),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade,
+    foreign key (category_id) references categories(id) on delete cascade
+);
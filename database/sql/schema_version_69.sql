@@ -0,0 +1 @@
+alter table feeds add column sort_order text not null default '';
@@ -0,0 +1,2 @@
+alter table feeds add column mark_read_after_days int not null default 0;
+alter table categories add column mark_read_after_days int not null default 0;
@@ -0,0 +1 @@
+alter table feeds add column refresh_interval int not null default 0;
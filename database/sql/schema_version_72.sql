@@ -0,0 +1 @@
+alter table entries add column thumbnail_url text not null default '';
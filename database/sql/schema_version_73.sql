@@ -0,0 +1 @@
+alter table entries add column explicit bool not null default 'f';
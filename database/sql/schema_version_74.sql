@@ -0,0 +1,2 @@
+alter table categories add column position int not null default 0;
+alter table feeds add column position int not null default 0;
@@ -0,0 +1,11 @@
+create table pending_entry_states (
+    user_id int not null,
+    feed_id bigint not null,
+    url_hash text not null,
+    read bool not null default 'f',
+    starred bool not null default 'f',
+    created_at timestamp with time zone not null default now(),
+    primary key (user_id, feed_id, url_hash),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
@@ -0,0 +1,34 @@
+create table import_jobs (
+    id bigserial not null,
+    user_id int not null,
+    status text not null default 'pending',
+    total int not null default 0,
+    created int not null default 0,
+    skipped int not null default 0,
+    failed int not null default 0,
+    error_msg text not null default '',
+    created_at timestamp with time zone not null default now(),
+    updated_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create index import_jobs_status_idx on import_jobs(status);
+
+create table import_job_items (
+    id bigserial not null,
+    job_id bigint not null,
+    title text not null default '',
+    feed_url text not null,
+    site_url text not null default '',
+    category text not null default '',
+    scraper_rules text not null default '',
+    rewrite_rules text not null default '',
+    crawler bool not null default 'f',
+    user_agent text not null default '',
+    status text not null default 'pending',
+    primary key (id),
+    foreign key (job_id) references import_jobs(id) on delete cascade
+);
+
+create index import_job_items_job_id_idx on import_job_items(job_id, status);
@@ -0,0 +1,9 @@
+alter table integrations add column gotify_enabled bool default 'f';
+alter table integrations add column gotify_url text default '';
+alter table integrations add column gotify_token text default '';
+alter table integrations add column gotify_priority int default 5;
+alter table integrations add column ntfy_enabled bool default 'f';
+alter table integrations add column ntfy_url text default 'https://ntfy.sh';
+alter table integrations add column ntfy_topic text default '';
+alter table integrations add column ntfy_token text default '';
+alter table integrations add column ntfy_priority int default 3;
@@ -0,0 +1,5 @@
+alter table integrations add column apprise_enabled bool default 'f';
+alter table integrations add column apprise_url text default '';
+alter table integrations add column apprise_services_url text default '';
+alter table integrations add column apprise_config_key text default '';
+alter table integrations add column apprise_tag text default '';
@@ -0,0 +1,2 @@
+alter table categories add column telegram_chat_id text default '';
+alter table categories add column webhook_url text default '';
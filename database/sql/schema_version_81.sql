@@ -0,0 +1,3 @@
+alter table feeds add column translation_language text default '';
+alter table entries add column translated_content text default '';
+alter table entries add column translation_language text default '';
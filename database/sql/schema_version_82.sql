@@ -0,0 +1,3 @@
+alter table feeds add column author_blocklist_rules text default '';
+alter table feeds add column author_keeplist_rules text default '';
+alter table feeds add column author_match_mode text default 'exact';
@@ -0,0 +1,3 @@
+alter table feeds add column crawler_mode text not null default '';
+update feeds set crawler_mode=case when crawler then 'enabled' else 'disabled' end;
+alter table feeds drop column crawler;
@@ -0,0 +1 @@
+alter table feeds add column language text not null default '';
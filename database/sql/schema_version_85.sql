@@ -0,0 +1 @@
+create index entries_missing_url_hash_idx on entries(id) where url_hash='';
@@ -22,8 +22,18 @@ func (l LocalizedError) Error() string {
 }
 
 // Localize returns the translated error message.
+// The nested localized errors, like the cause of a failure, are translated as well.
 func (l LocalizedError) Localize(printer *locale.Printer) string {
-	return printer.Printf(l.message, l.args...)
+	args := make([]interface{}, len(l.args))
+	for i, arg := range l.args {
+		if err, ok := arg.(*LocalizedError); ok && err != nil {
+			args[i] = err.Localize(printer)
+		} else {
+			args[i] = arg
+		}
+	}
+
+	return printer.Printf(l.message, args...)
 }
 
 // NewLocalizedError returns a new LocalizedError.
@@ -0,0 +1,27 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package errors // import "miniflux.app/errors"
+
+import (
+	"testing"
+
+	"miniflux.app/locale"
+)
+
+func TestLocalizeNestedError(t *testing.T) {
+	cause := NewLocalizedError("Resource not found (404), this feed doesn't exists anymore, check the feed URL")
+	err := NewLocalizedError("Unable to fetch this resource after %d attempts: %v", 3, cause)
+
+	expected := "Unable to fetch this resource after 3 attempts: Resource not found (404), this feed doesn't exists anymore, check the feed URL"
+	if err.Error() != expected {
+		t.Errorf(`Unexpected error message, got %q`, err.Error())
+	}
+
+	result := err.Localize(locale.NewPrinter("fr_FR"))
+	expected = "Impossible de récupérer cette ressource après 3 tentatives : Page introuvable (404), cet abonnement n'existe plus, vérifiez l'adresse du flux"
+	if result != expected {
+		t.Errorf(`The nested error should be translated, got %q`, result)
+	}
+}
@@ -9,10 +9,16 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime"
+	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 	"unicode/utf8"
 
+	"miniflux.app/reader/encoding"
+
 	"golang.org/x/net/html/charset"
 )
 
@@ -23,18 +29,21 @@ type Response struct {
 	Body          io.Reader
 	StatusCode    int
 	EffectiveURL  string
+	Redirects     []int
 	LastModified  string
 	ETag          string
 	Expires       string
 	ContentType   string
+	RetryAfter    string
 	ContentLength int64
 }
 
 func (r *Response) String() string {
 	return fmt.Sprintf(
-		`StatusCode=%d EffectiveURL=%q LastModified=%q ETag=%s Expires=%s ContentType=%q ContentLength=%d`,
+		`StatusCode=%d EffectiveURL=%q Redirects=%v LastModified=%q ETag=%s Expires=%s ContentType=%q ContentLength=%d`,
 		r.StatusCode,
 		r.EffectiveURL,
+		r.Redirects,
 		r.LastModified,
 		r.ETag,
 		r.Expires,
@@ -43,6 +52,21 @@ func (r *Response) String() string {
 	)
 }
 
+// IsPermanentRedirect returns true if the resource has been reached only through permanent redirects (301 or 308).
+func (r *Response) IsPermanentRedirect() bool {
+	if len(r.Redirects) == 0 {
+		return false
+	}
+
+	for _, statusCode := range r.Redirects {
+		if statusCode != http.StatusMovedPermanently && statusCode != http.StatusPermanentRedirect {
+			return false
+		}
+	}
+
+	return true
+}
+
 // IsNotFound returns true if the resource doesn't exists anymore.
 func (r *Response) IsNotFound() bool {
 	return r.StatusCode == 404 || r.StatusCode == 410
@@ -58,6 +82,35 @@ func (r *Response) HasServerFailure() bool {
 	return r.StatusCode >= 400
 }
 
+// RetryAfterDelay returns the delay requested by the server with the Retry-After header.
+//
+// The header contains either a number of seconds or a HTTP date,
+// zero is returned when the header is missing or invalid.
+func (r *Response) RetryAfterDelay() time.Duration {
+	return parseRetryAfter(r.RetryAfter, time.Now())
+}
+
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil || !date.After(now) {
+		return 0
+	}
+
+	return date.Sub(now)
+}
+
 // IsModified returns true if the resource has been modified.
 func (r *Response) IsModified(etag, lastModified string) bool {
 	if r.StatusCode == 304 {
@@ -87,6 +140,9 @@ func (r *Response) IsModified(etag, lastModified string) bool {
 // - Feeds with encoding specified only in XML document and not in HTTP header
 // - Feeds with wrong encoding defined and already in UTF-8
 func (r *Response) EnsureUnicodeBody() (err error) {
+	buffer, _ := ioutil.ReadAll(r.Body)
+	r.Body = bytes.NewReader(buffer)
+
 	if r.ContentType != "" {
 		// JSON feeds are always in UTF-8.
 		if strings.Contains(r.ContentType, "json") {
@@ -94,9 +150,6 @@ func (r *Response) EnsureUnicodeBody() (err error) {
 		}
 
 		if strings.Contains(r.ContentType, "xml") {
-			buffer, _ := ioutil.ReadAll(r.Body)
-			r.Body = bytes.NewReader(buffer)
-
 			// We ignore documents with encoding specified in XML prolog.
 			// This is going to be handled by the XML parser.
 			length := 1024
@@ -116,10 +169,29 @@ func (r *Response) EnsureUnicodeBody() (err error) {
 		}
 	}
 
+	// Without any encoding information, charset.NewReader assumes windows-1252 for non UTF-8 documents.
+	// The bytes are inspected to find the legacy charsets still used by some feeds.
+	// HTML documents are left to charset.NewReader, their charset can be declared in meta tags.
+	if !strings.Contains(r.ContentType, "html") && !hasCharsetParameter(r.ContentType) {
+		if label := encoding.DetectCharset(buffer); label != "" {
+			r.Body, err = charset.NewReaderLabel(label, r.Body)
+			return err
+		}
+	}
+
 	r.Body, err = charset.NewReader(r.Body, r.ContentType)
 	return err
 }
 
+func hasCharsetParameter(contentType string) bool {
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return params["charset"] != ""
+}
+
 // BodyAsString returns the response body as string.
 func (r *Response) BodyAsString() string {
 	bytes, _ := ioutil.ReadAll(r.Body)
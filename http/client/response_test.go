@@ -7,8 +7,10 @@ package client // import "miniflux.app/http/client"
 import (
 	"bytes"
 	"io/ioutil"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
 	"unicode/utf8"
 )
 
@@ -147,3 +149,61 @@ func TestEnsureUnicodeWithHTMLDocuments(t *testing.T) {
 		}
 	}
 }
+
+func TestRetryAfterWithSeconds(t *testing.T) {
+	r := &Response{RetryAfter: "120"}
+	if delay := r.RetryAfterDelay(); delay != 2*time.Minute {
+		t.Errorf(`Unexpected delay, got %v instead of 2m`, delay)
+	}
+}
+
+func TestRetryAfterWithDate(t *testing.T) {
+	now := time.Date(2020, time.May, 1, 10, 0, 0, 0, time.UTC)
+	value := now.Add(time.Hour).Format(http.TimeFormat)
+
+	if delay := parseRetryAfter(value, now); delay != time.Hour {
+		t.Errorf(`Unexpected delay, got %v instead of 1h`, delay)
+	}
+
+	if delay := parseRetryAfter(now.Add(-time.Hour).Format(http.TimeFormat), now); delay != 0 {
+		t.Errorf(`A date in the past should not return a delay, got %v`, delay)
+	}
+}
+
+func TestRetryAfterWithMissingHeader(t *testing.T) {
+	r := &Response{}
+	if delay := r.RetryAfterDelay(); delay != 0 {
+		t.Errorf(`A missing header should not return a delay, got %v`, delay)
+	}
+}
+
+func TestRetryAfterWithInvalidHeader(t *testing.T) {
+	for _, value := range []string{"invalid", "-10"} {
+		r := &Response{RetryAfter: value}
+		if delay := r.RetryAfterDelay(); delay != 0 {
+			t.Errorf(`An invalid header should not return a delay, got %v for %q`, delay, value)
+		}
+	}
+}
+
+func TestIsPermanentRedirect(t *testing.T) {
+	scenarios := []struct {
+		redirects []int
+		expected  bool
+	}{
+		{nil, false},
+		{[]int{301}, true},
+		{[]int{308}, true},
+		{[]int{301, 308}, true},
+		{[]int{302}, false},
+		{[]int{307}, false},
+		{[]int{301, 302}, false},
+	}
+
+	for _, scenario := range scenarios {
+		response := &Response{Redirects: scenario.redirects}
+		if result := response.IsPermanentRedirect(); result != scenario.expected {
+			t.Errorf(`Unexpected result for %v, got %v instead of %v`, scenario.redirects, result, scenario.expected)
+		}
+	}
+}
@@ -84,6 +84,17 @@ func Forbidden(w http.ResponseWriter, r *http.Request) {
 	builder.Write()
 }
 
+// TooManyRequests sends a rate limit error to the client.
+func TooManyRequests(w http.ResponseWriter, r *http.Request) {
+	logger.Error("[HTTP:Too Many Requests] %s", r.URL)
+
+	builder := response.New(w, r)
+	builder.WithStatus(http.StatusTooManyRequests)
+	builder.WithHeader("Content-Type", contentTypeHeader)
+	builder.WithBody(toJSONError(errors.New("Too Many Requests")))
+	builder.Write()
+}
+
 // NotFound sends a page not found error to the client.
 func NotFound(w http.ResponseWriter, r *http.Request) {
 	logger.Error("[HTTP:Not Found] %s", r.URL)
@@ -0,0 +1,54 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sse // import "miniflux.app/http/response/sse"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Stream sends events to the client as soon as they are written.
+type Stream struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+// NewStream writes the response headers and returns a new stream.
+// An error is returned when the response writer cannot flush the data.
+func NewStream(w http.ResponseWriter) (*Stream, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, errors.New("sse: streaming is not supported by the response writer")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	// Disable the buffering of reverse proxies like Nginx.
+	w.Header().Set("X-Accel-Buffering", "no")
+
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	return &Stream{w: w, flusher: flusher}, nil
+}
+
+// Send writes an event with the data encoded in JSON.
+func (s *Stream) Send(event string, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("sse: unable to encode the event data: %v", err)
+	}
+
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event, b); err != nil {
+		return err
+	}
+
+	s.flusher.Flush()
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sse // import "miniflux.app/http/response/sse"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStream(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	stream, err := NewStream(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := stream.Send("finish", map[string]int{"feed_id": 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf(`Unexpected status code, got %d instead of %d`, resp.StatusCode, http.StatusOK)
+	}
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "text/event-stream" {
+		t.Errorf(`Unexpected content type, got %q`, contentType)
+	}
+
+	expected := "event: finish\ndata: {\"feed_id\":1}\n\n"
+	if actual := w.Body.String(); actual != expected {
+		t.Errorf(`Unexpected body, got %q instead of %q`, actual, expected)
+	}
+
+	if !w.Flushed {
+		t.Error(`The events should be flushed`)
+	}
+}
+
+type responseWriterWithoutFlush struct {
+	http.ResponseWriter
+}
+
+func TestStreamWithoutFlusher(t *testing.T) {
+	if _, err := NewStream(responseWriterWithoutFlush{httptest.NewRecorder()}); err == nil {
+		t.Error(`An error should be returned when the response cannot be flushed`)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package apprise // import "miniflux.app/integration/apprise"
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+type notification struct {
+	URLs   string `json:"urls,omitempty"`
+	Tag    string `json:"tag,omitempty"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	Format string `json:"format"`
+}
+
+// Client represents an Apprise API client.
+//
+// Without configuration key, the notification is sent to the service URLs given with each request (stateless mode).
+// Otherwise, the services stored in the Apprise configuration are notified, optionally filtered by tag.
+type Client struct {
+	apiURL      string
+	servicesURL string
+	configKey   string
+	tag         string
+}
+
+// SendNotification sends the feed title as notification title and the list of items as Markdown body.
+func (c *Client) SendNotification(feedTitle string, items []string) error {
+	if c.apiURL == "" {
+		return fmt.Errorf("apprise: missing API URL")
+	}
+
+	payload := &notification{Title: feedTitle, Body: strings.Join(items, "\n"), Format: "markdown"}
+	endpoint := strings.TrimSuffix(c.apiURL, "/") + "/notify/"
+	if c.configKey != "" {
+		endpoint += url.PathEscape(c.configKey)
+		payload.Tag = c.tag
+	} else if c.servicesURL != "" {
+		payload.URLs = c.servicesURL
+	} else {
+		return fmt.Errorf("apprise: missing service URLs or configuration key")
+	}
+
+	response, err := client.New(endpoint).PostJSON(payload)
+	if err != nil {
+		return fmt.Errorf("apprise: unable to send notification: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("apprise: unable to send notification, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewClient returns a new Apprise API client.
+func NewClient(apiURL, servicesURL, configKey, tag string) *Client {
+	return &Client{apiURL: apiURL, servicesURL: servicesURL, configKey: configKey, tag: tag}
+}
+
+// SendAppriseMsg sends new feed entries to Apprise.
+func SendAppriseMsg(integration *model.Integration, feed *model.Feed, items []string) {
+	if len(items) == 0 {
+		return
+	}
+
+	if !integration.AppriseEnabled {
+		return
+	}
+
+	clt := NewClient(integration.AppriseURL, integration.AppriseServicesURL, integration.AppriseConfigKey, integration.AppriseTag)
+	if err := clt.SendNotification(feed.Title, items); err != nil {
+		logger.Error("[Apprise] Feed #%d: %v", feed.ID, err)
+	}
+}
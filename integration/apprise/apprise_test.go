@@ -0,0 +1,106 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package apprise // import "miniflux.app/integration/apprise"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+func newTestServer(t *testing.T, path *string, payload *notification) *httptest.Server {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*path = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+			t.Errorf(`Invalid payload: %v`, err)
+		}
+	}))
+}
+
+func TestSendNotificationStateless(t *testing.T) {
+	var path string
+	var payload notification
+	ts := newTestServer(t, &path, &payload)
+	defer ts.Close()
+
+	items := []string{"[Entry 1](https://example.org/1)", "[Entry 2](https://example.org/2)"}
+	if err := NewClient(ts.URL+"/", "tgram://token/chat", "", "ignored").SendNotification("Feed Title", items); err != nil {
+		t.Fatalf(`Unable to send notification: %v`, err)
+	}
+
+	if path != "/notify/" {
+		t.Errorf(`Unexpected path, got %q`, path)
+	}
+
+	expected := notification{
+		URLs:   "tgram://token/chat",
+		Title:  "Feed Title",
+		Body:   "[Entry 1](https://example.org/1)\n[Entry 2](https://example.org/2)",
+		Format: "markdown",
+	}
+	if payload != expected {
+		t.Errorf(`Unexpected payload, got %+v instead of %+v`, payload, expected)
+	}
+}
+
+func TestSendNotificationWithConfigurationKey(t *testing.T) {
+	var path string
+	var payload notification
+	ts := newTestServer(t, &path, &payload)
+	defer ts.Close()
+
+	if err := NewClient(ts.URL, "tgram://token/chat", "miniflux", "news").SendNotification("Feed Title", []string{"Entry"}); err != nil {
+		t.Fatalf(`Unable to send notification: %v`, err)
+	}
+
+	if path != "/notify/miniflux" {
+		t.Errorf(`Unexpected path, got %q`, path)
+	}
+
+	if payload.URLs != "" || payload.Tag != "news" {
+		t.Errorf(`The stored configuration should be used with the tag, got %+v`, payload)
+	}
+}
+
+func TestSendNotificationWithServerFailure(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusFailedDependency)
+	}))
+	defer ts.Close()
+
+	if err := NewClient(ts.URL, "tgram://token/chat", "", "").SendNotification("Feed Title", []string{"Entry"}); err == nil {
+		t.Fatal(`A server failure should return an error`)
+	}
+}
+
+func TestSendNotificationWithoutSettings(t *testing.T) {
+	if err := NewClient("", "", "", "").SendNotification("Feed Title", []string{"Entry"}); err == nil {
+		t.Error(`A missing API URL should return an error`)
+	}
+
+	if err := NewClient("http://apprise:8000", "", "", "").SendNotification("Feed Title", []string{"Entry"}); err == nil {
+		t.Error(`Missing service URLs should return an error`)
+	}
+}
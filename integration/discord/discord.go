@@ -0,0 +1,84 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package discord // import "miniflux.app/integration/discord"
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+// Discord rejects messages with a content longer than 2000 characters.
+const maxContentLength = 2000
+
+// Client represents a Discord webhook client.
+type Client struct {
+	webhookURL string
+}
+
+// SendMessages posts the feed title and the list of items to the webhook, split in several messages if necessary.
+func (c *Client) SendMessages(feedTitle string, items []string) error {
+	if c.webhookURL == "" {
+		return fmt.Errorf("discord: missing webhook URL")
+	}
+
+	for _, content := range splitContent(fmt.Sprintf("**%s**", feedTitle), items) {
+		clt := client.New(c.webhookURL)
+		response, err := clt.PostJSON(map[string]string{"content": content})
+		if err != nil {
+			return fmt.Errorf("discord: unable to send message: %v", err)
+		}
+
+		if response.HasServerFailure() {
+			return fmt.Errorf("discord: unable to send message, status=%d", response.StatusCode)
+		}
+	}
+
+	return nil
+}
+
+// NewClient returns a new Discord webhook client.
+func NewClient(webhookURL string) *Client {
+	return &Client{webhookURL: webhookURL}
+}
+
+// SendDiscordMsg sends new feed entries to Discord.
+func SendDiscordMsg(integration *model.Integration, feed *model.Feed, items []string) {
+	if len(items) == 0 {
+		return
+	}
+
+	if !integration.DiscordEnabled {
+		return
+	}
+
+	if err := NewClient(integration.DiscordWebhookURL).SendMessages(feed.Title, items); err != nil {
+		logger.Error("[Discord] Feed #%d: %v", feed.ID, err)
+	}
+}
+
+// splitContent groups the header and the items line by line in chunks that fit in a single message.
+// Items longer than the limit are truncated.
+func splitContent(header string, items []string) []string {
+	var chunks []string
+	current := header
+	for _, item := range items {
+		if utf8.RuneCountInString(item) > maxContentLength {
+			item = string([]rune(item)[:maxContentLength-1]) + "…"
+		}
+
+		if utf8.RuneCountInString(current)+1+utf8.RuneCountInString(item) > maxContentLength {
+			chunks = append(chunks, current)
+			current = item
+		} else {
+			current += "\n" + item
+		}
+	}
+
+	return append(chunks, current)
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package discord // import "miniflux.app/integration/discord"
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"miniflux.app/config"
+)
+
+func TestSendMessagesWithManyItems(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	var contents []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf(`Invalid payload: %v`, err)
+		}
+		contents = append(contents, payload["content"])
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	var items []string
+	for i := 0; i < 100; i++ {
+		items = append(items, fmt.Sprintf("[Entry %d](https://example.org/articles/%d)", i, i))
+	}
+
+	if err := NewClient(ts.URL).SendMessages("Feed Title", items); err != nil {
+		t.Fatalf(`Unable to send messages: %v`, err)
+	}
+
+	if len(contents) < 2 {
+		t.Fatalf(`Items should be split into multiple messages, got %d`, len(contents))
+	}
+
+	for i, content := range contents {
+		if utf8.RuneCountInString(content) > maxContentLength {
+			t.Fatalf(`Message #%d is too long: %d characters`, i, utf8.RuneCountInString(content))
+		}
+
+		if (i == 0) != strings.HasPrefix(content, "**Feed Title**\n") {
+			t.Fatalf(`Only the first message should start with the feed title`)
+		}
+	}
+}
+
+func TestSplitContentWithTooLongItem(t *testing.T) {
+	chunks := splitContent("**Feed Title**", []string{strings.Repeat("a", 3000)})
+	if len(chunks) != 2 {
+		t.Fatalf(`Unexpected number of chunks: %d`, len(chunks))
+	}
+
+	if utf8.RuneCountInString(chunks[1]) != maxContentLength {
+		t.Fatalf(`The long item should be truncated to the limit, got %d characters`, utf8.RuneCountInString(chunks[1]))
+	}
+}
+
+func TestSendMessagesWithServerFailure(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer ts.Close()
+
+	if err := NewClient(ts.URL).SendMessages("Feed Title", []string{"[Entry](https://example.org/)"}); err == nil {
+		t.Fatal(`A server failure should return an error`)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gotify // import "miniflux.app/integration/gotify"
+
+import (
+	"fmt"
+	"strings"
+
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+// Gotify priorities go from 0 (silent) to 10, the default priority of the applications is 5.
+const (
+	defaultPriority = 5
+	maxPriority     = 10
+)
+
+type message struct {
+	Title    string                 `json:"title"`
+	Message  string                 `json:"message"`
+	Priority int                    `json:"priority"`
+	Extras   map[string]interface{} `json:"extras"`
+}
+
+// Client represents a Gotify client.
+type Client struct {
+	serverURL string
+	token     string
+	priority  int
+}
+
+// SendMessage pushes a Markdown message, the clients open the URL when the notification is clicked.
+func (c *Client) SendMessage(title, text, clickURL string) error {
+	if c.serverURL == "" || c.token == "" {
+		return fmt.Errorf("gotify: missing server URL or application token")
+	}
+
+	extras := map[string]interface{}{
+		"client::display": map[string]string{"contentType": "text/markdown"},
+	}
+	if clickURL != "" {
+		extras["client::notification"] = map[string]interface{}{"click": map[string]string{"url": clickURL}}
+	}
+
+	clt := client.New(strings.TrimSuffix(c.serverURL, "/") + "/message")
+	clt.WithHeader("X-Gotify-Key", c.token)
+	response, err := clt.PostJSON(&message{Title: title, Message: text, Priority: c.priority, Extras: extras})
+	if err != nil {
+		return fmt.Errorf("gotify: unable to send message: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("gotify: unable to send message, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewClient returns a new Gotify client, the priority is reset to the default one when out of range.
+func NewClient(serverURL, token string, priority int) *Client {
+	if priority < 0 || priority > maxPriority {
+		priority = defaultPriority
+	}
+	return &Client{serverURL: serverURL, token: token, priority: priority}
+}
+
+// SendGotifyMsg sends new feed entries to Gotify, one message per entry.
+func SendGotifyMsg(integration *model.Integration, feed *model.Feed, entries model.Entries) {
+	if len(entries) == 0 {
+		return
+	}
+
+	if !integration.GotifyEnabled {
+		return
+	}
+
+	clt := NewClient(integration.GotifyURL, integration.GotifyToken, integration.GotifyPriority)
+	for _, entry := range entries {
+		if err := clt.SendMessage(feed.Title, fmt.Sprintf("[%s](%s)", entry.Title, entry.URL), entry.URL); err != nil {
+			logger.Error("[Gotify] Feed #%d: %v", feed.ID, err)
+			return
+		}
+	}
+}
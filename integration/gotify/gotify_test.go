@@ -0,0 +1,98 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package gotify // import "miniflux.app/integration/gotify"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+func TestSendMessage(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	var payload struct {
+		Title    string `json:"title"`
+		Message  string `json:"message"`
+		Priority int    `json:"priority"`
+		Extras   struct {
+			Notification struct {
+				Click struct {
+					URL string `json:"url"`
+				} `json:"click"`
+			} `json:"client::notification"`
+		} `json:"extras"`
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/gotify/message" {
+			t.Errorf(`Unexpected path, got %q`, r.URL.Path)
+		}
+
+		if token := r.Header.Get("X-Gotify-Key"); token != "secret" {
+			t.Errorf(`Unexpected token, got %q`, token)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf(`Invalid payload: %v`, err)
+		}
+	}))
+	defer ts.Close()
+
+	if err := NewClient(ts.URL+"/gotify/", "secret", 8).SendMessage("Feed Title", "[Entry](https://example.org/entry)", "https://example.org/entry"); err != nil {
+		t.Fatalf(`Unable to send message: %v`, err)
+	}
+
+	if payload.Title != "Feed Title" || payload.Message != "[Entry](https://example.org/entry)" || payload.Priority != 8 {
+		t.Errorf(`Unexpected payload, got %+v`, payload)
+	}
+
+	if payload.Extras.Notification.Click.URL != "https://example.org/entry" {
+		t.Errorf(`Unexpected click URL, got %q`, payload.Extras.Notification.Click.URL)
+	}
+}
+
+func TestSendMessageWithServerFailure(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	if err := NewClient(ts.URL, "invalid", 5).SendMessage("Feed Title", "Entry", ""); err == nil {
+		t.Fatal(`A server failure should return an error`)
+	}
+}
+
+func TestSendMessageWithoutSettings(t *testing.T) {
+	if err := NewClient("", "", 5).SendMessage("Feed Title", "Entry", ""); err == nil {
+		t.Fatal(`A missing server URL should return an error`)
+	}
+}
+
+func TestNewClientPriority(t *testing.T) {
+	scenarios := map[int]int{-1: defaultPriority, 0: 0, 10: 10, 11: defaultPriority}
+	for input, expected := range scenarios {
+		if result := NewClient("https://gotify.example.org", "token", input).priority; result != expected {
+			t.Errorf(`Unexpected priority for %d, got %d instead of %d`, input, result, expected)
+		}
+	}
+}
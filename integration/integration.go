@@ -5,18 +5,35 @@
 package integration // import "miniflux.app/integration"
 
 import (
+	"strings"
+
 	"miniflux.app/config"
 	"miniflux.app/integration/instapaper"
 	"miniflux.app/integration/nunuxkeeper"
 	"miniflux.app/integration/pinboard"
 	"miniflux.app/integration/pocket"
+	"miniflux.app/integration/shaarli"
 	"miniflux.app/integration/wallabag"
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/storage"
 )
 
 // SendEntry send the entry to the activated providers.
-func SendEntry(entry *model.Entry, integration *model.Integration) {
+// The entry is marked as read when it has been saved by a provider configured to do so.
+func SendEntry(store *storage.Storage, entry *model.Entry, integration *model.Integration) {
+	if saveEntry(entry, integration) && entry.Status != model.EntryStatusRead {
+		if err := store.SetEntriesStatus(integration.UserID, []int64{entry.ID}, model.EntryStatusRead); err != nil {
+			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+		}
+	}
+}
+
+// saveEntry sends the entry to the activated providers, it returns true when the entry should be marked as read.
+func saveEntry(entry *model.Entry, integration *model.Integration) bool {
+	markAsRead := false
+
 	if integration.PinboardEnabled {
 		client := pinboard.NewClient(integration.PinboardToken)
 		err := client.AddBookmark(
@@ -28,6 +45,8 @@ func SendEntry(entry *model.Entry, integration *model.Integration) {
 
 		if err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+		} else if integration.PinboardMarkReadOnSave {
+			markAsRead = true
 		}
 	}
 
@@ -35,6 +54,8 @@ func SendEntry(entry *model.Entry, integration *model.Integration) {
 		client := instapaper.NewClient(integration.InstapaperUsername, integration.InstapaperPassword)
 		if err := client.AddURL(entry.URL, entry.Title); err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+		} else if integration.InstapaperMarkReadOnSave {
+			markAsRead = true
 		}
 	}
 
@@ -49,6 +70,8 @@ func SendEntry(entry *model.Entry, integration *model.Integration) {
 
 		if err := client.AddEntry(entry.URL, entry.Title); err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+		} else if integration.WallabagMarkReadOnSave {
+			markAsRead = true
 		}
 	}
 
@@ -60,6 +83,8 @@ func SendEntry(entry *model.Entry, integration *model.Integration) {
 
 		if err := client.AddEntry(entry.URL, entry.Title, entry.Content); err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+		} else if integration.NunuxKeeperMarkReadOnSave {
+			markAsRead = true
 		}
 	}
 
@@ -67,6 +92,19 @@ func SendEntry(entry *model.Entry, integration *model.Integration) {
 		client := pocket.NewClient(config.Opts.PocketConsumerKey(integration.PocketConsumerKey), integration.PocketAccessToken)
 		if err := client.AddURL(entry.URL, entry.Title); err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+		} else if integration.PocketMarkReadOnSave {
+			markAsRead = true
+		}
+	}
+
+	if integration.ShaarliEnabled {
+		client := shaarli.NewClient(integration.ShaarliURL, integration.ShaarliAPISecret)
+		if err := client.AddLink(entry.URL, entry.Title, strings.TrimSpace(sanitizer.StripTags(entry.Content))); err != nil {
+			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+		} else if integration.ShaarliMarkReadOnSave {
+			markAsRead = true
 		}
 	}
+
+	return markAsRead
 }
@@ -0,0 +1,74 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package integration // import "miniflux.app/integration"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+)
+
+func newNunuxKeeperServer(t *testing.T, statusCode int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/documents" {
+			t.Errorf(`Unexpected path: %q`, r.URL.Path)
+		}
+		w.WriteHeader(statusCode)
+	}))
+}
+
+func TestSaveEntryMarksAsReadWhenSaved(t *testing.T) {
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	ts := newNunuxKeeperServer(t, http.StatusCreated)
+	defer ts.Close()
+
+	entry := &model.Entry{URL: "https://example.org/article", Title: "Article"}
+	integration := &model.Integration{
+		NunuxKeeperEnabled:        true,
+		NunuxKeeperURL:            ts.URL,
+		NunuxKeeperAPIKey:         "key",
+		NunuxKeeperMarkReadOnSave: true,
+	}
+
+	if !saveEntry(entry, integration) {
+		t.Error(`The entry should be marked as read after being saved`)
+	}
+
+	integration.NunuxKeeperMarkReadOnSave = false
+	if saveEntry(entry, integration) {
+		t.Error(`The entry should not be marked as read when the option is disabled`)
+	}
+}
+
+func TestSaveEntryKeepsStatusOnFailure(t *testing.T) {
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	ts := newNunuxKeeperServer(t, http.StatusInternalServerError)
+	defer ts.Close()
+
+	entry := &model.Entry{URL: "https://example.org/article", Title: "Article"}
+	integration := &model.Integration{
+		NunuxKeeperEnabled:        true,
+		NunuxKeeperURL:            ts.URL,
+		NunuxKeeperAPIKey:         "key",
+		NunuxKeeperMarkReadOnSave: true,
+	}
+
+	if saveEntry(entry, integration) {
+		t.Error(`The entry should not be marked as read when the provider fails`)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package libretranslate // import "miniflux.app/integration/libretranslate"
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"miniflux.app/http/client"
+)
+
+type translationRequest struct {
+	Query  string `json:"q"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Format string `json:"format"`
+	APIKey string `json:"api_key,omitempty"`
+}
+
+type translationResponse struct {
+	TranslatedText string `json:"translatedText"`
+	Error          string `json:"error"`
+}
+
+// RateLimitError is returned when the API refuses the request because too many requests have been sent.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("libretranslate: too many requests, retry after %v", e.RetryAfter)
+	}
+	return "libretranslate: too many requests"
+}
+
+// Client represents a LibreTranslate client.
+type Client struct {
+	apiURL string
+	apiKey string
+}
+
+// NewClient returns a new LibreTranslate client, the key is only required by some servers.
+func NewClient(apiURL, apiKey string) *Client {
+	return &Client{apiURL: apiURL, apiKey: apiKey}
+}
+
+// Translate translates the HTML content to the target language.
+// The source language is detected by the API when it is empty.
+func (c *Client) Translate(content, source, target string) (string, error) {
+	if c.apiURL == "" {
+		return "", fmt.Errorf("libretranslate: missing API URL")
+	}
+
+	if source == "" {
+		source = "auto"
+	}
+
+	clt := client.New(strings.TrimSuffix(c.apiURL, "/") + "/translate")
+	response, err := clt.PostJSON(&translationRequest{
+		Query:  content,
+		Source: source,
+		Target: target,
+		Format: "html",
+		APIKey: c.apiKey,
+	})
+	if err != nil {
+		return "", fmt.Errorf("libretranslate: unable to send request: %v", err)
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		return "", &RateLimitError{RetryAfter: response.RetryAfterDelay()}
+	}
+
+	var result translationResponse
+	decodeErr := json.NewDecoder(response.Body).Decode(&result)
+
+	if response.HasServerFailure() {
+		if decodeErr == nil && result.Error != "" {
+			return "", fmt.Errorf("libretranslate: unable to translate, status=%d: %s", response.StatusCode, result.Error)
+		}
+		return "", fmt.Errorf("libretranslate: unable to translate, status=%d", response.StatusCode)
+	}
+
+	if decodeErr != nil {
+		return "", fmt.Errorf("libretranslate: unable to decode response: %v", decodeErr)
+	}
+
+	return result.TranslatedText, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package libretranslate // import "miniflux.app/integration/libretranslate"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"miniflux.app/config"
+)
+
+func parseConfig(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	parseConfig(t)
+
+	var payload translationRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/translate" {
+			t.Errorf(`Unexpected path, got %q`, r.URL.Path)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf(`Invalid payload: %v`, err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"translatedText": "<p>Hello</p>"}`))
+	}))
+	defer ts.Close()
+
+	result, err := NewClient(ts.URL+"/", "secret").Translate("<p>Bonjour</p>", "", "en")
+	if err != nil {
+		t.Fatalf(`Unable to translate: %v`, err)
+	}
+
+	if result != "<p>Hello</p>" {
+		t.Errorf(`Unexpected translation, got %q`, result)
+	}
+
+	if payload.Query != "<p>Bonjour</p>" || payload.Source != "auto" || payload.Target != "en" || payload.Format != "html" || payload.APIKey != "secret" {
+		t.Errorf(`Unexpected payload, got %+v`, payload)
+	}
+}
+
+func TestTranslateWithRateLimit(t *testing.T) {
+	parseConfig(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "").Translate("<p>Bonjour</p>", "fr", "en")
+	rateLimitErr, ok := err.(*RateLimitError)
+	if !ok {
+		t.Fatalf(`A rate limit error was expected, got %v`, err)
+	}
+
+	if rateLimitErr.RetryAfter != 30*time.Second {
+		t.Errorf(`Unexpected delay, got %v`, rateLimitErr.RetryAfter)
+	}
+}
+
+func TestTranslateWithServerError(t *testing.T) {
+	parseConfig(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error": "xx is not supported"}`))
+	}))
+	defer ts.Close()
+
+	_, err := NewClient(ts.URL, "").Translate("<p>Bonjour</p>", "fr", "xx")
+	if err == nil {
+		t.Fatal(`An error should be returned`)
+	}
+
+	if expected := "libretranslate: unable to translate, status=400: xx is not supported"; err.Error() != expected {
+		t.Errorf(`Unexpected error, got %q instead of %q`, err, expected)
+	}
+}
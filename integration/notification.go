@@ -0,0 +1,89 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package integration // import "miniflux.app/integration"
+
+import (
+	"fmt"
+	"time"
+
+	"miniflux.app/integration/apprise"
+	"miniflux.app/integration/discord"
+	"miniflux.app/integration/gotify"
+	"miniflux.app/integration/ntfy"
+	"miniflux.app/integration/telegram"
+	"miniflux.app/integration/webhook"
+	"miniflux.app/model"
+)
+
+const (
+	testNotificationTitle   = "Miniflux"
+	testNotificationMessage = "This is a test notification from Miniflux."
+	testNotificationURL     = "https://miniflux.app/"
+)
+
+// Notification providers that can be tested with SendTestNotification.
+const (
+	NotificationProviderTelegram = "telegram"
+	NotificationProviderDiscord  = "discord"
+	NotificationProviderWebhook  = "webhook"
+	NotificationProviderGotify   = "gotify"
+	NotificationProviderNtfy     = "ntfy"
+	NotificationProviderApprise  = "apprise"
+)
+
+// IsValidNotificationProvider returns true if the notification provider can be tested.
+func IsValidNotificationProvider(provider string) bool {
+	switch provider {
+	case NotificationProviderTelegram, NotificationProviderDiscord, NotificationProviderWebhook, NotificationProviderGotify, NotificationProviderNtfy, NotificationProviderApprise:
+		return true
+	default:
+		return false
+	}
+}
+
+// SendTestNotification sends a sample message with the settings of the provider stored for the user.
+// The settings are used even if the provider is not enabled yet, to check them before enabling it.
+func SendTestNotification(integration *model.Integration, provider string) error {
+	switch provider {
+	case NotificationProviderTelegram:
+		return telegram.SendMessages(
+			integration.TelegramToken,
+			integration.TelegramChatID,
+			integration.TelegramTopicID,
+			testNotificationTitle,
+			[]string{testNotificationMessage},
+		)
+	case NotificationProviderDiscord:
+		return discord.NewClient(integration.DiscordWebhookURL).SendMessages(
+			testNotificationTitle,
+			[]string{testNotificationMessage},
+		)
+	case NotificationProviderWebhook:
+		feed := &model.Feed{Title: testNotificationTitle, FeedURL: testNotificationURL, SiteURL: testNotificationURL}
+		entries := model.Entries{
+			&model.Entry{Title: testNotificationTitle, URL: testNotificationURL, Content: testNotificationMessage, Date: time.Now()},
+		}
+		return webhook.NewClient(integration.WebhookURL, integration.WebhookSecret).SendEntries(feed, entries)
+	case NotificationProviderGotify:
+		return gotify.NewClient(integration.GotifyURL, integration.GotifyToken, integration.GotifyPriority).SendMessage(
+			testNotificationTitle,
+			testNotificationMessage,
+			testNotificationURL,
+		)
+	case NotificationProviderNtfy:
+		return ntfy.NewClient(integration.NtfyURL, integration.NtfyTopic, integration.NtfyToken, integration.NtfyPriority).SendMessage(
+			testNotificationTitle,
+			testNotificationMessage,
+			testNotificationURL,
+		)
+	case NotificationProviderApprise:
+		return apprise.NewClient(integration.AppriseURL, integration.AppriseServicesURL, integration.AppriseConfigKey, integration.AppriseTag).SendNotification(
+			testNotificationTitle,
+			[]string{testNotificationMessage},
+		)
+	default:
+		return fmt.Errorf("integration: unsupported notification provider %q", provider)
+	}
+}
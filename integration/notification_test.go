@@ -0,0 +1,72 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package integration // import "miniflux.app/integration"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+)
+
+func TestSendTestNotificationToWebhook(t *testing.T) {
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	var payload struct {
+		Entries []struct {
+			Content string `json:"content"`
+		} `json:"entries"`
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf(`Unable to decode the payload: %v`, err)
+		}
+	}))
+	defer ts.Close()
+
+	integration := &model.Integration{WebhookURL: ts.URL}
+	if err := SendTestNotification(integration, NotificationProviderWebhook); err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if len(payload.Entries) != 1 || payload.Entries[0].Content != testNotificationMessage {
+		t.Errorf(`Unexpected payload, got %+v`, payload)
+	}
+}
+
+func TestSendTestNotificationReturnsErrors(t *testing.T) {
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	integration := &model.Integration{DiscordWebhookURL: ts.URL}
+	if err := SendTestNotification(integration, NotificationProviderDiscord); err == nil || !strings.Contains(err.Error(), "status=500") {
+		t.Errorf(`The error of the provider should be returned, got %v`, err)
+	}
+
+	integration = &model.Integration{TelegramToken: "token", TelegramChatID: "not a number"}
+	if err := SendTestNotification(integration, NotificationProviderTelegram); err == nil || !strings.Contains(err.Error(), "invalid chat ID") {
+		t.Errorf(`An invalid chat ID should return an error, got %v`, err)
+	}
+
+	if err := SendTestNotification(&model.Integration{}, "pocket"); err == nil {
+		t.Error(`An unsupported provider should return an error`)
+	}
+}
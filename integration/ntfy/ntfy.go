@@ -0,0 +1,91 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ntfy // import "miniflux.app/integration/ntfy"
+
+import (
+	"fmt"
+
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+// DefaultServerURL is the public ntfy server, used when the user doesn't host one.
+const DefaultServerURL = "https://ntfy.sh"
+
+// ntfy priorities go from 1 (min) to 5 (max), 3 is the default priority.
+const (
+	defaultPriority = 3
+	minPriority     = 1
+	maxPriority     = 5
+)
+
+type message struct {
+	Topic    string `json:"topic"`
+	Title    string `json:"title"`
+	Message  string `json:"message"`
+	Priority int    `json:"priority"`
+	Click    string `json:"click,omitempty"`
+}
+
+// Client represents a ntfy client.
+type Client struct {
+	serverURL string
+	topic     string
+	token     string
+	priority  int
+}
+
+// SendMessage publishes a message to the topic, the clients open the URL when the notification is clicked.
+func (c *Client) SendMessage(title, text, clickURL string) error {
+	if c.topic == "" {
+		return fmt.Errorf("ntfy: missing topic")
+	}
+
+	// Publishing JSON to the root URL allows titles that are not valid in HTTP headers.
+	clt := client.New(c.serverURL)
+	clt.WithAuthorizationHeader("Bearer", c.token)
+	response, err := clt.PostJSON(&message{Topic: c.topic, Title: title, Message: text, Priority: c.priority, Click: clickURL})
+	if err != nil {
+		return fmt.Errorf("ntfy: unable to send message: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("ntfy: unable to send message, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewClient returns a new ntfy client for the topic, the access token is optional.
+// The public server is used when the server URL is empty and the priority is reset to the default one when out of range.
+func NewClient(serverURL, topic, token string, priority int) *Client {
+	if serverURL == "" {
+		serverURL = DefaultServerURL
+	}
+	if priority < minPriority || priority > maxPriority {
+		priority = defaultPriority
+	}
+	return &Client{serverURL: serverURL, topic: topic, token: token, priority: priority}
+}
+
+// SendNtfyMsg sends new feed entries to ntfy, one message per entry.
+func SendNtfyMsg(integration *model.Integration, feed *model.Feed, entries model.Entries) {
+	if len(entries) == 0 {
+		return
+	}
+
+	if !integration.NtfyEnabled {
+		return
+	}
+
+	clt := NewClient(integration.NtfyURL, integration.NtfyTopic, integration.NtfyToken, integration.NtfyPriority)
+	for _, entry := range entries {
+		if err := clt.SendMessage(feed.Title, entry.Title, entry.URL); err != nil {
+			logger.Error("[Ntfy] Feed #%d: %v", feed.ID, err)
+			return
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ntfy // import "miniflux.app/integration/ntfy"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+func TestSendMessage(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	var payload message
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if authorization := r.Header.Get("Authorization"); authorization != "Bearer tk_secret" {
+			t.Errorf(`Unexpected Authorization header, got %q`, authorization)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf(`Invalid payload: %v`, err)
+		}
+	}))
+	defer ts.Close()
+
+	if err := NewClient(ts.URL, "miniflux", "tk_secret", 4).SendMessage("Feed Title", "Entry Title", "https://example.org/entry"); err != nil {
+		t.Fatalf(`Unable to send message: %v`, err)
+	}
+
+	expected := message{Topic: "miniflux", Title: "Feed Title", Message: "Entry Title", Priority: 4, Click: "https://example.org/entry"}
+	if payload != expected {
+		t.Errorf(`Unexpected payload, got %+v instead of %+v`, payload, expected)
+	}
+}
+
+func TestSendMessageWithoutToken(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if authorization := r.Header.Get("Authorization"); authorization != "" {
+			t.Errorf(`No Authorization header should be sent, got %q`, authorization)
+		}
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	if err := NewClient(ts.URL, "miniflux", "", 3).SendMessage("Feed Title", "Entry Title", ""); err == nil {
+		t.Fatal(`A server failure should return an error`)
+	}
+}
+
+func TestSendMessageWithoutTopic(t *testing.T) {
+	if err := NewClient("", "", "", 3).SendMessage("Feed Title", "Entry Title", ""); err == nil {
+		t.Fatal(`A missing topic should return an error`)
+	}
+}
+
+func TestNewClientDefaults(t *testing.T) {
+	clt := NewClient("", "miniflux", "", 0)
+	if clt.serverURL != DefaultServerURL {
+		t.Errorf(`Unexpected server URL, got %q`, clt.serverURL)
+	}
+
+	if clt.priority != defaultPriority {
+		t.Errorf(`Unexpected priority, got %d`, clt.priority)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package shaarli // import "miniflux.app/integration/shaarli"
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/config"
+)
+
+func TestGenerateToken(t *testing.T) {
+	token, err := generateToken("secret", time.Unix(1580000000, 0))
+	if err != nil {
+		t.Fatalf(`Unable to generate token: %v`, err)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf(`Invalid token: %q`, token)
+	}
+
+	header, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	if string(header) != `{"alg":"HS512","typ":"JWT"}` {
+		t.Errorf(`Unexpected token header: %s`, header)
+	}
+
+	payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	if string(payload) != `{"iat":1580000000}` {
+		t.Errorf(`Unexpected token payload: %s`, payload)
+	}
+
+	mac := hmac.New(sha512.New, []byte("secret"))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if parts[2] != base64.RawURLEncoding.EncodeToString(mac.Sum(nil)) {
+		t.Errorf(`Invalid token signature: %q`, parts[2])
+	}
+}
+
+func TestAddLink(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	var link Link
+	var path, authorization string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		authorization = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&link); err != nil {
+			t.Fatalf(`Invalid request body: %v`, err)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	if err := NewClient(ts.URL+"/shaarli/", "secret").AddLink("https://example.org/article", "Title", "Description"); err != nil {
+		t.Fatalf(`Unable to add link: %v`, err)
+	}
+
+	if path != "/shaarli/api/v1/links" {
+		t.Errorf(`Unexpected API endpoint: %q`, path)
+	}
+
+	if !strings.HasPrefix(authorization, "Bearer ") || strings.Count(authorization, ".") != 2 {
+		t.Errorf(`Unexpected Authorization header: %q`, authorization)
+	}
+
+	if link.URL != "https://example.org/article" || link.Title != "Title" || link.Description != "Description" || link.Private {
+		t.Errorf(`Unexpected request body: %+v`, link)
+	}
+}
+
+func TestAddLinkWithoutCredentials(t *testing.T) {
+	if err := NewClient("", "").AddLink("https://example.org/article", "Title", ""); err == nil {
+		t.Fatal(`Missing credentials should return an error`)
+	}
+}
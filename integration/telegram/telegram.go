@@ -8,47 +8,195 @@ import (
 	"fmt"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
 	"miniflux.app/logger"
-	"miniflux.app/storage"
+	"miniflux.app/model"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 )
 
+// Telegram rejects messages longer than 4096 characters, counted in UTF-16 code units.
+const maxMessageLength = 4096
+
+const ellipsis = "..."
+
+var markdownSpecialChars = regexp.MustCompile("(\\[|\\*|\\`|\\_)")
+
+// EscapeMarkdown escapes the characters interpreted by the Telegram Markdown parser.
+func EscapeMarkdown(text string) string {
+	return markdownSpecialChars.ReplaceAllString(text, "\\$1")
+}
+
 // SendTelegramMsg sends feed to Telegram.
-func SendTelegramMsg(store *storage.Storage, userID int64, feedID int64, telegramItemMsg []string) {
-	if len(telegramItemMsg) > 0 {
-		integration, err := store.Integration(userID)
+func SendTelegramMsg(integration *model.Integration, feed *model.Feed, telegramItemMsg []string) {
+	if !shouldNotify(integration, feed, telegramItemMsg) {
+		return
+	}
+
+	if err := SendMessages(integration.TelegramToken, integration.TelegramChatID, integration.TelegramTopicID, feed.Title, telegramItemMsg); err != nil {
+		logger.Error(`[Telegram]: feed #%d Send msg error %v`, feed.ID, err)
+	}
+}
+
+// shouldNotify returns true when the integration is configured and the notifications are not disabled for the feed.
+func shouldNotify(integration *model.Integration, feed *model.Feed, items []string) bool {
+	return len(items) > 0 && integration.TelegramEnabled && integration.TelegramToken != "" && feed.NotifyTelegram
+}
+
+// SendMessages sends the feed title and the list of items to the chat, split in several messages if necessary.
+// The topic is optional, it is only used by supergroups.
+func SendMessages(token, chatID, topicID, feedTitle string, items []string) error {
+	if token == "" {
+		return fmt.Errorf("telegram: missing bot token")
+	}
+
+	parsedChatID, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("telegram: invalid chat ID: %v", err)
+	}
+
+	var parsedTopicID int64
+	if topicID != "" {
+		parsedTopicID, err = strconv.ParseInt(topicID, 10, 64)
 		if err != nil {
-			logger.Error("[Telegram] %v", err)
-			return
+			return fmt.Errorf("telegram: invalid topic ID: %v", err)
+		}
+	}
+
+	bot, err := tgbotapi.NewBotAPIWithClient(token, &http.Client{Timeout: 15 * time.Second})
+	if err != nil {
+		return fmt.Errorf("telegram: %v", err)
+	}
+
+	for _, message := range buildMessages(parsedChatID, feedTitle, items) {
+		if err := sendMessage(bot, message, parsedTopicID); err != nil {
+			return fmt.Errorf("telegram: unable to send message: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// sendMessage sends the message to the chat, or to the given topic of a supergroup.
+// The Telegram library doesn't know about topics, so the request is built manually in that case.
+func sendMessage(bot *tgbotapi.BotAPI, message tgbotapi.MessageConfig, topicID int64) error {
+	if topicID == 0 {
+		_, err := bot.Send(message)
+		return err
+	}
+
+	_, err := bot.MakeRequest("sendMessage", messageParams(message, topicID))
+	return err
+}
+
+func messageParams(message tgbotapi.MessageConfig, topicID int64) url.Values {
+	params := url.Values{}
+	params.Set("chat_id", strconv.FormatInt(message.ChatID, 10))
+	params.Set("message_thread_id", strconv.FormatInt(topicID, 10))
+	params.Set("text", message.Text)
+	params.Set("parse_mode", message.ParseMode)
+	params.Set("disable_web_page_preview", strconv.FormatBool(message.DisableWebPagePreview))
+	return params
+}
+
+// buildMessages splits the list of items into as many messages as required to stay under the Telegram limit.
+// The items are Markdown links, a message is only split between two items to keep the links valid.
+func buildMessages(chatID int64, feedTitle string, items []string) []tgbotapi.MessageConfig {
+	var messages []tgbotapi.MessageConfig
+	header := "*" + truncateText(EscapeMarkdown(feedTitle), maxMessageLength-2) + "*"
+	for _, text := range splitText(header, items) {
+		message := tgbotapi.NewMessage(chatID, text)
+		message.DisableWebPagePreview = true
+		message.ParseMode = "markdown"
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+// splitText joins items line by line, starting a new text each time the limit would be exceeded.
+// The header is only added to the first text.
+func splitText(header string, items []string) []string {
+	var texts []string
+	current := header
+	for _, item := range items {
+		item = truncateItem(item, maxMessageLength)
+		if item == "" {
+			continue
+		}
+
+		if current == "" {
+			current = item
+		} else if utf16Length(current)+1+utf16Length(item) <= maxMessageLength {
+			current += "\n" + item
+		} else {
+			texts = append(texts, current)
+			current = item
 		}
-		if integration != nil && integration.TelegramEnabled && len(integration.TelegramToken) > 0 {
-			feed, storeErr := store.FeedByID(userID, feedID)
-			if storeErr != nil {
-				logger.Error("[Telegram] %v", storeErr)
-				return
-			}
-			bot, botErr := tgbotapi.NewBotAPIWithClient(integration.TelegramToken, &http.Client{Timeout: 15 * time.Second})
-			if botErr != nil {
-				logger.Error("[Telegram] %v", botErr)
-				return
-			}
-			if bot != nil {
-				text := fmt.Sprintf("*%v*\n", feed.Title) + strings.Join(telegramItemMsg, "\n")
-				chatID, parseErr := strconv.ParseInt(integration.TelegramChatID, 10, 64)
-				if parseErr != nil {
-					logger.Error("[Telegram] %v", parseErr)
-					return
-				}
-				message := tgbotapi.NewMessage(chatID, text)
-				message.DisableWebPagePreview = true
-				message.ParseMode = "markdown"
-				_, err := bot.Send(message)
-				if err != nil {
-					logger.Error(`[Telegram]: feed #%d Send msg error %v`, feedID, err)
-				}
-			}
+	}
+	if current != "" {
+		texts = append(texts, current)
+	}
+	return texts
+}
+
+// truncateItem shortens the title of a Markdown link to fit in the limit, the URL is never truncated.
+// An empty string is returned when the item cannot fit in a message without breaking the link.
+func truncateItem(item string, limit int) string {
+	if utf16Length(item) <= limit {
+		return item
+	}
+
+	separator := strings.LastIndex(item, "](")
+	if !strings.HasPrefix(item, "[") || !strings.HasSuffix(item, ")") || separator < 1 {
+		return ""
+	}
+
+	suffix := item[separator:]
+	available := limit - 1 - utf16Length(suffix) - len(ellipsis)
+	if available <= 0 {
+		return ""
+	}
+
+	return "[" + truncateText(item[1:separator], available) + ellipsis + suffix
+}
+
+// truncateText cuts the text to the given number of UTF-16 code units without splitting a Markdown escape sequence.
+func truncateText(text string, limit int) string {
+	if utf16Length(text) <= limit {
+		return text
+	}
+
+	var length, end int
+	for i, r := range text {
+		size := 1
+		if r >= 0x10000 {
+			size = 2
+		}
+		if length+size > limit {
+			break
+		}
+		length += size
+		end = i + len(string(r))
+	}
+
+	text = text[:end]
+	if trailing := len(text) - len(strings.TrimRight(text, "\\")); trailing%2 == 1 {
+		text = text[:len(text)-1]
+	}
+	return text
+}
+
+// utf16Length returns the length of the text in UTF-16 code units, the unit used by Telegram.
+func utf16Length(text string) int {
+	var length int
+	for _, r := range text {
+		if r >= 0x10000 {
+			length += 2
+		} else {
+			length++
 		}
 	}
+	return length
 }
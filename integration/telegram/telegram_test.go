@@ -0,0 +1,156 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package telegram // import "miniflux.app/integration/telegram"
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestBuildMessagesWithManyItems(t *testing.T) {
+	var items []string
+	for i := 0; i < 100; i++ {
+		items = append(items, fmt.Sprintf("[Some quite long entry title number %d](https://example.org/articles/%d/some-long-slug-for-this-entry)", i, i))
+	}
+
+	messages := buildMessages(42, "Feed Title", items)
+	if len(messages) < 2 {
+		t.Fatalf(`Items should be split into multiple messages, got %d`, len(messages))
+	}
+
+	var count int
+	for i, message := range messages {
+		if message.ChatID != 42 {
+			t.Fatalf(`Unexpected chat ID: %d`, message.ChatID)
+		}
+
+		if utf16Length(message.Text) > maxMessageLength {
+			t.Fatalf(`Message #%d is too long: %d characters`, i, utf16Length(message.Text))
+		}
+
+		hasTitle := strings.HasPrefix(message.Text, "*Feed Title*\n")
+		if i == 0 && !hasTitle {
+			t.Fatalf(`The first message should start with the feed title`)
+		}
+
+		if i > 0 && hasTitle {
+			t.Fatalf(`Only the first message should contain the feed title`)
+		}
+
+		count += strings.Count(message.Text, "](https://example.org/")
+	}
+
+	if count != 100 {
+		t.Fatalf(`All items should be sent, got %d`, count)
+	}
+}
+
+func TestBuildMessagesWithTooLongItem(t *testing.T) {
+	items := []string{fmt.Sprintf("[%s](https://example.org/)", strings.Repeat("a", 5000)), "[Short](https://example.org/short)"}
+
+	messages := buildMessages(42, "Feed Title", items)
+	if len(messages) != 3 {
+		t.Fatalf(`Unexpected number of messages: %d`, len(messages))
+	}
+
+	if utf16Length(messages[1].Text) != maxMessageLength {
+		t.Fatalf(`The long item should be truncated to the limit, got %d characters`, utf16Length(messages[1].Text))
+	}
+
+	if !strings.HasSuffix(messages[1].Text, ellipsis+"](https://example.org/)") {
+		t.Fatalf(`The title of the truncated item should end with an ellipsis and keep the link: %q`, messages[1].Text[len(messages[1].Text)-40:])
+	}
+
+	if messages[2].Text != "[Short](https://example.org/short)" {
+		t.Fatalf(`Unexpected last message: %q`, messages[2].Text)
+	}
+}
+
+func TestBuildMessagesWithTooLongURL(t *testing.T) {
+	items := []string{fmt.Sprintf("[Title](https://example.org/%s)", strings.Repeat("a", 5000)), "[Short](https://example.org/short)"}
+
+	messages := buildMessages(42, "Feed Title", items)
+	if len(messages) != 1 || messages[0].Text != "*Feed Title*\n[Short](https://example.org/short)" {
+		t.Fatalf(`An item that cannot fit in a message should be skipped, got %+v`, messages)
+	}
+}
+
+func TestBuildMessagesEscapesFeedTitle(t *testing.T) {
+	messages := buildMessages(42, "My_Feed *News* [1]", []string{"[Title](https://example.org/)"})
+	if !strings.HasPrefix(messages[0].Text, "*My\\_Feed \\*News\\* \\[1]*\n") {
+		t.Fatalf(`The feed title should be escaped, got %q`, messages[0].Text)
+	}
+}
+
+func TestBuildMessagesCountsUTF16CodeUnits(t *testing.T) {
+	var items []string
+	for i := 0; i < 100; i++ {
+		items = append(items, fmt.Sprintf("[%s](https://example.org/%d)", strings.Repeat("😀", 20), i))
+	}
+
+	for i, message := range buildMessages(42, "Feed Title", items) {
+		if utf16Length(message.Text) > maxMessageLength {
+			t.Fatalf(`Message #%d is too long: %d UTF-16 code units`, i, utf16Length(message.Text))
+		}
+	}
+}
+
+func TestTruncateTextKeepsEscapeSequences(t *testing.T) {
+	if text := truncateText("abc\\_def", 4); text != "abc" {
+		t.Fatalf(`An escape sequence should not be split, got %q`, text)
+	}
+
+	if text := truncateText("a😀b", 2); text != "a" {
+		t.Fatalf(`A surrogate pair should not be split, got %q`, text)
+	}
+}
+
+func TestMessageParamsWithTopic(t *testing.T) {
+	messages := buildMessages(-1001234, "Feed Title", []string{"[Title](https://example.org/)"})
+	params := messageParams(messages[0], 42)
+
+	if params.Get("chat_id") != "-1001234" {
+		t.Fatalf(`Unexpected chat_id: %q`, params.Get("chat_id"))
+	}
+
+	if params.Get("message_thread_id") != "42" {
+		t.Fatalf(`Unexpected message_thread_id: %q`, params.Get("message_thread_id"))
+	}
+
+	if params.Get("text") != messages[0].Text {
+		t.Fatalf(`Unexpected text: %q`, params.Get("text"))
+	}
+
+	if params.Get("parse_mode") != "markdown" || params.Get("disable_web_page_preview") != "true" {
+		t.Fatalf(`Message options should be preserved: %v`, params)
+	}
+}
+
+func TestShouldNotify(t *testing.T) {
+	items := []string{"[Title](https://example.org/)"}
+	enabled := &model.Integration{TelegramEnabled: true, TelegramToken: "token", TelegramChatID: "42"}
+
+	scenarios := []struct {
+		integration *model.Integration
+		feed        *model.Feed
+		items       []string
+		expected    bool
+	}{
+		{enabled, &model.Feed{NotifyTelegram: true}, items, true},
+		{enabled, &model.Feed{NotifyTelegram: false}, items, false},
+		{enabled, &model.Feed{NotifyTelegram: true}, nil, false},
+		{&model.Integration{TelegramEnabled: false, TelegramToken: "token"}, &model.Feed{NotifyTelegram: true}, items, false},
+		{&model.Integration{TelegramEnabled: true}, &model.Feed{NotifyTelegram: true}, items, false},
+	}
+
+	for i, scenario := range scenarios {
+		if result := shouldNotify(scenario.integration, scenario.feed, scenario.items); result != scenario.expected {
+			t.Errorf(`Unexpected result for scenario #%d, got %v instead of %v`, i, result, scenario.expected)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webhook // import "miniflux.app/integration/webhook"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+// SignatureHeader contains the HMAC-SHA256 of the request body when a secret is defined.
+const SignatureHeader = "X-Miniflux-Signature"
+
+type payload struct {
+	Feed    *feed    `json:"feed"`
+	Entries []*entry `json:"entries"`
+}
+
+type feed struct {
+	ID      int64  `json:"id"`
+	Title   string `json:"title"`
+	FeedURL string `json:"feed_url"`
+	SiteURL string `json:"site_url"`
+}
+
+type entry struct {
+	ID          int64     `json:"id"`
+	Title       string    `json:"title"`
+	URL         string    `json:"url"`
+	Content     string    `json:"content"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// Client represents a webhook client.
+type Client struct {
+	webhookURL string
+	secret     string
+}
+
+// SendEntries posts the feed and its new entries to the webhook.
+func (c *Client) SendEntries(f *model.Feed, entries model.Entries) error {
+	if c.webhookURL == "" {
+		return fmt.Errorf("webhook: missing webhook URL")
+	}
+
+	body, err := json.Marshal(newPayload(f, entries))
+	if err != nil {
+		return fmt.Errorf("webhook: unable to encode payload: %v", err)
+	}
+
+	clt := client.New(c.webhookURL)
+	if c.secret != "" {
+		clt.WithHeader(SignatureHeader, Sign(c.secret, body))
+	}
+
+	response, err := clt.PostJSON(json.RawMessage(body))
+	if err != nil {
+		return fmt.Errorf("webhook: unable to send entries: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("webhook: unable to send entries, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewClient returns a new webhook client.
+func NewClient(webhookURL, secret string) *Client {
+	return &Client{webhookURL: webhookURL, secret: secret}
+}
+
+// Sign returns the hexadecimal HMAC-SHA256 of the body computed with the secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SendWebhook sends new feed entries to the webhook of the user.
+func SendWebhook(integration *model.Integration, feed *model.Feed, entries model.Entries) {
+	if len(entries) == 0 {
+		return
+	}
+
+	if !integration.WebhookEnabled {
+		return
+	}
+
+	if err := NewClient(integration.WebhookURL, integration.WebhookSecret).SendEntries(feed, entries); err != nil {
+		logger.Error("[Webhook] Feed #%d: %v", feed.ID, err)
+	}
+}
+
+func newPayload(f *model.Feed, entries model.Entries) *payload {
+	p := &payload{
+		Feed:    &feed{ID: f.ID, Title: f.Title, FeedURL: f.FeedURL, SiteURL: f.SiteURL},
+		Entries: make([]*entry, 0, len(entries)),
+	}
+
+	for _, e := range entries {
+		p.Entries = append(p.Entries, &entry{
+			ID:          e.ID,
+			Title:       e.Title,
+			URL:         e.URL,
+			Content:     e.Content,
+			PublishedAt: e.Date,
+		})
+	}
+
+	return p
+}
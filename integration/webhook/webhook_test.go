@@ -0,0 +1,111 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webhook // import "miniflux.app/integration/webhook"
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+)
+
+func parseConfig(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+}
+
+func TestSendEntries(t *testing.T) {
+	parseConfig(t)
+
+	var received payload
+	var signature string
+	var body []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		signature = r.Header.Get(SignatureHeader)
+		if err := json.Unmarshal(body, &received); err != nil {
+			t.Fatalf(`Invalid payload: %v`, err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	feed := &model.Feed{ID: 1, Title: "Feed Title", FeedURL: "https://example.org/feed.xml", SiteURL: "https://example.org/"}
+	date := time.Date(2020, time.March, 1, 10, 0, 0, 0, time.UTC)
+	entries := model.Entries{
+		{ID: 2, Title: "Entry", URL: "https://example.org/entry", Content: "<p>Content</p>", Date: date},
+	}
+
+	if err := NewClient(ts.URL, "secret").SendEntries(feed, entries); err != nil {
+		t.Fatalf(`Unable to send entries: %v`, err)
+	}
+
+	if received.Feed == nil || received.Feed.Title != "Feed Title" || received.Feed.FeedURL != feed.FeedURL {
+		t.Errorf(`Unexpected feed: %+v`, received.Feed)
+	}
+
+	if len(received.Entries) != 1 {
+		t.Fatalf(`Unexpected number of entries: %d`, len(received.Entries))
+	}
+
+	e := received.Entries[0]
+	if e.ID != 2 || e.Title != "Entry" || e.URL != "https://example.org/entry" || e.Content != "<p>Content</p>" || !e.PublishedAt.Equal(date) {
+		t.Errorf(`Unexpected entry: %+v`, e)
+	}
+
+	if signature != Sign("secret", body) {
+		t.Errorf(`Invalid signature, got %q`, signature)
+	}
+}
+
+func TestSendEntriesWithoutSecret(t *testing.T) {
+	parseConfig(t)
+
+	signed := true
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, signed = r.Header[SignatureHeader]
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	if err := NewClient(ts.URL, "").SendEntries(&model.Feed{ID: 1}, model.Entries{{ID: 2}}); err != nil {
+		t.Fatalf(`Unable to send entries: %v`, err)
+	}
+
+	if signed {
+		t.Error(`The request should not be signed without a secret`)
+	}
+}
+
+func TestSendEntriesWithServerFailure(t *testing.T) {
+	parseConfig(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer ts.Close()
+
+	if err := NewClient(ts.URL, "").SendEntries(&model.Feed{ID: 1}, model.Entries{{ID: 2}}); err == nil {
+		t.Fatal(`A server failure should return an error`)
+	}
+}
+
+func TestSign(t *testing.T) {
+	expected := "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"
+	if signature := Sign("key", []byte("The quick brown fox jumps over the lazy dog")); signature != expected {
+		t.Errorf(`Unexpected signature, got %q`, signature)
+	}
+}
@@ -42,6 +42,10 @@ var translations = map[string]string{
     "menu.show_all_entries": "Zeige alle Artikel",
     "menu.show_only_unread_entries": "Nur ungelesene Artikel anzeigen",
     "menu.refresh_feed": "Aktualisieren",
+    "menu.force_refresh_feed": "Aktualisierung erzwingen",
+    "menu.force_refresh_feed.title": "Das Abonnement erneut herunterladen und verarbeiten, auch wenn es nicht geändert wurde",
+    "menu.reprocess_feed_entries": "Artikel neu verarbeiten",
+    "menu.reprocess_feed_entries.title": "Die aktuellen Scraper- und Umschreiberegeln auf die vorhandenen Artikel anwenden",
     "menu.refresh_all_feeds": "Alle Abonnements im Hintergrund aktualisieren",
     "menu.edit_feed": "Bearbeiten",
     "menu.edit_category": "Bearbeiten",
@@ -126,11 +130,16 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Abonnement auswählen",
     "page.edit_feed.title": "Abonnement bearbeiten: %s",
     "page.edit_feed.last_check": "Letzte Aktualisierung:",
+    "page.edit_feed.last_status_code": "Letzter HTTP-Statuscode:",
     "page.edit_feed.last_modified_header": "Zuletzt geändert:",
     "page.edit_feed.etag_header": "ETag-Kopfzeile:",
     "page.edit_feed.no_header": "Nicht verfügbar",
     "page.edit_feed.last_parsing_error": "Letzter Analysefehler",
+    "page.edit_feed.disabled_reason": "Dieses Abonnement wurde automatisch deaktiviert",
     "page.entry.attachments": "Anlagen",
+    "page.entry.transcripts": "Transkripte",
+    "page.entry.summary": "Zusammenfassung des Abonnements",
+    "page.entry.original_content": "Originalinhalt",
     "page.keyboard_shortcuts.title": "Tastenkürzel",
     "page.keyboard_shortcuts.subtitle.sections": "Navigation zwischen den Menüpunkten",
     "page.keyboard_shortcuts.subtitle.items": "Navigation zwischen den Artikeln",
@@ -218,6 +227,7 @@ var translations = map[string]string{
     "alert.account_linked": "Ihr externes Konto wurde verknüpft!",
     "alert.pocket_linked": "Ihr Pocket Konto ist jetzt verknüpft!",
     "alert.prefs_saved": "Einstellungen gespeichert!",
+    "alert.opml_import_started": "%d Abonnements werden im Hintergrund importiert, sie erscheinen nach und nach.",
     "error.unlink_account_without_password": "Sie müssen ein Passwort festlegen, sonst können Sie sich nicht erneut anmelden.",
     "error.duplicate_linked_account": "Es ist bereits jemand mit diesem Anbieter assoziiert!",
     "error.duplicate_fever_username": "Es existiert bereits jemand mit diesem Fever Benutzernamen!",
@@ -240,22 +250,56 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Die Felder für Benutzername, Thema, Sprache und Zeitzone sind obligatorisch.",
     "error.entries_per_page_invalid": "Die Anzahl der Einträge pro Seite ist ungültig.",
     "error.feed_mandatory_fields": "Die URL und die Kategorie sind obligatorisch.",
+    "error.invalid_icon_url": "Die Icon-URL ist ungültig.",
+    "error.invalid_proxy_url": "Die Proxy-URL ist ungültig.",
+    "error.invalid_author_keeplist_rules": "Die Autor-Behalteregeln dürfen die leere Autorregel \"\" nicht enthalten, Artikel ohne Autor werden immer behalten.",
     "error.user_mandatory_fields": "Der Benutzername ist obligatorisch.",
     "error.api_key_already_exists": "Dieser API-Schlüssel ist bereits vorhanden.",
     "error.unable_to_create_api_key": "Dieser API-Schlüssel kann nicht erstellt werden.",
     "form.feed.label.title": "Titel",
     "form.feed.label.site_url": "Webseite-URL",
     "form.feed.label.feed_url": "Abonnement-URL",
+    "form.feed.label.icon_url": "Icon-URL (leer lassen, um das Icon automatisch zu finden)",
     "form.feed.label.category": "Kategorie",
     "form.feed.label.crawler": "Inhalt herunterladen",
     "form.feed.label.feed_username": "Benutzername des Abonnements",
     "form.feed.label.feed_password": "Passwort des Abonnements",
+    "form.feed.label.auth_scheme": "Authentifizierung",
+    "form.feed.auth_scheme.basic": "HTTP Basic (Benutzername und Passwort)",
+    "form.feed.auth_scheme.bearer": "Bearer-Token",
+    "form.feed.author_match_mode.exact": "Exakter Name",
+    "form.feed.author_match_mode.substring": "Teil des Namens",
+    "form.feed.crawler_mode.category": "Einstellung der Kategorie verwenden",
+    "form.feed.crawler_mode.enabled": "Aktiviert",
+    "form.feed.crawler_mode.disabled": "Deaktiviert",
+    "form.feed.label.auth_token": "Token (leer lassen, um das aktuelle Token zu behalten)",
     "form.feed.label.user_agent": "Standardbenutzeragenten überschreiben",
+    "form.feed.label.cookie": "Cookies",
+    "form.feed.label.proxy_url": "Proxy-URL",
+    "form.feed.label.translation_language": "Inhalt in diese Sprache übersetzen (Sprachcode wie \"de\", erfordert eine Übersetzungs-API)",
     "form.feed.label.scraper_rules": "Extraktionsregeln",
     "form.feed.label.rewrite_rules": "Umschreiberegeln",
+    "form.feed.label.blocklist_rules": "Blockierregeln (reguläre Ausdrücke, einer pro Zeile)",
+    "form.feed.label.keeplist_rules": "Behalteregeln (reguläre Ausdrücke, einer pro Zeile)",
+    "form.feed.label.author_blocklist_rules": "Autoren-Blockierregeln (ein Autor pro Zeile, \"\" für Artikel ohne Autor)",
+    "form.feed.label.author_keeplist_rules": "Autoren-Erlaubnisregeln (ein Autor pro Zeile)",
+    "form.feed.label.author_match_mode": "Autorenabgleich",
+    "form.feed.label.date_layouts": "Datumsformate (Go-Zeitformate, eins pro Zeile, verwendet wenn das Datum nicht erkannt wird)",
+    "form.feed.label.max_entries": "Maximale Anzahl der Artikel (0 für unbegrenzt)",
+    "form.feed.label.mark_read_after_days": "Ungelesene Artikel nach dieser Anzahl von Tagen als gelesen markieren (0 für den Wert der Kategorie oder den Standardwert)",
+    "form.feed.label.request_timeout": "Zeitlimit der Anfrage in Sekunden (0 für den Standardwert)",
+    "form.feed.label.refresh_interval": "Aktualisierungsintervall in Minuten (0 für den Standardwert)",
     "form.feed.label.ignore_http_cache": "Ignoriere HTTP-cache",
+    "form.feed.label.notify_telegram": "Neue Artikel an Telegram senden",
+    "form.feed.label.skip_duplicate_guids": "Artikel überspringen, deren GUID bereits in einem anderen Abonnement existiert",
     "form.feed.label.disabled": "Dieses Abonnement nicht aktualisieren",
+    "form.feed.label.sort_order": "Sortierung der Artikel",
+    "form.feed.sort_order.default": "Meine Einstellungen verwenden",
     "form.category.label.title": "Titel",
+    "form.category.label.crawler": "Inhalt für alle Abonnements dieser Kategorie herunterladen",
+    "form.category.label.mark_read_after_days": "Ungelesene Artikel nach dieser Anzahl von Tagen als gelesen markieren (0 für den Standardwert)",
+    "form.category.label.telegram_chat_id": "Telegram Chat-ID für die Abonnements dieser Kategorie (optional)",
+    "form.category.label.webhook_url": "Webhook-URL für die Abonnements dieser Kategorie (optional)",
     "form.user.label.username": "Benutzername",
     "form.user.label.password": "Passwort",
     "form.user.label.confirmation": "Passwort Bestätigung",
@@ -267,8 +311,13 @@ var translations = map[string]string{
     "form.prefs.label.entries_per_page": "Einträge pro Seite",
     "form.prefs.select.older_first": "Älteste Artikel zuerst",
     "form.prefs.select.recent_first": "Neueste Artikel zuerst",
+    "form.prefs.select.deduplication_disabled": "Duplikate behalten",
+    "form.prefs.select.deduplication_skip": "Duplikate ignorieren",
+    "form.prefs.select.deduplication_mark_as_read": "Duplikate als gelesen markieren",
     "form.prefs.label.keyboard_shortcuts": "Tastaturkürzel aktivieren",
     "form.prefs.label.show_reading_time": "Geschätzte Lesezeit für Artikel anzeigen",
+    "form.prefs.label.mark_read_on_scroll": "Anwendungen erlauben, Artikel beim Scrollen als gelesen zu markieren",
+    "form.prefs.label.entry_deduplication": "Doppelte Artikel in verschiedenen Abonnements",
     "form.prefs.label.custom_css": "Benutzerdefiniertes CSS",
     "form.import.label.file": "OPML Datei",
     "form.import.label.url": "URL",
@@ -296,9 +345,39 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Artikel in Nunux Keeper speichern",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API-Endpunkt",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API-Schlüssel",
+    "form.integration.shaarli_activate": "Artikel in Shaarli speichern",
+    "form.integration.shaarli_endpoint": "Shaarli-URL",
+    "form.integration.shaarli_api_secret": "Shaarli-API-Geheimnis",
     "form.integration.telegram_activate": "Forward to Telegram",
     "form.integration.telegram_token": "Telegram Bot Token",
     "form.integration.telegram_chat_id": "Recipient ChatId",
+    "form.integration.telegram_topic_id": "Themen-ID (optional)",
+    "form.integration.discord_activate": "An Discord weiterleiten",
+    "form.integration.discord_webhook_url": "Discord-Webhook-URL",
+    "form.integration.webhook_activate": "Neue Artikel an einen Webhook senden",
+    "form.integration.webhook_url": "Webhook-URL",
+    "form.integration.webhook_secret": "Webhook-Geheimnis (optional)",
+    "form.integration.gotify_activate": "Neue Artikel an Gotify senden",
+    "form.integration.gotify_url": "Gotify-Server-URL",
+    "form.integration.gotify_token": "Gotify-Anwendungstoken",
+    "form.integration.gotify_priority": "Priorität (0 bis 10)",
+    "form.integration.ntfy_activate": "Neue Artikel an ntfy senden",
+    "form.integration.ntfy_url": "ntfy-Server-URL",
+    "form.integration.ntfy_topic": "ntfy-Thema",
+    "form.integration.ntfy_token": "ntfy-Zugangstoken (optional)",
+    "form.integration.ntfy_priority": "Priorität (1 bis 5)",
+    "form.integration.apprise_activate": "Neue Artikel an Apprise senden",
+    "form.integration.apprise_url": "Apprise-API-URL",
+    "form.integration.apprise_services_url": "Apprise-Dienst-URLs, durch Kommas getrennt (ohne Konfigurationsschlüssel)",
+    "form.integration.apprise_config_key": "Apprise-Konfigurationsschlüssel (optional)",
+    "form.integration.apprise_tag": "Apprise-Tag (optional, mit einem Konfigurationsschlüssel)",
+    "form.integration.imap_activate": "E-Mails eines IMAP-Postfachs als Abonnement importieren",
+    "form.integration.imap_server": "IMAP-Server (Host:Port)",
+    "form.integration.imap_username": "IMAP-Benutzername",
+    "form.integration.imap_password": "IMAP-Passwort",
+    "form.integration.imap_mailbox": "Postfach",
+    "form.integration.imap_sender_filter": "Nur E-Mails dieses Absenders importieren (optional)",
+    "form.integration.mark_read_on_save": "Artikel nach dem Speichern als gelesen markieren",
     "form.api_key.label.description": "API-Schlüsselbezeichnung",
     "form.submit.loading": "Lade...",
     "form.submit.saving": "Speichern...",
@@ -330,6 +409,8 @@ var translations = map[string]string{
         "vor %d Jahren"
     ],
     "This feed already exists (%s)": "Diese Abonnement existiert bereits (%s)",
+    "Unable to refresh %d feeds: %s": "%d Abonnements konnten nicht aktualisiert werden: %s",
+    "Invalid filter rule %q: %v": "Ungültige Filterregel %q: %v",
     "Unable to fetch feed (Status Code = %d)": "Abonnement konnte nicht abgerufen werden (code=%d)",
     "Unable to open this link: %v": "Dieser Link konnte nicht geöffnet werden: %v",
     "Unable to analyze this page: %v": "Diese Seite konnte nicht analysiert werden: %v",
@@ -339,16 +420,23 @@ var translations = map[string]string{
     "Unable to parse Atom feed: %q": "Atom Abonnement konnte nicht gelesen werden: %q",
     "Unable to parse JSON feed: %q": "JSON Abonnement konnte nicht gelesen werden: %q",
     "Unable to parse RDF feed: %q": "RDF Abonnement konnte nicht gelesen werden: %q",
+    "Unable to parse Reddit listing: %q": "Reddit Liste konnte nicht gelesen werden: %q",
     "Unable to normalize encoding: %q": "Zeichenkodierung konnte nicht normalisiert werden: %q",
     "This feed is empty": "Dieses Abonnement ist leer",
+    "The IMAP integration is disabled for this feed": "Die IMAP-Integration ist für dieses Abonnement deaktiviert",
     "This web page is empty": "Diese Webseite ist leer",
+    "This resource is larger than the maximum size allowed (%d MiB)": "Diese Ressource ist größer als die maximal erlaubte Größe (%d MiB)",
     "Invalid SSL certificate (original error: %q)": "Ungültiges SSL-Zertifikat (ursprünglicher Fehler: %q)",
     "This website is temporarily unreachable (original error: %q)": "Diese Webseite ist vorübergehend nicht erreichbar (ursprünglicher Fehler: %q)",
     "This website is permanently unreachable (original error: %q)": "Diese Webseite ist dauerhaft nicht erreichbar (ursprünglicher Fehler: %q)",
     "Website unreachable, the request timed out after %d seconds": "Webseite nicht erreichbar, die Anfrage endete nach %d Sekunden",
+    "Invalid proxy URL": "Ungültige Proxy-URL",
+    "A Tor proxy is required to fetch .onion addresses": "Ein Tor-Proxy ist erforderlich, um .onion-Adressen abzurufen",
+    "This resource has been redirected too many times (more than %d)": "Diese Ressource wurde zu oft umgeleitet (mehr als %d)",
     "You are not authorized to access this resource (invalid username/password)": "Sie sind nicht berechtigt, auf diese Ressource zuzugreifen (Benutzername/Passwort ungültig)",
     "Unable to fetch this resource (Status Code = %d)": "Ressource konnte nicht abgerufen werden (code=%d)",
-    "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Ressource nicht gefunden (404), dieses Abonnement existiert nicht mehr, überprüfen Sie die Abonnement-URL"
+    "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Ressource nicht gefunden (404), dieses Abonnement existiert nicht mehr, überprüfen Sie die Abonnement-URL",
+    "Unable to fetch this resource after %d attempts: %v": "Ressource konnte nach %d Versuchen nicht abgerufen werden: %v"
 }
 `,
 	"en_US": `{
@@ -390,6 +478,10 @@ var translations = map[string]string{
     "menu.show_all_entries": "Show all entries",
     "menu.show_only_unread_entries": "Show only unread entries",
     "menu.refresh_feed": "Refresh",
+    "menu.force_refresh_feed": "Force refresh",
+    "menu.force_refresh_feed.title": "Download and process the feed again, even if it has not been modified",
+    "menu.reprocess_feed_entries": "Reprocess entries",
+    "menu.reprocess_feed_entries.title": "Apply the current scraper and rewrite rules to the existing entries",
     "menu.refresh_all_feeds": "Refresh all feeds in the background",
     "menu.edit_feed": "Edit",
     "menu.edit_category": "Edit",
@@ -474,11 +566,16 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Choose a Subscription",
     "page.edit_feed.title": "Edit Feed: %s",
     "page.edit_feed.last_check": "Last check:",
+    "page.edit_feed.last_status_code": "Last HTTP status code:",
     "page.edit_feed.last_modified_header": "LastModified header:",
     "page.edit_feed.etag_header": "ETag header:",
     "page.edit_feed.no_header": "None",
     "page.edit_feed.last_parsing_error": "Last Parsing Error",
+    "page.edit_feed.disabled_reason": "This feed has been disabled automatically",
     "page.entry.attachments": "Attachments",
+    "page.entry.transcripts": "Transcripts",
+    "page.entry.summary": "Summary provided by the feed",
+    "page.entry.original_content": "Original content",
     "page.keyboard_shortcuts.title": "Keyboard Shortcuts",
     "page.keyboard_shortcuts.subtitle.sections": "Sections Navigation",
     "page.keyboard_shortcuts.subtitle.items": "Items Navigation",
@@ -566,6 +663,7 @@ var translations = map[string]string{
     "alert.account_linked": "Your external account is now linked!",
     "alert.pocket_linked": "Your Pocket account is now linked!",
     "alert.prefs_saved": "Preferences saved!",
+    "alert.opml_import_started": "Importing %d feeds in the background, they will appear progressively.",
     "error.unlink_account_without_password": "You must define a password otherwise you won't be able to login again.",
     "error.duplicate_linked_account": "There is already someone associated with this provider!",
     "error.duplicate_fever_username": "There is already someone else with the same Fever username!",
@@ -588,22 +686,56 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "The username, theme, language and timezone fields are mandatory.",
     "error.entries_per_page_invalid": "The number of entries per page is not valid.",
     "error.feed_mandatory_fields": "The URL and the category are mandatory.",
+    "error.invalid_icon_url": "The icon URL is not valid.",
+    "error.invalid_proxy_url": "The proxy URL is not valid.",
+    "error.invalid_author_keeplist_rules": "The author keep rules can't contain the empty author rule \"\", the entries without author are always kept.",
     "error.user_mandatory_fields": "The username is mandatory.",
     "error.api_key_already_exists": "This API Key already exists.",
     "error.unable_to_create_api_key": "Unable to create this API Key.",
     "form.feed.label.title": "Title",
     "form.feed.label.site_url": "Site URL",
     "form.feed.label.feed_url": "Feed URL",
+    "form.feed.label.icon_url": "Icon URL (leave empty to find the icon automatically)",
     "form.feed.label.category": "Category",
     "form.feed.label.crawler": "Fetch original content",
     "form.feed.label.feed_username": "Feed Username",
     "form.feed.label.feed_password": "Feed Password",
+    "form.feed.label.auth_scheme": "Authentication",
+    "form.feed.auth_scheme.basic": "HTTP Basic (username and password)",
+    "form.feed.auth_scheme.bearer": "Bearer token",
+    "form.feed.author_match_mode.exact": "Exact name",
+    "form.feed.author_match_mode.substring": "Part of the name",
+    "form.feed.crawler_mode.category": "Use the category setting",
+    "form.feed.crawler_mode.enabled": "Enabled",
+    "form.feed.crawler_mode.disabled": "Disabled",
+    "form.feed.label.auth_token": "Token (leave empty to keep the current token)",
     "form.feed.label.user_agent": "Override Default User Agent",
+    "form.feed.label.cookie": "Cookies",
+    "form.feed.label.proxy_url": "Proxy URL",
+    "form.feed.label.translation_language": "Translate the content to this language (language code like \"en\", requires a translation API)",
     "form.feed.label.scraper_rules": "Scraper Rules",
     "form.feed.label.rewrite_rules": "Rewrite Rules",
+    "form.feed.label.blocklist_rules": "Block Rules (regular expressions, one per line)",
+    "form.feed.label.keeplist_rules": "Keep Rules (regular expressions, one per line)",
+    "form.feed.label.author_blocklist_rules": "Author Block Rules (one author per line, \"\" for the entries without author)",
+    "form.feed.label.author_keeplist_rules": "Author Keep Rules (one author per line)",
+    "form.feed.label.author_match_mode": "Author matching",
+    "form.feed.label.date_layouts": "Date Formats (Go time layouts, one per line, used when the date is not recognized)",
+    "form.feed.label.max_entries": "Maximum number of entries to keep (0 for unlimited)",
+    "form.feed.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the category or default value)",
+    "form.feed.label.request_timeout": "Request timeout in seconds (0 for the default value)",
+    "form.feed.label.refresh_interval": "Refresh interval in minutes (0 for the default value)",
     "form.feed.label.ignore_http_cache": "Ignore HTTP cache",
+    "form.feed.label.notify_telegram": "Send new entries to Telegram",
+    "form.feed.label.skip_duplicate_guids": "Skip entries whose GUID already exists in another feed",
     "form.feed.label.disabled": "Do not refresh this feed",
+    "form.feed.label.sort_order": "Entries sorting order",
+    "form.feed.sort_order.default": "Use my preferences",
     "form.category.label.title": "Title",
+    "form.category.label.crawler": "Fetch original content for all feeds of this category",
+    "form.category.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the default value)",
+    "form.category.label.telegram_chat_id": "Telegram chat ID for the feeds of this category (optional)",
+    "form.category.label.webhook_url": "Webhook URL for the feeds of this category (optional)",
     "form.user.label.username": "Username",
     "form.user.label.password": "Password",
     "form.user.label.confirmation": "Password Confirmation",
@@ -615,8 +747,13 @@ var translations = map[string]string{
     "form.prefs.label.entries_per_page": "Entries per page",
     "form.prefs.select.older_first": "Older entries first",
     "form.prefs.select.recent_first": "Recent entries first",
+    "form.prefs.select.deduplication_disabled": "Keep duplicates",
+    "form.prefs.select.deduplication_skip": "Ignore duplicates",
+    "form.prefs.select.deduplication_mark_as_read": "Mark duplicates as read",
     "form.prefs.label.keyboard_shortcuts": "Enable keyboard shortcuts",
     "form.prefs.label.show_reading_time": "Show estimated reading time for articles",
+    "form.prefs.label.mark_read_on_scroll": "Let applications mark entries as read while scrolling",
+    "form.prefs.label.entry_deduplication": "Duplicate entries across feeds",
     "form.prefs.label.custom_css": "Custom CSS",
     "form.import.label.file": "OPML file",
     "form.import.label.url": "URL",
@@ -644,9 +781,39 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Save articles to Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API Secret",
     "form.integration.telegram_activate": "Forward to Telegram",
     "form.integration.telegram_token": "Telegram Bot Token",
     "form.integration.telegram_chat_id": "Recipient ChatId",
+    "form.integration.telegram_topic_id": "Topic ID (optional)",
+    "form.integration.discord_activate": "Forward to Discord",
+    "form.integration.discord_webhook_url": "Discord Webhook URL",
+    "form.integration.webhook_activate": "Send new entries to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_secret": "Webhook Secret (optional)",
+    "form.integration.gotify_activate": "Send new entries to Gotify",
+    "form.integration.gotify_url": "Gotify Server URL",
+    "form.integration.gotify_token": "Gotify Application Token",
+    "form.integration.gotify_priority": "Priority (0 to 10)",
+    "form.integration.ntfy_activate": "Send new entries to ntfy",
+    "form.integration.ntfy_url": "ntfy Server URL",
+    "form.integration.ntfy_topic": "ntfy Topic",
+    "form.integration.ntfy_token": "ntfy Access Token (optional)",
+    "form.integration.ntfy_priority": "Priority (1 to 5)",
+    "form.integration.apprise_activate": "Send new entries to Apprise",
+    "form.integration.apprise_url": "Apprise API URL",
+    "form.integration.apprise_services_url": "Apprise Service URLs, separated by commas (without configuration key)",
+    "form.integration.apprise_config_key": "Apprise Configuration Key (optional)",
+    "form.integration.apprise_tag": "Apprise Tag (optional, with a configuration key)",
+    "form.integration.imap_activate": "Import the emails of an IMAP mailbox as a feed",
+    "form.integration.imap_server": "IMAP Server (host:port)",
+    "form.integration.imap_username": "IMAP Username",
+    "form.integration.imap_password": "IMAP Password",
+    "form.integration.imap_mailbox": "Mailbox",
+    "form.integration.imap_sender_filter": "Only import the emails of this sender (optional)",
+    "form.integration.mark_read_on_save": "Mark the entry as read after saving it",
     "form.api_key.label.description": "API Key Label",
     "form.submit.loading": "Loading...",
     "form.submit.saving": "Saving...",
@@ -676,7 +843,8 @@ var translations = map[string]string{
     "time_elapsed.years": [
         "%d year ago",
         "%d years ago"
-    ]
+    ],
+    "Unable to fetch this resource after %d attempts: %v": "Unable to fetch this resource after %d attempts: %v"
 }
 `,
 	"es_ES": `{
@@ -718,6 +886,10 @@ var translations = map[string]string{
     "menu.show_all_entries": "Mostrar todas las entradas",
     "menu.show_only_unread_entries": "Mostrar solo las entradas no leídas",
     "menu.refresh_feed": "Refrescar",
+    "menu.force_refresh_feed": "Force refresh",
+    "menu.force_refresh_feed.title": "Download and process the feed again, even if it has not been modified",
+    "menu.reprocess_feed_entries": "Reprocess entries",
+    "menu.reprocess_feed_entries.title": "Apply the current scraper and rewrite rules to the existing entries",
     "menu.refresh_all_feeds": "Refrescar todas las fuentes en el fondo",
     "menu.edit_feed": "Editar",
     "menu.edit_category": "Editar",
@@ -802,11 +974,16 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Elegir una suscripción",
     "page.edit_feed.title": "Editar fuente: %s",
     "page.edit_feed.last_check": "Última verificación:",
+    "page.edit_feed.last_status_code": "Last HTTP status code:",
     "page.edit_feed.last_modified_header": "Cabecera de LastModified:",
     "page.edit_feed.etag_header": "Cabecera de ETag:",
     "page.edit_feed.no_header": "Sin cabecera",
     "page.edit_feed.last_parsing_error": "Último error de análisis",
+    "page.edit_feed.disabled_reason": "This feed has been disabled automatically",
     "page.entry.attachments": "Archivos adjuntos",
+    "page.entry.transcripts": "Transcripciones",
+    "page.entry.summary": "Summary provided by the feed",
+    "page.entry.original_content": "Contenido original",
     "page.keyboard_shortcuts.title": "Atajos de teclado",
     "page.keyboard_shortcuts.subtitle.sections": "Navegación de secciones",
     "page.keyboard_shortcuts.subtitle.items": "Navegación de artículos",
@@ -894,6 +1071,7 @@ var translations = map[string]string{
     "alert.account_linked": "¡Tu cuenta externa ya está vinculada!",
     "alert.pocket_linked": "¡Tu cuenta de Pocket ya está vinculada!",
     "alert.prefs_saved": "¡Las preferencias se han guardado!",
+    "alert.opml_import_started": "Importing %d feeds in the background, they will appear progressively.",
     "error.unlink_account_without_password": "Debe definir una contraseña, de lo contrario no podrá volver a iniciar sesión.",
     "error.duplicate_linked_account": "¡Ya hay alguien asociado a este servicio!",
     "error.duplicate_fever_username": "¡Ya hay alguien con el mismo nombre de usuario de Fever!",
@@ -916,22 +1094,56 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Los campos de nombre de usuario, tema, idioma y zona horaria son obligatorios.",
     "error.entries_per_page_invalid": "El número de entradas por página no es válido.",
     "error.feed_mandatory_fields": "Los campos de URL y categoría son obligatorios.",
+    "error.invalid_icon_url": "The icon URL is not valid.",
+    "error.invalid_proxy_url": "La URL del proxy no es válida.",
+    "error.invalid_author_keeplist_rules": "Las reglas de conservación por autor no pueden contener la regla de autor vacía \"\", los artículos sin autor siempre se conservan.",
     "error.user_mandatory_fields": "El nombre de usuario es obligatorio.",
     "error.api_key_already_exists": "Esta clave API ya existe.",
     "error.unable_to_create_api_key": "No se puede crear esta clave API.",
     "form.feed.label.title": "Título",
     "form.feed.label.site_url": "URL del sitio",
     "form.feed.label.feed_url": "URL de la fuente",
+    "form.feed.label.icon_url": "Icon URL (leave empty to find the icon automatically)",
     "form.feed.label.category": "Categoría",
     "form.feed.label.crawler": "Obtener contento original",
     "form.feed.label.feed_username": "Nombre de usuario de fuente",
     "form.feed.label.feed_password": "Contraseña de fuente",
+    "form.feed.label.auth_scheme": "Authentication",
+    "form.feed.auth_scheme.basic": "HTTP Basic (username and password)",
+    "form.feed.auth_scheme.bearer": "Bearer token",
+    "form.feed.author_match_mode.exact": "Nombre exacto",
+    "form.feed.author_match_mode.substring": "Parte del nombre",
+    "form.feed.crawler_mode.category": "Usar la configuración de la categoría",
+    "form.feed.crawler_mode.enabled": "Activado",
+    "form.feed.crawler_mode.disabled": "Desactivado",
+    "form.feed.label.auth_token": "Token (leave empty to keep the current token)",
     "form.feed.label.user_agent": "Invalidar el agente de usuario predeterminado",
+    "form.feed.label.cookie": "Cookies",
+    "form.feed.label.proxy_url": "URL del proxy",
+    "form.feed.label.translation_language": "Traducir el contenido a este idioma (código de idioma como \"es\", requiere una API de traducción)",
     "form.feed.label.scraper_rules": "Reglas de raspador",
     "form.feed.label.rewrite_rules": "Reglas de reescribir",
+    "form.feed.label.blocklist_rules": "Block Rules (regular expressions, one per line)",
+    "form.feed.label.keeplist_rules": "Keep Rules (regular expressions, one per line)",
+    "form.feed.label.author_blocklist_rules": "Reglas de bloqueo de autores (un autor por línea, \"\" para los artículos sin autor)",
+    "form.feed.label.author_keeplist_rules": "Reglas de autores permitidos (un autor por línea)",
+    "form.feed.label.author_match_mode": "Coincidencia de autores",
+    "form.feed.label.date_layouts": "Date Formats (Go time layouts, one per line, used when the date is not recognized)",
+    "form.feed.label.max_entries": "Maximum number of entries to keep (0 for unlimited)",
+    "form.feed.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the category or default value)",
+    "form.feed.label.request_timeout": "Request timeout in seconds (0 for the default value)",
+    "form.feed.label.refresh_interval": "Refresh interval in minutes (0 for the default value)",
     "form.feed.label.ignore_http_cache": "Ignorar caché HTTP",
+    "form.feed.label.notify_telegram": "Enviar nuevos artículos a Telegram",
+    "form.feed.label.skip_duplicate_guids": "Skip entries whose GUID already exists in another feed",
     "form.feed.label.disabled": "No actualice este feed",
+    "form.feed.label.sort_order": "Entries sorting order",
+    "form.feed.sort_order.default": "Use my preferences",
     "form.category.label.title": "Título",
+    "form.category.label.crawler": "Fetch original content for all feeds of this category",
+    "form.category.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the default value)",
+    "form.category.label.telegram_chat_id": "ID de chat de Telegram para las fuentes de esta categoría (opcional)",
+    "form.category.label.webhook_url": "URL del webhook para las fuentes de esta categoría (opcional)",
     "form.user.label.username": "Nombre de usuario",
     "form.user.label.password": "Contraseña",
     "form.user.label.confirmation": "Confirmación de contraseña",
@@ -943,8 +1155,13 @@ var translations = map[string]string{
     "form.prefs.label.entries_per_page": "Entradas por página",
     "form.prefs.select.older_first": "Entradas más viejas primero",
     "form.prefs.select.recent_first": "Entradas recientes primero",
+    "form.prefs.select.deduplication_disabled": "Keep duplicates",
+    "form.prefs.select.deduplication_skip": "Ignore duplicates",
+    "form.prefs.select.deduplication_mark_as_read": "Mark duplicates as read",
     "form.prefs.label.keyboard_shortcuts": "Habilitar atajos de teclado",
     "form.prefs.label.show_reading_time": "Mostrar el tiempo estimado de lectura de los artículos",
+    "form.prefs.label.mark_read_on_scroll": "Let applications mark entries as read while scrolling",
+    "form.prefs.label.entry_deduplication": "Duplicate entries across feeds",
     "form.prefs.label.custom_css": "CSS personalizado",
     "form.import.label.file": "Archivo OPML",
     "form.import.label.url": "URL",
@@ -972,9 +1189,39 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Guardar artículos a Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Extremo de API de Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "Clave de API de Nunux Keeper",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API Secret",
     "form.integration.telegram_activate": "Forward to Telegram",
     "form.integration.telegram_token": "Telegram Bot Token",
     "form.integration.telegram_chat_id": "Recipient ChatId",
+    "form.integration.telegram_topic_id": "Topic ID (optional)",
+    "form.integration.discord_activate": "Reenviar a Discord",
+    "form.integration.discord_webhook_url": "Discord Webhook URL",
+    "form.integration.webhook_activate": "Send new entries to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_secret": "Webhook Secret (optional)",
+    "form.integration.gotify_activate": "Enviar los nuevos artículos a Gotify",
+    "form.integration.gotify_url": "URL del servidor Gotify",
+    "form.integration.gotify_token": "Token de la aplicación Gotify",
+    "form.integration.gotify_priority": "Prioridad (de 0 a 10)",
+    "form.integration.ntfy_activate": "Enviar los nuevos artículos a ntfy",
+    "form.integration.ntfy_url": "URL del servidor ntfy",
+    "form.integration.ntfy_topic": "Tema de ntfy",
+    "form.integration.ntfy_token": "Token de acceso de ntfy (opcional)",
+    "form.integration.ntfy_priority": "Prioridad (de 1 a 5)",
+    "form.integration.apprise_activate": "Enviar los nuevos artículos a Apprise",
+    "form.integration.apprise_url": "URL de la API de Apprise",
+    "form.integration.apprise_services_url": "URLs de los servicios de Apprise, separadas por comas (sin clave de configuración)",
+    "form.integration.apprise_config_key": "Clave de configuración de Apprise (opcional)",
+    "form.integration.apprise_tag": "Etiqueta de Apprise (opcional, con una clave de configuración)",
+    "form.integration.imap_activate": "Import the emails of an IMAP mailbox as a feed",
+    "form.integration.imap_server": "IMAP Server (host:port)",
+    "form.integration.imap_username": "IMAP Username",
+    "form.integration.imap_password": "IMAP Password",
+    "form.integration.imap_mailbox": "Mailbox",
+    "form.integration.imap_sender_filter": "Only import the emails of this sender (optional)",
+    "form.integration.mark_read_on_save": "Mark the entry as read after saving it",
     "form.api_key.label.description": "Etiqueta de clave API",
     "form.submit.loading": "Cargando...",
     "form.submit.saving": "Guardando...",
@@ -1004,7 +1251,8 @@ var translations = map[string]string{
     "time_elapsed.years": [
         "hace %d año",
         "hace %d años"
-    ]
+    ],
+    "Unable to fetch this resource after %d attempts: %v": "No se puede obtener este recurso después de %d intentos: %v"
 }
 `,
 	"fr_FR": `{
@@ -1046,6 +1294,10 @@ var translations = map[string]string{
     "menu.show_all_entries": "Afficher tous les articles",
     "menu.show_only_unread_entries": "Afficher uniquement les articles non lus",
     "menu.refresh_feed": "Actualiser",
+    "menu.force_refresh_feed": "Forcer l'actualisation",
+    "menu.force_refresh_feed.title": "Télécharger et traiter le flux à nouveau, même s'il n'a pas été modifié",
+    "menu.reprocess_feed_entries": "Retraiter les articles",
+    "menu.reprocess_feed_entries.title": "Appliquer les règles d'extraction et de réécriture actuelles aux articles existants",
     "menu.refresh_all_feeds": "Actualiser les abonnements en arrière-plan",
     "menu.edit_feed": "Modifier",
     "menu.edit_category": "Modifier",
@@ -1130,11 +1382,16 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Choisissez un abonnement",
     "page.edit_feed.title": "Modification de l'abonnement : %s",
     "page.edit_feed.last_check": "Dernière vérification :",
+    "page.edit_feed.last_status_code": "Dernier code de statut HTTP :",
     "page.edit_feed.last_modified_header": "En-tête LastModified :",
     "page.edit_feed.etag_header": "En-tête ETag :",
     "page.edit_feed.no_header": "Aucune",
     "page.edit_feed.last_parsing_error": "Dernière erreur d'analyse",
+    "page.edit_feed.disabled_reason": "Cet abonnement a été désactivé automatiquement",
     "page.entry.attachments": "Pièces Jointes",
+    "page.entry.transcripts": "Transcriptions",
+    "page.entry.summary": "Résumé fourni par le flux",
+    "page.entry.original_content": "Contenu original",
     "page.keyboard_shortcuts.title": "Raccourcis clavier",
     "page.keyboard_shortcuts.subtitle.sections": "Naviguation entre les sections",
     "page.keyboard_shortcuts.subtitle.items": "Naviguation entre les éléments",
@@ -1222,6 +1479,7 @@ var translations = map[string]string{
     "alert.account_linked": "Votre compte externe est maintenant associé !",
     "alert.pocket_linked": "Votre compte Pocket est maintenant connecté !",
     "alert.prefs_saved": "Préférences sauvegardées !",
+    "alert.opml_import_started": "Importation de %d abonnements en arrière-plan, ils apparaîtront progressivement.",
     "error.unlink_account_without_password": "Vous devez définir un mot de passe sinon vous ne pourrez plus vous connecter par la suite.",
     "error.duplicate_linked_account": "Il y a déjà quelqu'un d'associé avec ce provider !",
     "error.duplicate_fever_username": "Il y a déjà quelqu'un d'autre avec le même nom d'utilisateur Fever !",
@@ -1244,22 +1502,56 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Le nom d'utilisateur, le thème, la langue et le fuseau horaire sont obligatoire.",
     "error.entries_per_page_invalid": "Le nombre d'entrées par page n'est pas valide.",
     "error.feed_mandatory_fields": "L'URL et la catégorie sont obligatoire.",
+    "error.invalid_icon_url": "L'URL de l'icône n'est pas valide.",
+    "error.invalid_proxy_url": "L'URL du proxy n'est pas valide.",
+    "error.invalid_author_keeplist_rules": "Les règles de conservation par auteur ne peuvent pas contenir la règle d'auteur vide \"\", les articles sans auteur sont toujours conservés.",
     "error.user_mandatory_fields": "Le nom d'utilisateur est obligatoire.",
     "error.api_key_already_exists": "Cette clé d'API existe déjà.",
     "error.unable_to_create_api_key": "Impossible de créer cette clé d'API.",
     "form.feed.label.title": "Titre",
     "form.feed.label.site_url": "URL du site web",
     "form.feed.label.feed_url": "URL du flux",
+    "form.feed.label.icon_url": "URL de l'icône (laisser vide pour la trouver automatiquement)",
     "form.feed.label.category": "Catégorie",
     "form.feed.label.crawler": "Récupérer le contenu original",
     "form.feed.label.feed_username": "Nom d'utilisateur du flux",
     "form.feed.label.feed_password": "Mot de passe du flux",
+    "form.feed.label.auth_scheme": "Authentification",
+    "form.feed.auth_scheme.basic": "HTTP Basic (nom d'utilisateur et mot de passe)",
+    "form.feed.auth_scheme.bearer": "Jeton Bearer",
+    "form.feed.author_match_mode.exact": "Nom exact",
+    "form.feed.author_match_mode.substring": "Partie du nom",
+    "form.feed.crawler_mode.category": "Utiliser le réglage de la catégorie",
+    "form.feed.crawler_mode.enabled": "Activé",
+    "form.feed.crawler_mode.disabled": "Désactivé",
+    "form.feed.label.auth_token": "Jeton (laisser vide pour conserver le jeton actuel)",
     "form.feed.label.user_agent": "Remplacer l'agent utilisateur par défaut",
+    "form.feed.label.cookie": "Cookies",
+    "form.feed.label.proxy_url": "URL du proxy",
+    "form.feed.label.translation_language": "Traduire le contenu dans cette langue (code de langue comme \"fr\", nécessite une API de traduction)",
     "form.feed.label.scraper_rules": "Règles pour récupérer le contenu original",
     "form.feed.label.rewrite_rules": "Règles de réécriture",
+    "form.feed.label.blocklist_rules": "Règles de blocage (expressions régulières, une par ligne)",
+    "form.feed.label.keeplist_rules": "Règles de conservation (expressions régulières, une par ligne)",
+    "form.feed.label.author_blocklist_rules": "Règles de blocage des auteurs (un auteur par ligne, \"\" pour les articles sans auteur)",
+    "form.feed.label.author_keeplist_rules": "Règles de conservation des auteurs (un auteur par ligne)",
+    "form.feed.label.author_match_mode": "Correspondance des auteurs",
+    "form.feed.label.date_layouts": "Formats de date (formats Go, un par ligne, utilisés lorsque la date n'est pas reconnue)",
+    "form.feed.label.max_entries": "Nombre maximum d'articles à conserver (0 pour illimité)",
+    "form.feed.label.mark_read_after_days": "Marquer les articles non lus comme lus après ce nombre de jours (0 pour la valeur de la catégorie ou par défaut)",
+    "form.feed.label.request_timeout": "Délai d'attente de la requête en secondes (0 pour la valeur par défaut)",
+    "form.feed.label.refresh_interval": "Intervalle de rafraîchissement en minutes (0 pour la valeur par défaut)",
     "form.feed.label.ignore_http_cache": "Ignore cache HTTP",
+    "form.feed.label.notify_telegram": "Envoyer les nouveaux articles sur Telegram",
+    "form.feed.label.skip_duplicate_guids": "Ignorer les articles dont le GUID existe déjà dans un autre flux",
     "form.feed.label.disabled": "Ne pas actualiser ce flux",
+    "form.feed.label.sort_order": "Ordre de tri des articles",
+    "form.feed.sort_order.default": "Utiliser mes préférences",
     "form.category.label.title": "Titre",
+    "form.category.label.crawler": "Récupérer le contenu original pour tous les abonnements de cette catégorie",
+    "form.category.label.mark_read_after_days": "Marquer les articles non lus comme lus après ce nombre de jours (0 pour la valeur par défaut)",
+    "form.category.label.telegram_chat_id": "Identifiant du chat Telegram pour les abonnements de cette catégorie (facultatif)",
+    "form.category.label.webhook_url": "URL du webhook pour les abonnements de cette catégorie (facultatif)",
     "form.user.label.username": "Nom d'utilisateur",
     "form.user.label.password": "Mot de passe",
     "form.user.label.confirmation": "Confirmation du mot de passe",
@@ -1271,8 +1563,13 @@ var translations = map[string]string{
     "form.prefs.label.entries_per_page": "Entrées par page",
     "form.prefs.select.older_first": "Ancien éléments en premier",
     "form.prefs.select.recent_first": "Éléments récents en premier",
+    "form.prefs.select.deduplication_disabled": "Conserver les doublons",
+    "form.prefs.select.deduplication_skip": "Ignorer les doublons",
+    "form.prefs.select.deduplication_mark_as_read": "Marquer les doublons comme lus",
     "form.prefs.label.keyboard_shortcuts": "Activer les raccourcis clavier",
     "form.prefs.label.show_reading_time": "Afficher le temps de lecture estimé des articles",
+    "form.prefs.label.mark_read_on_scroll": "Permettre aux applications de marquer les articles comme lus lors du défilement",
+    "form.prefs.label.entry_deduplication": "Articles en double entre les abonnements",
     "form.prefs.label.custom_css": "CSS personnalisé",
     "form.import.label.file": "Fichier OPML",
     "form.import.label.url": "URL",
@@ -1300,9 +1597,39 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Sauvegarder les articles vers Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "URL de l'API de Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "Clé d'API de Nunux Keeper",
+    "form.integration.shaarli_activate": "Sauvegarder les articles vers Shaarli",
+    "form.integration.shaarli_endpoint": "URL de Shaarli",
+    "form.integration.shaarli_api_secret": "Secret de l'API Shaarli",
     "form.integration.telegram_activate": "Forward to Telegram",
     "form.integration.telegram_token": "Telegram Bot Token",
     "form.integration.telegram_chat_id": "Recipient ChatId",
+    "form.integration.telegram_topic_id": "ID du sujet (optionnel)",
+    "form.integration.discord_activate": "Envoyer vers Discord",
+    "form.integration.discord_webhook_url": "URL du webhook Discord",
+    "form.integration.webhook_activate": "Envoyer les nouveaux articles vers un webhook",
+    "form.integration.webhook_url": "URL du webhook",
+    "form.integration.webhook_secret": "Secret du webhook (optionnel)",
+    "form.integration.gotify_activate": "Envoyer les nouveaux articles vers Gotify",
+    "form.integration.gotify_url": "URL du serveur Gotify",
+    "form.integration.gotify_token": "Jeton de l'application Gotify",
+    "form.integration.gotify_priority": "Priorité (de 0 à 10)",
+    "form.integration.ntfy_activate": "Envoyer les nouveaux articles vers ntfy",
+    "form.integration.ntfy_url": "URL du serveur ntfy",
+    "form.integration.ntfy_topic": "Sujet ntfy",
+    "form.integration.ntfy_token": "Jeton d'accès ntfy (optionnel)",
+    "form.integration.ntfy_priority": "Priorité (de 1 à 5)",
+    "form.integration.apprise_activate": "Envoyer les nouveaux articles vers Apprise",
+    "form.integration.apprise_url": "URL de l'API Apprise",
+    "form.integration.apprise_services_url": "URLs des services Apprise, séparées par des virgules (sans clé de configuration)",
+    "form.integration.apprise_config_key": "Clé de configuration Apprise (optionnel)",
+    "form.integration.apprise_tag": "Étiquette Apprise (optionnel, avec une clé de configuration)",
+    "form.integration.imap_activate": "Importer les courriels d'une boîte IMAP comme un abonnement",
+    "form.integration.imap_server": "Serveur IMAP (hôte:port)",
+    "form.integration.imap_username": "Nom d'utilisateur IMAP",
+    "form.integration.imap_password": "Mot de passe IMAP",
+    "form.integration.imap_mailbox": "Dossier",
+    "form.integration.imap_sender_filter": "Importer uniquement les courriels de cet expéditeur (optionnel)",
+    "form.integration.mark_read_on_save": "Marquer l'article comme lu après l'avoir sauvegardé",
     "form.api_key.label.description": "Libellé de la clé d'API",
     "form.submit.loading": "Chargement...",
     "form.submit.saving": "Sauvegarde en cours...",
@@ -1334,6 +1661,8 @@ var translations = map[string]string{
         "il y a %d ans"
     ],
     "This feed already exists (%s)": "Cet abonnement existe déjà (%s)",
+    "Unable to refresh %d feeds: %s": "Impossible d'actualiser %d abonnements : %s",
+    "Invalid filter rule %q: %v": "Règle de filtrage invalide %q : %v",
     "Unable to fetch feed (Status Code = %d)": "Impossible de récupérer cet abonnement (code=%d)",
     "Unable to open this link: %v": "Impossible d'ouvrir ce lien : %v",
     "Unable to analyze this page: %v": "Impossible d'analyzer cette page : %v",
@@ -1343,16 +1672,23 @@ var translations = map[string]string{
     "Unable to parse Atom feed: %q": "Impossible de lire ce flux Atom : %q",
     "Unable to parse JSON feed: %q": "Impossible de lire ce flux JSON : %q",
     "Unable to parse RDF feed: %q": "Impossible de lire ce flux RDF : %q",
+    "Unable to parse Reddit listing: %q": "Impossible de lire cette liste Reddit : %q",
     "Unable to normalize encoding: %q": "Impossible de normaliser l'encodage : %q",
     "This feed is empty": "Cet abonnement est vide",
+    "The IMAP integration is disabled for this feed": "L'intégration IMAP est désactivée pour cet abonnement",
     "This web page is empty": "Cette page web est vide",
+    "This resource is larger than the maximum size allowed (%d MiB)": "Cette ressource dépasse la taille maximale autorisée (%d Mio)",
     "Invalid SSL certificate (original error: %q)": "Certificat SSL invalide (erreur originale : %q)",
     "This website is temporarily unreachable (original error: %q)": "Ce site web est temporairement injoignable (erreur originale : %q)",
     "This website is permanently unreachable (original error: %q)": "Ce site web n'est pas joignable de façon permanente (erreur originale : %q)",
     "Website unreachable, the request timed out after %d seconds": "Site web injoignable, la requête à échouée après %d secondes",
+    "Invalid proxy URL": "URL du proxy invalide",
+    "A Tor proxy is required to fetch .onion addresses": "Un proxy Tor est nécessaire pour récupérer les adresses .onion",
+    "This resource has been redirected too many times (more than %d)": "Cette ressource a été redirigée trop de fois (plus de %d)",
     "You are not authorized to access this resource (invalid username/password)": "Vous n'êtes pas autorisé à accéder à cette ressource (nom d'utilisateur / mot de passe incorrect)",
     "Unable to fetch this resource (Status Code = %d)": "Impossible de récupérer cette ressource (code=%d)",
-    "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Page introuvable (404), cet abonnement n'existe plus, vérifiez l'adresse du flux"
+    "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Page introuvable (404), cet abonnement n'existe plus, vérifiez l'adresse du flux",
+    "Unable to fetch this resource after %d attempts: %v": "Impossible de récupérer cette ressource après %d tentatives : %v"
 }
 `,
 	"it_IT": `{
@@ -1394,6 +1730,10 @@ var translations = map[string]string{
     "menu.show_all_entries": "Mostra tutte le voci",
     "menu.show_only_unread_entries": "Mostra solo voci non lette",
     "menu.refresh_feed": "Aggiorna",
+    "menu.force_refresh_feed": "Force refresh",
+    "menu.force_refresh_feed.title": "Download and process the feed again, even if it has not been modified",
+    "menu.reprocess_feed_entries": "Reprocess entries",
+    "menu.reprocess_feed_entries.title": "Apply the current scraper and rewrite rules to the existing entries",
     "menu.refresh_all_feeds": "Aggiorna tutti i feed in background",
     "menu.edit_feed": "Modifica",
     "menu.edit_category": "Modifica",
@@ -1478,11 +1818,16 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Scegli un feed",
     "page.edit_feed.title": "Modifica feed: %s",
     "page.edit_feed.last_check": "Ultimo controllo:",
+    "page.edit_feed.last_status_code": "Last HTTP status code:",
     "page.edit_feed.last_modified_header": "Header LastModified:",
     "page.edit_feed.etag_header": "Header ETag:",
     "page.edit_feed.no_header": "Nessun header",
     "page.edit_feed.last_parsing_error": "Ultimo errore di parsing",
+    "page.edit_feed.disabled_reason": "This feed has been disabled automatically",
     "page.entry.attachments": "Allegati",
+    "page.entry.transcripts": "Trascrizioni",
+    "page.entry.summary": "Summary provided by the feed",
+    "page.entry.original_content": "Contenuto originale",
     "page.keyboard_shortcuts.title": "Scorciatoie da tastiera",
     "page.keyboard_shortcuts.subtitle.sections": "Navigazione sezioni",
     "page.keyboard_shortcuts.subtitle.items": "Navigazione articoli",
@@ -1570,6 +1915,7 @@ var translations = map[string]string{
     "alert.account_linked": "Il tuo account esterno ora è collegato!",
     "alert.pocket_linked": "Il tuo account Pocket ora è collegato!",
     "alert.prefs_saved": "Preferenze salvate!",
+    "alert.opml_import_started": "Importing %d feeds in the background, they will appear progressively.",
     "error.unlink_account_without_password": "Devi scegliere una password altrimenti la prossima volta non riuscirai ad accedere.",
     "error.duplicate_linked_account": "Esiste già un account configurato per questo servizio!",
     "error.duplicate_fever_username": "Esiste già un account Fever con lo stesso nome utente!",
@@ -1592,22 +1938,56 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Il nome utente, il tema, la lingua ed il fuso orario sono campi obbligatori.",
     "error.entries_per_page_invalid": "Il numero di articoli per pagina non è valido.",
     "error.feed_mandatory_fields": "L'URL e la categoria sono obbligatori.",
+    "error.invalid_icon_url": "The icon URL is not valid.",
+    "error.invalid_proxy_url": "L'URL del proxy non è valido.",
+    "error.invalid_author_keeplist_rules": "Le regole di conservazione per autore non possono contenere la regola dell'autore vuota \"\", gli articoli senza autore vengono sempre conservati.",
     "error.user_mandatory_fields": "Il nome utente è obbligatorio.",
     "error.api_key_already_exists": "Questa chiave API esiste già.",
     "error.unable_to_create_api_key": "Impossibile creare questa chiave API.",
     "form.feed.label.title": "Titolo",
     "form.feed.label.site_url": "URL del sito",
     "form.feed.label.feed_url": "URL del feed",
+    "form.feed.label.icon_url": "Icon URL (leave empty to find the icon automatically)",
     "form.feed.label.category": "Categoria",
     "form.feed.label.crawler": "Scarica il contenuto integrale",
     "form.feed.label.feed_username": "Nome utente del feed",
     "form.feed.label.feed_password": "Password del feed",
+    "form.feed.label.auth_scheme": "Authentication",
+    "form.feed.auth_scheme.basic": "HTTP Basic (username and password)",
+    "form.feed.auth_scheme.bearer": "Bearer token",
+    "form.feed.author_match_mode.exact": "Nome esatto",
+    "form.feed.author_match_mode.substring": "Parte del nome",
+    "form.feed.crawler_mode.category": "Usa l'impostazione della categoria",
+    "form.feed.crawler_mode.enabled": "Abilitato",
+    "form.feed.crawler_mode.disabled": "Disabilitato",
+    "form.feed.label.auth_token": "Token (leave empty to keep the current token)",
     "form.feed.label.user_agent": "Usa user agent personalizzato",
+    "form.feed.label.cookie": "Cookies",
+    "form.feed.label.proxy_url": "URL del proxy",
+    "form.feed.label.translation_language": "Traduci il contenuto in questa lingua (codice come \"it\", richiede una API di traduzione)",
     "form.feed.label.scraper_rules": "Regole di estrazione del contenuto",
     "form.feed.label.rewrite_rules": "Regole di impaginazione del contenuto",
+    "form.feed.label.blocklist_rules": "Block Rules (regular expressions, one per line)",
+    "form.feed.label.keeplist_rules": "Keep Rules (regular expressions, one per line)",
+    "form.feed.label.author_blocklist_rules": "Regole di blocco degli autori (un autore per riga, \"\" per gli articoli senza autore)",
+    "form.feed.label.author_keeplist_rules": "Regole di mantenimento degli autori (un autore per riga)",
+    "form.feed.label.author_match_mode": "Corrispondenza degli autori",
+    "form.feed.label.date_layouts": "Date Formats (Go time layouts, one per line, used when the date is not recognized)",
+    "form.feed.label.max_entries": "Maximum number of entries to keep (0 for unlimited)",
+    "form.feed.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the category or default value)",
+    "form.feed.label.request_timeout": "Request timeout in seconds (0 for the default value)",
+    "form.feed.label.refresh_interval": "Refresh interval in minutes (0 for the default value)",
     "form.feed.label.ignore_http_cache": "Ignora cache HTTP",
+    "form.feed.label.notify_telegram": "Invia i nuovi articoli a Telegram",
+    "form.feed.label.skip_duplicate_guids": "Skip entries whose GUID already exists in another feed",
     "form.feed.label.disabled": "Non aggiornare questo feed",
+    "form.feed.label.sort_order": "Entries sorting order",
+    "form.feed.sort_order.default": "Use my preferences",
     "form.category.label.title": "Titolo",
+    "form.category.label.crawler": "Fetch original content for all feeds of this category",
+    "form.category.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the default value)",
+    "form.category.label.telegram_chat_id": "ID della chat Telegram per i feed di questa categoria (facoltativo)",
+    "form.category.label.webhook_url": "URL del webhook per i feed di questa categoria (facoltativo)",
     "form.user.label.username": "Nome utente",
     "form.user.label.password": "Password",
     "form.user.label.confirmation": "Conferma password",
@@ -1619,8 +1999,13 @@ var translations = map[string]string{
     "form.prefs.label.entries_per_page": "Articoli per pagina",
     "form.prefs.select.older_first": "Prima i più vecchi",
     "form.prefs.select.recent_first": "Prima i più recenti",
+    "form.prefs.select.deduplication_disabled": "Keep duplicates",
+    "form.prefs.select.deduplication_skip": "Ignore duplicates",
+    "form.prefs.select.deduplication_mark_as_read": "Mark duplicates as read",
     "form.prefs.label.keyboard_shortcuts": "Abilita le scorciatoie da tastiera",
     "form.prefs.label.show_reading_time": "Mostra il tempo di lettura stimato per gli articoli",
+    "form.prefs.label.mark_read_on_scroll": "Let applications mark entries as read while scrolling",
+    "form.prefs.label.entry_deduplication": "Duplicate entries across feeds",
     "form.prefs.label.custom_css": "CSS personalizzati",
     "form.import.label.file": "File OPML",
     "form.import.label.url": "URL",
@@ -1648,9 +2033,39 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Salva gli articoli su Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Endpoint dell'API di Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "API key dell'account Nunux Keeper",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API Secret",
     "form.integration.telegram_activate": "Forward to Telegram",
     "form.integration.telegram_token": "Telegram Bot Token",
     "form.integration.telegram_chat_id": "Recipient ChatId",
+    "form.integration.telegram_topic_id": "Topic ID (optional)",
+    "form.integration.discord_activate": "Inoltra a Discord",
+    "form.integration.discord_webhook_url": "Discord Webhook URL",
+    "form.integration.webhook_activate": "Send new entries to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_secret": "Webhook Secret (optional)",
+    "form.integration.gotify_activate": "Invia i nuovi articoli a Gotify",
+    "form.integration.gotify_url": "URL del server Gotify",
+    "form.integration.gotify_token": "Token dell'applicazione Gotify",
+    "form.integration.gotify_priority": "Priorità (da 0 a 10)",
+    "form.integration.ntfy_activate": "Invia i nuovi articoli a ntfy",
+    "form.integration.ntfy_url": "URL del server ntfy",
+    "form.integration.ntfy_topic": "Argomento ntfy",
+    "form.integration.ntfy_token": "Token di accesso ntfy (facoltativo)",
+    "form.integration.ntfy_priority": "Priorità (da 1 a 5)",
+    "form.integration.apprise_activate": "Invia i nuovi articoli ad Apprise",
+    "form.integration.apprise_url": "URL dell'API Apprise",
+    "form.integration.apprise_services_url": "URL dei servizi Apprise, separati da virgole (senza chiave di configurazione)",
+    "form.integration.apprise_config_key": "Chiave di configurazione Apprise (facoltativa)",
+    "form.integration.apprise_tag": "Tag Apprise (facoltativo, con una chiave di configurazione)",
+    "form.integration.imap_activate": "Import the emails of an IMAP mailbox as a feed",
+    "form.integration.imap_server": "IMAP Server (host:port)",
+    "form.integration.imap_username": "IMAP Username",
+    "form.integration.imap_password": "IMAP Password",
+    "form.integration.imap_mailbox": "Mailbox",
+    "form.integration.imap_sender_filter": "Only import the emails of this sender (optional)",
+    "form.integration.mark_read_on_save": "Mark the entry as read after saving it",
     "form.api_key.label.description": "Etichetta chiave API",
     "form.submit.loading": "Caricamento in corso...",
     "form.submit.saving": "Salvataggio in corso...",
@@ -1680,7 +2095,8 @@ var translations = map[string]string{
     "time_elapsed.years": [
         "%d anno fa",
         "%d anni fa"
-    ]
+    ],
+    "Unable to fetch this resource after %d attempts: %v": "Impossibile recuperare questa risorsa dopo %d tentativi: %v"
 }
 `,
 	"ja_JP": `{
@@ -1722,6 +2138,10 @@ var translations = map[string]string{
     "menu.show_all_entries": "全ての記事を表示",
     "menu.show_only_unread_entries": "未読の記事だけを表示",
     "menu.refresh_feed": "更新",
+    "menu.force_refresh_feed": "Force refresh",
+    "menu.force_refresh_feed.title": "Download and process the feed again, even if it has not been modified",
+    "menu.reprocess_feed_entries": "Reprocess entries",
+    "menu.reprocess_feed_entries.title": "Apply the current scraper and rewrite rules to the existing entries",
     "menu.refresh_all_feeds": "全てのフィードをバックグラウンドで更新",
     "menu.edit_feed": "編集",
     "menu.edit_category": "編集",
@@ -1806,11 +2226,16 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "購読を選択",
     "page.edit_feed.title": "フィード(%s)を編集",
     "page.edit_feed.last_check": "最終チェック:",
+    "page.edit_feed.last_status_code": "Last HTTP status code:",
     "page.edit_feed.last_modified_header": "最後に更新されたヘッダー:",
     "page.edit_feed.etag_header": "ETag ヘッダー:",
     "page.edit_feed.no_header": " なし",
     "page.edit_feed.last_parsing_error": "最新の解析エラー",
+    "page.edit_feed.disabled_reason": "This feed has been disabled automatically",
     "page.entry.attachments": "添付物",
+    "page.entry.transcripts": "文字起こし",
+    "page.entry.summary": "Summary provided by the feed",
+    "page.entry.original_content": "元のコンテンツ",
     "page.keyboard_shortcuts.title": "キーボード・ショートカット",
     "page.keyboard_shortcuts.subtitle.sections": "セクション 移動",
     "page.keyboard_shortcuts.subtitle.items": "アイテム 移動",
@@ -1898,6 +2323,7 @@ var translations = map[string]string{
     "alert.account_linked": "外部アカウントとリンクされました!",
     "alert.pocket_linked": "Pocket アカウントとリンクされました!",
     "alert.prefs_saved": "設定情報は保存されました!",
+    "alert.opml_import_started": "Importing %d feeds in the background, they will appear progressively.",
     "error.unlink_account_without_password": "パスワードを設定しなければ再びログインすることはできません。",
     "error.duplicate_linked_account": "別なユーザーが既にこのサービスの同じユーザーとリンクしています。",
     "error.duplicate_fever_username": "既に同じ名前の Fever ユーザー名が使われています!",
@@ -1920,22 +2346,56 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "ユーザー名、テーマ、言語、タイムゾーンの全てが必要です。",
     "error.entries_per_page_invalid": "ページあたりのエントリ数が無効です。",
     "error.feed_mandatory_fields": "URL と カテゴリが必要です。",
+    "error.invalid_icon_url": "The icon URL is not valid.",
+    "error.invalid_proxy_url": "プロキシ URL が無効です。",
+    "error.invalid_author_keeplist_rules": "The author keep rules can't contain the empty author rule \"\", the entries without author are always kept.",
     "error.user_mandatory_fields": "ユーザー名が必要です。",
     "error.api_key_already_exists": "このAPIキーは既に存在します。",
     "error.unable_to_create_api_key": "このAPIキーを作成できません。",
     "form.feed.label.title": "タイトル",
     "form.feed.label.site_url": "サイト URL",
     "form.feed.label.feed_url": "フィード URL",
+    "form.feed.label.icon_url": "Icon URL (leave empty to find the icon automatically)",
     "form.feed.label.category": "カテゴリ",
     "form.feed.label.crawler": "オリジナルの内容を取得",
     "form.feed.label.feed_username": "フィードのユーザー名",
     "form.feed.label.feed_password": "フィードのパスワード",
+    "form.feed.label.auth_scheme": "Authentication",
+    "form.feed.auth_scheme.basic": "HTTP Basic (username and password)",
+    "form.feed.auth_scheme.bearer": "Bearer token",
+    "form.feed.author_match_mode.exact": "完全一致",
+    "form.feed.author_match_mode.substring": "部分一致",
+    "form.feed.crawler_mode.category": "カテゴリの設定を使用",
+    "form.feed.crawler_mode.enabled": "有効",
+    "form.feed.crawler_mode.disabled": "無効",
+    "form.feed.label.auth_token": "Token (leave empty to keep the current token)",
     "form.feed.label.user_agent": "ディフォルトの User Agent を上書きする",
+    "form.feed.label.cookie": "Cookies",
+    "form.feed.label.proxy_url": "プロキシ URL",
+    "form.feed.label.translation_language": "コンテンツをこの言語に翻訳する（\"ja\" などの言語コード、翻訳 API が必要）",
     "form.feed.label.scraper_rules": "スクラップルール",
     "form.feed.label.rewrite_rules": "Rewrite ルール",
+    "form.feed.label.blocklist_rules": "Block Rules (regular expressions, one per line)",
+    "form.feed.label.keeplist_rules": "Keep Rules (regular expressions, one per line)",
+    "form.feed.label.author_blocklist_rules": "著者のブロックルール（1 行に 1 人、著者のない記事は \"\"）",
+    "form.feed.label.author_keeplist_rules": "著者の許可ルール（1 行に 1 人）",
+    "form.feed.label.author_match_mode": "著者の照合",
+    "form.feed.label.date_layouts": "Date Formats (Go time layouts, one per line, used when the date is not recognized)",
+    "form.feed.label.max_entries": "Maximum number of entries to keep (0 for unlimited)",
+    "form.feed.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the category or default value)",
+    "form.feed.label.request_timeout": "Request timeout in seconds (0 for the default value)",
+    "form.feed.label.refresh_interval": "Refresh interval in minutes (0 for the default value)",
     "form.feed.label.ignore_http_cache": "HTTPキャッシュを無視",
+    "form.feed.label.notify_telegram": "新しい記事を Telegram に送信",
+    "form.feed.label.skip_duplicate_guids": "Skip entries whose GUID already exists in another feed",
     "form.feed.label.disabled": "このフィードを更新しない",
+    "form.feed.label.sort_order": "Entries sorting order",
+    "form.feed.sort_order.default": "Use my preferences",
     "form.category.label.title": "タイトル",
+    "form.category.label.crawler": "Fetch original content for all feeds of this category",
+    "form.category.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the default value)",
+    "form.category.label.telegram_chat_id": "このカテゴリのフィードの Telegram チャット ID（任意）",
+    "form.category.label.webhook_url": "このカテゴリのフィードの Webhook URL（任意）",
     "form.user.label.username": "ユーザー名",
     "form.user.label.password": "パスワード",
     "form.user.label.confirmation": "パスワード確認",
@@ -1947,8 +2407,13 @@ var translations = map[string]string{
     "form.prefs.label.entries_per_page": "ページあたりのエントリ",
     "form.prefs.select.older_first": "古い記事を最初に",
     "form.prefs.select.recent_first": "新しい記事を最初に",
+    "form.prefs.select.deduplication_disabled": "Keep duplicates",
+    "form.prefs.select.deduplication_skip": "Ignore duplicates",
+    "form.prefs.select.deduplication_mark_as_read": "Mark duplicates as read",
     "form.prefs.label.keyboard_shortcuts": "キーボード・ショートカットを有効にする",
     "form.prefs.label.show_reading_time": "記事の推定読書時間を表示する",
+    "form.prefs.label.mark_read_on_scroll": "Let applications mark entries as read while scrolling",
+    "form.prefs.label.entry_deduplication": "Duplicate entries across feeds",
     "form.prefs.label.custom_css": "カスタムCSS",
     "form.import.label.file": "OPML ファイル",
     "form.import.label.url": "URL",
@@ -1976,9 +2441,39 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Nunux Keeper に記事を保存する",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper の API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper の API key",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API Secret",
     "form.integration.telegram_activate": "Forward to Telegram",
     "form.integration.telegram_token": "Telegram Bot Token",
     "form.integration.telegram_chat_id": "Recipient ChatId",
+    "form.integration.telegram_topic_id": "Topic ID (optional)",
+    "form.integration.discord_activate": "Discord に転送",
+    "form.integration.discord_webhook_url": "Discord Webhook URL",
+    "form.integration.webhook_activate": "Send new entries to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_secret": "Webhook Secret (optional)",
+    "form.integration.gotify_activate": "新しい記事を Gotify に送信する",
+    "form.integration.gotify_url": "Gotify サーバー URL",
+    "form.integration.gotify_token": "Gotify アプリケーショントークン",
+    "form.integration.gotify_priority": "優先度 (0〜10)",
+    "form.integration.ntfy_activate": "新しい記事を ntfy に送信する",
+    "form.integration.ntfy_url": "ntfy サーバー URL",
+    "form.integration.ntfy_topic": "ntfy トピック",
+    "form.integration.ntfy_token": "ntfy アクセストークン (任意)",
+    "form.integration.ntfy_priority": "優先度 (1〜5)",
+    "form.integration.apprise_activate": "新しい記事を Apprise に送信する",
+    "form.integration.apprise_url": "Apprise API URL",
+    "form.integration.apprise_services_url": "Apprise サービス URL (カンマ区切り、設定キーなしの場合)",
+    "form.integration.apprise_config_key": "Apprise 設定キー (任意)",
+    "form.integration.apprise_tag": "Apprise タグ (任意、設定キー使用時)",
+    "form.integration.imap_activate": "Import the emails of an IMAP mailbox as a feed",
+    "form.integration.imap_server": "IMAP Server (host:port)",
+    "form.integration.imap_username": "IMAP Username",
+    "form.integration.imap_password": "IMAP Password",
+    "form.integration.imap_mailbox": "Mailbox",
+    "form.integration.imap_sender_filter": "Only import the emails of this sender (optional)",
+    "form.integration.mark_read_on_save": "Mark the entry as read after saving it",
     "form.api_key.label.description": "APIキーラベル",
     "form.submit.loading": "読み込み中…",
     "form.submit.saving": "保存中…",
@@ -2008,7 +2503,8 @@ var translations = map[string]string{
     "time_elapsed.years": [
         "%d 年前",
         "%d 年前"
-    ]
+    ],
+    "Unable to fetch this resource after %d attempts: %v": "%d 回試行しましたが、このリソースを取得できませんでした: %v"
 }
 `,
 	"nl_NL": `{
@@ -2050,6 +2546,10 @@ var translations = map[string]string{
     "menu.show_all_entries": "Toon alle artikelen",
     "menu.show_only_unread_entries": "Toon alleen ongelezen artikelen",
     "menu.refresh_feed": "Vernieuwen",
+    "menu.force_refresh_feed": "Force refresh",
+    "menu.force_refresh_feed.title": "Download and process the feed again, even if it has not been modified",
+    "menu.reprocess_feed_entries": "Reprocess entries",
+    "menu.reprocess_feed_entries.title": "Apply the current scraper and rewrite rules to the existing entries",
     "menu.refresh_all_feeds": "Vernieuw alle feeds in de achtergrond",
     "menu.edit_feed": "Bewerken",
     "menu.edit_category": "Bewerken",
@@ -2135,11 +2635,16 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Feed kiezen",
     "page.edit_feed.title": "Bewerken van feed: %s",
     "page.edit_feed.last_check": "Laatste update:",
+    "page.edit_feed.last_status_code": "Last HTTP status code:",
     "page.edit_feed.last_modified_header": "LastModified-header:",
     "page.edit_feed.etag_header": "ETAG-header:",
     "page.edit_feed.no_header": "Geen",
     "page.edit_feed.last_parsing_error": "Laatste parse error",
+    "page.edit_feed.disabled_reason": "This feed has been disabled automatically",
     "page.entry.attachments": "Bijlagen",
+    "page.entry.transcripts": "Transcripties",
+    "page.entry.summary": "Summary provided by the feed",
+    "page.entry.original_content": "Originele inhoud",
     "page.keyboard_shortcuts.title": "Sneltoetsen",
     "page.keyboard_shortcuts.subtitle.sections": "Naviguatie tussen menu's",
     "page.keyboard_shortcuts.subtitle.items": "Navigatie tussen items",
@@ -2226,6 +2731,7 @@ var translations = map[string]string{
     "alert.account_linked": "Uw externe account is nu gekoppeld!",
     "alert.pocket_linked": "Uw Pocket-account is nu gekoppeld!",
     "alert.prefs_saved": "Instellingen opgeslagen!",
+    "alert.opml_import_started": "Importing %d feeds in the background, they will appear progressively.",
     "error.unlink_account_without_password": "U moet een wachtwoord definiëren anders kunt u zich niet opnieuw aanmelden.",
     "error.duplicate_linked_account": "Er is al iemand geregistreerd met deze provider!",
     "error.duplicate_fever_username": "Er is al iemand met dezelfde Fever gebruikersnaam!",
@@ -2248,22 +2754,56 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Gebruikersnaam, skin, taal en tijdzone zijn verplicht.",
     "error.entries_per_page_invalid": "Het aantal inzendingen per pagina is niet geldig.",
     "error.feed_mandatory_fields": "The URL en de categorie zijn verplicht.",
+    "error.invalid_icon_url": "The icon URL is not valid.",
+    "error.invalid_proxy_url": "De proxy-URL is ongeldig.",
+    "error.invalid_author_keeplist_rules": "De auteursbewaarregels mogen de lege auteursregel \"\" niet bevatten, artikelen zonder auteur worden altijd bewaard.",
     "error.user_mandatory_fields": "Gebruikersnaam is verplicht",
     "error.api_key_already_exists": "This API Key already exists.",
     "error.unable_to_create_api_key": "Kan deze API-sleutel niet maken.",
     "form.feed.label.title": "Naam",
     "form.feed.label.site_url": "Website URL",
     "form.feed.label.feed_url": "Feed URL",
+    "form.feed.label.icon_url": "Icon URL (leave empty to find the icon automatically)",
     "form.feed.label.category": "Categorie",
     "form.feed.label.crawler": "Download originele content",
     "form.feed.label.feed_username": "Feed-gebruikersnaam",
     "form.feed.label.feed_password": "Feed wachtwoord",
+    "form.feed.label.auth_scheme": "Authentication",
+    "form.feed.auth_scheme.basic": "HTTP Basic (username and password)",
+    "form.feed.auth_scheme.bearer": "Bearer token",
+    "form.feed.author_match_mode.exact": "Exacte naam",
+    "form.feed.author_match_mode.substring": "Deel van de naam",
+    "form.feed.crawler_mode.category": "Instelling van de categorie gebruiken",
+    "form.feed.crawler_mode.enabled": "Ingeschakeld",
+    "form.feed.crawler_mode.disabled": "Uitgeschakeld",
+    "form.feed.label.auth_token": "Token (leave empty to keep the current token)",
     "form.feed.label.user_agent": "Standaard User Agent overschrijven",
+    "form.feed.label.cookie": "Cookies",
+    "form.feed.label.proxy_url": "Proxy-URL",
+    "form.feed.label.translation_language": "Vertaal de inhoud naar deze taal (taalcode zoals \"nl\", vereist een vertaal-API)",
     "form.feed.label.scraper_rules": "Scraper regels",
     "form.feed.label.rewrite_rules": "Rewrite regels",
+    "form.feed.label.blocklist_rules": "Block Rules (regular expressions, one per line)",
+    "form.feed.label.keeplist_rules": "Keep Rules (regular expressions, one per line)",
+    "form.feed.label.author_blocklist_rules": "Blokkeerregels voor auteurs (één auteur per regel, \"\" voor artikelen zonder auteur)",
+    "form.feed.label.author_keeplist_rules": "Toestaanregels voor auteurs (één auteur per regel)",
+    "form.feed.label.author_match_mode": "Auteursvergelijking",
+    "form.feed.label.date_layouts": "Date Formats (Go time layouts, one per line, used when the date is not recognized)",
+    "form.feed.label.max_entries": "Maximum number of entries to keep (0 for unlimited)",
+    "form.feed.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the category or default value)",
+    "form.feed.label.request_timeout": "Request timeout in seconds (0 for the default value)",
+    "form.feed.label.refresh_interval": "Refresh interval in minutes (0 for the default value)",
     "form.feed.label.ignore_http_cache": "Negeer HTTP-cache",
+    "form.feed.label.notify_telegram": "Nieuwe artikelen naar Telegram sturen",
+    "form.feed.label.skip_duplicate_guids": "Skip entries whose GUID already exists in another feed",
     "form.feed.label.disabled": "Vernieuw deze feed niet",
+    "form.feed.label.sort_order": "Entries sorting order",
+    "form.feed.sort_order.default": "Use my preferences",
     "form.category.label.title": "Naam",
+    "form.category.label.crawler": "Fetch original content for all feeds of this category",
+    "form.category.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the default value)",
+    "form.category.label.telegram_chat_id": "Telegram chat-ID voor de feeds van deze categorie (optioneel)",
+    "form.category.label.webhook_url": "Webhook-URL voor de feeds van deze categorie (optioneel)",
     "form.user.label.username": "Gebruikersnaam",
     "form.user.label.password": "Wachtwoord",
     "form.user.label.confirmation": "Bevestig wachtwoord",
@@ -2275,8 +2815,13 @@ var translations = map[string]string{
     "form.prefs.label.entries_per_page": "Inzendingen per pagina",
     "form.prefs.select.older_first": "Oudere items eerst",
     "form.prefs.select.recent_first": "Recente items eerst",
+    "form.prefs.select.deduplication_disabled": "Keep duplicates",
+    "form.prefs.select.deduplication_skip": "Ignore duplicates",
+    "form.prefs.select.deduplication_mark_as_read": "Mark duplicates as read",
     "form.prefs.label.keyboard_shortcuts": "Schakel sneltoetsen in",
     "form.prefs.label.show_reading_time": "Toon geschatte leestijd voor artikelen",
+    "form.prefs.label.mark_read_on_scroll": "Let applications mark entries as read while scrolling",
+    "form.prefs.label.entry_deduplication": "Duplicate entries across feeds",
     "form.prefs.label.custom_css": "Aangepaste CSS",
     "form.import.label.file": "OPML-bestand",
     "form.import.label.url": "URL",
@@ -2304,9 +2849,39 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Opslaan naar Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper URL",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API-sleutel",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API Secret",
     "form.integration.telegram_activate": "Forward to Telegram",
     "form.integration.telegram_token": "Telegram Bot Token",
     "form.integration.telegram_chat_id": "Recipient ChatId",
+    "form.integration.telegram_topic_id": "Topic ID (optional)",
+    "form.integration.discord_activate": "Doorsturen naar Discord",
+    "form.integration.discord_webhook_url": "Discord Webhook URL",
+    "form.integration.webhook_activate": "Send new entries to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_secret": "Webhook Secret (optional)",
+    "form.integration.gotify_activate": "Nieuwe artikelen naar Gotify sturen",
+    "form.integration.gotify_url": "Gotify-server-URL",
+    "form.integration.gotify_token": "Gotify-applicatietoken",
+    "form.integration.gotify_priority": "Prioriteit (0 tot 10)",
+    "form.integration.ntfy_activate": "Nieuwe artikelen naar ntfy sturen",
+    "form.integration.ntfy_url": "ntfy-server-URL",
+    "form.integration.ntfy_topic": "ntfy-onderwerp",
+    "form.integration.ntfy_token": "ntfy-toegangstoken (optioneel)",
+    "form.integration.ntfy_priority": "Prioriteit (1 tot 5)",
+    "form.integration.apprise_activate": "Nieuwe artikelen naar Apprise sturen",
+    "form.integration.apprise_url": "Apprise API-URL",
+    "form.integration.apprise_services_url": "Apprise-service-URL’s, gescheiden door komma’s (zonder configuratiesleutel)",
+    "form.integration.apprise_config_key": "Apprise-configuratiesleutel (optioneel)",
+    "form.integration.apprise_tag": "Apprise-tag (optioneel, met een configuratiesleutel)",
+    "form.integration.imap_activate": "Import the emails of an IMAP mailbox as a feed",
+    "form.integration.imap_server": "IMAP Server (host:port)",
+    "form.integration.imap_username": "IMAP Username",
+    "form.integration.imap_password": "IMAP Password",
+    "form.integration.imap_mailbox": "Mailbox",
+    "form.integration.imap_sender_filter": "Only import the emails of this sender (optional)",
+    "form.integration.mark_read_on_save": "Mark the entry as read after saving it",
     "form.api_key.label.description": "API-sleutellabel",
     "form.submit.loading": "Laden...",
     "form.submit.saving": "Opslaag...",
@@ -2354,7 +2929,11 @@ var translations = map[string]string{
     "Invalid SSL certificate (original error: %q)": "Ongeldig SSL-certificaat (originele error: %q)",
     "This website is temporarily unreachable (original error: %q)": "Deze website is tijdelijk onbereikbaar (originele error: %q)",
     "This website is permanently unreachable (original error: %q)": "Deze website is permanent onbereikbaar (originele error: %q)",
-    "Website unreachable, the request timed out after %d seconds": "Website onbereikbaar, de request gaf een timeout na %d seconden"
+    "Website unreachable, the request timed out after %d seconds": "Website onbereikbaar, de request gaf een timeout na %d seconden",
+    "Invalid proxy URL": "Ongeldige proxy-URL",
+    "A Tor proxy is required to fetch .onion addresses": "Een Tor-proxy is vereist om .onion-adressen op te halen",
+    "This resource has been redirected too many times (more than %d)": "Deze bron is te vaak omgeleid (meer dan %d)",
+    "Unable to fetch this resource after %d attempts: %v": "Kan deze bron niet ophalen na %d pogingen: %v"
 }
 `,
 	"pl_PL": `{
@@ -2396,6 +2975,10 @@ var translations = map[string]string{
     "menu.show_all_entries": "Pokaż wszystkie artykuły",
     "menu.show_only_unread_entries": "Pokaż tylko nieprzeczytane artykuły",
     "menu.refresh_feed": "Odśwież",
+    "menu.force_refresh_feed": "Force refresh",
+    "menu.force_refresh_feed.title": "Download and process the feed again, even if it has not been modified",
+    "menu.reprocess_feed_entries": "Reprocess entries",
+    "menu.reprocess_feed_entries.title": "Apply the current scraper and rewrite rules to the existing entries",
     "menu.refresh_all_feeds": "Odśwież wszystkie subskrypcje w tle",
     "menu.edit_feed": "Edytuj",
     "menu.edit_category": "Edytuj",
@@ -2482,11 +3065,16 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Wybierz subskrypcję",
     "page.edit_feed.title": "Edytuj kanał: %s",
     "page.edit_feed.last_check": "Ostatnia aktualizacja:",
+    "page.edit_feed.last_status_code": "Last HTTP status code:",
     "page.edit_feed.last_modified_header": "Ostatnio zmienione:",
     "page.edit_feed.etag_header": "Nagłówek ETag:",
     "page.edit_feed.no_header": "Brak",
     "page.edit_feed.last_parsing_error": "Ostatni błąd analizy",
+    "page.edit_feed.disabled_reason": "This feed has been disabled automatically",
     "page.entry.attachments": "Załączniki",
+    "page.entry.transcripts": "Transkrypcje",
+    "page.entry.summary": "Summary provided by the feed",
+    "page.entry.original_content": "Oryginalna treść",
     "page.keyboard_shortcuts.title": "Skróty klawiszowe",
     "page.keyboard_shortcuts.subtitle.sections": "Nawigacja między punktami menu",
     "page.keyboard_shortcuts.subtitle.items": "Nawigacja między artykułami",
@@ -2574,6 +3162,7 @@ var translations = map[string]string{
     "alert.account_linked": "Twoje konto zewnętrzne jest teraz połączone!",
     "alert.pocket_linked": "Twoje konto Pocket jest teraz połączone!",
     "alert.prefs_saved": "Ustawienia zapisane!",
+    "alert.opml_import_started": "Importing %d feeds in the background, they will appear progressively.",
     "error.unlink_account_without_password": "Musisz zdefiniować hasło, inaczej nie będziesz mógł się ponownie zalogować.",
     "error.duplicate_linked_account": "Już ktoś jest powiązany z tym dostawcą!",
     "error.duplicate_fever_username": "Już ktoś inny używa tej nazwy użytkownika Fever!",
@@ -2596,22 +3185,56 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Pola nazwy użytkownika, tematu, języka i strefy czasowej są obowiązkowe.",
     "error.entries_per_page_invalid": "Liczba wpisów na stronę jest nieprawidłowa.",
     "error.feed_mandatory_fields": "URL i kategoria są obowiązkowe.",
+    "error.invalid_icon_url": "The icon URL is not valid.",
+    "error.invalid_proxy_url": "Adres URL proxy jest nieprawidłowy.",
+    "error.invalid_author_keeplist_rules": "The author keep rules can't contain the empty author rule \"\", the entries without author are always kept.",
     "error.user_mandatory_fields": "Nazwa użytkownika jest obowiązkowa.",
     "error.api_key_already_exists": "Deze API-sleutel bestaat al.",
     "error.unable_to_create_api_key": "Nie można utworzyć tego klucza API.",
     "form.feed.label.title": "Tytuł",
     "form.feed.label.site_url": "URL strony",
     "form.feed.label.feed_url": "URL kanału",
+    "form.feed.label.icon_url": "Icon URL (leave empty to find the icon automatically)",
     "form.feed.label.category": "Kategoria",
     "form.feed.label.crawler": "Pobierz oryginalną treść",
     "form.feed.label.feed_username": "Subskrypcję nazwa użytkownika",
     "form.feed.label.feed_password": "Subskrypcję Hasło",
+    "form.feed.label.auth_scheme": "Authentication",
+    "form.feed.auth_scheme.basic": "HTTP Basic (username and password)",
+    "form.feed.auth_scheme.bearer": "Bearer token",
+    "form.feed.author_match_mode.exact": "Dokładna nazwa",
+    "form.feed.author_match_mode.substring": "Część nazwy",
+    "form.feed.crawler_mode.category": "Użyj ustawienia kategorii",
+    "form.feed.crawler_mode.enabled": "Włączone",
+    "form.feed.crawler_mode.disabled": "Wyłączone",
+    "form.feed.label.auth_token": "Token (leave empty to keep the current token)",
     "form.feed.label.user_agent": "Zastąp domyślny agent użytkownika",
+    "form.feed.label.cookie": "Cookies",
+    "form.feed.label.proxy_url": "Adres URL proxy",
+    "form.feed.label.translation_language": "Przetłumacz treść na ten język (kod języka, np. \"pl\", wymaga API tłumaczenia)",
     "form.feed.label.scraper_rules": "Zasady ekstrakcji",
     "form.feed.label.rewrite_rules": "Reguły zapisu",
+    "form.feed.label.blocklist_rules": "Block Rules (regular expressions, one per line)",
+    "form.feed.label.keeplist_rules": "Keep Rules (regular expressions, one per line)",
+    "form.feed.label.author_blocklist_rules": "Reguły blokowania autorów (jeden autor w wierszu, \"\" dla artykułów bez autora)",
+    "form.feed.label.author_keeplist_rules": "Reguły zezwalania autorów (jeden autor w wierszu)",
+    "form.feed.label.author_match_mode": "Dopasowanie autorów",
+    "form.feed.label.date_layouts": "Date Formats (Go time layouts, one per line, used when the date is not recognized)",
+    "form.feed.label.max_entries": "Maximum number of entries to keep (0 for unlimited)",
+    "form.feed.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the category or default value)",
+    "form.feed.label.request_timeout": "Request timeout in seconds (0 for the default value)",
+    "form.feed.label.refresh_interval": "Refresh interval in minutes (0 for the default value)",
     "form.feed.label.ignore_http_cache": "Zignoruj ​​pamięć podręczną HTTP",
+    "form.feed.label.notify_telegram": "Wysyłaj nowe artykuły do Telegrama",
+    "form.feed.label.skip_duplicate_guids": "Skip entries whose GUID already exists in another feed",
     "form.feed.label.disabled": "Не обновлять этот канал",
+    "form.feed.label.sort_order": "Entries sorting order",
+    "form.feed.sort_order.default": "Use my preferences",
     "form.category.label.title": "Tytuł",
+    "form.category.label.crawler": "Fetch original content for all feeds of this category",
+    "form.category.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the default value)",
+    "form.category.label.telegram_chat_id": "Identyfikator czatu Telegram dla kanałów tej kategorii (opcjonalnie)",
+    "form.category.label.webhook_url": "Adres URL webhooka dla kanałów tej kategorii (opcjonalnie)",
     "form.user.label.username": "Nazwa użytkownika",
     "form.user.label.password": "Hasło",
     "form.user.label.confirmation": "Potwierdzenie hasła",
@@ -2624,7 +3247,12 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Najstarsze wpisy jako pierwsze",
     "form.prefs.label.keyboard_shortcuts": "Włącz skróty klawiaturowe",
     "form.prefs.label.show_reading_time": "Pokaż szacowany czas czytania artykułów",
+    "form.prefs.label.mark_read_on_scroll": "Let applications mark entries as read while scrolling",
+    "form.prefs.label.entry_deduplication": "Duplicate entries across feeds",
     "form.prefs.select.recent_first": "Najnowsze wpisy jako pierwsze",
+    "form.prefs.select.deduplication_disabled": "Keep duplicates",
+    "form.prefs.select.deduplication_skip": "Ignore duplicates",
+    "form.prefs.select.deduplication_mark_as_read": "Mark duplicates as read",
     "form.prefs.label.custom_css": "Niestandardowy CSS",
     "form.import.label.file": "Plik OPML",
     "form.import.label.url": "URL",
@@ -2652,9 +3280,39 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Zapisz artykuly do Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper URL",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API Secret",
     "form.integration.telegram_activate": "Forward to Telegram",
     "form.integration.telegram_token": "Telegram Bot Token",
     "form.integration.telegram_chat_id": "Recipient ChatId",
+    "form.integration.telegram_topic_id": "Topic ID (optional)",
+    "form.integration.discord_activate": "Przekaż do Discorda",
+    "form.integration.discord_webhook_url": "Discord Webhook URL",
+    "form.integration.webhook_activate": "Send new entries to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_secret": "Webhook Secret (optional)",
+    "form.integration.gotify_activate": "Wysyłaj nowe artykuły do Gotify",
+    "form.integration.gotify_url": "Adres URL serwera Gotify",
+    "form.integration.gotify_token": "Token aplikacji Gotify",
+    "form.integration.gotify_priority": "Priorytet (od 0 do 10)",
+    "form.integration.ntfy_activate": "Wysyłaj nowe artykuły do ntfy",
+    "form.integration.ntfy_url": "Adres URL serwera ntfy",
+    "form.integration.ntfy_topic": "Temat ntfy",
+    "form.integration.ntfy_token": "Token dostępu ntfy (opcjonalnie)",
+    "form.integration.ntfy_priority": "Priorytet (od 1 do 5)",
+    "form.integration.apprise_activate": "Wysyłaj nowe artykuły do Apprise",
+    "form.integration.apprise_url": "Adres URL API Apprise",
+    "form.integration.apprise_services_url": "Adresy URL usług Apprise, oddzielone przecinkami (bez klucza konfiguracji)",
+    "form.integration.apprise_config_key": "Klucz konfiguracji Apprise (opcjonalnie)",
+    "form.integration.apprise_tag": "Tag Apprise (opcjonalnie, z kluczem konfiguracji)",
+    "form.integration.imap_activate": "Import the emails of an IMAP mailbox as a feed",
+    "form.integration.imap_server": "IMAP Server (host:port)",
+    "form.integration.imap_username": "IMAP Username",
+    "form.integration.imap_password": "IMAP Password",
+    "form.integration.imap_mailbox": "Mailbox",
+    "form.integration.imap_sender_filter": "Only import the emails of this sender (optional)",
+    "form.integration.mark_read_on_save": "Mark the entry as read after saving it",
     "form.api_key.label.description": "Etykieta klucza API",
     "form.submit.loading": "Ładowanie...",
     "form.submit.saving": "Zapisywanie...",
@@ -2708,7 +3366,11 @@ var translations = map[string]string{
     "Invalid SSL certificate (original error: %q)": "Certyfikat SSL jest nieprawidłowy (błąd: %q)",
     "This website is temporarily unreachable (original error: %q)": "Ta strona jest tymczasowo niedostępna (błąd: %q)",
     "This website is permanently unreachable (original error: %q)": "Ta strona jest niedostępna (błąd: %q)",
-    "Website unreachable, the request timed out after %d seconds": "Strona internetowa nieosiągalna, żądanie wygasło po %d sekundach"
+    "Website unreachable, the request timed out after %d seconds": "Strona internetowa nieosiągalna, żądanie wygasło po %d sekundach",
+    "Invalid proxy URL": "Nieprawidłowy adres URL proxy",
+    "A Tor proxy is required to fetch .onion addresses": "Do pobierania adresów .onion wymagany jest serwer proxy Tor",
+    "This resource has been redirected too many times (more than %d)": "Ten zasób został przekierowany zbyt wiele razy (więcej niż %d)",
+    "Unable to fetch this resource after %d attempts: %v": "Nie można pobrać tego zasobu po %d próbach: %v"
 }
 `,
 	"pt_BR": `{
@@ -2750,6 +3412,10 @@ var translations = map[string]string{
     "menu.show_all_entries": "Mostrar todas os itens",
     "menu.show_only_unread_entries": "Mostrar apenas itens não lidos",
     "menu.refresh_feed": "Atualizar",
+    "menu.force_refresh_feed": "Force refresh",
+    "menu.force_refresh_feed.title": "Download and process the feed again, even if it has not been modified",
+    "menu.reprocess_feed_entries": "Reprocess entries",
+    "menu.reprocess_feed_entries.title": "Apply the current scraper and rewrite rules to the existing entries",
     "menu.refresh_all_feeds": "Atualizar todas as fontes",
     "menu.edit_feed": "Editar",
     "menu.edit_category": "Editar",
@@ -2834,11 +3500,16 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Escolher uma fonte",
     "page.edit_feed.title": "Editar fonte: %s",
     "page.edit_feed.last_check": "Última verificação:",
+    "page.edit_feed.last_status_code": "Last HTTP status code:",
     "page.edit_feed.last_modified_header": "Cabeçalho 'LastModified':",
     "page.edit_feed.etag_header": "Cabeçalho 'ETag':",
     "page.edit_feed.no_header": "Sem cabeçalhos",
     "page.edit_feed.last_parsing_error": "Último erro durante processamento",
+    "page.edit_feed.disabled_reason": "This feed has been disabled automatically",
     "page.entry.attachments": "Anexos",
+    "page.entry.transcripts": "Transcrições",
+    "page.entry.summary": "Summary provided by the feed",
+    "page.entry.original_content": "Conteúdo original",
     "page.keyboard_shortcuts.title": "Atalhos de teclado",
     "page.keyboard_shortcuts.subtitle.sections": "Navegação de seções",
     "page.keyboard_shortcuts.subtitle.items": "Navegação de itens",
@@ -2926,6 +3597,7 @@ var translations = map[string]string{
     "alert.account_linked": "Sua conta externa está vinculada!",
     "alert.pocket_linked": "Sua conta do Pocket está vinculada!",
     "alert.prefs_saved": "Suas preferências foram salvas!",
+    "alert.opml_import_started": "Importing %d feeds in the background, they will appear progressively.",
     "error.unlink_account_without_password": "Você deve definir uma senha, senão não será possível efetuar a sessão novamente.",
     "error.duplicate_linked_account": "Alguém já está vinculado a esse serviço!",
     "error.duplicate_fever_username": "Alguém já está utilizando esse nome de usuário do Fever!",
@@ -2948,22 +3620,56 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Os campos de nome de usuário, tema, idioma e fuso horário são obrigatórios.",
     "error.entries_per_page_invalid": "O número de itens por página é inválido.",
     "error.feed_mandatory_fields": "O campo de URL e categoria são obrigatórios.",
+    "error.invalid_icon_url": "The icon URL is not valid.",
+    "error.invalid_proxy_url": "A URL do proxy não é válida.",
+    "error.invalid_author_keeplist_rules": "As regras de manutenção por autor não podem conter a regra de autor vazia \"\", os artigos sem autor são sempre mantidos.",
     "error.user_mandatory_fields": "O nome de usuário é obrigatório.",
     "error.api_key_already_exists": "Essa chave de API já existe.",
     "error.unable_to_create_api_key": "Não foi possível criar uma chave de API.",
     "form.feed.label.title": "Título",
     "form.feed.label.site_url": "URL do site",
     "form.feed.label.feed_url": "URL da fonte",
+    "form.feed.label.icon_url": "Icon URL (leave empty to find the icon automatically)",
     "form.feed.label.category": "Categoria",
     "form.feed.label.crawler": "Obter conteúdo original",
     "form.feed.label.feed_username": "Nome de usuário da fonte",
     "form.feed.label.feed_password": "Senha da fonte",
+    "form.feed.label.auth_scheme": "Authentication",
+    "form.feed.auth_scheme.basic": "HTTP Basic (username and password)",
+    "form.feed.auth_scheme.bearer": "Bearer token",
+    "form.feed.author_match_mode.exact": "Nome exato",
+    "form.feed.author_match_mode.substring": "Parte do nome",
+    "form.feed.crawler_mode.category": "Usar a configuração da categoria",
+    "form.feed.crawler_mode.enabled": "Ativado",
+    "form.feed.crawler_mode.disabled": "Desativado",
+    "form.feed.label.auth_token": "Token (leave empty to keep the current token)",
     "form.feed.label.user_agent": "Sobrescrever o agente de usuário (user-agent) padrão",
+    "form.feed.label.cookie": "Cookies",
+    "form.feed.label.proxy_url": "URL do proxy",
+    "form.feed.label.translation_language": "Traduzir o conteúdo para este idioma (código como \"pt\", requer uma API de tradução)",
     "form.feed.label.scraper_rules": "Regras do scraper",
     "form.feed.label.rewrite_rules": "Regras para o Rewrite",
+    "form.feed.label.blocklist_rules": "Block Rules (regular expressions, one per line)",
+    "form.feed.label.keeplist_rules": "Keep Rules (regular expressions, one per line)",
+    "form.feed.label.author_blocklist_rules": "Regras de bloqueio de autores (um autor por linha, \"\" para os itens sem autor)",
+    "form.feed.label.author_keeplist_rules": "Regras de permissão de autores (um autor por linha)",
+    "form.feed.label.author_match_mode": "Correspondência de autores",
+    "form.feed.label.date_layouts": "Date Formats (Go time layouts, one per line, used when the date is not recognized)",
+    "form.feed.label.max_entries": "Maximum number of entries to keep (0 for unlimited)",
+    "form.feed.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the category or default value)",
+    "form.feed.label.request_timeout": "Request timeout in seconds (0 for the default value)",
+    "form.feed.label.refresh_interval": "Refresh interval in minutes (0 for the default value)",
     "form.feed.label.ignore_http_cache": "Ignorar cache HTTP",
+    "form.feed.label.notify_telegram": "Enviar novos itens para o Telegram",
+    "form.feed.label.skip_duplicate_guids": "Skip entries whose GUID already exists in another feed",
     "form.feed.label.disabled": "Não atualizar esta fonte",
+    "form.feed.label.sort_order": "Entries sorting order",
+    "form.feed.sort_order.default": "Use my preferences",
     "form.category.label.title": "Título",
+    "form.category.label.crawler": "Fetch original content for all feeds of this category",
+    "form.category.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the default value)",
+    "form.category.label.telegram_chat_id": "ID do chat do Telegram para as fontes desta categoria (opcional)",
+    "form.category.label.webhook_url": "URL do webhook para as fontes desta categoria (opcional)",
     "form.user.label.username": "Nome de usuário",
     "form.user.label.password": "Senha",
     "form.user.label.confirmation": "Confirmação de senha",
@@ -2975,8 +3681,13 @@ var translations = map[string]string{
     "form.prefs.label.entries_per_page": "Itens por página",
     "form.prefs.select.older_first": "Itens mais velhos primeiro",
     "form.prefs.select.recent_first": "Itens mais recentes",
+    "form.prefs.select.deduplication_disabled": "Keep duplicates",
+    "form.prefs.select.deduplication_skip": "Ignore duplicates",
+    "form.prefs.select.deduplication_mark_as_read": "Mark duplicates as read",
     "form.prefs.label.keyboard_shortcuts": "Habilitar atalhos do teclado",
     "form.prefs.label.show_reading_time": "Mostrar tempo estimado de leitura de artigos",
+    "form.prefs.label.mark_read_on_scroll": "Let applications mark entries as read while scrolling",
+    "form.prefs.label.entry_deduplication": "Duplicate entries across feeds",
     "form.prefs.label.custom_css": "CSS customizado",
     "form.import.label.file": "Arquivo OPML",
     "form.import.label.url": "URL",
@@ -3004,9 +3715,39 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Salvar itens no Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Endpoint de API do Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "Chave de API do Nunux Keeper",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API Secret",
     "form.integration.telegram_activate": "Forward to Telegram",
     "form.integration.telegram_token": "Telegram Bot Token",
     "form.integration.telegram_chat_id": "Recipient ChatId",
+    "form.integration.telegram_topic_id": "Topic ID (optional)",
+    "form.integration.discord_activate": "Encaminhar para o Discord",
+    "form.integration.discord_webhook_url": "Discord Webhook URL",
+    "form.integration.webhook_activate": "Send new entries to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_secret": "Webhook Secret (optional)",
+    "form.integration.gotify_activate": "Enviar os novos itens para o Gotify",
+    "form.integration.gotify_url": "URL do servidor Gotify",
+    "form.integration.gotify_token": "Token do aplicativo Gotify",
+    "form.integration.gotify_priority": "Prioridade (de 0 a 10)",
+    "form.integration.ntfy_activate": "Enviar os novos itens para o ntfy",
+    "form.integration.ntfy_url": "URL do servidor ntfy",
+    "form.integration.ntfy_topic": "Tópico do ntfy",
+    "form.integration.ntfy_token": "Token de acesso do ntfy (opcional)",
+    "form.integration.ntfy_priority": "Prioridade (de 1 a 5)",
+    "form.integration.apprise_activate": "Enviar os novos itens para o Apprise",
+    "form.integration.apprise_url": "URL da API do Apprise",
+    "form.integration.apprise_services_url": "URLs dos serviços do Apprise, separadas por vírgulas (sem chave de configuração)",
+    "form.integration.apprise_config_key": "Chave de configuração do Apprise (opcional)",
+    "form.integration.apprise_tag": "Tag do Apprise (opcional, com uma chave de configuração)",
+    "form.integration.imap_activate": "Import the emails of an IMAP mailbox as a feed",
+    "form.integration.imap_server": "IMAP Server (host:port)",
+    "form.integration.imap_username": "IMAP Username",
+    "form.integration.imap_password": "IMAP Password",
+    "form.integration.imap_mailbox": "Mailbox",
+    "form.integration.imap_sender_filter": "Only import the emails of this sender (optional)",
+    "form.integration.mark_read_on_save": "Mark the entry as read after saving it",
     "form.api_key.label.description": "Etiqueta da chave de API",
     "form.submit.loading": "Carregando...",
     "form.submit.saving": "Salvando...",
@@ -3036,7 +3777,8 @@ var translations = map[string]string{
     "time_elapsed.years": [
         "há %d ano",
         "há %d anos"
-    ]
+    ],
+    "Unable to fetch this resource after %d attempts: %v": "Não foi possível obter este recurso após %d tentativas: %v"
 }
 `,
 	"ru_RU": `{
@@ -3078,6 +3820,10 @@ var translations = map[string]string{
     "menu.show_all_entries": "Показать все статьи",
     "menu.show_only_unread_entries": "Показывать только непрочитанные статьи",
     "menu.refresh_feed": "Обновить",
+    "menu.force_refresh_feed": "Force refresh",
+    "menu.force_refresh_feed.title": "Download and process the feed again, even if it has not been modified",
+    "menu.reprocess_feed_entries": "Reprocess entries",
+    "menu.reprocess_feed_entries.title": "Apply the current scraper and rewrite rules to the existing entries",
     "menu.refresh_all_feeds": "Обновить все подписки в фоне",
     "menu.edit_feed": "Изменить",
     "menu.edit_category": "Изменить",
@@ -3164,11 +3910,16 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "Выбрать подписку",
     "page.edit_feed.title": "Изменить подписку: %s",
     "page.edit_feed.last_check": "Последняя проверка:",
+    "page.edit_feed.last_status_code": "Last HTTP status code:",
     "page.edit_feed.last_modified_header": "Заголовок LastModified:",
     "page.edit_feed.etag_header": "Заголовок ETag:",
     "page.edit_feed.no_header": "Отсутствует",
     "page.edit_feed.last_parsing_error": "Последняя ошибка парсинга",
+    "page.edit_feed.disabled_reason": "This feed has been disabled automatically",
     "page.entry.attachments": "Вложения",
+    "page.entry.transcripts": "Расшифровки",
+    "page.entry.summary": "Summary provided by the feed",
+    "page.entry.original_content": "Исходное содержимое",
     "page.keyboard_shortcuts.title": "Сочетания клавиш",
     "page.keyboard_shortcuts.subtitle.sections": "Навигация по секциям",
     "page.keyboard_shortcuts.subtitle.items": "Навигация по элементам",
@@ -3256,6 +4007,7 @@ var translations = map[string]string{
     "alert.account_linked": "Ваш внешний аккаунт теперь привязан!",
     "alert.pocket_linked": "Ваш Pocket аккаунт теперь привязан!",
     "alert.prefs_saved": "Предпочтения сохранены!",
+    "alert.opml_import_started": "Importing %d feeds in the background, they will appear progressively.",
     "error.unlink_account_without_password": "Вы должны установить пароль, иначе вы не сможете войти снова.",
     "error.duplicate_linked_account": "Уже есть кто-то, кто ассоциирован с этим аккаунтом!",
     "error.duplicate_fever_username": "Уже есть кто-то с таким же именем пользователя Fever!",
@@ -3278,22 +4030,56 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Имя пользователя, тема, язык и часовой пояс обязательны.",
     "error.entries_per_page_invalid": "Количество записей на странице недействительно.",
     "error.feed_mandatory_fields": "URL и категория обязательны.",
+    "error.invalid_icon_url": "The icon URL is not valid.",
+    "error.invalid_proxy_url": "Неверный URL прокси.",
+    "error.invalid_author_keeplist_rules": "The author keep rules can't contain the empty author rule \"\", the entries without author are always kept.",
     "error.user_mandatory_fields": "Имя пользователя обязательно.",
     "error.api_key_already_exists": "Этот ключ API уже существует.",
     "error.unable_to_create_api_key": "Невозможно создать этот ключ API.",
     "form.feed.label.title": "Название",
     "form.feed.label.site_url": "URL сайта",
     "form.feed.label.feed_url": "URL подписки",
+    "form.feed.label.icon_url": "Icon URL (leave empty to find the icon automatically)",
     "form.feed.label.category": "Категория",
     "form.feed.label.crawler": "Извлечь оригинальное содержимое",
     "form.feed.label.feed_username": "Имя пользователя подписки",
     "form.feed.label.feed_password": "Пароль подписки",
+    "form.feed.label.auth_scheme": "Authentication",
+    "form.feed.auth_scheme.basic": "HTTP Basic (username and password)",
+    "form.feed.auth_scheme.bearer": "Bearer token",
+    "form.feed.author_match_mode.exact": "Точное имя",
+    "form.feed.author_match_mode.substring": "Часть имени",
+    "form.feed.crawler_mode.category": "Использовать настройку категории",
+    "form.feed.crawler_mode.enabled": "Включено",
+    "form.feed.crawler_mode.disabled": "Выключено",
+    "form.feed.label.auth_token": "Token (leave empty to keep the current token)",
     "form.feed.label.user_agent": "Переопределить User Agent по умолчанию",
+    "form.feed.label.cookie": "Cookies",
+    "form.feed.label.proxy_url": "URL прокси",
+    "form.feed.label.translation_language": "Переводить содержимое на этот язык (код языка, например \"ru\", требуется API перевода)",
     "form.feed.label.scraper_rules": "Правила Scraper",
     "form.feed.label.rewrite_rules": "Правила Rewrite",
+    "form.feed.label.blocklist_rules": "Block Rules (regular expressions, one per line)",
+    "form.feed.label.keeplist_rules": "Keep Rules (regular expressions, one per line)",
+    "form.feed.label.author_blocklist_rules": "Правила блокировки авторов (один автор на строку, \"\" для статей без автора)",
+    "form.feed.label.author_keeplist_rules": "Правила разрешения авторов (один автор на строку)",
+    "form.feed.label.author_match_mode": "Сравнение авторов",
+    "form.feed.label.date_layouts": "Date Formats (Go time layouts, one per line, used when the date is not recognized)",
+    "form.feed.label.max_entries": "Maximum number of entries to keep (0 for unlimited)",
+    "form.feed.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the category or default value)",
+    "form.feed.label.request_timeout": "Request timeout in seconds (0 for the default value)",
+    "form.feed.label.refresh_interval": "Refresh interval in minutes (0 for the default value)",
     "form.feed.label.ignore_http_cache": "Игнорировать HTTP-кеш",
+    "form.feed.label.notify_telegram": "Отправлять новые статьи в Telegram",
+    "form.feed.label.skip_duplicate_guids": "Skip entries whose GUID already exists in another feed",
     "form.feed.label.disabled": "Не обновлять этот канал",
+    "form.feed.label.sort_order": "Entries sorting order",
+    "form.feed.sort_order.default": "Use my preferences",
     "form.category.label.title": "Название",
+    "form.category.label.crawler": "Fetch original content for all feeds of this category",
+    "form.category.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the default value)",
+    "form.category.label.telegram_chat_id": "ID чата Telegram для подписок этой категории (необязательно)",
+    "form.category.label.webhook_url": "URL вебхука для подписок этой категории (необязательно)",
     "form.user.label.username": "Имя пользователя",
     "form.user.label.password": "Пароль",
     "form.user.label.confirmation": "Подтверждение пароля",
@@ -3305,8 +4091,13 @@ var translations = map[string]string{
     "form.prefs.label.entries_per_page": "Записи на странице",
     "form.prefs.select.older_first": "Сначала старые записи",
     "form.prefs.select.recent_first": "Сначала последние записи",
+    "form.prefs.select.deduplication_disabled": "Keep duplicates",
+    "form.prefs.select.deduplication_skip": "Ignore duplicates",
+    "form.prefs.select.deduplication_mark_as_read": "Mark duplicates as read",
     "form.prefs.label.keyboard_shortcuts": "Включить сочетания клавиш",
     "form.prefs.label.show_reading_time": "Показать примерное время чтения статей",
+    "form.prefs.label.mark_read_on_scroll": "Let applications mark entries as read while scrolling",
+    "form.prefs.label.entry_deduplication": "Duplicate entries across feeds",
     "form.prefs.label.custom_css": "Пользовательские CSS",
     "form.import.label.file": "OPML файл",
     "form.import.label.url": "URL",
@@ -3334,9 +4125,39 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Сохранять статьи в Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Конечная точка Nunux Keeper API",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API Key",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API Secret",
     "form.integration.telegram_activate": "Forward to Telegram",
     "form.integration.telegram_token": "Telegram Bot Token",
     "form.integration.telegram_chat_id": "Recipient ChatId",
+    "form.integration.telegram_topic_id": "Topic ID (optional)",
+    "form.integration.discord_activate": "Пересылать в Discord",
+    "form.integration.discord_webhook_url": "Discord Webhook URL",
+    "form.integration.webhook_activate": "Send new entries to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_secret": "Webhook Secret (optional)",
+    "form.integration.gotify_activate": "Отправлять новые статьи в Gotify",
+    "form.integration.gotify_url": "URL сервера Gotify",
+    "form.integration.gotify_token": "Токен приложения Gotify",
+    "form.integration.gotify_priority": "Приоритет (от 0 до 10)",
+    "form.integration.ntfy_activate": "Отправлять новые статьи в ntfy",
+    "form.integration.ntfy_url": "URL сервера ntfy",
+    "form.integration.ntfy_topic": "Тема ntfy",
+    "form.integration.ntfy_token": "Токен доступа ntfy (необязательно)",
+    "form.integration.ntfy_priority": "Приоритет (от 1 до 5)",
+    "form.integration.apprise_activate": "Отправлять новые статьи в Apprise",
+    "form.integration.apprise_url": "URL API Apprise",
+    "form.integration.apprise_services_url": "URL сервисов Apprise через запятую (без ключа конфигурации)",
+    "form.integration.apprise_config_key": "Ключ конфигурации Apprise (необязательно)",
+    "form.integration.apprise_tag": "Тег Apprise (необязательно, с ключом конфигурации)",
+    "form.integration.imap_activate": "Import the emails of an IMAP mailbox as a feed",
+    "form.integration.imap_server": "IMAP Server (host:port)",
+    "form.integration.imap_username": "IMAP Username",
+    "form.integration.imap_password": "IMAP Password",
+    "form.integration.imap_mailbox": "Mailbox",
+    "form.integration.imap_sender_filter": "Only import the emails of this sender (optional)",
+    "form.integration.mark_read_on_save": "Mark the entry as read after saving it",
     "form.api_key.label.description": "Описание API-ключа",
     "form.submit.loading": "Загрузка…",
     "form.submit.saving": "Сохранение…",
@@ -3372,7 +4193,8 @@ var translations = map[string]string{
         "%d год назад",
         "%d года назад",
         "%d лет назад"
-    ]
+    ],
+    "Unable to fetch this resource after %d attempts: %v": "Не удалось получить этот ресурс после %d попыток: %v"
 }
 `,
 	"zh_CN": `{
@@ -3414,6 +4236,10 @@ var translations = map[string]string{
     "menu.show_all_entries": "显示所有条目",
     "menu.show_only_unread_entries": "仅显示未读文章",
     "menu.refresh_feed": "更新",
+    "menu.force_refresh_feed": "Force refresh",
+    "menu.force_refresh_feed.title": "Download and process the feed again, even if it has not been modified",
+    "menu.reprocess_feed_entries": "Reprocess entries",
+    "menu.reprocess_feed_entries.title": "Apply the current scraper and rewrite rules to the existing entries",
     "menu.refresh_all_feeds": "在后台更新全部源",
     "menu.edit_feed": "编辑",
     "menu.edit_category": "编辑",
@@ -3496,11 +4322,16 @@ var translations = map[string]string{
     "page.add_feed.choose_feed": "选择一个订阅",
     "page.edit_feed.title": "编辑源 : %s",
     "page.edit_feed.last_check": "最后检查时间：",
+    "page.edit_feed.last_status_code": "Last HTTP status code:",
     "page.edit_feed.last_modified_header": "最后修改的 Header：",
     "page.edit_feed.etag_header": "ETag 标题：",
     "page.edit_feed.no_header": "无",
     "page.edit_feed.last_parsing_error": "最后一次解析错误",
+    "page.edit_feed.disabled_reason": "This feed has been disabled automatically",
     "page.entry.attachments": "附件",
+    "page.entry.transcripts": "文字稿",
+    "page.entry.summary": "Summary provided by the feed",
+    "page.entry.original_content": "原始内容",
     "page.keyboard_shortcuts.title": "快捷键",
     "page.keyboard_shortcuts.subtitle.sections": "分区导航",
     "page.keyboard_shortcuts.subtitle.items": "条目导航",
@@ -3588,6 +4419,7 @@ var translations = map[string]string{
     "alert.account_linked": "您的外部账号已关联！",
     "alert.pocket_linked": "您的Pocket帐户现已关联",
     "alert.prefs_saved": "设置已存储！",
+    "alert.opml_import_started": "Importing %d feeds in the background, they will appear progressively.",
     "error.unlink_account_without_password": "您必须定义密码，否则您将无法再次登录。",
     "error.duplicate_linked_account": "该 Provider 已被关联！",
     "error.duplicate_fever_username": "Fever 用户名已被占用！",
@@ -3610,22 +4442,56 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "必须填写用户名、主题、语言以及时区",
     "error.entries_per_page_invalid": "每页的条目数无效。",
     "error.feed_mandatory_fields": "必须填写 URL 和分类",
+    "error.invalid_icon_url": "The icon URL is not valid.",
+    "error.invalid_proxy_url": "代理 URL 无效。",
+    "error.invalid_author_keeplist_rules": "The author keep rules can't contain the empty author rule \"\", the entries without author are always kept.",
     "error.user_mandatory_fields": "必须填写用户名",
     "error.api_key_already_exists": "此API密钥已存在。",
     "error.unable_to_create_api_key": "无法创建此API密钥。",
     "form.feed.label.title": "标题",
     "form.feed.label.site_url": "站点 URL",
     "form.feed.label.feed_url": "源 URL",
+    "form.feed.label.icon_url": "Icon URL (leave empty to find the icon automatically)",
     "form.feed.label.category": "类别",
     "form.feed.label.crawler": "获取原始内容",
     "form.feed.label.feed_username": "源用户名",
     "form.feed.label.feed_password": "源密码",
+    "form.feed.label.auth_scheme": "Authentication",
+    "form.feed.auth_scheme.basic": "HTTP Basic (username and password)",
+    "form.feed.auth_scheme.bearer": "Bearer token",
+    "form.feed.author_match_mode.exact": "完全匹配",
+    "form.feed.author_match_mode.substring": "部分匹配",
+    "form.feed.crawler_mode.category": "使用分类设置",
+    "form.feed.crawler_mode.enabled": "启用",
+    "form.feed.crawler_mode.disabled": "禁用",
+    "form.feed.label.auth_token": "Token (leave empty to keep the current token)",
     "form.feed.label.user_agent": "覆盖默认 User-Agent",
+    "form.feed.label.cookie": "Cookies",
+    "form.feed.label.proxy_url": "代理 URL",
+    "form.feed.label.translation_language": "将内容翻译成此语言（语言代码，例如 \"zh\"，需要翻译 API）",
     "form.feed.label.scraper_rules": "Scraper 规则",
     "form.feed.label.rewrite_rules": "重写规则",
+    "form.feed.label.blocklist_rules": "Block Rules (regular expressions, one per line)",
+    "form.feed.label.keeplist_rules": "Keep Rules (regular expressions, one per line)",
+    "form.feed.label.author_blocklist_rules": "作者屏蔽规则（每行一个作者，\"\" 表示没有作者的文章）",
+    "form.feed.label.author_keeplist_rules": "作者保留规则（每行一个作者）",
+    "form.feed.label.author_match_mode": "作者匹配",
+    "form.feed.label.date_layouts": "Date Formats (Go time layouts, one per line, used when the date is not recognized)",
+    "form.feed.label.max_entries": "Maximum number of entries to keep (0 for unlimited)",
+    "form.feed.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the category or default value)",
+    "form.feed.label.request_timeout": "Request timeout in seconds (0 for the default value)",
+    "form.feed.label.refresh_interval": "Refresh interval in minutes (0 for the default value)",
     "form.feed.label.ignore_http_cache": "忽略HTTP缓存",
+    "form.feed.label.notify_telegram": "发送新文章到 Telegram",
+    "form.feed.label.skip_duplicate_guids": "Skip entries whose GUID already exists in another feed",
     "form.feed.label.disabled": "请勿刷新此Feed",
+    "form.feed.label.sort_order": "Entries sorting order",
+    "form.feed.sort_order.default": "Use my preferences",
     "form.category.label.title": "标题",
+    "form.category.label.crawler": "Fetch original content for all feeds of this category",
+    "form.category.label.mark_read_after_days": "Mark unread entries as read after this number of days (0 for the default value)",
+    "form.category.label.telegram_chat_id": "此分类订阅源的 Telegram 聊天 ID（可选）",
+    "form.category.label.webhook_url": "此分类订阅源的 Webhook URL（可选）",
     "form.user.label.username": "用户名",
     "form.user.label.password": "密码",
     "form.user.label.confirmation": "确认",
@@ -3637,8 +4503,13 @@ var translations = map[string]string{
     "form.prefs.label.entries_per_page": "每页条目",
     "form.prefs.select.older_first": "旧->新",
     "form.prefs.select.recent_first": "新->旧",
+    "form.prefs.select.deduplication_disabled": "保留重复文章",
+    "form.prefs.select.deduplication_skip": "忽略重复文章",
+    "form.prefs.select.deduplication_mark_as_read": "将重复文章标记为已读",
     "form.prefs.label.keyboard_shortcuts": "启用键盘快捷键",
     "form.prefs.label.show_reading_time": "显示文章的预计阅读时间",
+    "form.prefs.label.mark_read_on_scroll": "Let applications mark entries as read while scrolling",
+    "form.prefs.label.entry_deduplication": "跨订阅的重复文章",
     "form.prefs.label.custom_css": "自定义CSS",
     "form.import.label.file": "OPML 文件",
     "form.import.label.url": "URL",
@@ -3666,9 +4537,39 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "保存文章到 Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API 密钥",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API Secret",
     "form.integration.telegram_activate": "转发至 Telegram",
     "form.integration.telegram_token": "Telegram 机器人 Token",
     "form.integration.telegram_chat_id": "接收者 ChatId",
+    "form.integration.telegram_topic_id": "话题 ID（可选）",
+    "form.integration.discord_activate": "转发到 Discord",
+    "form.integration.discord_webhook_url": "Discord Webhook 地址",
+    "form.integration.webhook_activate": "Send new entries to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_secret": "Webhook Secret (optional)",
+    "form.integration.gotify_activate": "将新文章发送到 Gotify",
+    "form.integration.gotify_url": "Gotify 服务器 URL",
+    "form.integration.gotify_token": "Gotify 应用令牌",
+    "form.integration.gotify_priority": "优先级（0 到 10）",
+    "form.integration.ntfy_activate": "将新文章发送到 ntfy",
+    "form.integration.ntfy_url": "ntfy 服务器 URL",
+    "form.integration.ntfy_topic": "ntfy 主题",
+    "form.integration.ntfy_token": "ntfy 访问令牌（可选）",
+    "form.integration.ntfy_priority": "优先级（1 到 5）",
+    "form.integration.apprise_activate": "将新文章发送到 Apprise",
+    "form.integration.apprise_url": "Apprise API URL",
+    "form.integration.apprise_services_url": "Apprise 服务 URL，以逗号分隔（无配置密钥时）",
+    "form.integration.apprise_config_key": "Apprise 配置密钥（可选）",
+    "form.integration.apprise_tag": "Apprise 标签（可选，需配置密钥）",
+    "form.integration.imap_activate": "Import the emails of an IMAP mailbox as a feed",
+    "form.integration.imap_server": "IMAP Server (host:port)",
+    "form.integration.imap_username": "IMAP Username",
+    "form.integration.imap_password": "IMAP Password",
+    "form.integration.imap_mailbox": "Mailbox",
+    "form.integration.imap_sender_filter": "Only import the emails of this sender (optional)",
+    "form.integration.mark_read_on_save": "Mark the entry as read after saving it",
     "form.api_key.label.description": "API密钥标签",
     "form.submit.loading": "载入中…",
     "form.submit.saving": "保存中…",
@@ -3711,21 +4612,25 @@ var translations = map[string]string{
     "Invalid SSL certificate (original error: %q)": "无效的SSL证书 (原始错误: %q)",
     "This website is temporarily unreachable (original error: %q)": "该网站暂时不可达 (原始错误: %q)",
     "This website is permanently unreachable (original error: %q)": "该网站永久不可达 (原始错误: %q)",
-    "Website unreachable, the request timed out after %d seconds": "网站不可达, 请求已在 %d 秒后超时"
+    "Website unreachable, the request timed out after %d seconds": "网站不可达, 请求已在 %d 秒后超时",
+    "Invalid proxy URL": "无效的代理 URL",
+    "A Tor proxy is required to fetch .onion addresses": "获取 .onion 地址需要 Tor 代理",
+    "This resource has been redirected too many times (more than %d)": "此资源被重定向的次数过多（超过 %d 次）",
+    "Unable to fetch this resource after %d attempts: %v": "尝试 %d 次后仍无法获取此资源：%v"
 }
 `,
 }
 
 var translationsChecksums = map[string]string{
-	"de_DE": "2031ffa9cca38510454cfe46501cec167e60ce44182011319f6e986b6e3c6eb1",
-	"en_US": "bf985665228062c9c3fc5ed29ec28834e3beef173cacb526326df2863ece69fd",
-	"es_ES": "a91859943168173f3d72db4ed857b965e76474c2536243b960842309f50420c7",
-	"fr_FR": "9dbb39060fa509fe7d000adec8fa09320fea2ca7adf64ee9e7e08cec3e64fe6a",
-	"it_IT": "65463e1e3b29f4e0520b237effa804a112b489574f63d61a3020eceb79957772",
-	"ja_JP": "7ed564c4d95f81b64186b0544eb773c51c7d122ecc83e8c71cecaa39a21987ea",
-	"nl_NL": "99be12acee6bbf51980fcc600e9bcb3e6b3f208d2ddf4c483e3c1e29eba0a2ab",
-	"pl_PL": "c68464ffe6118bcb8fdc053c95067ff227d79218ac418af6a326e1823b5c9ba8",
-	"pt_BR": "5750d30a67e60b00f4b6a7fb3e4559afb9bf394f162ba25d0580e26fc94b0513",
-	"ru_RU": "af48d2c25e26b90f523478aaa7675165cdea7bbbaf195cdcf2c8661deb68b36f",
-	"zh_CN": "48b19c5e68e323d2e584d3c3c57018e7f174f5c3668a14647996598c8024a24b",
+	"de_DE": "b042913f4585e8bea150e98f9b62d995d3bc8798b87aa753152e686dbda3f6f9",
+	"en_US": "e99e19bc9642c632ddee4ac085cdd1ca096c2f175221a426573fe800c7df0862",
+	"es_ES": "1c122d0734f7a9df70f27707eba67f054734c15c8c442f0a8d0f7dc74815c614",
+	"fr_FR": "d60e1403dfff4825dfa1505cdd3ac28e82e4bcaa1726fcd281b69e4f738a57b2",
+	"it_IT": "41769738e9ac386b25de3c7e300d984bc199f3a42a28080a9ecd5d74ca223d5a",
+	"ja_JP": "b8586c345ff02865fe9a67bff87b5e1cd5e2bf844b78cab64fc093cebead0939",
+	"nl_NL": "90b99a29cd9c2670de8c97614332b73e1ad941830912e0439f8ad42dfb5488a2",
+	"pl_PL": "e11c08911a57e7864b9781795b1602c99f2bc991373278c7062158e89836ec04",
+	"pt_BR": "ba11b97b5746ed0e2a64bc4c08ba93604cff7ee11ebb9407933d6aeaf6b4a345",
+	"ru_RU": "25728165c1e66a996afff02bbed75ec0c47c086169bf9ece0344729ff27c64e3",
+	"zh_CN": "7ac51d77519d43e51cd9a9236890ada8c2253607a5fc15daa54ebb9e2edd8ffc",
 }
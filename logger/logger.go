@@ -5,13 +5,18 @@
 package logger // import "miniflux.app/logger"
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"time"
 )
 
 var requestedLevel = InfoLevel
 var displayDateTime = false
+var jsonFormat = false
+var output io.Writer = os.Stderr
 
 // LogLevel type.
 type LogLevel uint32
@@ -50,6 +55,11 @@ func EnableDateTime() {
 	displayDateTime = true
 }
 
+// EnableJSONFormat writes log messages as JSON lines instead of plain text.
+func EnableJSONFormat() {
+	jsonFormat = true
+}
+
 // EnableDebug increases logging, more verbose (debug)
 func EnableDebug() {
 	requestedLevel = DebugLevel
@@ -85,7 +95,22 @@ func Fatal(format string, v ...interface{}) {
 	}
 }
 
+// jsonMessage is a log message written as a JSON line.
+type jsonMessage struct {
+	Time      string `json:"time"`
+	Level     string `json:"level"`
+	Component string `json:"component,omitempty"`
+	Message   string `json:"message"`
+}
+
 func formatMessage(level LogLevel, format string, v ...interface{}) {
+	message := fmt.Sprintf(format, v...)
+
+	if jsonFormat {
+		fmt.Fprintln(output, formatJSONMessage(time.Now(), level, message))
+		return
+	}
+
 	var prefix string
 
 	if displayDateTime {
@@ -94,5 +119,29 @@ func formatMessage(level LogLevel, format string, v ...interface{}) {
 		prefix = fmt.Sprintf("[%s] ", level)
 	}
 
-	fmt.Fprintf(os.Stderr, prefix+format+"\n", v...)
+	fmt.Fprintln(output, prefix+message)
+}
+
+// formatJSONMessage returns the log message as a JSON line.
+// The component written between brackets at the beginning of the message, like "[Worker]", is moved to its own field.
+func formatJSONMessage(date time.Time, level LogLevel, message string) string {
+	var component string
+	if strings.HasPrefix(message, "[") {
+		if end := strings.Index(message, "] "); end > 1 {
+			component = message[1:end]
+			message = message[end+2:]
+		}
+	}
+
+	data, err := json.Marshal(&jsonMessage{
+		Time:      date.Format(time.RFC3339),
+		Level:     strings.ToLower(level.String()),
+		Component: component,
+		Message:   message,
+	})
+	if err != nil {
+		return fmt.Sprintf(`{"level":"error","message":%q}`, err.Error())
+	}
+
+	return string(data)
 }
@@ -0,0 +1,71 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package logger // import "miniflux.app/logger"
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestFormatJSONMessage(t *testing.T) {
+	date := time.Date(2020, time.March, 1, 10, 30, 0, 0, time.UTC)
+	result := formatJSONMessage(date, ErrorLevel, "[Worker] Unable to refresh feed #42")
+	expected := `{"time":"2020-03-01T10:30:00Z","level":"error","component":"Worker","message":"Unable to refresh feed #42"}`
+
+	if result != expected {
+		t.Errorf(`Unexpected JSON message, got %s instead of %s`, result, expected)
+	}
+}
+
+func TestFormatJSONMessageWithoutComponent(t *testing.T) {
+	date := time.Date(2020, time.March, 1, 10, 30, 0, 0, time.UTC)
+	result := formatJSONMessage(date, InfoLevel, `Listening on "127.0.0.1:8080"`)
+	expected := `{"time":"2020-03-01T10:30:00Z","level":"info","message":"Listening on \"127.0.0.1:8080\""}`
+
+	if result != expected {
+		t.Errorf(`Unexpected JSON message, got %s instead of %s`, result, expected)
+	}
+}
+
+func TestFormatMessageWithTextFormat(t *testing.T) {
+	var buffer bytes.Buffer
+	output = &buffer
+	defer func() { output = os.Stderr }()
+
+	formatMessage(InfoLevel, "[Scheduler] %d feeds refreshed", 3)
+
+	expected := "[INFO] [Scheduler] 3 feeds refreshed\n"
+	if buffer.String() != expected {
+		t.Errorf(`Unexpected text message, got %q instead of %q`, buffer.String(), expected)
+	}
+}
+
+func TestFormatMessageWithJSONFormat(t *testing.T) {
+	var buffer bytes.Buffer
+	output = &buffer
+	jsonFormat = true
+	defer func() {
+		output = os.Stderr
+		jsonFormat = false
+	}()
+
+	formatMessage(DebugLevel, "[Scheduler] %d feeds refreshed", 3)
+
+	var message jsonMessage
+	if err := json.Unmarshal(buffer.Bytes(), &message); err != nil {
+		t.Fatalf(`Unable to decode the JSON message %q: %v`, buffer.String(), err)
+	}
+
+	if message.Level != "debug" || message.Component != "Scheduler" || message.Message != "3 feeds refreshed" {
+		t.Errorf(`Unexpected JSON message: %+v`, message)
+	}
+
+	if _, err := time.Parse(time.RFC3339, message.Time); err != nil {
+		t.Errorf(`Invalid time in the JSON message: %v`, err)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package metric // import "miniflux.app/metric"
+
+import (
+	"strconv"
+	"time"
+)
+
+var (
+	feedRefreshDuration = NewHistogram(
+		"miniflux_feed_refresh_duration_seconds",
+		"Time spent to refresh a feed.",
+		[]float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	)
+
+	feedRefreshTotal = NewCounter(
+		"miniflux_feed_refresh_total",
+		"Number of feed refreshes by feed and result.",
+		"feed_id", "result",
+	)
+
+	feedHTTPStatusTotal = NewCounter(
+		"miniflux_feed_http_status_total",
+		"Number of HTTP responses received while refreshing feeds by status code.",
+		"code",
+	)
+
+	entriesCreatedTotal = NewCounter(
+		"miniflux_entries_created_total",
+		"Number of entries created while refreshing feeds.",
+	)
+
+	// DefaultRegistry contains the metrics of the application.
+	DefaultRegistry = &Registry{}
+)
+
+func init() {
+	DefaultRegistry.Register(feedRefreshDuration, feedRefreshTotal, feedHTTPStatusTotal, entriesCreatedTotal)
+}
+
+// ObserveFeedRefresh records the duration and the result of a feed refresh.
+func ObserveFeedRefresh(feedID int64, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+
+	feedRefreshDuration.Observe(duration.Seconds())
+	feedRefreshTotal.Inc(strconv.FormatInt(feedID, 10), result)
+}
+
+// ObserveFeedHTTPStatus records the status code returned by the server of a feed.
+func ObserveFeedHTTPStatus(statusCode int) {
+	feedHTTPStatusTotal.Inc(strconv.Itoa(statusCode))
+}
+
+// ObserveEntryCreated counts a new entry.
+func ObserveEntryCreated() {
+	entriesCreatedTotal.Inc()
+}
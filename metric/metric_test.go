@@ -0,0 +1,92 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package metric // import "miniflux.app/metric"
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	counter := NewCounter("test_total", "Test counter.", "feed_id", "result")
+	counter.Inc("2", "error")
+	counter.Inc("1", "success")
+	counter.Add(2, "1", "success")
+
+	registry := &Registry{}
+	registry.Register(counter)
+
+	var buffer bytes.Buffer
+	registry.WriteMetrics(&buffer)
+
+	expected := `# HELP test_total Test counter.
+# TYPE test_total counter
+test_total{feed_id="1",result="success"} 3
+test_total{feed_id="2",result="error"} 1
+`
+
+	if buffer.String() != expected {
+		t.Errorf(`Unexpected output, got %q instead of %q`, buffer.String(), expected)
+	}
+}
+
+func TestCounterWithoutLabels(t *testing.T) {
+	counter := NewCounter("test_total", "Test counter.")
+	counter.Inc()
+
+	var buffer bytes.Buffer
+	counter.write(&buffer)
+
+	expected := "# HELP test_total Test counter.\n# TYPE test_total counter\ntest_total 1\n"
+	if buffer.String() != expected {
+		t.Errorf(`Unexpected output, got %q instead of %q`, buffer.String(), expected)
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	histogram := NewHistogram("test_seconds", "Test histogram.", []float64{0.5, 1})
+	histogram.Observe(0.25)
+	histogram.Observe(0.75)
+	histogram.Observe(2)
+
+	var buffer bytes.Buffer
+	histogram.write(&buffer)
+
+	expected := `# HELP test_seconds Test histogram.
+# TYPE test_seconds histogram
+test_seconds_bucket{le="0.5"} 1
+test_seconds_bucket{le="1"} 2
+test_seconds_bucket{le="+Inf"} 3
+test_seconds_sum 3
+test_seconds_count 3
+`
+
+	if buffer.String() != expected {
+		t.Errorf(`Unexpected output, got %q instead of %q`, buffer.String(), expected)
+	}
+}
+
+func TestHandlerWithBasicAuth(t *testing.T) {
+	handler := Handler(&Registry{}, "user", "secret")
+
+	r := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf(`Unexpected status code without credentials, got %d`, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	r.SetBasicAuth("user", "secret")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf(`Unexpected status code with valid credentials, got %d`, w.Code)
+	}
+}
@@ -11,10 +11,15 @@ import (
 
 // Category represents a category in the system.
 type Category struct {
-	ID        int64  `json:"id,omitempty"`
-	Title     string `json:"title,omitempty"`
-	UserID    int64  `json:"user_id,omitempty"`
-	FeedCount int    `json:"nb_feeds,omitempty"`
+	ID                int64  `json:"id,omitempty"`
+	Title             string `json:"title,omitempty"`
+	Crawler           bool   `json:"crawler"`
+	MarkReadAfterDays int    `json:"mark_read_after_days"`
+	TelegramChatID    string `json:"telegram_chat_id"`
+	WebhookURL        string `json:"webhook_url"`
+	Position          int    `json:"position"`
+	UserID            int64  `json:"user_id,omitempty"`
+	FeedCount         int    `json:"nb_feeds,omitempty"`
 }
 
 func (c *Category) String() string {
@@ -31,6 +36,10 @@ func (c Category) ValidateCategoryCreation() error {
 		return errors.New("The userID is mandatory")
 	}
 
+	if c.MarkReadAfterDays < 0 {
+		return errors.New("The number of days must be positive")
+	}
+
 	return nil
 }
 
@@ -48,6 +57,10 @@ func (c Category) ValidateCategoryModification() error {
 		return errors.New("The ID is mandatory")
 	}
 
+	if c.MarkReadAfterDays < 0 {
+		return errors.New("The number of days must be positive")
+	}
+
 	return nil
 }
 
@@ -22,6 +22,11 @@ func TestValidateCategoryCreation(t *testing.T) {
 		t.Error(`A category without title should generate an error`)
 	}
 
+	category = &Category{Title: "Test", UserID: 42, MarkReadAfterDays: -1}
+	if err := category.ValidateCategoryCreation(); err == nil {
+		t.Error(`A negative number of days should generate an error`)
+	}
+
 	category = &Category{Title: "Test", UserID: 42}
 	if err := category.ValidateCategoryCreation(); err != nil {
 		t.Error(`All required fields are filled, it should not generate any error`)
@@ -54,6 +59,11 @@ func TestValidateCategoryModification(t *testing.T) {
 		t.Error(`An invalid categoryID should generate an error`)
 	}
 
+	category = &Category{ID: 1, Title: "Test", UserID: 42, MarkReadAfterDays: -1}
+	if err := category.ValidateCategoryModification(); err == nil {
+		t.Error(`A negative number of days should generate an error`)
+	}
+
 	category = &Category{ID: 1, Title: "Test", UserID: 42}
 	if err := category.ValidateCategoryModification(); err != nil {
 		t.Error(`All required fields are filled, it should not generate any error`)
@@ -7,6 +7,8 @@ package model // import "miniflux.app/model"
 import (
 	"fmt"
 	"time"
+
+	"miniflux.app/crypto"
 )
 
 // Entry statuses
@@ -18,23 +20,49 @@ const (
 	DefaultSortingDirection = "asc"
 )
 
+// Read sources, they describe why a client marks entries as read.
+const (
+	ReadSourceManual = "manual"
+	ReadSourceView   = "view"
+	ReadSourceScroll = "scroll"
+)
+
 // Entry represents a feed item in the system.
 type Entry struct {
-	ID          int64         `json:"id"`
-	UserID      int64         `json:"user_id"`
-	FeedID      int64         `json:"feed_id"`
-	Status      string        `json:"status"`
-	Hash        string        `json:"hash"`
-	Title       string        `json:"title"`
-	URL         string        `json:"url"`
-	CommentsURL string        `json:"comments_url"`
-	Date        time.Time     `json:"published_at"`
-	Content     string        `json:"content"`
-	Author      string        `json:"author"`
-	ShareCode   string        `json:"share_code"`
-	Starred     bool          `json:"starred"`
-	Enclosures  EnclosureList `json:"enclosures,omitempty"`
-	Feed        *Feed         `json:"feed,omitempty"`
+	ID                  int64          `json:"id"`
+	UserID              int64          `json:"user_id"`
+	FeedID              int64          `json:"feed_id"`
+	Status              string         `json:"status"`
+	Hash                string         `json:"hash"`
+	ContentHash         string         `json:"-"`
+	GUID                string         `json:"-"`
+	RawDate             string         `json:"-"`
+	Title               string         `json:"title"`
+	URL                 string         `json:"url"`
+	CommentsURL         string         `json:"comments_url"`
+	Date                time.Time      `json:"published_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+	Content             string         `json:"content"`
+	Summary             string         `json:"summary"`
+	Language            string         `json:"language"`
+	TranslatedContent   string         `json:"translated_content,omitempty"`
+	TranslationLanguage string         `json:"translation_language,omitempty"`
+	ReadingTime         int            `json:"reading_time"`
+	ThumbnailURL        string         `json:"thumbnail_url"`
+	Explicit            bool           `json:"explicit"`
+	Author              string         `json:"author"`
+	ShareCode           string         `json:"share_code"`
+	Starred             bool           `json:"starred"`
+	Snippet             string         `json:"snippet,omitempty"`
+	Tags                []string       `json:"tags,omitempty"`
+	Enclosures          EnclosureList  `json:"enclosures,omitempty"`
+	Transcripts         TranscriptList `json:"transcripts,omitempty"`
+	Feed                *Feed          `json:"feed,omitempty"`
+}
+
+// ComputeContentHash returns the hash of the title and the content, it is used to detect the changes of an entry.
+func (e *Entry) ComputeContentHash() string {
+	return crypto.Hash(e.Title + "\n" + e.Content)
 }
 
 // Entries represents a list of entries.
@@ -50,14 +78,24 @@ func ValidateEntryStatus(status string) error {
 	return fmt.Errorf(`Invalid entry status, valid status values are: "%s", "%s" and "%s"`, EntryStatusRead, EntryStatusUnread, EntryStatusRemoved)
 }
 
+// ValidateReadSource makes sure the read source is valid.
+func ValidateReadSource(source string) error {
+	switch source {
+	case ReadSourceManual, ReadSourceView, ReadSourceScroll:
+		return nil
+	}
+
+	return fmt.Errorf(`Invalid read source, valid source values are: "%s", "%s" and "%s"`, ReadSourceManual, ReadSourceView, ReadSourceScroll)
+}
+
 // ValidateEntryOrder makes sure the sorting order is valid.
 func ValidateEntryOrder(order string) error {
 	switch order {
-	case "id", "status", "changed_at", "published_at", "category_title", "category_id":
+	case "id", "status", "changed_at", "published_at", "updated_at", "category_title", "category_id":
 		return nil
 	}
 
-	return fmt.Errorf(`Invalid entry order, valid order values are: "id", "status", "changed_at", "published_at", "category_title", "category_id"`)
+	return fmt.Errorf(`Invalid entry order, valid order values are: "id", "status", "changed_at", "published_at", "updated_at", "category_title", "category_id"`)
 }
 
 // ValidateDirection makes sure the sorting direction is valid.
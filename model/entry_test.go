@@ -4,7 +4,10 @@
 
 package model // import "miniflux.app/model"
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestValidateEntryStatus(t *testing.T) {
 	for _, status := range []string{EntryStatusRead, EntryStatusUnread, EntryStatusRemoved} {
@@ -18,8 +21,22 @@ func TestValidateEntryStatus(t *testing.T) {
 	}
 }
 
+func TestValidateReadSource(t *testing.T) {
+	for _, source := range []string{ReadSourceManual, ReadSourceView, ReadSourceScroll} {
+		if err := ValidateReadSource(source); err != nil {
+			t.Error(`A valid source should not generate any error`)
+		}
+	}
+
+	for _, source := range []string{"", "invalid", "Scroll"} {
+		if err := ValidateReadSource(source); err == nil {
+			t.Errorf(`The source %q should generate an error`, source)
+		}
+	}
+}
+
 func TestValidateEntryOrder(t *testing.T) {
-	for _, status := range []string{"id", "status", "changed_at", "published_at", "category_title", "category_id"} {
+	for _, status := range []string{"id", "status", "changed_at", "published_at", "updated_at", "category_title", "category_id"} {
 		if err := ValidateEntryOrder(status); err != nil {
 			t.Error(`A valid order should not generate any error`)
 		}
@@ -69,3 +86,23 @@ func TestGetOppositeDirection(t *testing.T) {
 		t.Errorf(`An invalid direction should return "asc"`)
 	}
 }
+
+func TestComputeContentHash(t *testing.T) {
+	entry := &Entry{Title: "Title", Content: "Content", Date: time.Now(), Author: "Author"}
+	hash := entry.ComputeContentHash()
+
+	updatedMetadata := &Entry{Title: "Title", Content: "Content", Date: time.Now().Add(time.Hour), Author: "Someone else"}
+	if updatedMetadata.ComputeContentHash() != hash {
+		t.Error(`The content hash should not change when only the metadata are different`)
+	}
+
+	updatedContent := &Entry{Title: "Title", Content: "Updated content"}
+	if updatedContent.ComputeContentHash() == hash {
+		t.Error(`The content hash should change when the content is different`)
+	}
+
+	updatedTitle := &Entry{Title: "Title Content", Content: ""}
+	if updatedTitle.ComputeContentHash() == (&Entry{Title: "Title", Content: " Content"}).ComputeContentHash() {
+		t.Error(`The title and the content should be separated`)
+	}
+}
@@ -7,38 +7,68 @@ package model // import "miniflux.app/model"
 import (
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"miniflux.app/config"
 	"miniflux.app/http/client"
+	"miniflux.app/url"
 )
 
 // Feed represents a feed in the application.
 type Feed struct {
-	ID                 int64     `json:"id"`
-	UserID             int64     `json:"user_id"`
-	FeedURL            string    `json:"feed_url"`
-	SiteURL            string    `json:"site_url"`
-	Title              string    `json:"title"`
-	CheckedAt          time.Time `json:"checked_at"`
-	NextCheckAt        time.Time `json:"next_check_at"`
-	EtagHeader         string    `json:"etag_header"`
-	LastModifiedHeader string    `json:"last_modified_header"`
-	ParsingErrorMsg    string    `json:"parsing_error_message"`
-	ParsingErrorCount  int       `json:"parsing_error_count"`
-	ScraperRules       string    `json:"scraper_rules"`
-	RewriteRules       string    `json:"rewrite_rules"`
-	Crawler            bool      `json:"crawler"`
-	UserAgent          string    `json:"user_agent"`
-	Username           string    `json:"username"`
-	Password           string    `json:"password"`
-	Disabled           bool      `json:"disabled"`
-	IgnoreHTTPCache    bool      `json:"ignore_http_cache"`
-	Category           *Category `json:"category,omitempty"`
-	Entries            Entries   `json:"entries,omitempty"`
-	Icon               *FeedIcon `json:"icon"`
-	UnreadCount        int       `json:"-"`
-	ReadCount          int       `json:"-"`
+	ID                   int64     `json:"id"`
+	UserID               int64     `json:"user_id"`
+	FeedURL              string    `json:"feed_url"`
+	SiteURL              string    `json:"site_url"`
+	Title                string    `json:"title"`
+	CheckedAt            time.Time `json:"checked_at"`
+	NextCheckAt          time.Time `json:"next_check_at"`
+	EtagHeader           string    `json:"etag_header"`
+	LastModifiedHeader   string    `json:"last_modified_header"`
+	ParsingErrorMsg      string    `json:"parsing_error_message"`
+	ParsingErrorCount    int       `json:"parsing_error_count"`
+	LastStatusCode       int       `json:"last_status_code"`
+	ScraperRules         string    `json:"scraper_rules"`
+	RewriteRules         string    `json:"rewrite_rules"`
+	BlocklistRules       string    `json:"blocklist_rules"`
+	KeeplistRules        string    `json:"keeplist_rules"`
+	AuthorBlocklistRules string    `json:"author_blocklist_rules"`
+	AuthorKeeplistRules  string    `json:"author_keeplist_rules"`
+	AuthorMatchMode      string    `json:"author_match_mode"`
+	DateLayouts          string    `json:"date_layouts"`
+	MaxEntries           int       `json:"max_entries"`
+	SkipDuplicateGUIDs   bool      `json:"skip_duplicate_guids"`
+	RequestTimeout       int       `json:"request_timeout"`
+	Crawler              bool      `json:"crawler"` // Derived from the crawler mode and the category setting, see IsCrawlerEnabled.
+	CrawlerMode          string    `json:"crawler_mode"`
+	UserAgent            string    `json:"user_agent"`
+	Cookie               string    `json:"cookie"`
+	Username             string    `json:"username"`
+	Password             string    `json:"password"`
+	AuthScheme           string    `json:"auth_scheme"`
+	AuthToken            string    `json:"-"` // Stored in plain text like the feed password, it is never returned by the API.
+	IconURL              string    `json:"icon_url"`
+	SortOrder            string    `json:"sort_order"`
+	MarkReadAfterDays    int       `json:"mark_read_after_days"`
+	RefreshInterval      int       `json:"refresh_interval"`
+	ProxyURL             string    `json:"proxy_url"`
+	TranslationLanguage  string    `json:"translation_language"`
+	Position             int       `json:"position"`
+	Disabled             bool      `json:"disabled"`
+	DisabledReason       string    `json:"disabled_reason"`
+	IgnoreHTTPCache      bool      `json:"ignore_http_cache"`
+	NotifyTelegram       bool      `json:"notify_telegram"`
+	HubURL               string    `json:"-"`
+	TopicURL             string    `json:"-"`
+	UpdateInterval       int       `json:"-"`
+	TTL                  int       `json:"-"`
+	Language             string    `json:"language"`
+	Category             *Category `json:"category,omitempty"`
+	Entries              Entries   `json:"entries,omitempty"`
+	Icon                 *FeedIcon `json:"icon"`
+	UnreadCount          int       `json:"-"`
+	ReadCount            int       `json:"-"`
 }
 
 // List of supported schedulers.
@@ -47,6 +77,86 @@ const (
 	SchedulerEntryFrequency = "entry_frequency"
 )
 
+// List of supported authentication schemes.
+const (
+	AuthSchemeBasic  = "basic"
+	AuthSchemeBearer = "bearer"
+)
+
+// List of supported crawler modes, an empty crawler mode uses the category setting.
+const (
+	CrawlerModeEnabled  = "enabled"
+	CrawlerModeDisabled = "disabled"
+)
+
+// IsValidCrawlerMode returns true if the crawler mode is empty or supported.
+func IsValidCrawlerMode(mode string) bool {
+	return mode == "" || mode == CrawlerModeEnabled || mode == CrawlerModeDisabled
+}
+
+// IsValidAuthScheme returns true if the authentication scheme is supported.
+func IsValidAuthScheme(scheme string) bool {
+	return scheme == AuthSchemeBasic || scheme == AuthSchemeBearer
+}
+
+// IsValidIconURL returns true if the icon URL is empty, an absolute HTTP URL or a data URL.
+func IsValidIconURL(iconURL string) bool {
+	return iconURL == "" || url.IsHTTPURL(iconURL) || strings.HasPrefix(iconURL, "data:image/")
+}
+
+// List of supported feed sort orders, an empty sort order uses the user preferences.
+const (
+	FeedSortOrderPublishedAsc  = "published-asc"
+	FeedSortOrderPublishedDesc = "published-desc"
+)
+
+// List of supported author match modes, the author rules match the whole name or a part of it, ignoring the case.
+const (
+	AuthorMatchExact     = "exact"
+	AuthorMatchSubstring = "substring"
+)
+
+// IsValidAuthorMatchMode returns true if the author match mode is supported.
+func IsValidAuthorMatchMode(mode string) bool {
+	return mode == AuthorMatchExact || mode == AuthorMatchSubstring
+}
+
+// EmptyAuthorRule is the author rule that matches the entries without author.
+const EmptyAuthorRule = `""`
+
+// IsValidAuthorKeeplistRules returns false when the author keep rules contain the empty author rule.
+// The entries without author are always kept, the empty author rule is only meaningful in the block rules.
+func IsValidAuthorKeeplistRules(rules string) bool {
+	for _, rule := range strings.Split(rules, "\n") {
+		if strings.TrimSpace(rule) == EmptyAuthorRule {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidProxyURL returns true if the proxy URL is empty or supported by the HTTP client.
+func IsValidProxyURL(proxyURL string) bool {
+	return proxyURL == "" || client.IsValidProxyURL(proxyURL)
+}
+
+// IsValidFeedSortOrder returns true if the sort order is empty or supported.
+func IsValidFeedSortOrder(sortOrder string) bool {
+	return sortOrder == "" || sortOrder == FeedSortOrderPublishedAsc || sortOrder == FeedSortOrderPublishedDesc
+}
+
+// SortDirection returns the direction of the feed sort order, or the given direction when the feed has none.
+func (f *Feed) SortDirection(defaultDirection string) string {
+	switch f.SortOrder {
+	case FeedSortOrderPublishedAsc:
+		return "asc"
+	case FeedSortOrderPublishedDesc:
+		return "desc"
+	default:
+		return defaultDirection
+	}
+}
+
 func (f *Feed) String() string {
 	return fmt.Sprintf("ID=%d, UserID=%d, FeedURL=%s, SiteURL=%s, Title=%s, Category={%s}",
 		f.ID,
@@ -60,9 +170,14 @@ func (f *Feed) String() string {
 
 // WithClientResponse updates feed attributes from an HTTP request.
 func (f *Feed) WithClientResponse(response *client.Response) {
+	f.WithCachingHeaders(response)
+	f.FeedURL = response.EffectiveURL
+}
+
+// WithCachingHeaders updates the caching headers of the feed from an HTTP request.
+func (f *Feed) WithCachingHeaders(response *client.Response) {
 	f.EtagHeader = response.ETag
 	f.LastModifiedHeader = response.LastModified
-	f.FeedURL = response.EffectiveURL
 }
 
 // WithCategoryID initializes the category attribute of the feed.
@@ -71,19 +186,60 @@ func (f *Feed) WithCategoryID(categoryID int64) {
 }
 
 // WithBrowsingParameters defines browsing parameters.
-func (f *Feed) WithBrowsingParameters(crawler bool, userAgent, username, password, scraperRules, rewriteRules string) {
-	f.Crawler = crawler
+func (f *Feed) WithBrowsingParameters(crawler bool, userAgent, cookie, username, password, scraperRules, rewriteRules string) {
+	if crawler {
+		f.CrawlerMode = CrawlerModeEnabled
+	}
 	f.UserAgent = userAgent
+	f.Cookie = cookie
 	f.Username = username
 	f.Password = password
 	f.ScraperRules = scraperRules
 	f.RewriteRules = rewriteRules
 }
 
+// IsCrawlerEnabled returns true when the original content should be fetched.
+// The crawler mode of the feed wins over the category setting, which is used only when the feed has no crawler mode.
+func (f *Feed) IsCrawlerEnabled() bool {
+	switch f.CrawlerMode {
+	case CrawlerModeEnabled:
+		return true
+	case CrawlerModeDisabled:
+		return false
+	default:
+		return f.Category != nil && f.Category.Crawler
+	}
+}
+
+// UsesBearerToken returns true when the feed is authenticated with a bearer token instead of HTTP Basic authentication.
+func (f *Feed) UsesBearerToken() bool {
+	return f.AuthScheme == AuthSchemeBearer && f.AuthToken != ""
+}
+
 // WithError adds a new error message and increment the error counter.
+// The feed is disabled when the counter reaches the limit defined in the configuration.
 func (f *Feed) WithError(message string) {
 	f.ParsingErrorCount++
 	f.ParsingErrorMsg = message
+
+	if limit := config.Opts.PollingDisableErrorLimit(); limit > 0 && f.ParsingErrorCount >= limit && !f.Disabled {
+		f.Disabled = true
+		f.DisabledReason = fmt.Sprintf("Disabled after %d consecutive errors: %s", f.ParsingErrorCount, message)
+	}
+}
+
+// WithTransientError records a temporary error, like a truncated document, and increments the error counter.
+// Unlike WithError, the feed is never disabled: the stored content is still valid and the error
+// is reported with the other feed errors when it persists.
+func (f *Feed) WithTransientError(message string) {
+	f.ParsingErrorCount++
+	f.ParsingErrorMsg = message
+}
+
+// Enable allows the feed to be refreshed again and clears the reason why it was disabled.
+func (f *Feed) Enable() {
+	f.Disabled = false
+	f.DisabledReason = ""
 }
 
 // ResetErrorCounter removes all previous errors.
@@ -103,19 +259,40 @@ func (f *Feed) CheckedNow() {
 
 // ScheduleNextCheck set "next_check_at" of a feed based on the scheduler selected from the configuration.
 func (f *Feed) ScheduleNextCheck(weeklyCount int) {
+	var intervalMinutes int
 	switch config.Opts.PollingScheduler() {
 	case SchedulerEntryFrequency:
-		var intervalMinutes int
 		if weeklyCount == 0 {
 			intervalMinutes = config.Opts.SchedulerEntryFrequencyMaxInterval()
 		} else {
 			intervalMinutes = int(math.Round(float64(7*24*60) / float64(weeklyCount)))
 		}
+		// Feeds announcing how often they are updated don't need to be polled more frequently.
+		intervalMinutes = int(math.Max(float64(intervalMinutes), float64(f.UpdateInterval)))
+		// The TTL is the number of minutes the feed may be cached, polling it more often is pointless.
+		intervalMinutes = int(math.Max(float64(intervalMinutes), float64(f.TTL)))
 		intervalMinutes = int(math.Min(float64(intervalMinutes), float64(config.Opts.SchedulerEntryFrequencyMaxInterval())))
 		intervalMinutes = int(math.Max(float64(intervalMinutes), float64(config.Opts.SchedulerEntryFrequencyMinInterval())))
-		f.NextCheckAt = time.Now().Add(time.Minute * time.Duration(intervalMinutes))
-	default:
-		f.NextCheckAt = time.Now()
+	}
+
+	// The refresh interval defined by the user overrides the computed interval, within the configured limit.
+	if f.RefreshInterval > 0 {
+		intervalMinutes = int(math.Max(float64(f.RefreshInterval), float64(config.Opts.SchedulerFeedMinInterval())))
+	}
+
+	f.NextCheckAt = time.Now().Add(time.Minute * time.Duration(intervalMinutes))
+}
+
+// ScheduleRetryAfter postpones the next check when the server asked to wait before sending another request.
+// The delay is capped to the maximum polling interval.
+func (f *Feed) ScheduleRetryAfter(delay time.Duration) {
+	maxDelay := time.Duration(config.Opts.SchedulerEntryFrequencyMaxInterval()) * time.Minute
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	if nextCheckAt := time.Now().Add(delay); nextCheckAt.After(f.NextCheckAt) {
+		f.NextCheckAt = nextCheckAt
 	}
 }
 
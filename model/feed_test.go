@@ -46,11 +46,60 @@ func TestFeedCategorySetter(t *testing.T) {
 	}
 }
 
+func TestFeedCrawlerEnabledByCategory(t *testing.T) {
+	feed := &Feed{}
+	if feed.IsCrawlerEnabled() {
+		t.Error(`The crawler should be disabled by default`)
+	}
+
+	feed.WithCategoryID(int64(123))
+	if feed.IsCrawlerEnabled() {
+		t.Error(`The crawler should be disabled when the category doesn't enable it`)
+	}
+
+	feed.Category.Crawler = true
+	if !feed.IsCrawlerEnabled() {
+		t.Error(`The crawler should be enabled by the category`)
+	}
+
+	feed = &Feed{CrawlerMode: CrawlerModeEnabled}
+	if !feed.IsCrawlerEnabled() {
+		t.Error(`The crawler should be enabled by the feed`)
+	}
+}
+
+func TestFeedCrawlerModeWinsOverCategory(t *testing.T) {
+	feed := &Feed{CrawlerMode: CrawlerModeDisabled}
+	feed.WithCategoryID(int64(123))
+	feed.Category.Crawler = true
+	if feed.IsCrawlerEnabled() {
+		t.Error(`The crawler should be disabled by the feed even when the category enables it`)
+	}
+
+	feed.CrawlerMode = CrawlerModeEnabled
+	feed.Category.Crawler = false
+	if !feed.IsCrawlerEnabled() {
+		t.Error(`The crawler should be enabled by the feed even when the category doesn't enable it`)
+	}
+}
+
+func TestIsValidCrawlerMode(t *testing.T) {
+	for _, mode := range []string{"", CrawlerModeEnabled, CrawlerModeDisabled} {
+		if !IsValidCrawlerMode(mode) {
+			t.Errorf(`The crawler mode %q should be valid`, mode)
+		}
+	}
+
+	if IsValidCrawlerMode("inherit") {
+		t.Error(`Unknown crawler modes should be invalid`)
+	}
+}
+
 func TestFeedBrowsingParams(t *testing.T) {
 	feed := &Feed{}
-	feed.WithBrowsingParameters(true, "Custom User Agent", "Username", "Secret", "Some Rule", "Another Rule")
+	feed.WithBrowsingParameters(true, "Custom User Agent", "Custom Cookie", "Username", "Secret", "Some Rule", "Another Rule")
 
-	if !feed.Crawler {
+	if feed.CrawlerMode != CrawlerModeEnabled {
 		t.Error(`The crawler must be activated`)
 	}
 
@@ -58,6 +107,10 @@ func TestFeedBrowsingParams(t *testing.T) {
 		t.Error(`The user agent must be set`)
 	}
 
+	if feed.Cookie != "Custom Cookie" {
+		t.Error(`The cookie must be set`)
+	}
+
 	if feed.Username != "Username" {
 		t.Error(`The username must be set`)
 	}
@@ -76,6 +129,15 @@ func TestFeedBrowsingParams(t *testing.T) {
 }
 
 func TestFeedErrorCounter(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
 	feed := &Feed{}
 	feed.WithError("Some Error")
 
@@ -96,6 +158,70 @@ func TestFeedErrorCounter(t *testing.T) {
 	if feed.ParsingErrorCount != 0 {
 		t.Error(`The error counter must be set to 0`)
 	}
+
+	if feed.Disabled {
+		t.Error(`The feed must not be disabled by default`)
+	}
+}
+
+func TestFeedDisabledAfterErrors(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_DISABLE_ERROR_LIMIT", "2")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	feed := &Feed{}
+	feed.WithError("Not Found")
+	if feed.Disabled {
+		t.Error(`The feed must not be disabled before reaching the limit`)
+	}
+
+	feed.WithError("Not Found")
+	if !feed.Disabled {
+		t.Error(`The feed must be disabled when reaching the limit`)
+	}
+
+	if feed.DisabledReason != "Disabled after 2 consecutive errors: Not Found" {
+		t.Errorf(`Unexpected disabled reason: %q`, feed.DisabledReason)
+	}
+
+	feed.Enable()
+	if feed.Disabled || feed.DisabledReason != "" {
+		t.Error(`The feed must be enabled again`)
+	}
+}
+
+func TestFeedTransientErrorsDoNotDisableFeed(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_DISABLE_ERROR_LIMIT", "2")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	feed := &Feed{}
+	feed.WithTransientError("Truncated document")
+	feed.WithTransientError("Truncated document")
+
+	if feed.Disabled {
+		t.Error(`The feed must not be disabled after transient errors`)
+	}
+
+	if feed.ParsingErrorCount != 2 {
+		t.Errorf(`The error counter must be set to 2, got %d`, feed.ParsingErrorCount)
+	}
+
+	if feed.ParsingErrorMsg != "Truncated document" {
+		t.Errorf(`The error message must be set, got %q`, feed.ParsingErrorMsg)
+	}
 }
 
 func TestFeedCheckedNow(t *testing.T) {
@@ -182,3 +308,276 @@ func TestFeedScheduleNextCheckEntryCountBasedMinInterval(t *testing.T) {
 		t.Error(`The next_check_at should not be before the now + min interval`)
 	}
 }
+
+func TestFeedScheduleNextCheckEntryCountBasedWithUpdateInterval(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_SCHEDULER", "entry_frequency")
+	os.Setenv("SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL", "1440")
+	os.Setenv("SCHEDULER_ENTRY_FREQUENCY_MIN_INTERVAL", "5")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	feed := &Feed{UpdateInterval: 720}
+	feed.ScheduleNextCheck(1000)
+
+	if feed.NextCheckAt.Before(time.Now().Add(time.Minute * 719)) {
+		t.Error(`The next_check_at should not be before the update interval announced by the feed`)
+	}
+
+	feed = &Feed{UpdateInterval: 10080}
+	feed.ScheduleNextCheck(1000)
+
+	if feed.NextCheckAt.After(time.Now().Add(time.Minute * 1440)) {
+		t.Error(`The next_check_at should not be after the now + max interval`)
+	}
+}
+
+func TestFeedScheduleNextCheckRoundRobinWithTTL(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	feed := &Feed{TTL: 60}
+	feed.ScheduleNextCheck(0)
+
+	if feed.NextCheckAt.After(time.Now().Add(time.Minute)) {
+		t.Error(`The TTL should be ignored by the round robin scheduler`)
+	}
+}
+
+func TestFeedScheduleNextCheckEntryCountBasedWithTTL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_SCHEDULER", "entry_frequency")
+	os.Setenv("SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL", "500")
+	os.Setenv("SCHEDULER_ENTRY_FREQUENCY_MIN_INTERVAL", "100")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	feed := &Feed{TTL: 300}
+	feed.ScheduleNextCheck(1000)
+
+	if feed.NextCheckAt.Before(time.Now().Add(time.Minute * 299)) {
+		t.Error(`The TTL should be used when it is larger than the computed interval`)
+	}
+
+	feed = &Feed{TTL: 1000}
+	feed.ScheduleNextCheck(1000)
+
+	if feed.NextCheckAt.After(time.Now().Add(time.Minute * 501)) {
+		t.Error(`The TTL should be capped to the max interval`)
+	}
+
+	feed = &Feed{TTL: 10}
+	feed.ScheduleNextCheck(1000)
+
+	if feed.NextCheckAt.Before(time.Now().Add(time.Minute * 99)) {
+		t.Error(`The computed interval should be used when it is larger than the TTL`)
+	}
+
+	if feed.NextCheckAt.After(time.Now().Add(time.Minute * 101)) {
+		t.Error(`The computed interval should be used when it is larger than the TTL`)
+	}
+}
+
+func TestFeedScheduleNextCheckWithRefreshInterval(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("POLLING_SCHEDULER", "entry_frequency")
+	os.Setenv("SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL", "1440")
+	os.Setenv("SCHEDULER_ENTRY_FREQUENCY_MIN_INTERVAL", "60")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	feed := &Feed{RefreshInterval: 10, TTL: 120}
+	feed.ScheduleNextCheck(0)
+
+	if feed.NextCheckAt.Before(time.Now().Add(time.Minute * 9)) {
+		t.Error(`The next_check_at should not be before the now + refresh interval`)
+	}
+
+	if feed.NextCheckAt.After(time.Now().Add(time.Minute * 11)) {
+		t.Error(`The refresh interval should override the computed interval`)
+	}
+}
+
+func TestFeedScheduleNextCheckWithRefreshIntervalBelowMinimum(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SCHEDULER_FEED_MIN_INTERVAL", "30")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	feed := &Feed{RefreshInterval: 1}
+	feed.ScheduleNextCheck(0)
+
+	if feed.NextCheckAt.Before(time.Now().Add(time.Minute * 29)) {
+		t.Error(`The next_check_at should not be before the now + minimum refresh interval`)
+	}
+}
+
+func TestFeedScheduleRetryAfter(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	feed := &Feed{NextCheckAt: time.Now().Add(time.Minute)}
+	feed.ScheduleRetryAfter(2 * time.Hour)
+
+	if feed.NextCheckAt.Before(time.Now().Add(time.Hour)) {
+		t.Error(`The next_check_at should be postponed`)
+	}
+
+	nextCheckAt := time.Now().Add(3 * time.Hour)
+	feed = &Feed{NextCheckAt: nextCheckAt}
+	feed.ScheduleRetryAfter(time.Hour)
+
+	if !feed.NextCheckAt.Equal(nextCheckAt) {
+		t.Error(`The next_check_at should not be moved earlier`)
+	}
+}
+
+func TestFeedScheduleRetryAfterWithLongDelay(t *testing.T) {
+	maxInterval := 60
+	os.Clearenv()
+	os.Setenv("SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL", fmt.Sprintf("%d", maxInterval))
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	feed := &Feed{}
+	feed.ScheduleRetryAfter(30 * 24 * time.Hour)
+
+	if feed.NextCheckAt.After(time.Now().Add(time.Minute * time.Duration(maxInterval))) {
+		t.Error(`The next_check_at should not be after the now + max interval`)
+	}
+}
+
+func TestFeedUsesBearerToken(t *testing.T) {
+	scenarios := []struct {
+		feed     *Feed
+		expected bool
+	}{
+		{&Feed{}, false},
+		{&Feed{AuthScheme: AuthSchemeBasic, AuthToken: "secret"}, false},
+		{&Feed{AuthScheme: AuthSchemeBearer}, false},
+		{&Feed{AuthScheme: AuthSchemeBearer, AuthToken: "secret"}, true},
+	}
+
+	for _, scenario := range scenarios {
+		if result := scenario.feed.UsesBearerToken(); result != scenario.expected {
+			t.Errorf(`Unexpected result for scheme %q, got %v instead of %v`, scenario.feed.AuthScheme, result, scenario.expected)
+		}
+	}
+}
+
+func TestIsValidIconURL(t *testing.T) {
+	scenarios := map[string]bool{
+		"":                                   true,
+		"https://example.org/favicon.ico":    true,
+		"http://example.org/icon.png":        true,
+		"data:image/png;base64,iVBORw0KGgo=": true,
+		"/favicon.ico":                       false,
+		"ftp://example.org/favicon.ico":      false,
+		"data:text/html;base64,PGI+":         false,
+	}
+
+	for input, expected := range scenarios {
+		if result := IsValidIconURL(input); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, input, result, expected)
+		}
+	}
+}
+
+func TestIsValidProxyURL(t *testing.T) {
+	scenarios := map[string]bool{
+		"":                        true,
+		"http://127.0.0.1:3128":   true,
+		"socks5://127.0.0.1:1080": true,
+		"ftp://127.0.0.1:21":      false,
+		"127.0.0.1:3128":          false,
+	}
+
+	for input, expected := range scenarios {
+		if result := IsValidProxyURL(input); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, input, result, expected)
+		}
+	}
+}
+
+func TestIsValidFeedSortOrder(t *testing.T) {
+	scenarios := map[string]bool{
+		"":               true,
+		"published-asc":  true,
+		"published-desc": true,
+		"published":      false,
+		"title-asc":      false,
+	}
+
+	for input, expected := range scenarios {
+		if result := IsValidFeedSortOrder(input); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, input, result, expected)
+		}
+	}
+}
+
+func TestFeedSortDirection(t *testing.T) {
+	scenarios := map[string]string{
+		"":               "desc",
+		"published-asc":  "asc",
+		"published-desc": "desc",
+	}
+
+	for sortOrder, expected := range scenarios {
+		feed := &Feed{SortOrder: sortOrder}
+		if result := feed.SortDirection("desc"); result != expected {
+			t.Errorf(`Unexpected direction for %q, got %q instead of %q`, sortOrder, result, expected)
+		}
+	}
+
+	feed := &Feed{}
+	if result := feed.SortDirection("asc"); result != "asc" {
+		t.Errorf(`The default direction should be used, got %q`, result)
+	}
+}
+
+func TestIsValidAuthorKeeplistRules(t *testing.T) {
+	if !IsValidAuthorKeeplistRules("Jane\nJohn Doe") {
+		t.Error(`Author names should be valid keep rules`)
+	}
+
+	if IsValidAuthorKeeplistRules("Jane\n  \"\"  ") {
+		t.Error(`The empty author rule should be rejected in the keep rules`)
+	}
+}
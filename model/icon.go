@@ -7,14 +7,17 @@ package model // import "miniflux.app/model"
 import (
 	"encoding/base64"
 	"fmt"
+	"time"
 )
 
 // Icon represents a website icon (favicon)
 type Icon struct {
-	ID       int64  `json:"id"`
-	Hash     string `json:"hash"`
-	MimeType string `json:"mime_type"`
-	Content  []byte `json:"content"`
+	ID                 int64  `json:"id"`
+	Hash               string `json:"hash"`
+	MimeType           string `json:"mime_type"`
+	Content            []byte `json:"content"`
+	EtagHeader         string `json:"-"`
+	LastModifiedHeader string `json:"-"`
 }
 
 // DataURL returns the data URL of the icon.
@@ -27,6 +30,9 @@ type Icons []*Icon
 
 // FeedIcon is a jonction table between feeds and icons
 type FeedIcon struct {
-	FeedID int64 `json:"feed_id"`
-	IconID int64 `json:"icon_id"`
+	FeedID             int64     `json:"feed_id"`
+	IconID             int64     `json:"icon_id"`
+	EtagHeader         string    `json:"-"`
+	LastModifiedHeader string    `json:"-"`
+	CheckedAt          time.Time `json:"-"`
 }
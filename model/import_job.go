@@ -0,0 +1,64 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"fmt"
+	"time"
+)
+
+// Import job statuses.
+const (
+	ImportJobStatusPending   = "pending"
+	ImportJobStatusRunning   = "running"
+	ImportJobStatusCompleted = "completed"
+	ImportJobStatusFailed    = "failed"
+)
+
+// Import job item statuses.
+const (
+	ImportItemStatusPending = "pending"
+	ImportItemStatusCreated = "created"
+	ImportItemStatusSkipped = "skipped"
+	ImportItemStatusFailed  = "failed"
+)
+
+// ImportJob represents an OPML import running in the background.
+type ImportJob struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Status    string    `json:"status"`
+	Total     int       `json:"total"`
+	Created   int       `json:"created"`
+	Skipped   int       `json:"skipped"`
+	Failed    int       `json:"failed"`
+	ErrorMsg  string    `json:"error_message"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (j *ImportJob) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Status=%s, Progress=%d/%d", j.ID, j.UserID, j.Status, j.Processed(), j.Total)
+}
+
+// Processed returns the number of subscriptions already handled by the job.
+func (j *ImportJob) Processed() int {
+	return j.Created + j.Skipped + j.Failed
+}
+
+// ImportJobItem represents a subscription imported by a job.
+type ImportJobItem struct {
+	ID           int64
+	JobID        int64
+	Title        string
+	FeedURL      string
+	SiteURL      string
+	CategoryName string
+	ScraperRules string
+	RewriteRules string
+	Crawler      bool
+	UserAgent    string
+	Status       string
+}
@@ -0,0 +1,20 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestImportJobProgress(t *testing.T) {
+	job := &ImportJob{ID: 1, UserID: 2, Status: ImportJobStatusRunning, Total: 10, Created: 3, Skipped: 2, Failed: 1}
+
+	if processed := job.Processed(); processed != 6 {
+		t.Errorf(`Unexpected number of processed subscriptions, got %d instead of %d`, processed, 6)
+	}
+
+	expected := "ID=1, UserID=2, Status=running, Progress=6/10"
+	if result := job.String(); result != expected {
+		t.Errorf(`Unexpected string, got %q instead of %q`, result, expected)
+	}
+}
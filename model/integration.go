@@ -6,31 +6,98 @@ package model // import "miniflux.app/model"
 
 // Integration represents user integration settings.
 type Integration struct {
-	UserID               int64
-	PinboardEnabled      bool
-	PinboardToken        string
-	PinboardTags         string
-	PinboardMarkAsUnread bool
-	InstapaperEnabled    bool
-	InstapaperUsername   string
-	InstapaperPassword   string
-	FeverEnabled         bool
-	FeverUsername        string
-	FeverPassword        string
-	FeverToken           string
-	WallabagEnabled      bool
-	WallabagURL          string
-	WallabagClientID     string
-	WallabagClientSecret string
-	WallabagUsername     string
-	WallabagPassword     string
-	NunuxKeeperEnabled   bool
-	NunuxKeeperURL       string
-	NunuxKeeperAPIKey    string
-	PocketEnabled        bool
-	PocketAccessToken    string
-	PocketConsumerKey    string
-	TelegramEnabled      bool
-	TelegramToken        string
-	TelegramChatID       string
+	UserID                    int64
+	PinboardEnabled           bool
+	PinboardToken             string
+	PinboardTags              string
+	PinboardMarkAsUnread      bool
+	PinboardMarkReadOnSave    bool
+	InstapaperEnabled         bool
+	InstapaperUsername        string
+	InstapaperPassword        string
+	InstapaperMarkReadOnSave  bool
+	FeverEnabled              bool
+	FeverUsername             string
+	FeverPassword             string
+	FeverToken                string
+	WallabagEnabled           bool
+	WallabagURL               string
+	WallabagClientID          string
+	WallabagClientSecret      string
+	WallabagUsername          string
+	WallabagPassword          string
+	WallabagMarkReadOnSave    bool
+	NunuxKeeperEnabled        bool
+	NunuxKeeperURL            string
+	NunuxKeeperAPIKey         string
+	NunuxKeeperMarkReadOnSave bool
+	PocketEnabled             bool
+	PocketAccessToken         string
+	PocketConsumerKey         string
+	PocketMarkReadOnSave      bool
+	ShaarliEnabled            bool
+	ShaarliURL                string
+	ShaarliAPISecret          string
+	ShaarliMarkReadOnSave     bool
+	TelegramEnabled           bool
+	TelegramToken             string
+	TelegramChatID            string
+	TelegramTopicID           string
+	DiscordEnabled            bool
+	DiscordWebhookURL         string
+	WebhookEnabled            bool
+	WebhookURL                string
+	WebhookSecret             string
+	IMAPEnabled               bool
+	IMAPServer                string
+	IMAPUsername              string
+	IMAPPassword              string
+	IMAPMailbox               string
+	IMAPSenderFilter          string
+	IMAPFeedID                int64
+	GotifyEnabled             bool
+	GotifyURL                 string
+	GotifyToken               string
+	GotifyPriority            int
+	NtfyEnabled               bool
+	NtfyURL                   string
+	NtfyTopic                 string
+	NtfyToken                 string
+	NtfyPriority              int
+	AppriseEnabled            bool
+	AppriseURL                string
+	AppriseServicesURL        string
+	AppriseConfigKey          string
+	AppriseTag                string
+}
+
+// ForCategory returns a copy of the integration settings with the notification targets of the category.
+// The settings of the user are kept when the category has no target, otherwise the targets of the category
+// replace all the notification targets of the user, even when they are disabled in the user settings.
+func (i *Integration) ForCategory(category *Category) *Integration {
+	integration := *i
+	if category == nil || (category.TelegramChatID == "" && category.WebhookURL == "") {
+		return &integration
+	}
+
+	integration.TelegramEnabled = false
+	integration.DiscordEnabled = false
+	integration.WebhookEnabled = false
+	integration.GotifyEnabled = false
+	integration.NtfyEnabled = false
+	integration.AppriseEnabled = false
+
+	if category.TelegramChatID != "" {
+		// The bot of the user sends the messages, a topic belongs to the chat of the user, it doesn't exist in another chat.
+		integration.TelegramEnabled = integration.TelegramToken != ""
+		integration.TelegramChatID = category.TelegramChatID
+		integration.TelegramTopicID = ""
+	}
+
+	if category.WebhookURL != "" {
+		integration.WebhookEnabled = true
+		integration.WebhookURL = category.WebhookURL
+	}
+
+	return &integration
 }
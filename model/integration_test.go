@@ -0,0 +1,83 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestIntegrationForCategoryWithoutOverride(t *testing.T) {
+	integration := &Integration{TelegramChatID: "123", TelegramTopicID: "7", WebhookURL: "https://example.org/hook"}
+
+	for _, category := range []*Category{nil, {ID: 1, Title: "News"}} {
+		result := integration.ForCategory(category)
+		if result.TelegramChatID != "123" || result.TelegramTopicID != "7" || result.WebhookURL != "https://example.org/hook" {
+			t.Errorf(`The user settings should be kept, got %+v`, result)
+		}
+	}
+}
+
+func TestIntegrationForCategoryWithOverride(t *testing.T) {
+	integration := &Integration{TelegramChatID: "123", TelegramTopicID: "7", WebhookURL: "https://example.org/hook", WebhookSecret: "secret"}
+	category := &Category{ID: 1, Title: "Work", TelegramChatID: "456", WebhookURL: "https://example.org/work"}
+
+	result := integration.ForCategory(category)
+	if result.TelegramChatID != "456" {
+		t.Errorf(`The Telegram chat of the category should be used, got %q`, result.TelegramChatID)
+	}
+
+	if result.TelegramTopicID != "" {
+		t.Errorf(`The topic of the user should not be used with another chat, got %q`, result.TelegramTopicID)
+	}
+
+	if result.WebhookURL != "https://example.org/work" || result.WebhookSecret != "secret" {
+		t.Errorf(`The webhook of the category should be used with the secret of the user, got %q and %q`, result.WebhookURL, result.WebhookSecret)
+	}
+
+	if integration.TelegramChatID != "123" || integration.WebhookURL != "https://example.org/hook" {
+		t.Error(`The user settings should not be modified`)
+	}
+}
+
+func TestIntegrationForCategoryReplacesUserTargets(t *testing.T) {
+	integration := &Integration{
+		TelegramEnabled: true,
+		TelegramToken:   "token",
+		TelegramChatID:  "123",
+		DiscordEnabled:  true,
+		WebhookEnabled:  true,
+		WebhookURL:      "https://example.org/hook",
+		GotifyEnabled:   true,
+		NtfyEnabled:     true,
+		AppriseEnabled:  true,
+	}
+	category := &Category{ID: 1, Title: "Work", WebhookURL: "https://example.org/work"}
+
+	result := integration.ForCategory(category)
+	if result.TelegramEnabled || result.DiscordEnabled || result.GotifyEnabled || result.NtfyEnabled || result.AppriseEnabled {
+		t.Errorf(`The targets of the user should not be used with a category override, got %+v`, result)
+	}
+
+	if !result.WebhookEnabled || result.WebhookURL != "https://example.org/work" {
+		t.Errorf(`The webhook of the category should be used, got %v and %q`, result.WebhookEnabled, result.WebhookURL)
+	}
+}
+
+func TestIntegrationForCategoryIgnoresUserToggles(t *testing.T) {
+	integration := &Integration{TelegramToken: "token"}
+	category := &Category{ID: 1, Title: "Work", TelegramChatID: "456", WebhookURL: "https://example.org/work"}
+
+	result := integration.ForCategory(category)
+	if !result.WebhookEnabled {
+		t.Error(`The webhook of the category should not depend on the user toggle`)
+	}
+
+	if !result.TelegramEnabled || result.TelegramChatID != "456" {
+		t.Errorf(`The Telegram chat of the category should be used with the bot of the user, got %v and %q`, result.TelegramEnabled, result.TelegramChatID)
+	}
+
+	result = (&Integration{}).ForCategory(category)
+	if result.TelegramEnabled {
+		t.Error(`Telegram should stay disabled without a bot token`)
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ValidateOrdering makes sure the list of IDs used to reorder categories or feeds is not empty and doesn't contain duplicates.
+func ValidateOrdering(ids []int64) error {
+	if len(ids) == 0 {
+		return errors.New("The list of IDs is empty")
+	}
+
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return fmt.Errorf("Invalid ID: %d", id)
+		}
+
+		if seen[id] {
+			return fmt.Errorf("The ID %d is listed more than once", id)
+		}
+
+		seen[id] = true
+	}
+
+	return nil
+}
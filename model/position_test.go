@@ -0,0 +1,26 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateOrdering(t *testing.T) {
+	if err := ValidateOrdering([]int64{3, 1, 2}); err != nil {
+		t.Errorf(`A list of distinct IDs should be valid: %v`, err)
+	}
+
+	scenarios := map[string][]int64{
+		"empty list":   {},
+		"duplicate ID": {1, 2, 1},
+		"invalid ID":   {1, 0},
+		"negative ID":  {-1},
+	}
+
+	for name, ids := range scenarios {
+		if err := ValidateOrdering(ids); err == nil {
+			t.Errorf(`The list should be rejected: %s`, name)
+		}
+	}
+}
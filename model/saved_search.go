@@ -0,0 +1,52 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// SavedSearch represents a named entry query saved by a user.
+type SavedSearch struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	Title       string    `json:"title"`
+	SearchQuery string    `json:"search_query"`
+	FeedID      int64     `json:"feed_id,omitempty"`
+	CategoryID  int64     `json:"category_id,omitempty"`
+	Status      string    `json:"status"`
+	Starred     bool      `json:"starred"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (s *SavedSearch) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Title=%s, SearchQuery=%s", s.ID, s.UserID, s.Title, s.SearchQuery)
+}
+
+// ValidateSavedSearch validates a saved search during the creation and the modification.
+func (s SavedSearch) ValidateSavedSearch() error {
+	if s.Title == "" {
+		return errors.New("The title is mandatory")
+	}
+
+	if s.UserID == 0 {
+		return errors.New("The userID is mandatory")
+	}
+
+	if s.FeedID < 0 || s.CategoryID < 0 {
+		return errors.New("The feed and category IDs must be positive")
+	}
+
+	if s.Status != "" {
+		return ValidateEntryStatus(s.Status)
+	}
+
+	return nil
+}
+
+// SavedSearches represents a list of saved searches.
+type SavedSearches []*SavedSearch
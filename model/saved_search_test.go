@@ -0,0 +1,35 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateSavedSearch(t *testing.T) {
+	search := SavedSearch{UserID: 1, Title: "Go", SearchQuery: "golang", Status: EntryStatusUnread}
+	if err := search.ValidateSavedSearch(); err != nil {
+		t.Errorf(`A valid saved search should not generate any error: %v`, err)
+	}
+
+	search = SavedSearch{UserID: 1, Title: "Starred", Starred: true}
+	if err := search.ValidateSavedSearch(); err != nil {
+		t.Errorf(`A saved search without status should not generate any error: %v`, err)
+	}
+}
+
+func TestValidateInvalidSavedSearch(t *testing.T) {
+	scenarios := map[string]SavedSearch{
+		"missing title":    {UserID: 1, SearchQuery: "golang"},
+		"missing user":     {Title: "Go"},
+		"invalid status":   {UserID: 1, Title: "Go", Status: "invalid"},
+		"invalid feed":     {UserID: 1, Title: "Go", FeedID: -1},
+		"invalid category": {UserID: 1, Title: "Go", CategoryID: -1},
+	}
+
+	for name, search := range scenarios {
+		if err := search.ValidateSavedSearch(); err == nil {
+			t.Errorf(`The saved search with %s should generate an error`, name)
+		}
+	}
+}
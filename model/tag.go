@@ -0,0 +1,63 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxTagLength is the maximum number of characters of a tag name.
+const MaxTagLength = 64
+
+// Tag represents a user-defined label attached to entries.
+type Tag struct {
+	ID         int64  `json:"id"`
+	UserID     int64  `json:"user_id"`
+	Name       string `json:"name"`
+	EntryCount int    `json:"nb_entries"`
+}
+
+func (t *Tag) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Name=%s", t.ID, t.UserID, t.Name)
+}
+
+// Tags represents a list of tags.
+type Tags []*Tag
+
+// NormalizeTagName returns the tag name in lower case without leading, trailing and repeated spaces.
+func NormalizeTagName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// NormalizeTagNames normalizes the tag names and removes the duplicates, the order is preserved.
+func NormalizeTagNames(names []string) ([]string, error) {
+	var normalizedNames []string
+	seen := make(map[string]bool)
+
+	for _, name := range names {
+		name = NormalizeTagName(name)
+		if name == "" {
+			return nil, errors.New("The tag name is mandatory")
+		}
+
+		if utf8.RuneCountInString(name) > MaxTagLength {
+			return nil, fmt.Errorf("The tag name must not exceed %d characters", MaxTagLength)
+		}
+
+		if !seen[name] {
+			seen[name] = true
+			normalizedNames = append(normalizedNames, name)
+		}
+	}
+
+	if len(normalizedNames) == 0 {
+		return nil, errors.New("The list of tags is empty")
+	}
+
+	return normalizedNames, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeTagName(t *testing.T) {
+	scenarios := map[string]string{
+		"work":                "work",
+		"  To-Read ":          "to-read",
+		"Machine  \tLearning": "machine learning",
+		"ÉTÉ":                 "été",
+		"   ":                 "",
+	}
+
+	for input, expected := range scenarios {
+		if result := NormalizeTagName(input); result != expected {
+			t.Errorf(`Unexpected tag name for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
+
+func TestNormalizeTagNamesRemovesDuplicates(t *testing.T) {
+	names, err := NormalizeTagNames([]string{"Work", "to-read", " work ", "TO-READ", "Go"})
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	expected := []string{"work", "to-read", "go"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf(`Unexpected tag names, got %v instead of %v`, names, expected)
+	}
+}
+
+func TestNormalizeInvalidTagNames(t *testing.T) {
+	scenarios := [][]string{
+		nil,
+		{},
+		{"work", "  "},
+		{strings.Repeat("a", MaxTagLength+1)},
+	}
+
+	for _, names := range scenarios {
+		if _, err := NormalizeTagNames(names); err == nil {
+			t.Errorf(`The tag names %v should generate an error`, names)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+// Transcript represents a podcast episode transcript.
+type Transcript struct {
+	ID       int64  `json:"id"`
+	UserID   int64  `json:"user_id"`
+	EntryID  int64  `json:"entry_id"`
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type"`
+	Language string `json:"language"`
+}
+
+// TranscriptList represents a list of transcripts.
+type TranscriptList []*Transcript
@@ -11,21 +11,30 @@ import (
 	"miniflux.app/timezone"
 )
 
+// Entry deduplication modes.
+const (
+	EntryDeduplicationDisabled   = "disabled"
+	EntryDeduplicationSkip       = "skip"
+	EntryDeduplicationMarkAsRead = "mark_as_read"
+)
+
 // User represents a user in the system.
 type User struct {
-	ID                int64             `json:"id"`
-	Username          string            `json:"username"`
-	Password          string            `json:"password,omitempty"`
-	IsAdmin           bool              `json:"is_admin"`
-	Theme             string            `json:"theme"`
-	Language          string            `json:"language"`
-	Timezone          string            `json:"timezone"`
-	EntryDirection    string            `json:"entry_sorting_direction"`
-	EntriesPerPage    int               `json:"entries_per_page"`
-	KeyboardShortcuts bool              `json:"keyboard_shortcuts"`
-	ShowReadingTime	  bool              `json:"show_reading_time"`
-	LastLoginAt       *time.Time        `json:"last_login_at,omitempty"`
-	Extra             map[string]string `json:"extra"`
+	ID                 int64             `json:"id"`
+	Username           string            `json:"username"`
+	Password           string            `json:"password,omitempty"`
+	IsAdmin            bool              `json:"is_admin"`
+	Theme              string            `json:"theme"`
+	Language           string            `json:"language"`
+	Timezone           string            `json:"timezone"`
+	EntryDirection     string            `json:"entry_sorting_direction"`
+	EntriesPerPage     int               `json:"entries_per_page"`
+	KeyboardShortcuts  bool              `json:"keyboard_shortcuts"`
+	ShowReadingTime    bool              `json:"show_reading_time"`
+	EntryDeduplication string            `json:"entry_deduplication"`
+	MarkReadOnScroll   bool              `json:"mark_read_on_scroll"`
+	LastLoginAt        *time.Time        `json:"last_login_at,omitempty"`
+	Extra              map[string]string `json:"extra"`
 }
 
 // NewUser returns a new User.
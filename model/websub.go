@@ -0,0 +1,51 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"crypto/subtle"
+	"time"
+)
+
+// WebSubSubscription represents a subscription to the WebSub hub of a feed.
+type WebSubSubscription struct {
+	FeedID       int64
+	UserID       int64
+	Secret       string
+	Token        string
+	Pending      bool
+	LeaseSeconds int
+	ExpiresAt    time.Time
+}
+
+// IsPending returns true when a subscription request has been sent to the hub and the given callback token matches.
+func (w *WebSubSubscription) IsPending(token string) bool {
+	return w.Pending && w.HasToken(token)
+}
+
+// HasToken returns true when the given callback token belongs to this subscription.
+func (w *WebSubSubscription) HasToken(token string) bool {
+	return w.Token != "" && subtle.ConstantTimeCompare([]byte(w.Token), []byte(token)) == 1
+}
+
+// IsVerified returns true when the hub has confirmed the subscription.
+func (w *WebSubSubscription) IsVerified() bool {
+	return w.LeaseSeconds > 0
+}
+
+// IsActive returns true when the hub is expected to push new content.
+func (w *WebSubSubscription) IsActive() bool {
+	return w.IsVerified() && time.Now().Before(w.ExpiresAt)
+}
+
+// RenewAt returns the time when the subscription should be renewed, at the half of the lease.
+func (w *WebSubSubscription) RenewAt() time.Time {
+	return w.ExpiresAt.Add(-time.Duration(w.LeaseSeconds/2) * time.Second)
+}
+
+// NeedsRenewal returns true when the subscription is not verified or close to the end of the lease.
+func (w *WebSubSubscription) NeedsRenewal() bool {
+	return !w.IsVerified() || !time.Now().Before(w.RenewAt())
+}
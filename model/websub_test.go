@@ -0,0 +1,73 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWebSubSubscriptionNotVerified(t *testing.T) {
+	subscription := &WebSubSubscription{ExpiresAt: time.Now().Add(time.Hour)}
+
+	if subscription.IsActive() {
+		t.Error(`A subscription not verified by the hub should not be active`)
+	}
+
+	if !subscription.NeedsRenewal() {
+		t.Error(`A subscription not verified by the hub should be renewed`)
+	}
+}
+
+func TestWebSubSubscriptionLease(t *testing.T) {
+	subscription := &WebSubSubscription{LeaseSeconds: 86400, ExpiresAt: time.Now().Add(20 * time.Hour)}
+
+	if !subscription.IsActive() {
+		t.Error(`The subscription should be active`)
+	}
+
+	if subscription.NeedsRenewal() {
+		t.Error(`The subscription should not be renewed before the half of the lease`)
+	}
+
+	subscription.ExpiresAt = time.Now().Add(10 * time.Hour)
+	if !subscription.NeedsRenewal() {
+		t.Error(`The subscription should be renewed after the half of the lease`)
+	}
+
+	subscription.ExpiresAt = time.Now().Add(-time.Hour)
+	if subscription.IsActive() {
+		t.Error(`An expired subscription should not be active`)
+	}
+}
+
+func TestWebSubSubscriptionPendingToken(t *testing.T) {
+	subscription := &WebSubSubscription{Token: "token", Pending: true}
+
+	if !subscription.IsPending("token") {
+		t.Error(`The subscription request should be pending for its token`)
+	}
+
+	if subscription.IsPending("other") || subscription.IsPending("") {
+		t.Error(`The subscription request should not be pending for another token`)
+	}
+
+	subscription.Pending = false
+	if subscription.IsPending("token") {
+		t.Error(`A verified subscription should not be pending`)
+	}
+
+	if !subscription.HasToken("token") {
+		t.Error(`The token should belong to the subscription`)
+	}
+}
+
+func TestWebSubSubscriptionWithoutToken(t *testing.T) {
+	subscription := &WebSubSubscription{Pending: true}
+
+	if subscription.IsPending("") || subscription.HasToken("") {
+		t.Error(`A subscription without token should never match`)
+	}
+}
@@ -24,13 +24,20 @@ type atom03Feed struct {
 	Title   atom03Text    `xml:"title"`
 	Author  atomPerson    `xml:"author"`
 	Links   atomLinks     `xml:"link"`
+	BaseURL string        `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
 	Entries []atom03Entry `xml:"entry"`
 }
 
 func (a *atom03Feed) Transform() *model.Feed {
+	// The feed base URL is relative to the document URL, which is unknown here.
+	feedBaseURL := strings.TrimSpace(a.BaseURL)
+	a.Links.resolve(feedBaseURL)
+
 	feed := new(model.Feed)
 	feed.FeedURL = a.Links.firstLinkWithRelation("self")
 	feed.SiteURL = a.Links.originalLink()
+	feed.HubURL = a.Links.hubURL()
+	feed.TopicURL = feed.FeedURL
 	feed.Title = a.Title.String()
 
 	if feed.Title == "" {
@@ -38,12 +45,22 @@ func (a *atom03Feed) Transform() *model.Feed {
 	}
 
 	for _, entry := range a.Entries {
+		entry.resolveURLs(feed.SiteURL, feedBaseURL)
 		item := entry.Transform()
-		entryURL, err := url.AbsoluteURL(feed.SiteURL, item.URL)
-		if err == nil {
+
+		entryBaseURL := url.ResolveBaseURL(feed.SiteURL, entry.BaseURL)
+		if item.URL == "" {
+			item.URL = feed.SiteURL
+		} else if entryURL, err := url.AbsoluteURL(entryBaseURL, item.URL); err == nil {
 			item.URL = entryURL
 		}
 
+		for _, enclosure := range item.Enclosures {
+			if enclosureURL, err := url.AbsoluteURL(entryBaseURL, enclosure.URL); err == nil {
+				enclosure.URL = enclosureURL
+			}
+		}
+
 		if item.Author == "" {
 			item.Author = a.Author.String()
 		}
@@ -68,16 +85,32 @@ type atom03Entry struct {
 	Summary  atom03Text `xml:"summary"`
 	Content  atom03Text `xml:"content"`
 	Author   atomPerson `xml:"author"`
+	BaseURL  string     `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
+}
+
+// resolveURLs combines the base URL of the entry with the one of the feed and converts the entry links.
+// A relative base URL is resolved against the site URL because the document URL is unknown.
+func (a *atom03Entry) resolveURLs(siteURL, feedBaseURL string) {
+	a.BaseURL = url.ResolveBaseURL(feedBaseURL, a.BaseURL)
+	if a.BaseURL != "" {
+		a.BaseURL = url.ResolveBaseURL(siteURL, a.BaseURL)
+	}
+
+	a.Links.resolve(a.BaseURL)
 }
 
 func (a *atom03Entry) Transform() *model.Entry {
 	entry := new(model.Entry)
 	entry.URL = a.Links.originalLink()
 	entry.Date = a.entryDate()
+	entry.RawDate = a.entryDateText()
+	entry.UpdatedAt = a.entryUpdatedDate(entry.Date)
 	entry.Author = a.Author.String()
 	entry.Hash = a.entryHash()
+	entry.GUID = a.ID
 	entry.Content = a.entryContent()
 	entry.Title = a.entryTitle()
+	entry.Enclosures = a.Links.enclosures(make(map[string]bool))
 	return entry
 }
 
@@ -88,26 +121,29 @@ func (a *atom03Entry) entryTitle() string {
 func (a *atom03Entry) entryContent() string {
 	content := a.Content.String()
 	if content != "" {
-		return content
+		return resolveContentURLs(url.ResolveBaseURL(a.BaseURL, a.Content.BaseURL), content)
 	}
 
 	summary := a.Summary.String()
 	if summary != "" {
-		return summary
+		return resolveContentURLs(url.ResolveBaseURL(a.BaseURL, a.Summary.BaseURL), summary)
 	}
 
 	return ""
 }
 
-func (a *atom03Entry) entryDate() time.Time {
-	dateText := ""
+func (a *atom03Entry) entryDateText() string {
 	for _, value := range []string{a.Issued, a.Modified, a.Created} {
 		if value != "" {
-			dateText = value
-			break
+			return value
 		}
 	}
 
+	return ""
+}
+
+func (a *atom03Entry) entryDate() time.Time {
+	dateText := a.entryDateText()
 	if dateText != "" {
 		result, err := date.Parse(dateText)
 		if err != nil {
@@ -121,6 +157,20 @@ func (a *atom03Entry) entryDate() time.Time {
 	return time.Now()
 }
 
+// entryUpdatedDate returns the date of the last modification, or the publication date when the entry has never been modified.
+func (a *atom03Entry) entryUpdatedDate(published time.Time) time.Time {
+	if a.Modified != "" {
+		result, err := date.Parse(a.Modified)
+		if err == nil {
+			return result
+		}
+
+		logger.Error("atom: %v", err)
+	}
+
+	return published
+}
+
 func (a *atom03Entry) entryHash() string {
 	for _, value := range []string{a.ID, a.Links.originalLink()} {
 		if value != "" {
@@ -132,10 +182,11 @@ func (a *atom03Entry) entryHash() string {
 }
 
 type atom03Text struct {
-	Type string `xml:"type,attr"`
-	Mode string `xml:"mode,attr"`
-	Data string `xml:",chardata"`
-	XML  string `xml:",innerxml"`
+	Type    string `xml:"type,attr"`
+	Mode    string `xml:"mode,attr"`
+	Data    string `xml:",chardata"`
+	XML     string `xml:",innerxml"`
+	BaseURL string `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
 }
 
 func (a *atom03Text) String() string {
@@ -217,3 +217,70 @@ func TestParseAtom03WithBase64Content(t *testing.T) {
 		t.Errorf("Incorrect entry content, got: %s", feed.Entries[0].Content)
 	}
 }
+
+func TestParseAtom03WithEnclosures(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed version="0.3" xmlns="http://purl.org/atom/ns#">
+		<title>dive into mark</title>
+		<link rel="alternate" type="text/html" href="http://diveintomark.org/"/>
+		<entry>
+			<title>Episode</title>
+			<link rel="alternate" type="text/html" href="http://diveintomark.org/2003/12/13/atom03"/>
+			<link rel="enclosure" type="audio/mpeg" href="http://diveintomark.org/episode.mp3" length="1234"/>
+			<link rel="enclosure" type="application/json" href="http://diveintomark.org/chapters.json"/>
+			<link rel="enclosure" type="audio/mpeg" href="http://diveintomark.org/episode.mp3" length="1234"/>
+			<id>tag:diveintomark.org,2003:3.2397</id>
+			<issued>2003-12-13T08:29:29-04:00</issued>
+		</entry>
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enclosures := feed.Entries[0].Enclosures
+	if len(enclosures) != 2 {
+		t.Fatalf("Incorrect number of enclosures, got: %d", len(enclosures))
+	}
+
+	if enclosures[0].URL != "http://diveintomark.org/episode.mp3" || enclosures[0].MimeType != "audio/mpeg" || enclosures[0].Size != 1234 {
+		t.Errorf("Incorrect first enclosure: %+v", enclosures[0])
+	}
+
+	if enclosures[1].URL != "http://diveintomark.org/chapters.json" || enclosures[1].MimeType != "application/json" || enclosures[1].Size != 0 {
+		t.Errorf("Incorrect second enclosure: %+v", enclosures[1])
+	}
+}
+
+func TestParseAtom03WithNestedXMLBase(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed version="0.3" xmlns="http://purl.org/atom/ns#" xml:base="http://diveintomark.org/">
+		<title>dive into mark</title>
+		<link rel="alternate" type="text/html" href="/"/>
+		<entry xml:base="2003/">
+			<title>Atom 0.3 snapshot</title>
+			<link rel="alternate" type="text/html" xml:base="12/" href="atom03"/>
+			<id>tag:diveintomark.org,2003:3.2397</id>
+			<issued>2003-12-13T08:29:29-04:00</issued>
+			<content type="text/html" mode="escaped" xml:base="images/"><![CDATA[<img src="atom.png"/>]]></content>
+		</entry>
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.SiteURL != "http://diveintomark.org/" {
+		t.Errorf("Incorrect site URL, got: %s", feed.SiteURL)
+	}
+
+	if feed.Entries[0].URL != "http://diveintomark.org/2003/12/atom03" {
+		t.Errorf("Incorrect entry URL, got: %s", feed.Entries[0].URL)
+	}
+
+	if feed.Entries[0].Content != `<img src="http://diveintomark.org/2003/images/atom.png"/>` {
+		t.Errorf("Incorrect entry content, got: %s", feed.Entries[0].Content)
+	}
+}
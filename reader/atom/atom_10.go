@@ -7,7 +7,6 @@ package atom // import "miniflux.app/reader/atom"
 import (
 	"encoding/xml"
 	"html"
-	"strconv"
 	"strings"
 	"time"
 
@@ -24,31 +23,50 @@ import (
 // https://tools.ietf.org/html/rfc4287
 // https://validator.w3.org/feed/docs/atom.html
 type atom10Feed struct {
-	XMLName xml.Name      `xml:"http://www.w3.org/2005/Atom feed"`
-	ID      string        `xml:"id"`
-	Title   atom10Text    `xml:"title"`
-	Author  atomPerson    `xml:"author"`
-	Links   atomLinks     `xml:"link"`
-	Entries []atom10Entry `xml:"entry"`
+	XMLName  xml.Name      `xml:"http://www.w3.org/2005/Atom feed"`
+	ID       string        `xml:"id"`
+	Title    atom10Text    `xml:"title"`
+	Author   atomPerson    `xml:"author"`
+	Links    atomLinks     `xml:"link"`
+	BaseURL  string        `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
+	Language string        `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Entries  []atom10Entry `xml:"entry"`
 }
 
 func (a *atom10Feed) Transform() *model.Feed {
+	// The feed base URL is relative to the document URL, which is unknown here.
+	feedBaseURL := strings.TrimSpace(a.BaseURL)
+	a.Links.resolve(feedBaseURL)
+
 	feed := new(model.Feed)
 	feed.FeedURL = a.Links.firstLinkWithRelation("self")
 	feed.SiteURL = a.Links.originalLink()
+	feed.HubURL = a.Links.hubURL()
+	feed.TopicURL = feed.FeedURL
 	feed.Title = a.Title.String()
+	feed.Language = strings.TrimSpace(a.Language)
 
 	if feed.Title == "" {
 		feed.Title = feed.SiteURL
 	}
 
 	for _, entry := range a.Entries {
+		entry.resolveURLs(feed.SiteURL, feedBaseURL)
 		item := entry.Transform()
-		entryURL, err := url.AbsoluteURL(feed.SiteURL, item.URL)
-		if err == nil {
+
+		entryBaseURL := url.ResolveBaseURL(feed.SiteURL, entry.BaseURL)
+		if item.URL == "" {
+			item.URL = feed.SiteURL
+		} else if entryURL, err := url.AbsoluteURL(entryBaseURL, item.URL); err == nil {
 			item.URL = entryURL
 		}
 
+		for _, enclosure := range item.Enclosures {
+			if enclosureURL, err := url.AbsoluteURL(entryBaseURL, enclosure.URL); err == nil {
+				enclosure.URL = enclosureURL
+			}
+		}
+
 		if item.Author == "" {
 			item.Author = a.Author.String()
 		}
@@ -72,18 +90,35 @@ type atom10Entry struct {
 	Summary   atom10Text `xml:"summary"`
 	Content   atom10Text `xml:"http://www.w3.org/2005/Atom content"`
 	Author    atomPerson `xml:"author"`
+	BaseURL   string     `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
 	media.Element
 }
 
+// resolveURLs combines the base URL of the entry with the one of the feed and converts the entry links.
+// A relative base URL is resolved against the site URL because the document URL is unknown.
+func (a *atom10Entry) resolveURLs(siteURL, feedBaseURL string) {
+	a.BaseURL = url.ResolveBaseURL(feedBaseURL, a.BaseURL)
+	if a.BaseURL != "" {
+		a.BaseURL = url.ResolveBaseURL(siteURL, a.BaseURL)
+	}
+
+	a.Links.resolve(a.BaseURL)
+}
+
 func (a *atom10Entry) Transform() *model.Entry {
 	entry := new(model.Entry)
 	entry.URL = a.Links.originalLink()
 	entry.Date = a.entryDate()
+	entry.RawDate = a.entryDateText()
+	entry.UpdatedAt = a.entryUpdatedDate(entry.Date)
 	entry.Author = a.Author.String()
 	entry.Hash = a.entryHash()
+	entry.GUID = a.ID
 	entry.Content = a.entryContent()
 	entry.Title = a.entryTitle()
 	entry.Enclosures = a.entryEnclosures()
+	entry.ThumbnailURL = a.LargestMediaThumbnailURL()
+	entry.Explicit, _ = a.MediaRatingIsAdult()
 	entry.CommentsURL = a.entryCommentsURL()
 	return entry
 }
@@ -95,12 +130,12 @@ func (a *atom10Entry) entryTitle() string {
 func (a *atom10Entry) entryContent() string {
 	content := a.Content.String()
 	if content != "" {
-		return content
+		return resolveContentURLs(url.ResolveBaseURL(a.BaseURL, a.Content.BaseURL), content)
 	}
 
 	summary := a.Summary.String()
 	if summary != "" {
-		return summary
+		return resolveContentURLs(url.ResolveBaseURL(a.BaseURL, a.Summary.BaseURL), summary)
 	}
 
 	mediaDescription := a.FirstMediaDescription()
@@ -115,12 +150,16 @@ func (a *atom10Entry) entryContent() string {
 // Example:
 // <published>2019-01-26T08:02:28+00:00</published>
 // <updated>2019-01-29T07:27:27+00:00</updated>
-func (a *atom10Entry) entryDate() time.Time {
-	dateText := a.Published
-	if dateText == "" {
-		dateText = a.Updated
+func (a *atom10Entry) entryDateText() string {
+	if a.Published != "" {
+		return a.Published
 	}
 
+	return a.Updated
+}
+
+func (a *atom10Entry) entryDate() time.Time {
+	dateText := a.entryDateText()
 	if dateText != "" {
 		result, err := date.Parse(dateText)
 		if err != nil {
@@ -134,6 +173,20 @@ func (a *atom10Entry) entryDate() time.Time {
 	return time.Now()
 }
 
+// entryUpdatedDate returns the date of the last modification, or the publication date when the entry has never been modified.
+func (a *atom10Entry) entryUpdatedDate(published time.Time) time.Time {
+	if a.Updated != "" {
+		result, err := date.Parse(a.Updated)
+		if err == nil {
+			return result
+		}
+
+		logger.Error("atom: %v", err)
+	}
+
+	return published
+}
+
 func (a *atom10Entry) entryHash() string {
 	for _, value := range []string{a.ID, a.Links.originalLink()} {
 		if value != "" {
@@ -159,19 +212,7 @@ func (a *atom10Entry) entryEnclosures() model.EnclosureList {
 		}
 	}
 
-	for _, link := range a.Links {
-		if strings.ToLower(link.Rel) == "enclosure" {
-			if link.URL == "" {
-				continue
-			}
-
-			if _, found := duplicates[link.URL]; !found {
-				duplicates[link.URL] = true
-				length, _ := strconv.ParseInt(link.Length, 10, 0)
-				enclosures = append(enclosures, &model.Enclosure{URL: link.URL, MimeType: link.Type, Size: length})
-			}
-		}
-	}
+	enclosures = append(enclosures, a.Links.enclosures(duplicates)...)
 
 	for _, mediaContent := range a.AllMediaContents() {
 		if _, found := duplicates[mediaContent.URL]; !found {
@@ -210,9 +251,10 @@ func (a *atom10Entry) entryCommentsURL() string {
 }
 
 type atom10Text struct {
-	Type string `xml:"type,attr"`
-	Data string `xml:",chardata"`
-	XML  string `xml:",innerxml"`
+	Type    string `xml:"type,attr"`
+	Data    string `xml:",chardata"`
+	XML     string `xml:",innerxml"`
+	BaseURL string `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
 }
 
 func (a *atom10Text) String() string {
@@ -82,6 +82,23 @@ func TestParseAtomSample(t *testing.T) {
 	}
 }
 
+func TestParseFeedLanguage(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom" xml:lang="fr-CA">
+		<title>Example Feed</title>
+		<link rel="alternate" type="text/html" href="https://example.org/"/>
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Language != "fr-CA" {
+		t.Errorf("Incorrect feed language, got: %s", feed.Language)
+	}
+}
+
 func TestParseFeedWithoutTitle(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 		<feed xmlns="http://www.w3.org/2005/Atom">
@@ -180,6 +197,161 @@ func TestParseEntryWithRelativeURL(t *testing.T) {
 	}
 }
 
+func TestParseEntryWithRelativeURLAndXMLBase(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom" xml:base="https://example.org/blog/">
+	  <title>Example Feed</title>
+	  <link href="http://example.org/"/>
+
+	  <entry xml:base="posts/">
+		<title>Test 1</title>
+		<link href="something.html"/>
+		<link rel="enclosure" type="audio/mpeg" length="1234" href="audio/something.mp3"/>
+		<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+		<updated>2003-12-13T18:30:02Z</updated>
+	  </entry>
+
+	  <entry>
+		<title>Test 2</title>
+		<link href="//cdn.example.org/other.html"/>
+		<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6b</id>
+		<updated>2003-12-13T18:30:02Z</updated>
+	  </entry>
+
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].URL != "https://example.org/blog/posts/something.html" {
+		t.Errorf("Incorrect entry URL, got: %s", feed.Entries[0].URL)
+	}
+
+	if feed.Entries[0].Enclosures[0].URL != "https://example.org/blog/posts/audio/something.mp3" {
+		t.Errorf("Incorrect enclosure URL, got: %s", feed.Entries[0].Enclosures[0].URL)
+	}
+
+	if feed.Entries[1].URL != "https://cdn.example.org/other.html" {
+		t.Errorf("Incorrect entry URL, got: %s", feed.Entries[1].URL)
+	}
+}
+
+func TestParseEntryWithNestedXMLBase(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom" xml:base="https://example.org/">
+	  <title>Example Feed</title>
+	  <link href="site/"/>
+
+	  <entry xml:base="blog/">
+		<title>Test 1</title>
+		<link xml:base="2020/" href="post.html"/>
+		<link rel="enclosure" type="audio/mpeg" href="/media/post.mp3"/>
+		<link rel="replies" type="text/html" href="comments.html"/>
+		<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+		<updated>2003-12-13T18:30:02Z</updated>
+		<content type="html" xml:base="images/">&lt;p&gt;&lt;img src="photo.png"/&gt; &lt;a href="../about.html"&gt;About&lt;/a&gt;&lt;/p&gt;</content>
+	  </entry>
+
+	  <entry>
+		<title>Test 2</title>
+		<link href="other.html"/>
+		<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6b</id>
+		<updated>2003-12-13T18:30:02Z</updated>
+		<summary type="xhtml"><div xmlns="http://www.w3.org/1999/xhtml"><a href="page.html">Page</a></div></summary>
+	  </entry>
+
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.SiteURL != "https://example.org/site/" {
+		t.Errorf("Incorrect site URL, got: %s", feed.SiteURL)
+	}
+
+	if feed.Entries[0].URL != "https://example.org/blog/2020/post.html" {
+		t.Errorf("Incorrect entry URL, got: %s", feed.Entries[0].URL)
+	}
+
+	if feed.Entries[0].Enclosures[0].URL != "https://example.org/media/post.mp3" {
+		t.Errorf("Incorrect enclosure URL, got: %s", feed.Entries[0].Enclosures[0].URL)
+	}
+
+	if feed.Entries[0].CommentsURL != "https://example.org/blog/comments.html" {
+		t.Errorf("Incorrect comments URL, got: %s", feed.Entries[0].CommentsURL)
+	}
+
+	expected := `<p><img src="https://example.org/blog/images/photo.png"/> <a href="https://example.org/blog/about.html">About</a></p>`
+	if feed.Entries[0].Content != expected {
+		t.Errorf("Incorrect entry content, got: %s", feed.Entries[0].Content)
+	}
+
+	if feed.Entries[1].URL != "https://example.org/other.html" {
+		t.Errorf("Incorrect entry URL, got: %s", feed.Entries[1].URL)
+	}
+
+	expected = `<div xmlns="http://www.w3.org/1999/xhtml"><a href="https://example.org/page.html">Page</a></div>`
+	if feed.Entries[1].Content != expected {
+		t.Errorf("Incorrect entry content, got: %s", feed.Entries[1].Content)
+	}
+}
+
+func TestParseEntryWithRelativeXMLBase(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom" xml:base="/blog/">
+	  <title>Example Feed</title>
+	  <link href="https://example.org/"/>
+
+	  <entry xml:base="posts/">
+		<title>Test</title>
+		<link href="post.html"/>
+		<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+		<updated>2003-12-13T18:30:02Z</updated>
+	  </entry>
+
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].URL != "https://example.org/blog/posts/post.html" {
+		t.Errorf("Incorrect entry URL, got: %s", feed.Entries[0].URL)
+	}
+}
+
+func TestParseEntryContentWithoutXMLBase(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom">
+	  <title>Example Feed</title>
+	  <link href="https://example.org/"/>
+
+	  <entry>
+		<title>Test</title>
+		<link href="https://example.org/blog/post.html"/>
+		<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+		<updated>2003-12-13T18:30:02Z</updated>
+		<content type="html">&lt;img src="photo.png"/&gt;</content>
+	  </entry>
+
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The relative URLs are resolved against the entry URL by the sanitizer.
+	if feed.Entries[0].Content != `<img src="photo.png"/>` {
+		t.Errorf("Incorrect entry content, got: %s", feed.Entries[0].Content)
+	}
+}
+
 func TestParseEntryTitleWithWhitespaces(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 	<feed xmlns="http://www.w3.org/2005/Atom">
@@ -563,6 +735,10 @@ func TestParseEntryWithPublished(t *testing.T) {
 	if !feed.Entries[0].Date.Equal(time.Date(2003, time.December, 13, 18, 30, 2, 0, time.UTC)) {
 		t.Errorf("Incorrect entry date, got: %v", feed.Entries[0].Date)
 	}
+
+	if !feed.Entries[0].UpdatedAt.Equal(feed.Entries[0].Date) {
+		t.Errorf("The updated date should default to the published date, got: %v", feed.Entries[0].UpdatedAt)
+	}
 }
 
 func TestParseEntryWithPublishedAndUpdated(t *testing.T) {
@@ -589,6 +765,10 @@ func TestParseEntryWithPublishedAndUpdated(t *testing.T) {
 	if !feed.Entries[0].Date.Equal(time.Date(2002, time.November, 12, 18, 30, 2, 0, time.UTC)) {
 		t.Errorf("Incorrect entry date, got: %v", feed.Entries[0].Date)
 	}
+
+	if !feed.Entries[0].UpdatedAt.Equal(time.Date(2003, time.December, 13, 18, 30, 2, 0, time.UTC)) {
+		t.Errorf("Incorrect entry updated date, got: %v", feed.Entries[0].UpdatedAt)
+	}
 }
 
 func TestParseInvalidXml(t *testing.T) {
@@ -677,6 +857,10 @@ A website: http://example.org/</media:description>
 		t.Errorf("Incorrect entry content, got: %q", feed.Entries[0].Content)
 	}
 
+	if feed.Entries[0].ThumbnailURL != "https://example.org/thumbnail.jpg" {
+		t.Errorf("Incorrect entry thumbnail, got: %q", feed.Entries[0].ThumbnailURL)
+	}
+
 	if len(feed.Entries[0].Enclosures) != 2 {
 		t.Fatalf("Incorrect number of enclosures, got: %d", len(feed.Entries[0].Enclosures))
 	}
@@ -937,3 +1121,80 @@ func TestAbsoluteCommentsURL(t *testing.T) {
 		t.Errorf("Incorrect entry comments URL, got: %s", feed.Entries[0].CommentsURL)
 	}
 }
+
+func TestParseEntryWithDuplicatedEnclosures(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom">
+		<id>http://www.example.org/myfeed</id>
+		<title>My Podcast Feed</title>
+		<link href="http://example.org" />
+		<entry>
+			<id>http://www.example.org/entries/1</id>
+			<title>Episode 1</title>
+			<link href="http://www.example.org/entries/1" />
+			<link rel="enclosure" type="audio/mpeg" href="http://www.example.org/episode1.mp3" length="1234" />
+			<link rel="enclosure" type="application/json+chapters" href=" http://www.example.org/episode1-chapters.json " />
+			<link rel="enclosure" type="audio/mpeg" href="http://www.example.org/episode1.mp3" length="1234" />
+			<link rel="ENCLOSURE" type="text/vtt" href="http://www.example.org/episode1.vtt" length="invalid" />
+		</entry>
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedResults := []struct {
+		url      string
+		mimeType string
+		size     int64
+	}{
+		{"http://www.example.org/episode1.mp3", "audio/mpeg", 1234},
+		{"http://www.example.org/episode1-chapters.json", "application/json+chapters", 0},
+		{"http://www.example.org/episode1.vtt", "text/vtt", 0},
+	}
+
+	if len(feed.Entries[0].Enclosures) != len(expectedResults) {
+		t.Fatalf("Incorrect number of enclosures, got: %d", len(feed.Entries[0].Enclosures))
+	}
+
+	for index, enclosure := range feed.Entries[0].Enclosures {
+		if expectedResults[index].url != enclosure.URL {
+			t.Errorf(`Unexpected enclosure URL, got %q instead of %q`, enclosure.URL, expectedResults[index].url)
+		}
+
+		if expectedResults[index].mimeType != enclosure.MimeType {
+			t.Errorf(`Unexpected enclosure type, got %q instead of %q`, enclosure.MimeType, expectedResults[index].mimeType)
+		}
+
+		if expectedResults[index].size != enclosure.Size {
+			t.Errorf(`Unexpected enclosure size, got %d instead of %d`, enclosure.Size, expectedResults[index].size)
+		}
+	}
+}
+
+func TestParseFeedWithWebSubLinks(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom">
+		<id>http://www.example.org/myfeed</id>
+		<title>Example Feed</title>
+		<link href="http://example.org/" />
+		<link rel="hub" href="ftp://example.org/hub" />
+		<link rel="hub" href=" https://websub.example.org/ " />
+		<link rel="hub" href="https://pubsubhubbub.appspot.com/" />
+		<link rel="self" href="http://example.org/feed.atom" />
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.HubURL != "https://websub.example.org/" {
+		t.Errorf(`Incorrect hub URL, got: %q`, feed.HubURL)
+	}
+
+	if feed.TopicURL != "http://example.org/feed.atom" {
+		t.Errorf(`Incorrect topic URL, got: %q`, feed.TopicURL)
+	}
+}
@@ -4,7 +4,14 @@
 
 package atom // import "miniflux.app/reader/atom"
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+
+	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/url"
+)
 
 type atomPerson struct {
 	Name  string `xml:"name"`
@@ -25,14 +32,31 @@ func (a *atomPerson) String() string {
 }
 
 type atomLink struct {
-	URL    string `xml:"href,attr"`
-	Type   string `xml:"type,attr"`
-	Rel    string `xml:"rel,attr"`
-	Length string `xml:"length,attr"`
+	URL     string `xml:"href,attr"`
+	Type    string `xml:"type,attr"`
+	Rel     string `xml:"rel,attr"`
+	Length  string `xml:"length,attr"`
+	BaseURL string `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
 }
 
 type atomLinks []*atomLink
 
+// resolve converts the links to absolute URLs when a base URL is declared on the parent elements or on the link itself.
+// The links are left untouched otherwise, they are resolved later against the site URL.
+func (a atomLinks) resolve(baseURL string) {
+	for _, link := range a {
+		linkBaseURL := url.ResolveBaseURL(baseURL, link.BaseURL)
+		linkURL := strings.TrimSpace(link.URL)
+		if linkBaseURL == "" || linkURL == "" {
+			continue
+		}
+
+		if absoluteURL, err := url.AbsoluteURL(linkBaseURL, linkURL); err == nil {
+			link.URL = absoluteURL
+		}
+	}
+}
+
 func (a atomLinks) originalLink() string {
 	for _, link := range a {
 		if strings.ToLower(link.Rel) == "alternate" {
@@ -70,3 +94,63 @@ func (a atomLinks) firstLinkWithRelationAndType(relation string, contentTypes ..
 
 	return ""
 }
+
+// hubURL returns the first valid WebSub hub declared by the feed.
+func (a atomLinks) hubURL() string {
+	for _, link := range a.findAllLinksWithRelation("hub") {
+		if linkURL := strings.TrimSpace(link.URL); url.IsHTTPURL(linkURL) {
+			return linkURL
+		}
+	}
+
+	return ""
+}
+
+func (a atomLinks) findAllLinksWithRelation(relation string) []*atomLink {
+	var links []*atomLink
+
+	for _, link := range a {
+		if strings.ToLower(link.Rel) == relation {
+			links = append(links, link)
+		}
+	}
+
+	return links
+}
+
+// enclosures returns the links with the "enclosure" relation, without duplicates.
+// The length attribute is optional, so an invalid or missing value is stored as zero.
+func (a atomLinks) enclosures(duplicates map[string]bool) model.EnclosureList {
+	enclosures := make(model.EnclosureList, 0)
+
+	for _, link := range a.findAllLinksWithRelation("enclosure") {
+		linkURL := strings.TrimSpace(link.URL)
+		if linkURL == "" {
+			continue
+		}
+
+		if _, found := duplicates[linkURL]; found {
+			continue
+		}
+
+		duplicates[linkURL] = true
+		length, _ := strconv.ParseInt(strings.TrimSpace(link.Length), 10, 0)
+		if length < 0 {
+			length = 0
+		}
+
+		enclosures = append(enclosures, &model.Enclosure{URL: linkURL, MimeType: link.Type, Size: length})
+	}
+
+	return enclosures
+}
+
+// resolveContentURLs converts the relative URLs of the content when a base URL is declared with xml:base.
+// Without base URL, the relative URLs are resolved against the entry URL by the sanitizer.
+func resolveContentURLs(baseURL, content string) string {
+	if baseURL == "" || content == "" {
+		return content
+	}
+
+	return sanitizer.ResolveURLs(baseURL, content)
+}
@@ -30,9 +30,7 @@ func Parse(r io.Reader) (*model.Feed, *errors.LocalizedError) {
 		rawFeed = new(atom10Feed)
 	}
 
-	decoder := xml_decoder.NewDecoder(&buf)
-	err := decoder.Decode(rawFeed)
-	if err != nil {
+	if err := xml_decoder.Decode(&buf, rawFeed); err != nil {
 		return nil, errors.NewLocalizedError("Unable to parse Atom feed: %q", err)
 	}
 
@@ -5,8 +5,15 @@
 package browser // import "miniflux.app/reader/browser"
 
 import (
+	"strings"
+	"time"
+
 	"miniflux.app/errors"
 	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	url_helper "miniflux.app/url"
+
+	"golang.org/x/net/html"
 )
 
 var (
@@ -16,40 +23,183 @@ var (
 	errEmptyFeed        = "This feed is empty"
 	errResourceNotFound = "Resource not found (404), this feed doesn't exists anymore, check the feed URL"
 	errNotAuthorized    = "You are not authorized to access this resource (invalid username/password)"
+	errTooManyAttempts  = "Unable to fetch this resource after %d attempts: %v"
+	errResponseTooLarge = "This resource is larger than the maximum size allowed (%d MiB)"
 )
 
 // Exec executes a HTTP request and handles errors.
+//
+// The response is also returned when the server replied but the request failed,
+// the caller can inspect the status code or headers like Retry-After.
 func Exec(request *client.Client) (*client.Response, *errors.LocalizedError) {
+	response, _, err := exec(request)
+	return response, err
+}
+
+// ExecWithRetry executes a HTTP request and retries transient failures with an exponential backoff.
+//
+// Network errors and the status codes 429, 502, 503 and 504 are considered transient,
+// other failures are returned immediately. Responses with a Retry-After header are not retried:
+// the server asked to wait longer than the backoff delay.
+func ExecWithRetry(request *client.Client, maxRetries int, baseDelay time.Duration) (*client.Response, *errors.LocalizedError) {
+	for attempt := 1; ; attempt++ {
+		response, transient, err := exec(request)
+		if err == nil {
+			return response, nil
+		}
+
+		ctx := request.Context()
+		if transient && attempt <= maxRetries && ctx.Err() == nil {
+			delay := baseDelay * time.Duration(1<<uint(attempt-1))
+			logger.Debug("[Browser] Attempt #%d failed (%v), retrying in %v", attempt, err, delay)
+
+			select {
+			case <-time.After(delay):
+				continue
+			case <-ctx.Done():
+			}
+		}
+
+		// The number of attempts is the number of requests sent, the first one included.
+		if attempt > 1 {
+			return response, errors.NewLocalizedError(errTooManyAttempts, attempt, err)
+		}
+		return response, err
+	}
+}
+
+// exec follows the meta refresh of HTML pages, only once to avoid loops.
+func exec(request *client.Client) (*client.Response, bool, *errors.LocalizedError) {
+	response, transient, err := execRequest(request)
+	if err != nil || response.StatusCode == 304 {
+		return response, transient, err
+	}
+
+	if targetURL := metaRefreshURL(response); targetURL != "" {
+		logger.Debug("[Browser] Following the meta refresh from %s to %s", response.EffectiveURL, targetURL)
+		return execRequest(request.WithRedirect(targetURL))
+	}
+
+	return response, transient, err
+}
+
+func execRequest(request *client.Client) (*client.Response, bool, *errors.LocalizedError) {
 	response, err := request.Get()
 	if err != nil {
+		// Downloading the same resource again would exceed the limit again.
+		if e, ok := err.(*client.TooLargeError); ok {
+			return nil, false, errors.NewLocalizedError(errResponseTooLarge, e.MaxBodySize/1024/1024)
+		}
 		if e, ok := err.(*errors.LocalizedError); ok {
-			return nil, e
+			return nil, true, e
 		}
-		return nil, errors.NewLocalizedError(errRequestFailed, err)
+		return nil, true, errors.NewLocalizedError(errRequestFailed, err)
 	}
 
 	if response.IsNotFound() {
-		return nil, errors.NewLocalizedError(errResourceNotFound)
+		return response, false, errors.NewLocalizedError(errResourceNotFound)
 	}
 
 	if response.IsNotAuthorized() {
-		return nil, errors.NewLocalizedError(errNotAuthorized)
+		return response, false, errors.NewLocalizedError(errNotAuthorized)
 	}
 
 	if response.HasServerFailure() {
-		return nil, errors.NewLocalizedError(errServerFailure, response.StatusCode)
+		transient := isTransientStatusCode(response.StatusCode) && response.RetryAfter == ""
+		return response, transient, errors.NewLocalizedError(errServerFailure, response.StatusCode)
 	}
 
 	if response.StatusCode != 304 {
 		// Content-Length = -1 when no Content-Length header is sent.
 		if response.ContentLength == 0 {
-			return nil, errors.NewLocalizedError(errEmptyFeed)
+			return response, false, errors.NewLocalizedError(errEmptyFeed)
 		}
 
 		if err := response.EnsureUnicodeBody(); err != nil {
-			return nil, errors.NewLocalizedError(errEncoding, err)
+			return response, false, errors.NewLocalizedError(errEncoding, err)
 		}
 	}
 
-	return response, nil
+	return response, false, nil
+}
+
+// metaRefreshURL returns the absolute URL of the <meta http-equiv="refresh"> tag of an HTML response.
+// The body of the response is kept intact.
+func metaRefreshURL(response *client.Response) string {
+	if !strings.Contains(strings.ToLower(response.ContentType), "html") {
+		return ""
+	}
+
+	body := response.BodyAsString()
+	response.Body = strings.NewReader(body)
+
+	targetURL := findMetaRefresh(body)
+	if targetURL == "" {
+		return ""
+	}
+
+	absoluteURL, err := url_helper.AbsoluteURL(response.EffectiveURL, targetURL)
+	if err != nil || !url_helper.IsHTTPURL(absoluteURL) || absoluteURL == response.EffectiveURL {
+		return ""
+	}
+
+	return absoluteURL
+}
+
+// findMetaRefresh returns the URL of the first meta refresh tag, the document body is not inspected.
+func findMetaRefresh(body string) string {
+	tokenizer := html.NewTokenizer(strings.NewReader(body))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return ""
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			if token.Data == "body" {
+				return ""
+			}
+
+			if token.Data == "meta" && strings.EqualFold(strings.TrimSpace(getAttribute(token, "http-equiv")), "refresh") {
+				return parseMetaRefreshContent(getAttribute(token, "content"))
+			}
+		}
+	}
+}
+
+func getAttribute(token html.Token, name string) string {
+	for _, attr := range token.Attr {
+		if attr.Key == name {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+// parseMetaRefreshContent extracts the URL of a meta refresh content like "0; url='https://example.org/'".
+func parseMetaRefreshContent(content string) string {
+	parts := strings.SplitN(content, ";", 2)
+	if len(parts) != 2 {
+		parts = strings.SplitN(content, ",", 2)
+		if len(parts) != 2 {
+			return ""
+		}
+	}
+
+	targetURL := strings.TrimSpace(parts[1])
+	if len(targetURL) > 3 && strings.EqualFold(targetURL[:3], "url") {
+		if value := strings.TrimSpace(targetURL[3:]); strings.HasPrefix(value, "=") {
+			targetURL = strings.TrimSpace(value[1:])
+		}
+	}
+
+	return strings.Trim(targetURL, `"'`)
+}
+
+func isTransientStatusCode(statusCode int) bool {
+	switch statusCode {
+	case 429, 502, 503, 504:
+		return true
+	default:
+		return false
+	}
 }
@@ -0,0 +1,293 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package browser // import "miniflux.app/reader/browser"
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/client"
+)
+
+func newTestServer(t *testing.T, statusCode int, attempts *int) *httptest.Server {
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*attempts++
+		w.WriteHeader(statusCode)
+	}))
+}
+
+func TestExecWithRetryDoesNotRetryPermanentFailure(t *testing.T) {
+	attempts := 0
+	ts := newTestServer(t, http.StatusNotFound, &attempts)
+	defer ts.Close()
+
+	_, err := ExecWithRetry(client.New(ts.URL), 3, time.Millisecond)
+	if err == nil {
+		t.Fatal(`A 404 response should return an error`)
+	}
+
+	if attempts != 1 {
+		t.Fatalf(`A 404 response should not be retried, got %d attempts`, attempts)
+	}
+}
+
+func TestExecWithRetryRetriesTransientFailure(t *testing.T) {
+	attempts := 0
+	ts := newTestServer(t, http.StatusServiceUnavailable, &attempts)
+	defer ts.Close()
+
+	_, err := ExecWithRetry(client.New(ts.URL), 3, time.Millisecond)
+	if err == nil {
+		t.Fatal(`A 503 response should return an error`)
+	}
+
+	if attempts != 4 {
+		t.Fatalf(`A 503 response should be retried 3 times, got %d attempts`, attempts)
+	}
+
+	if !strings.Contains(err.Error(), "after 4 attempts") {
+		t.Fatalf(`The error message should contain the number of attempts, got %q`, err.Error())
+	}
+}
+
+func TestExecWithRetryStopsWhenContextIsCanceled(t *testing.T) {
+	attempts := 0
+	ts := newTestServer(t, http.StatusServiceUnavailable, &attempts)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	request := client.New(ts.URL).WithContext(ctx)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	_, err := ExecWithRetry(request, 10, time.Second)
+	if err == nil {
+		t.Fatal(`A canceled request should return an error`)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf(`The retries should stop when the context is canceled, took %v`, elapsed)
+	}
+
+	if attempts != 1 {
+		t.Fatalf(`The request should not be retried after the cancellation, got %d attempts`, attempts)
+	}
+
+	if strings.Contains(err.Error(), "attempts") {
+		t.Fatalf(`The canceled retry should not be counted as an attempt, got %q`, err.Error())
+	}
+}
+
+func TestIsTransientStatusCode(t *testing.T) {
+	scenarios := map[int]bool{
+		429: true,
+		502: true,
+		503: true,
+		504: true,
+		404: false,
+		410: false,
+		500: false,
+	}
+
+	for statusCode, expected := range scenarios {
+		if result := isTransientStatusCode(statusCode); result != expected {
+			t.Errorf(`Unexpected result for status code %d, got %v instead of %v`, statusCode, result, expected)
+		}
+	}
+}
+
+func TestExecWithRetryDoesNotRetryWhenRetryAfterIsDefined(t *testing.T) {
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	response, requestErr := ExecWithRetry(client.New(ts.URL), 3, time.Millisecond)
+	if requestErr == nil {
+		t.Fatal(`A 429 response should return an error`)
+	}
+
+	if attempts != 1 {
+		t.Fatalf(`A 429 response with a Retry-After header should not be retried, got %d attempts`, attempts)
+	}
+
+	if response == nil || response.RetryAfterDelay() != time.Hour {
+		t.Fatal(`The response should be returned with the Retry-After header`)
+	}
+}
+
+func TestExecReturnsResponseWithFailure(t *testing.T) {
+	attempts := 0
+	ts := newTestServer(t, http.StatusGone, &attempts)
+	defer ts.Close()
+
+	response, err := Exec(client.New(ts.URL))
+	if err == nil {
+		t.Fatal(`A 410 response should return an error`)
+	}
+
+	if response == nil || response.StatusCode != http.StatusGone {
+		t.Fatal(`The response should be returned with its status code`)
+	}
+}
+
+func TestExecWithRetryDoesNotRetryTooLargeResponse(t *testing.T) {
+	os.Setenv("HTTP_CLIENT_MAX_BODY_SIZE", "1")
+	defer os.Clearenv()
+
+	attempts := 0
+	ts := newTestServer(t, http.StatusOK, &attempts)
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Write(bytes.Repeat([]byte("a"), 2*1024*1024))
+	})
+	defer ts.Close()
+
+	_, err := ExecWithRetry(client.New(ts.URL), 3, time.Millisecond)
+	if err == nil {
+		t.Fatal(`A response larger than the limit should return an error`)
+	}
+
+	if attempts != 1 {
+		t.Fatalf(`A response larger than the limit should not be retried, got %d attempts`, attempts)
+	}
+}
+
+func TestExecFollowsMetaRefresh(t *testing.T) {
+	page, err := ioutil.ReadFile("testdata/meta_refresh.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attempts := 0
+	ts := newTestServer(t, http.StatusOK, &attempts)
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if r.URL.Path == "/feed.xml" {
+			w.Header().Set("Content-Type", "application/rss+xml")
+			w.Write([]byte(`<rss version="2.0"><channel><title>Feed</title></channel></rss>`))
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(page)
+	})
+	defer ts.Close()
+
+	response, execErr := Exec(client.New(ts.URL + "/moved"))
+	if execErr != nil {
+		t.Fatalf(`Unexpected error: %v`, execErr)
+	}
+
+	if response.EffectiveURL != ts.URL+"/feed.xml" {
+		t.Errorf(`Unexpected effective URL, got %q`, response.EffectiveURL)
+	}
+
+	if body := response.BodyAsString(); !strings.Contains(body, "<rss") {
+		t.Errorf(`Unexpected body, got %q`, body)
+	}
+}
+
+func TestExecMetaRefreshDropsCacheHeaders(t *testing.T) {
+	attempts := 0
+	ts := newTestServer(t, http.StatusOK, &attempts)
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if r.URL.Path == "/feed.xml" {
+			if r.Header.Get("If-None-Match") != "" || r.Header.Get("If-Modified-Since") != "" {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("Content-Type", "application/rss+xml")
+			w.Write([]byte(`<rss version="2.0"><channel><title>Feed</title></channel></rss>`))
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<meta http-equiv="refresh" content="0;url=/feed.xml">`))
+	})
+	defer ts.Close()
+
+	request := client.New(ts.URL + "/moved")
+	request.WithCacheHeaders(`"etag"`, "Mon, 02 Jan 2006 15:04:05 GMT")
+
+	response, execErr := Exec(request)
+	if execErr != nil {
+		t.Fatalf(`Unexpected error: %v`, execErr)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		t.Errorf(`The cache headers of the original URL should not be sent to the meta refresh target, got status %d`, response.StatusCode)
+	}
+}
+
+func TestExecFollowsMetaRefreshOnlyOnce(t *testing.T) {
+	attempts := 0
+	ts := newTestServer(t, http.StatusOK, &attempts)
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<meta http-equiv="refresh" content="0;url=/page` + strconv.Itoa(attempts) + `">`))
+	})
+	defer ts.Close()
+
+	response, execErr := Exec(client.New(ts.URL))
+	if execErr != nil {
+		t.Fatalf(`Unexpected error: %v`, execErr)
+	}
+
+	if attempts != 2 {
+		t.Errorf(`Only one meta refresh should be followed, got %d requests`, attempts)
+	}
+
+	if response.EffectiveURL != ts.URL+"/page1" {
+		t.Errorf(`Unexpected effective URL, got %q`, response.EffectiveURL)
+	}
+}
+
+func TestParseMetaRefreshContent(t *testing.T) {
+	scenarios := map[string]string{
+		"0; url=https://example.org/feed.xml":  "https://example.org/feed.xml",
+		"0;URL='https://example.org/feed.xml'": "https://example.org/feed.xml",
+		`5; url="/feed.xml"`:                   "/feed.xml",
+		"0, https://example.org/feed.xml":      "https://example.org/feed.xml",
+		"0; https://example.org/feed.xml":      "https://example.org/feed.xml",
+		"300":                                  "",
+		"":                                     "",
+	}
+
+	for input, expected := range scenarios {
+		if result := parseMetaRefreshContent(input); result != expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
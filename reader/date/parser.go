@@ -233,6 +233,27 @@ func Parse(ds string) (t time.Time, err error) {
 	return
 }
 
+// ParseWithLayouts parses a given date string using the commonly found feed date formats,
+// then using the given layouts when none of them matches.
+func ParseWithLayouts(ds string, layouts []string) (time.Time, error) {
+	if t, err := Parse(ds); err == nil {
+		return t, nil
+	}
+
+	d := strings.TrimSpace(ds)
+	for _, layout := range layouts {
+		if layout = strings.TrimSpace(layout); layout == "" {
+			continue
+		}
+
+		if t, err := time.Parse(layout, d); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf(`date parser: failed to parse date "%s" with layouts %q`, ds, layouts)
+}
+
 // According to Golang documentation:
 //
 // RFC822, RFC850, and RFC1123 formats should be applied only to local times.
@@ -6,6 +6,7 @@ package date // import "miniflux.app/reader/date"
 
 import (
 	"testing"
+	"time"
 )
 
 func TestParseEmptyDate(t *testing.T) {
@@ -140,3 +141,29 @@ func TestParseWeirdDateFormat(t *testing.T) {
 		}
 	}
 }
+
+func TestParseWithLayouts(t *testing.T) {
+	layouts := []string{"", "2006年01月02日 15:04"}
+
+	date, err := ParseWithLayouts("2020年03月01日 10:30", layouts)
+	if err != nil {
+		t.Fatalf(`Unable to parse date with custom layouts: %v`, err)
+	}
+
+	if expected := time.Date(2020, time.March, 1, 10, 30, 0, 0, time.UTC); !date.Equal(expected) {
+		t.Errorf(`Unexpected date, got %v instead of %v`, date, expected)
+	}
+
+	date, err = ParseWithLayouts("2020-03-01T10:30:00Z", layouts)
+	if err != nil {
+		t.Fatalf(`The common formats should be used first: %v`, err)
+	}
+
+	if expected := time.Date(2020, time.March, 1, 10, 30, 0, 0, time.UTC); !date.Equal(expected) {
+		t.Errorf(`Unexpected date, got %v instead of %v`, date, expected)
+	}
+
+	if _, err := ParseWithLayouts("not a date", layouts); err == nil {
+		t.Error(`An error should be returned when no layout matches`)
+	}
+}
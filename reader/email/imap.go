@@ -0,0 +1,202 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package email // import "miniflux.app/reader/email"
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	literalRegex = regexp.MustCompile(`\{(\d+)\}$`)
+	uidRegex     = regexp.MustCompile(`\bUID (\d+)`)
+)
+
+// imapResponse is an untagged response sent by the server.
+// The literals are the strings sent after the line announcing their size, like message bodies.
+type imapResponse struct {
+	text     string
+	literals [][]byte
+}
+
+// imapMessage is a message downloaded from the server.
+type imapMessage struct {
+	uid  string
+	body []byte
+}
+
+// imapClient implements the small subset of the IMAP protocol required to download messages.
+type imapClient struct {
+	conn           net.Conn
+	reader         *bufio.Reader
+	tag            int
+	maxLiteralSize int64
+}
+
+// newIMAPClient returns a client reading the server greeting, the literals larger than maxLiteralSize are rejected.
+func newIMAPClient(conn net.Conn, maxLiteralSize int64) (*imapClient, error) {
+	c := &imapClient{conn: conn, reader: bufio.NewReader(conn), maxLiteralSize: maxLiteralSize}
+
+	greeting, err := c.readLine()
+	if err != nil {
+		return nil, fmt.Errorf("email: unable to read server greeting: %v", err)
+	}
+
+	if !strings.HasPrefix(greeting, "* OK") && !strings.HasPrefix(greeting, "* PREAUTH") {
+		return nil, fmt.Errorf("email: unexpected server greeting: %q", greeting)
+	}
+
+	return c, nil
+}
+
+func (c *imapClient) login(username, password string) error {
+	_, err := c.execute("LOGIN " + quote(username) + " " + quote(password))
+	return err
+}
+
+func (c *imapClient) selectMailbox(mailbox string) error {
+	_, err := c.execute("SELECT " + quote(mailbox))
+	return err
+}
+
+// searchUnseen returns the UIDs of the unseen messages, optionally sent by the given sender.
+func (c *imapClient) searchUnseen(sender string) ([]string, error) {
+	command := "UID SEARCH UNSEEN"
+	if sender != "" {
+		command += " FROM " + quote(sender)
+	}
+
+	responses, err := c.execute(command)
+	if err != nil {
+		return nil, err
+	}
+
+	var uids []string
+	for _, response := range responses {
+		if strings.HasPrefix(response.text, "* SEARCH") {
+			uids = append(uids, strings.Fields(strings.TrimPrefix(response.text, "* SEARCH"))...)
+		}
+	}
+
+	return uids, nil
+}
+
+// fetchMessages downloads the given messages without flagging them as seen.
+func (c *imapClient) fetchMessages(uids []string) ([]*imapMessage, error) {
+	responses, err := c.execute("UID FETCH " + strings.Join(uids, ",") + " (UID BODY.PEEK[])")
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []*imapMessage
+	for _, response := range responses {
+		matches := uidRegex.FindStringSubmatch(response.text)
+		if matches == nil || len(response.literals) == 0 {
+			continue
+		}
+
+		messages = append(messages, &imapMessage{uid: matches[1], body: response.literals[0]})
+	}
+
+	return messages, nil
+}
+
+// markSeen flags the given messages as seen.
+func (c *imapClient) markSeen(uids []string) error {
+	_, err := c.execute("UID STORE " + strings.Join(uids, ",") + ` +FLAGS.SILENT (\Seen)`)
+	return err
+}
+
+func (c *imapClient) logout() {
+	c.execute("LOGOUT")
+	c.conn.Close()
+}
+
+// execute sends a command and returns the untagged responses when the server completes it successfully.
+func (c *imapClient) execute(command string) ([]*imapResponse, error) {
+	c.tag++
+	tag := fmt.Sprintf("A%03d", c.tag)
+
+	if _, err := fmt.Fprintf(c.conn, "%s %s\r\n", tag, command); err != nil {
+		return nil, fmt.Errorf("email: unable to send command: %v", err)
+	}
+
+	var responses []*imapResponse
+	for {
+		response, err := c.readResponse()
+		if err != nil {
+			return nil, fmt.Errorf("email: unable to read response: %v", err)
+		}
+
+		if strings.HasPrefix(response.text, tag+" ") {
+			status := strings.TrimPrefix(response.text, tag+" ")
+			if strings.HasPrefix(status, "OK") {
+				return responses, nil
+			}
+
+			return nil, fmt.Errorf("email: command rejected by the server: %s", status)
+		}
+
+		if strings.HasPrefix(response.text, "* ") {
+			responses = append(responses, response)
+		}
+	}
+}
+
+// readResponse reads a response line and the literals it contains.
+func (c *imapClient) readResponse() (*imapResponse, error) {
+	line, err := c.readLine()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &imapResponse{text: line}
+	for {
+		matches := literalRegex.FindStringSubmatch(line)
+		if matches == nil {
+			return response, nil
+		}
+
+		size, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil || size > c.maxLiteralSize {
+			return nil, fmt.Errorf("literal of %s bytes exceeds the limit of %d bytes", matches[1], c.maxLiteralSize)
+		}
+
+		// The buffer grows with the data actually received instead of trusting the announced size.
+		var literal bytes.Buffer
+		n, err := io.Copy(&literal, io.LimitReader(c.reader, size))
+		if err != nil {
+			return nil, err
+		}
+		if n < size {
+			return nil, io.ErrUnexpectedEOF
+		}
+		response.literals = append(response.literals, literal.Bytes())
+
+		if line, err = c.readLine(); err != nil {
+			return nil, err
+		}
+		response.text += " " + line
+	}
+}
+
+func (c *imapClient) readLine() (string, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func quote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
@@ -0,0 +1,133 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package email // import "miniflux.app/reader/email"
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+const (
+	defaultIMAPPort = "993"
+	sessionTimeout  = 2 * time.Minute
+
+	// maxMessages is the number of messages downloaded at once, the remaining messages are imported during the next refresh.
+	maxMessages = 50
+)
+
+// Mailbox is an IMAP mailbox.
+type Mailbox struct {
+	server   string
+	username string
+	password string
+	name     string
+	sender   string
+}
+
+// NewMailbox returns a new Mailbox, the messages can be restricted to a given sender.
+func NewMailbox(server, username, password, name, sender string) *Mailbox {
+	if name == "" {
+		name = "INBOX"
+	}
+
+	return &Mailbox{server: server, username: username, password: password, name: name, sender: sender}
+}
+
+// FetchEntries downloads the unseen messages of the mailbox over TLS, converts them to entries and hands them to store.
+// The messages are flagged as seen by the server only once store succeeds, they are downloaded again otherwise.
+func (m *Mailbox) FetchEntries(store func(model.Entries) error) error {
+	address := m.server
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, defaultIMAPPort)
+	}
+
+	host, _, _ := net.SplitHostPort(address)
+	dialer := &net.Dialer{Timeout: sessionTimeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, &tls.Config{ServerName: host})
+	if err != nil {
+		return fmt.Errorf("email: unable to connect to %s: %v", address, err)
+	}
+	conn.SetDeadline(time.Now().Add(sessionTimeout))
+
+	client, err := newIMAPClient(conn, config.Opts.HTTPClientMaxBodySize())
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.logout()
+
+	return m.fetchEntries(client, store)
+}
+
+func (m *Mailbox) fetchEntries(client *imapClient, store func(model.Entries) error) error {
+	if err := client.login(m.username, m.password); err != nil {
+		return err
+	}
+
+	if err := client.selectMailbox(m.name); err != nil {
+		return err
+	}
+
+	uids, err := client.searchUnseen(m.sender)
+	if err != nil {
+		return err
+	}
+
+	if len(uids) == 0 {
+		return nil
+	}
+
+	if len(uids) > maxMessages {
+		uids = uids[:maxMessages]
+	}
+
+	messages, err := client.fetchMessages(uids)
+	if err != nil {
+		return err
+	}
+
+	// The messages that cannot be parsed are left unseen in the mailbox.
+	var entries model.Entries
+	var parsedUIDs []string
+	for _, message := range messages {
+		entry, err := parseMessage(message.body)
+		if err != nil {
+			logger.Error("[Email] Unable to parse message %s from %s: %v", message.uid, m.server, err)
+			continue
+		}
+
+		entries = append(entries, entry)
+		parsedUIDs = append(parsedUIDs, message.uid)
+	}
+
+	if err := store(entries); err != nil {
+		return err
+	}
+
+	if len(parsedUIDs) == 0 {
+		return nil
+	}
+
+	return client.markSeen(parsedUIDs)
+}
+
+// MailboxURL returns the URL identifying an IMAP mailbox.
+func MailboxURL(server, username, name string) string {
+	u := &url.URL{Scheme: "imap", User: url.User(username), Host: server, Path: "/" + name}
+	return u.String()
+}
+
+// IsMailboxURL returns true if the URL identifies an IMAP mailbox.
+func IsMailboxURL(feedURL string) bool {
+	return strings.HasPrefix(feedURL, "imap://")
+}
@@ -0,0 +1,250 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package email // import "miniflux.app/reader/email"
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+const testMaxLiteralSize = 1024
+
+// writeInChunks sends the data a few bytes at a time, so responses and literals are split across reads.
+func writeInChunks(conn net.Conn, data string) {
+	for len(data) > 0 {
+		n := 3
+		if len(data) < n {
+			n = len(data)
+		}
+		conn.Write([]byte(data[:n]))
+		data = data[n:]
+	}
+}
+
+// fakeServer replies to the commands of the client with the given responses, indexed by command name.
+func fakeServer(t *testing.T, conn net.Conn, responses map[string]string) <-chan []string {
+	commands := make(chan []string, 1)
+
+	go func() {
+		var received []string
+		defer func() {
+			conn.Close()
+			commands <- received
+		}()
+
+		reader := bufio.NewReader(conn)
+		fmt.Fprint(conn, "* OK IMAP4rev1 ready\r\n")
+
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			parts := strings.SplitN(strings.TrimRight(line, "\r\n"), " ", 2)
+			tag, command := parts[0], parts[1]
+			received = append(received, command)
+
+			name := strings.Fields(command)[0]
+			if name == "UID" {
+				name += " " + strings.Fields(command)[1]
+			}
+
+			writeInChunks(conn, responses[name])
+			writeInChunks(conn, fmt.Sprintf("%s OK %s completed\r\n", tag, name))
+
+			if name == "LOGOUT" {
+				return
+			}
+		}
+	}()
+
+	return commands
+}
+
+func TestFetchEntries(t *testing.T) {
+	message := "Subject: Hello\r\nMessage-Id: <1@example.org>\r\n\r\nBody"
+
+	clientConn, serverConn := net.Pipe()
+	commands := fakeServer(t, serverConn, map[string]string{
+		"UID SEARCH": "* SEARCH 3 7\r\n",
+		"UID FETCH":  fmt.Sprintf("* 1 FETCH (UID 3 BODY[] {%d}\r\n%s)\r\n* 2 FETCH (UID 7 FLAGS (\\Recent))\r\n", len(message), message),
+	})
+
+	client, err := newIMAPClient(clientConn, testMaxLiteralSize)
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	var entries model.Entries
+	mailbox := NewMailbox("imap.example.org", "me", `pass"word`, "", "news@example.org")
+	err = mailbox.fetchEntries(client, func(stored model.Entries) error {
+		entries = stored
+		return nil
+	})
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+	client.logout()
+
+	if len(entries) != 1 {
+		t.Fatalf(`Unexpected number of entries, got %d`, len(entries))
+	}
+
+	if entries[0].Title != "Hello" || entries[0].Content != "<p>Body</p>" {
+		t.Errorf(`Unexpected entry: %q %q`, entries[0].Title, entries[0].Content)
+	}
+
+	expected := []string{
+		`LOGIN "me" "pass\"word"`,
+		`SELECT "INBOX"`,
+		`UID SEARCH UNSEEN FROM "news@example.org"`,
+		`UID FETCH 3,7 (UID BODY.PEEK[])`,
+		`UID STORE 3 +FLAGS.SILENT (\Seen)`,
+		`LOGOUT`,
+	}
+
+	received := <-commands
+	if strings.Join(received, "\n") != strings.Join(expected, "\n") {
+		t.Errorf(`Unexpected commands, got %q instead of %q`, received, expected)
+	}
+}
+
+func TestFetchEntriesWithStoreError(t *testing.T) {
+	message := "Subject: Hello\r\nMessage-Id: <1@example.org>\r\n\r\nBody"
+
+	clientConn, serverConn := net.Pipe()
+	commands := fakeServer(t, serverConn, map[string]string{
+		"UID SEARCH": "* SEARCH 3\r\n",
+		"UID FETCH":  fmt.Sprintf("* 1 FETCH (UID 3 BODY[] {%d}\r\n%s)\r\n", len(message), message),
+	})
+
+	client, err := newIMAPClient(clientConn, testMaxLiteralSize)
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	mailbox := NewMailbox("imap.example.org", "me", "secret", "INBOX", "")
+	err = mailbox.fetchEntries(client, func(model.Entries) error {
+		return errors.New("database unavailable")
+	})
+	if err == nil {
+		t.Error(`The store error should be returned`)
+	}
+	client.logout()
+
+	for _, command := range <-commands {
+		if strings.HasPrefix(command, "UID STORE") {
+			t.Errorf(`The messages should not be flagged as seen when they are not stored`)
+		}
+	}
+}
+
+func TestReadResponseWithMultipleLiterals(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	go func() {
+		writeInChunks(serverConn, "* OK ready\r\n")
+		writeInChunks(serverConn, "* 1 FETCH (UID 3 BODY[HEADER] {5}\r\nfirst BODY[TEXT] {6}\r\nsecond)\r\n")
+		serverConn.Close()
+	}()
+
+	client, err := newIMAPClient(clientConn, testMaxLiteralSize)
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	response, err := client.readResponse()
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if len(response.literals) != 2 || string(response.literals[0]) != "first" || string(response.literals[1]) != "second" {
+		t.Errorf(`Unexpected literals: %q`, response.literals)
+	}
+
+	if response.text != "* 1 FETCH (UID 3 BODY[HEADER] {5}  BODY[TEXT] {6} )" {
+		t.Errorf(`Unexpected response text: %q`, response.text)
+	}
+}
+
+func TestReadResponseWithOversizedLiteral(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	go func() {
+		fmt.Fprint(serverConn, "* OK ready\r\n")
+		fmt.Fprint(serverConn, "* 1 FETCH (UID 3 BODY[] {9999999999}\r\n")
+		serverConn.Close()
+	}()
+
+	client, err := newIMAPClient(clientConn, testMaxLiteralSize)
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if _, err := client.readResponse(); err == nil {
+		t.Error(`A literal larger than the limit should be rejected`)
+	}
+}
+
+func TestReadResponseWithTruncatedLiteral(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	go func() {
+		fmt.Fprint(serverConn, "* OK ready\r\n")
+		fmt.Fprint(serverConn, "* 1 FETCH (UID 3 BODY[] {100}\r\nshort")
+		serverConn.Close()
+	}()
+
+	client, err := newIMAPClient(clientConn, testMaxLiteralSize)
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if _, err := client.readResponse(); err == nil {
+		t.Error(`A truncated literal should be rejected`)
+	}
+}
+
+func TestFetchEntriesWithRejectedLogin(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	go func() {
+		reader := bufio.NewReader(serverConn)
+		fmt.Fprint(serverConn, "* OK ready\r\n")
+		reader.ReadString('\n')
+		fmt.Fprint(serverConn, "A001 NO [AUTHENTICATIONFAILED] Invalid credentials\r\n")
+		serverConn.Close()
+	}()
+
+	client, err := newIMAPClient(clientConn, testMaxLiteralSize)
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	store := func(model.Entries) error { return nil }
+	if err := NewMailbox("imap.example.org", "me", "secret", "INBOX", "").fetchEntries(client, store); err == nil {
+		t.Error(`An error should be returned when the login is rejected`)
+	}
+}
+
+func TestMailboxURL(t *testing.T) {
+	result := MailboxURL("imap.example.org:993", "me@example.org", "INBOX")
+	expected := "imap://me%40example.org@imap.example.org:993/INBOX"
+
+	if result != expected {
+		t.Errorf(`Unexpected URL, got %q instead of %q`, result, expected)
+	}
+
+	if !IsMailboxURL(result) {
+		t.Error(`The URL should be recognized as a mailbox URL`)
+	}
+
+	if IsMailboxURL("https://example.org/feed.xml") {
+		t.Error(`The URL should not be recognized as a mailbox URL`)
+	}
+}
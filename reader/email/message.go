@@ -0,0 +1,166 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package email // import "miniflux.app/reader/email"
+
+import (
+	"bytes"
+	"encoding/base64"
+	"html"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+	"miniflux.app/reader/encoding"
+)
+
+var wordDecoder = &mime.WordDecoder{CharsetReader: encoding.CharsetReader}
+
+// parseMessage converts an email message into an entry.
+// The subject is used as title, the body as content and the sender as author.
+func parseMessage(raw []byte) (*model.Entry, error) {
+	message, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &model.Entry{Date: time.Now()}
+
+	entry.Title = message.Header.Get("Subject")
+	if title, err := wordDecoder.DecodeHeader(entry.Title); err == nil {
+		entry.Title = title
+	}
+
+	if date, err := message.Header.Date(); err == nil {
+		entry.Date = date
+	}
+
+	addressParser := &mail.AddressParser{WordDecoder: wordDecoder}
+	if from, err := addressParser.Parse(message.Header.Get("From")); err == nil {
+		entry.Author = from.Name
+		if entry.Author == "" {
+			entry.Author = from.Address
+		}
+	}
+
+	messageID := strings.Trim(message.Header.Get("Message-Id"), "<> ")
+	if messageID == "" {
+		messageID = entry.Title + entry.Date.String()
+	}
+
+	// The "mid" scheme identifies a message by its Message-ID (RFC 2392).
+	entry.URL = "mid:" + url.PathEscape(messageID)
+	entry.GUID = messageID
+	entry.Hash = crypto.Hash(messageID)
+
+	htmlContent, textContent, err := readPart(message.Header.Get("Content-Type"), message.Header.Get("Content-Transfer-Encoding"), message.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Content = htmlContent
+	if entry.Content == "" {
+		entry.Content = textToHTML(textContent)
+	}
+
+	if entry.Title == "" {
+		entry.Title = entry.Author
+	}
+
+	return entry, nil
+}
+
+// readPart returns the first HTML and plain text contents found in a message part.
+// The attachments are ignored.
+func readPart(contentType, transferEncoding string, body io.Reader) (htmlContent, textContent string, err error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "text/plain"
+		params = map[string]string{}
+	}
+
+	body = decodeTransferEncoding(transferEncoding, body)
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				return htmlContent, textContent, nil
+			}
+			if err != nil {
+				return "", "", err
+			}
+
+			if disposition, _, _ := mime.ParseMediaType(part.Header.Get("Content-Disposition")); disposition == "attachment" {
+				continue
+			}
+
+			partHTML, partText, err := readPart(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part)
+			if err != nil {
+				return "", "", err
+			}
+
+			if htmlContent == "" {
+				htmlContent = partHTML
+			}
+			if textContent == "" {
+				textContent = partText
+			}
+		}
+	}
+
+	if mediaType != "text/html" && mediaType != "text/plain" {
+		return "", "", nil
+	}
+
+	if charset := strings.ToLower(params["charset"]); charset != "" && charset != "utf-8" && charset != "us-ascii" {
+		if body, err = encoding.CharsetReader(charset, body); err != nil {
+			return "", "", err
+		}
+	}
+
+	content, err := ioutil.ReadAll(body)
+	if err != nil {
+		return "", "", err
+	}
+
+	if mediaType == "text/html" {
+		return string(content), "", nil
+	}
+
+	return "", string(content), nil
+}
+
+func decodeTransferEncoding(transferEncoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(transferEncoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+// textToHTML converts a plain text message into HTML paragraphs.
+func textToHTML(text string) string {
+	var builder strings.Builder
+	for _, paragraph := range strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph != "" {
+			builder.WriteString("<p>" + strings.Replace(html.EscapeString(paragraph), "\n", "<br>", -1) + "</p>")
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,117 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package email // import "miniflux.app/reader/email"
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParsePlainTextMessage(t *testing.T) {
+	raw := strings.Join([]string{
+		"From: Weekly News <news@example.org>",
+		"Subject: =?UTF-8?Q?Caf=C3=A9_news?=",
+		"Date: Mon, 02 Mar 2020 10:00:00 +0000",
+		"Message-Id: <1234@example.org>",
+		"Content-Type: text/plain; charset=utf-8",
+		"",
+		"Hello <World>",
+		"Second line",
+		"",
+		"Second paragraph",
+	}, "\r\n")
+
+	entry, err := parseMessage([]byte(raw))
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if entry.Title != "Café news" {
+		t.Errorf(`Unexpected title, got %q`, entry.Title)
+	}
+
+	if entry.Author != "Weekly News" {
+		t.Errorf(`Unexpected author, got %q`, entry.Author)
+	}
+
+	if !entry.Date.Equal(time.Date(2020, time.March, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected date, got %v`, entry.Date)
+	}
+
+	if entry.URL != "mid:1234@example.org" {
+		t.Errorf(`Unexpected URL, got %q`, entry.URL)
+	}
+
+	if entry.Hash == "" {
+		t.Error(`The entry hash should be set`)
+	}
+
+	expected := `<p>Hello &lt;World&gt;<br>Second line</p><p>Second paragraph</p>`
+	if entry.Content != expected {
+		t.Errorf(`Unexpected content, got %q instead of %q`, entry.Content, expected)
+	}
+}
+
+func TestParseMultipartMessage(t *testing.T) {
+	raw := strings.Join([]string{
+		"From: news@example.org",
+		"Subject: Multipart",
+		"Content-Type: multipart/mixed; boundary=outer",
+		"",
+		"--outer",
+		"Content-Type: multipart/alternative; boundary=inner",
+		"",
+		"--inner",
+		"Content-Type: text/plain",
+		"",
+		"Plain text version",
+		"--inner",
+		"Content-Type: text/html; charset=utf-8",
+		"Content-Transfer-Encoding: base64",
+		"",
+		"PHA+SFRNTCB2ZXJzaW9uPC9wPg==",
+		"--inner--",
+		"--outer",
+		"Content-Type: text/html",
+		"Content-Disposition: attachment; filename=page.html",
+		"",
+		"<p>Attachment</p>",
+		"--outer--",
+	}, "\r\n")
+
+	entry, err := parseMessage([]byte(raw))
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if entry.Content != "<p>HTML version</p>" {
+		t.Errorf(`The HTML part should be used, got %q`, entry.Content)
+	}
+
+	if entry.Author != "news@example.org" {
+		t.Errorf(`The address should be used when the sender has no name, got %q`, entry.Author)
+	}
+}
+
+func TestParseQuotedPrintableMessage(t *testing.T) {
+	raw := strings.Join([]string{
+		"Subject: Quoted",
+		"Content-Type: text/html; charset=utf-8",
+		"Content-Transfer-Encoding: quoted-printable",
+		"",
+		"<p>Caf=C3=A9 =",
+		"au lait</p>",
+	}, "\r\n")
+
+	entry, err := parseMessage([]byte(raw))
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if entry.Content != "<p>Café au lait</p>" {
+		t.Errorf(`Unexpected content, got %q`, entry.Content)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package encoding // import "miniflux.app/reader/encoding"
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// multiByteCharset describes a legacy charset used by CJK feeds.
+//
+// The decode function returns the length of the character at the beginning of the data,
+// zero when the bytes are not valid in this charset, and whether the character
+// is frequently used in the language of the charset.
+type multiByteCharset struct {
+	label  string
+	decode func(data []byte) (size int, frequent bool)
+}
+
+// The order matters: the first charset wins when several ones have the same score.
+var multiByteCharsets = []multiByteCharset{
+	{"gb18030", decodeGB18030},
+	{"shift_jis", decodeShiftJIS},
+	{"euc-jp", decodeEUCJP},
+	{"big5", decodeBig5},
+}
+
+// DetectCharset guesses the charset of a document without encoding information.
+//
+// An empty string is returned when the document is already UTF-8 or when the charset cannot be guessed.
+// Only the most common multi-byte charsets are detected, the bytes must be valid in the charset
+// and the charset with the highest number of frequent characters is chosen.
+func DetectCharset(data []byte) string {
+	if utf8.Valid(data) || hasUTF16ByteOrderMark(data) {
+		return ""
+	}
+
+	label := ""
+	bestScore := 0
+
+	for _, charset := range multiByteCharsets {
+		if score := charsetScore(data, charset); score > bestScore {
+			label = charset.label
+			bestScore = score
+		}
+	}
+
+	return label
+}
+
+func hasUTF16ByteOrderMark(data []byte) bool {
+	return bytes.HasPrefix(data, []byte{0xFE, 0xFF}) || bytes.HasPrefix(data, []byte{0xFF, 0xFE})
+}
+
+// charsetScore returns the number of frequent characters, or zero when the data is not valid in the charset.
+func charsetScore(data []byte, charset multiByteCharset) int {
+	score := 0
+
+	for i := 0; i < len(data); {
+		if data[i] < 0x80 {
+			i++
+			continue
+		}
+
+		size, frequent := charset.decode(data[i:])
+		if size == 0 {
+			return 0
+		}
+
+		if frequent {
+			score++
+		}
+
+		i += size
+	}
+
+	return score
+}
+
+func isBetween(b, min, max byte) bool {
+	return b >= min && b <= max
+}
+
+// decodeGB18030 handles GBK two-byte and GB18030 four-byte sequences.
+// The GB2312 hanzi are counted as frequent characters.
+func decodeGB18030(data []byte) (int, bool) {
+	if len(data) < 2 || !isBetween(data[0], 0x81, 0xFE) {
+		return 0, false
+	}
+
+	if isBetween(data[1], 0x30, 0x39) {
+		if len(data) < 4 || !isBetween(data[2], 0x81, 0xFE) || !isBetween(data[3], 0x30, 0x39) {
+			return 0, false
+		}
+		return 4, false
+	}
+
+	if !isBetween(data[1], 0x40, 0x7E) && !isBetween(data[1], 0x80, 0xFE) {
+		return 0, false
+	}
+
+	return 2, isBetween(data[0], 0xB0, 0xF7) && isBetween(data[1], 0xA1, 0xFE)
+}
+
+// decodeBig5 handles Big5 two-byte sequences, the hanzi are counted as frequent characters.
+func decodeBig5(data []byte) (int, bool) {
+	if len(data) < 2 || !isBetween(data[0], 0x81, 0xFE) {
+		return 0, false
+	}
+
+	if !isBetween(data[1], 0x40, 0x7E) && !isBetween(data[1], 0xA1, 0xFE) {
+		return 0, false
+	}
+
+	return 2, isBetween(data[0], 0xA4, 0xF9)
+}
+
+// decodeShiftJIS handles Shift_JIS sequences, the kana and the kanji are counted as frequent characters.
+func decodeShiftJIS(data []byte) (int, bool) {
+	// Half-width katakana, they are rarely used.
+	if isBetween(data[0], 0xA1, 0xDF) {
+		return 1, false
+	}
+
+	if len(data) < 2 || (!isBetween(data[0], 0x81, 0x9F) && !isBetween(data[0], 0xE0, 0xFC)) {
+		return 0, false
+	}
+
+	if !isBetween(data[1], 0x40, 0x7E) && !isBetween(data[1], 0x80, 0xFC) {
+		return 0, false
+	}
+
+	return 2, isBetween(data[0], 0x82, 0x83) || isBetween(data[0], 0x88, 0x9F) || isBetween(data[0], 0xE0, 0xEA)
+}
+
+// decodeEUCJP handles EUC-JP sequences, the kana and the kanji are counted as frequent characters.
+func decodeEUCJP(data []byte) (int, bool) {
+	switch {
+	case data[0] == 0x8E:
+		// Half-width katakana, they are rarely used.
+		if len(data) < 2 || !isBetween(data[1], 0xA1, 0xDF) {
+			return 0, false
+		}
+		return 2, false
+	case data[0] == 0x8F:
+		// JIS X 0212.
+		if len(data) < 3 || !isBetween(data[1], 0xA1, 0xFE) || !isBetween(data[2], 0xA1, 0xFE) {
+			return 0, false
+		}
+		return 3, false
+	case isBetween(data[0], 0xA1, 0xFE):
+		if len(data) < 2 || !isBetween(data[1], 0xA1, 0xFE) {
+			return 0, false
+		}
+		return 2, isBetween(data[0], 0xA4, 0xA5) || isBetween(data[0], 0xB0, 0xF4)
+	}
+
+	return 0, false
+}
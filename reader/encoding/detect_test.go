@@ -0,0 +1,32 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package encoding // import "miniflux.app/reader/encoding"
+
+import (
+	"testing"
+)
+
+func TestDetectCharset(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		data     []byte
+		expected string
+	}{
+		{"ascii", []byte("<title>Title</title>"), ""},
+		{"utf-8", []byte("<title>中文新闻标题</title>"), ""},
+		{"utf-16", []byte{0xFF, 0xFE, 0x3C, 0x00, 0x61, 0x00}, ""},
+		{"gb2312", []byte{0xD6, 0xD0, 0xCE, 0xC4, 0xD0, 0xC2, 0xCE, 0xC5, 0xB1, 0xEA, 0xCC, 0xE2}, "gb18030"},
+		{"big5", []byte{0xA4, 0xA4, 0xA4, 0xE5, 0xB7, 0x73, 0xBB, 0x44, 0xBC, 0xD0, 0xC3, 0x44}, "big5"},
+		{"shift_jis", []byte{0x93, 0xFA, 0x96, 0x7B, 0x82, 0xCC, 0x83, 0x6A, 0x83, 0x85, 0x81, 0x5B, 0x83, 0x58}, "shift_jis"},
+		{"euc-jp", []byte{0xC6, 0xFC, 0xCB, 0xDC, 0xA4, 0xCE, 0xA5, 0xCB, 0xA5, 0xE5, 0xA1, 0xBC, 0xA5, 0xB9}, "euc-jp"},
+		{"truncated", []byte{0x3C, 0x74, 0x3E, 0xFF}, ""},
+	}
+
+	for _, scenario := range scenarios {
+		if result := DetectCharset(scenario.data); result != scenario.expected {
+			t.Errorf(`Unexpected charset for %s, got %q instead of %q`, scenario.name, result, scenario.expected)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"regexp"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+var errInvalidFilterRule = "Invalid filter rule %q: %v"
+
+// entryFilter decides which entries are stored according to the feed block and keep rules.
+type entryFilter struct {
+	blocklist       []*regexp.Regexp
+	keeplist        []*regexp.Regexp
+	authorBlocklist []string
+	authorKeeplist  []string
+	authorSubstring bool
+}
+
+// newEntryFilter compiles the rules of the feed, one regular expression per line.
+// The author rules contain one name per line, they are compared to the entry author without regular expressions.
+func newEntryFilter(feed *model.Feed) (*entryFilter, *errors.LocalizedError) {
+	blocklist, err := compileFilterRules(feed.BlocklistRules)
+	if err != nil {
+		return nil, err
+	}
+
+	keeplist, err := compileFilterRules(feed.KeeplistRules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entryFilter{
+		blocklist:       blocklist,
+		keeplist:        keeplist,
+		authorBlocklist: parseAuthorRules(feed.AuthorBlocklistRules),
+		authorKeeplist:  parseAuthorRules(feed.AuthorKeeplistRules),
+		authorSubstring: feed.AuthorMatchMode == model.AuthorMatchSubstring,
+	}, nil
+}
+
+func compileFilterRules(rules string) ([]*regexp.Regexp, *errors.LocalizedError) {
+	var expressions []*regexp.Regexp
+
+	for _, rule := range strings.Split(rules, "\n") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+
+		expression, err := regexp.Compile(rule)
+		if err != nil {
+			return nil, errors.NewLocalizedError(errInvalidFilterRule, rule, err)
+		}
+
+		expressions = append(expressions, expression)
+	}
+
+	return expressions, nil
+}
+
+// parseAuthorRules returns the lowercase author names, the empty author rule is kept as an empty name.
+func parseAuthorRules(rules string) []string {
+	var names []string
+
+	for _, rule := range strings.Split(rules, "\n") {
+		rule = strings.TrimSpace(rule)
+		switch rule {
+		case "":
+			continue
+		case model.EmptyAuthorRule:
+			names = append(names, "")
+		default:
+			names = append(names, strings.ToLower(rule))
+		}
+	}
+
+	return names
+}
+
+// isAllowed returns false when the entry matches a block rule,
+// or when keep rules are defined and none of them matches the entry.
+// The entries without author are only blocked by the empty author rule, the author keep rules don't apply to them.
+func (f *entryFilter) isAllowed(entry *model.Entry) bool {
+	if matchEntry(f.blocklist, entry) {
+		return false
+	}
+
+	if len(f.keeplist) > 0 && !matchEntry(f.keeplist, entry) {
+		return false
+	}
+
+	author := strings.ToLower(strings.TrimSpace(entry.Author))
+	if f.matchAuthor(f.authorBlocklist, author) {
+		return false
+	}
+
+	if author != "" && len(f.authorKeeplist) > 0 && !f.matchAuthor(f.authorKeeplist, author) {
+		return false
+	}
+
+	return true
+}
+
+func (f *entryFilter) matchAuthor(names []string, author string) bool {
+	for _, name := range names {
+		// The empty author rule only matches the entries without author, and the other rules never match them.
+		if name == "" || author == "" {
+			if name == author {
+				return true
+			}
+			continue
+		}
+
+		if name == author || (f.authorSubstring && strings.Contains(author, name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchEntry(expressions []*regexp.Regexp, entry *model.Entry) bool {
+	for _, expression := range expressions {
+		if expression.MatchString(entry.Title) || expression.MatchString(entry.URL) {
+			return true
+		}
+	}
+
+	return false
+}
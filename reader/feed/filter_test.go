@@ -0,0 +1,159 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestEntryFilterWithoutRules(t *testing.T) {
+	filter, err := newEntryFilter(&model.Feed{})
+	if err != nil {
+		t.Fatalf(`Unable to create filter: %v`, err)
+	}
+
+	if !filter.isAllowed(&model.Entry{Title: "Sponsored post", URL: "https://example.org/"}) {
+		t.Error(`An entry should be allowed when no rules are defined`)
+	}
+}
+
+func TestEntryFilterWithBlocklist(t *testing.T) {
+	filter, err := newEntryFilter(&model.Feed{BlocklistRules: "(?i)sponsored\n\n  /ads/  \n"})
+	if err != nil {
+		t.Fatalf(`Unable to create filter: %v`, err)
+	}
+
+	scenarios := map[*model.Entry]bool{
+		{Title: "[SPONSORED] Buy this", URL: "https://example.org/1"}: false,
+		{Title: "Some title", URL: "https://example.org/ads/2"}:       false,
+		{Title: "Some title", URL: "https://example.org/3"}:           true,
+	}
+
+	for entry, expected := range scenarios {
+		if result := filter.isAllowed(entry); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, entry.Title, result, expected)
+		}
+	}
+}
+
+func TestEntryFilterWithKeeplist(t *testing.T) {
+	filter, err := newEntryFilter(&model.Feed{KeeplistRules: "golang\nrust"})
+	if err != nil {
+		t.Fatalf(`Unable to create filter: %v`, err)
+	}
+
+	scenarios := map[*model.Entry]bool{
+		{Title: "Release of golang 1.14", URL: "https://example.org/1"}: true,
+		{Title: "Some title", URL: "https://example.org/rust/2"}:        true,
+		{Title: "Some title", URL: "https://example.org/3"}:             false,
+	}
+
+	for entry, expected := range scenarios {
+		if result := filter.isAllowed(entry); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, entry.Title, result, expected)
+		}
+	}
+}
+
+func TestEntryFilterWithBlocklistAndKeeplist(t *testing.T) {
+	filter, err := newEntryFilter(&model.Feed{BlocklistRules: "sponsored", KeeplistRules: "golang"})
+	if err != nil {
+		t.Fatalf(`Unable to create filter: %v`, err)
+	}
+
+	if filter.isAllowed(&model.Entry{Title: "golang sponsored post"}) {
+		t.Error(`The block rules should take precedence over the keep rules`)
+	}
+
+	if !filter.isAllowed(&model.Entry{Title: "golang post"}) {
+		t.Error(`An entry matching the keep rules should be allowed`)
+	}
+}
+
+func TestEntryFilterWithInvalidRule(t *testing.T) {
+	if _, err := newEntryFilter(&model.Feed{BlocklistRules: "valid\n[invalid"}); err == nil {
+		t.Error(`An invalid block rule should return an error`)
+	}
+
+	if _, err := newEntryFilter(&model.Feed{KeeplistRules: "(invalid"}); err == nil {
+		t.Error(`An invalid keep rule should return an error`)
+	}
+}
+
+func TestEntryFilterWithAuthorBlocklist(t *testing.T) {
+	filter, err := newEntryFilter(&model.Feed{AuthorBlocklistRules: "John Doe\n\n  jane  \n"})
+	if err != nil {
+		t.Fatalf(`Unable to create filter: %v`, err)
+	}
+
+	scenarios := map[*model.Entry]bool{
+		{Title: "Post 1", Author: "john doe"}:   false,
+		{Title: "Post 2", Author: "JANE"}:       false,
+		{Title: "Post 3", Author: "Jane Smith"}: true,
+		{Title: "Post 4", Author: ""}:           true,
+	}
+
+	for entry, expected := range scenarios {
+		if result := filter.isAllowed(entry); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, entry.Title, result, expected)
+		}
+	}
+}
+
+func TestEntryFilterWithAuthorSubstringMode(t *testing.T) {
+	filter, err := newEntryFilter(&model.Feed{AuthorBlocklistRules: "jane", AuthorMatchMode: model.AuthorMatchSubstring})
+	if err != nil {
+		t.Fatalf(`Unable to create filter: %v`, err)
+	}
+
+	scenarios := map[*model.Entry]bool{
+		{Title: "Post 1", Author: "Jane Smith"}:     false,
+		{Title: "Post 2", Author: "John Doe, JANE"}: false,
+		{Title: "Post 3", Author: "John Doe"}:       true,
+		{Title: "Post 4", Author: ""}:               true,
+	}
+
+	for entry, expected := range scenarios {
+		if result := filter.isAllowed(entry); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, entry.Title, result, expected)
+		}
+	}
+}
+
+func TestEntryFilterWithAuthorKeeplist(t *testing.T) {
+	filter, err := newEntryFilter(&model.Feed{AuthorKeeplistRules: "Jane Smith"})
+	if err != nil {
+		t.Fatalf(`Unable to create filter: %v`, err)
+	}
+
+	scenarios := map[*model.Entry]bool{
+		{Title: "Post 1", Author: "jane smith"}: true,
+		{Title: "Post 2", Author: "John Doe"}:   false,
+		{Title: "Post 3", Author: ""}:           true,
+	}
+
+	for entry, expected := range scenarios {
+		if result := filter.isAllowed(entry); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, entry.Title, result, expected)
+		}
+	}
+}
+
+func TestEntryFilterWithEmptyAuthorRule(t *testing.T) {
+	filter, err := newEntryFilter(&model.Feed{AuthorBlocklistRules: `""`, AuthorMatchMode: model.AuthorMatchSubstring})
+	if err != nil {
+		t.Fatalf(`Unable to create filter: %v`, err)
+	}
+
+	if filter.isAllowed(&model.Entry{Title: "Post 1"}) {
+		t.Error(`The entries without author should be blocked by the empty author rule`)
+	}
+
+	if !filter.isAllowed(&model.Entry{Title: "Post 2", Author: "John Doe"}) {
+		t.Error(`The empty author rule should not match the entries with an author`)
+	}
+}
@@ -5,24 +5,39 @@
 package feed // import "miniflux.app/reader/feed"
 
 import (
+	"context"
 	"fmt"
 	"miniflux.app/config"
 	"miniflux.app/errors"
 	"miniflux.app/http/client"
+	"miniflux.app/integration/apprise"
+	"miniflux.app/integration/discord"
+	"miniflux.app/integration/gotify"
+	"miniflux.app/integration/ntfy"
 	"miniflux.app/integration/telegram"
+	"miniflux.app/integration/webhook"
 	"miniflux.app/locale"
 	"miniflux.app/logger"
+	"miniflux.app/metric"
 	"miniflux.app/model"
 	"miniflux.app/reader/browser"
+	"miniflux.app/reader/email"
 	"miniflux.app/reader/icon"
 	"miniflux.app/reader/parser"
 	"miniflux.app/reader/processor"
+	"miniflux.app/reader/subscription"
 	"miniflux.app/storage"
 	"miniflux.app/timer"
-	"regexp"
+	"strings"
 	"time"
 )
 
+// iconCheckInterval is the minimum delay between two revalidations of a feed icon.
+const iconCheckInterval = 24 * time.Hour
+
+// reprocessCrawlDelay is the pause between two web page downloads when the entries of a feed are processed again.
+const reprocessCrawlDelay = time.Second
+
 var (
 	errDuplicate        = "This feed already exists (%s)"
 	errNotFound         = "Feed %d not found"
@@ -31,22 +46,136 @@ var (
 
 // Handler contains all the logic to create and refresh feeds.
 type Handler struct {
-	store *storage.Storage
+	store            *storage.Storage
+	iconChecker      *iconChecker
+	translationQueue *translationQueue
 }
 
 // CreateFeed fetch, parse and store a new feed.
-func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool, userAgent, username, password, scraperRules, rewriteRules string) (*model.Feed, error) {
+//
+// When the URL is a web page instead of a feed, the feeds advertised by the page are discovered:
+// the feed is created if there is only one of them, otherwise the list is returned so the caller can pick one.
+// The download is aborted when the context is done.
+func (h *Handler) CreateFeed(ctx context.Context, userID, categoryID int64, url string, crawler bool, userAgent, cookie, proxyURL, username, password, scraperRules, rewriteRules string) (*model.Feed, subscription.Subscriptions, error) {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:CreateFeed] feedUrl=%s", url))
 
+	response, feed, subscriptions, err := h.fetchAndParseNewFeed(ctx, userID, categoryID, url, userAgent, cookie, proxyURL, username, password)
+	if err != nil || feed == nil {
+		return nil, subscriptions, err
+	}
+
+	h.initializeNewFeed(feed, response, userID, categoryID, crawler, userAgent, cookie, proxyURL, username, password, scraperRules, rewriteRules)
+	processor.ProcessFeedEntries(h.store, feed)
+
+	if storeErr := h.store.CreateFeed(feed); storeErr != nil {
+		return nil, nil, storeErr
+	}
+
+	logger.Debug("[Handler:CreateFeed] Feed saved with ID: %d", feed.ID)
+
+	h.iconChecker.push(feed.ID, feed.SiteURL, feed.IconURL, iconRequestSettings(feed))
+	h.subscribeWebSub(feed)
+	return feed, nil, nil
+}
+
+// DryRunCreateFeed fetch and parse a new feed without storing anything.
+//
+// The feeds advertised by a web page are discovered the same way as CreateFeed.
+// The entries are processed without downloading their web pages, even when the crawler is enabled.
+func (h *Handler) DryRunCreateFeed(ctx context.Context, userID, categoryID int64, url string, crawler bool, userAgent, cookie, proxyURL, username, password, scraperRules, rewriteRules string) (*model.Feed, subscription.Subscriptions, error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:DryRunCreateFeed] feedUrl=%s", url))
+
+	response, feed, subscriptions, err := h.fetchAndParseNewFeed(ctx, userID, categoryID, url, userAgent, cookie, proxyURL, username, password)
+	if err != nil || feed == nil {
+		return nil, subscriptions, err
+	}
+
+	h.initializeNewFeed(feed, response, userID, categoryID, crawler, userAgent, cookie, proxyURL, username, password, scraperRules, rewriteRules)
+	processor.PreviewFeedEntries(feed)
+	return feed, nil, nil
+}
+
+// fetchAndParseNewFeed downloads and parses a new feed.
+// The single feed advertised by a web page is downloaded instead, the list is returned when there are several of them.
+func (h *Handler) fetchAndParseNewFeed(ctx context.Context, userID, categoryID int64, url, userAgent, cookie, proxyURL, username, password string) (*client.Response, *model.Feed, subscription.Subscriptions, error) {
+	response, err := h.fetchNewFeed(ctx, userID, categoryID, url, userAgent, cookie, proxyURL, username, password)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	feed, subscriptions, discoveredURL, err := parseNewFeed(response, url)
+	if discoveredURL != "" {
+		logger.Debug("[Handler:CreateFeed] Feed discovered from %s: %s", url, discoveredURL)
+		return h.fetchAndParseNewFeed(ctx, userID, categoryID, discoveredURL, userAgent, cookie, proxyURL, username, password)
+	}
+
+	return response, feed, subscriptions, err
+}
+
+// parseNewFeed parses the response, or discovers the feeds advertised when the response is a web page.
+// The URL of the discovered feed is returned when the page advertises only one feed located elsewhere.
+func parseNewFeed(response *client.Response, url string) (*model.Feed, subscription.Subscriptions, string, error) {
+	body := response.BodyAsString()
+	feed, parseErr := parser.ParseFeed(body)
+	if parseErr == nil {
+		return feed, nil, "", nil
+	}
+
+	if !isHTMLResponse(response) {
+		return nil, nil, "", parseErr
+	}
+
+	subscriptions, findErr := subscription.FindSubscriptionsInWebPage(response.EffectiveURL, body)
+	switch {
+	case findErr != nil || len(subscriptions) == 0:
+		return nil, nil, "", parseErr
+	case len(subscriptions) == 1 && subscriptions[0].URL != url && subscriptions[0].URL != response.EffectiveURL:
+		return nil, nil, subscriptions[0].URL, nil
+	case len(subscriptions) == 1:
+		return nil, nil, "", parseErr
+	default:
+		return nil, subscriptions, "", nil
+	}
+}
+
+// initializeNewFeed applies the subscription settings to a feed that has just been downloaded.
+func (h *Handler) initializeNewFeed(feed *model.Feed, response *client.Response, userID, categoryID int64, crawler bool, userAgent, cookie, proxyURL, username, password, scraperRules, rewriteRules string) {
+	feed.UserID = userID
+	feed.WithCategoryID(categoryID)
+	feed.Category.Crawler = h.isCategoryCrawlerEnabled(userID, categoryID)
+	feed.WithBrowsingParameters(crawler, userAgent, cookie, username, password, scraperRules, rewriteRules)
+	feed.ProxyURL = proxyURL
+	feed.WithClientResponse(response)
+	feed.LastStatusCode = response.StatusCode
+	feed.CheckedNow()
+}
+
+func isHTMLResponse(response *client.Response) bool {
+	contentType := strings.ToLower(response.ContentType)
+	return strings.Contains(contentType, "text/html") || strings.Contains(contentType, "application/xhtml+xml")
+}
+
+// fetchNewFeed downloads a feed that is not yet subscribed by the user.
+func (h *Handler) fetchNewFeed(ctx context.Context, userID, categoryID int64, url, userAgent, cookie, proxyURL, username, password string) (*client.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	if !h.store.CategoryExists(userID, categoryID) {
 		return nil, errors.NewLocalizedError(errCategoryNotFound)
 	}
 
 	request := client.New(url)
 	request.WithCredentials(username, password)
-	request.WithUserAgent(userAgent)
+	request.WithUserAgent(client.ExpandUserAgent(userAgent, ""))
+	request.WithCookie(cookie)
+	request.WithProxy(proxyURL)
+	request.WithContext(ctx)
 	response, requestErr := browser.Exec(request)
 	if requestErr != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
 		return nil, requestErr
 	}
 
@@ -54,32 +183,43 @@ func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool,
 		return nil, errors.NewLocalizedError(errDuplicate, response.EffectiveURL)
 	}
 
-	subscription, parseErr := parser.ParseFeed(response.BodyAsString())
-	if parseErr != nil {
-		return nil, parseErr
-	}
-
-	subscription.UserID = userID
-	subscription.WithCategoryID(categoryID)
-	subscription.WithBrowsingParameters(crawler, userAgent, username, password, scraperRules, rewriteRules)
-	subscription.WithClientResponse(response)
-	subscription.CheckedNow()
-
-	processor.ProcessFeedEntries(h.store, subscription)
+	return response, nil
+}
 
-	if storeErr := h.store.CreateFeed(subscription); storeErr != nil {
-		return nil, storeErr
+// isCategoryCrawlerEnabled returns true when the crawler is enabled for all the feeds of the category.
+func (h *Handler) isCategoryCrawlerEnabled(userID, categoryID int64) bool {
+	category, err := h.store.Category(userID, categoryID)
+	if err != nil {
+		logger.Error("[Handler] %v", err)
+		return false
 	}
 
-	logger.Debug("[Handler:CreateFeed] Feed saved with ID: %d", subscription.ID)
+	return category != nil && category.Crawler
+}
 
-	checkFeedIcon(h.store, subscription.ID, subscription.SiteURL)
-	return subscription, nil
+// RefreshFeed fetch and update a feed if necessary, the download is aborted when the context is done.
+// A canceled refresh is not recorded as a feed error.
+func (h *Handler) RefreshFeed(ctx context.Context, userID, feedID int64) error {
+	return h.refreshFeed(ctx, userID, feedID, false)
 }
 
-// RefreshFeed fetch and update a feed if necessary.
-func (h *Handler) RefreshFeed(userID, feedID int64) error {
-	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:RefreshFeed] feedID=%d", feedID))
+// ForceRefreshFeed fetch and update a feed without sending the caching headers,
+// the feed is processed even when the server reports that it has not been modified.
+// The IgnoreHTTPCache setting of the feed is left untouched.
+func (h *Handler) ForceRefreshFeed(ctx context.Context, userID, feedID int64) error {
+	return h.refreshFeed(ctx, userID, feedID, true)
+}
+
+func (h *Handler) refreshFeed(ctx context.Context, userID, feedID int64, force bool) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	defer timer.ExecutionTime(start, fmt.Sprintf("[Handler:RefreshFeed] feedID=%d", feedID))
+	defer func() {
+		metric.ObserveFeedRefresh(feedID, time.Since(start), err)
+	}()
 	userLanguage := h.store.UserLanguage(userID)
 	printer := locale.NewPrinter(userLanguage)
 
@@ -104,50 +244,115 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 	originalFeed.CheckedNow()
 	originalFeed.ScheduleNextCheck(weeklyEntryCount)
 
-	request := client.New(originalFeed.FeedURL)
-	request.WithCredentials(originalFeed.Username, originalFeed.Password)
-	request.WithUserAgent(originalFeed.UserAgent)
+	filter, filterErr := newEntryFilter(originalFeed)
+	if filterErr != nil {
+		originalFeed.WithError(filterErr.Localize(printer))
+		h.store.UpdateFeedError(originalFeed)
+		return filterErr
+	}
 
-	if !originalFeed.IgnoreHTTPCache {
+	if email.IsMailboxURL(originalFeed.FeedURL) {
+		return h.refreshMailboxFeed(originalFeed, filter, printer)
+	}
+
+	request := client.New(originalFeed.FeedURL)
+	if originalFeed.UsesBearerToken() {
+		request.WithAuthorizationHeader("Bearer", originalFeed.AuthToken)
+	} else {
+		request.WithCredentials(originalFeed.Username, originalFeed.Password)
+	}
+	request.WithUserAgent(client.ExpandUserAgent(originalFeed.UserAgent, originalFeed.Title))
+	request.WithCookie(originalFeed.Cookie)
+	request.WithProxy(originalFeed.ProxyURL)
+	request.WithTimeout(originalFeed.RequestTimeout)
+	request.WithContext(ctx)
+
+	ignoreHTTPCache := force || originalFeed.IgnoreHTTPCache
+	if !ignoreHTTPCache {
 		request.WithCacheHeaders(originalFeed.EtagHeader, originalFeed.LastModifiedHeader)
 	}
 
-	response, requestErr := browser.Exec(request)
+	retryDelay := time.Duration(config.Opts.PollingRetryDelay()) * time.Second
+	response, requestErr := browser.ExecWithRetry(request, config.Opts.PollingRetryCount(), retryDelay)
 	if requestErr != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		// The status code is unknown when the server did not reply.
+		originalFeed.LastStatusCode = 0
+		if response != nil {
+			metric.ObserveFeedHTTPStatus(response.StatusCode)
+			originalFeed.LastStatusCode = response.StatusCode
+			originalFeed.ScheduleRetryAfter(response.RetryAfterDelay())
+		}
+
 		originalFeed.WithError(requestErr.Localize(printer))
 		h.store.UpdateFeedError(originalFeed)
 		return requestErr
 	}
 
-	if originalFeed.IgnoreHTTPCache || response.IsModified(originalFeed.EtagHeader, originalFeed.LastModifiedHeader) {
+	metric.ObserveFeedHTTPStatus(response.StatusCode)
+	originalFeed.LastStatusCode = response.StatusCode
+
+	if ignoreHTTPCache || response.IsModified(originalFeed.EtagHeader, originalFeed.LastModifiedHeader) {
 		logger.Debug("[Handler:RefreshFeed] Feed #%d has been modified", feedID)
 
-		updatedFeed, parseErr := parser.ParseFeed(response.BodyAsString())
+		body := response.BodyAsString()
+		updatedFeed, parseErr := parser.ParseFeed(body)
 		if parseErr != nil {
-			originalFeed.WithError(parseErr.Localize(printer))
+			if isTransientParseError(body, parseErr) {
+				// The last good content is kept: entries and caching headers are left untouched.
+				logger.Info("[Handler:RefreshFeed] Feed #%d: ignoring a partial document: %v", feedID, parseErr)
+				originalFeed.WithTransientError(parseErr.Localize(printer))
+			} else {
+				originalFeed.WithError(parseErr.Localize(printer))
+			}
 			h.store.UpdateFeedError(originalFeed)
 			return parseErr
 		}
 
 		originalFeed.Entries = updatedFeed.Entries
+		originalFeed.HubURL = updatedFeed.HubURL
+		originalFeed.TopicURL = updatedFeed.TopicURL
+		originalFeed.Language = updatedFeed.Language
+		if originalFeed.UpdateInterval != updatedFeed.UpdateInterval || originalFeed.TTL != updatedFeed.TTL {
+			originalFeed.UpdateInterval = updatedFeed.UpdateInterval
+			originalFeed.TTL = updatedFeed.TTL
+			originalFeed.ScheduleNextCheck(weeklyEntryCount)
+		}
 		processor.ProcessFeedEntries(h.store, originalFeed)
 
 		// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries).
-		if storeErr := updateEntries(h.store, originalFeed.UserID, originalFeed.ID, originalFeed.Entries, !originalFeed.Crawler); storeErr != nil {
+		entryHashes, storeErr := updateEntries(h.store, originalFeed, filter, !originalFeed.IsCrawlerEnabled())
+		if storeErr != nil {
 			originalFeed.WithError(storeErr.Error())
 			h.store.UpdateFeedError(originalFeed)
 			return storeErr
 		}
 
+		h.translationQueue.push(originalFeed.ID, originalFeed.TranslationLanguage)
+
+		if err := h.store.CleanupEntries(originalFeed.ID, entryHashes); err != nil {
+			logger.Error(`[Handler:RefreshFeed] feed #%d: %v`, feedID, err)
+		}
+
+		if err := h.store.PruneEntries(originalFeed.ID, originalFeed.MaxEntries); err != nil {
+			logger.Error(`[Handler:RefreshFeed] feed #%d: %v`, feedID, err)
+		}
+
 		// We update caching headers only if the feed has been modified,
 		// because some websites don't return the same headers when replying with a 304.
-		originalFeed.WithClientResponse(response)
-		checkFeedIcon(h.store, originalFeed.ID, originalFeed.SiteURL)
+		originalFeed.WithCachingHeaders(response)
+		h.updateFeedURL(originalFeed, response)
+		h.iconChecker.push(originalFeed.ID, originalFeed.SiteURL, originalFeed.IconURL, iconRequestSettings(originalFeed))
 	} else {
 		logger.Debug("[Handler:RefreshFeed] Feed #%d not modified", feedID)
 	}
 
 	originalFeed.ResetErrorCounter()
+	h.subscribeWebSub(originalFeed)
+	h.scheduleWebSubCheck(originalFeed)
 
 	if storeErr := h.store.UpdateFeed(originalFeed); storeErr != nil {
 		originalFeed.WithError(storeErr.Error())
@@ -158,62 +363,297 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 	return nil
 }
 
-// UpdateEntries updates a list of entries while refreshing a feed.
-func updateEntries(store *storage.Storage, userID, feedID int64, entries model.Entries, updateExistingEntries bool) (err error) {
-	var entryHashes []string
-	var telegramItemMsg []string
-	for _, entry := range entries {
+// updateFeedURL stores the new URL of a feed permanently moved, the URL is kept after a temporary redirect.
+func (h *Handler) updateFeedURL(feed *model.Feed, response *client.Response) {
+	if !response.IsPermanentRedirect() || response.EffectiveURL == feed.FeedURL {
+		return
+	}
+
+	if h.store.AnotherFeedURLExists(feed.UserID, feed.ID, response.EffectiveURL) {
+		logger.Info("[Handler:RefreshFeed] Feed #%d moved to %s, but this URL is already used by another feed", feed.ID, response.EffectiveURL)
+		return
+	}
+
+	logger.Info("[Handler:RefreshFeed] Feed #%d permanently moved from %s to %s", feed.ID, feed.FeedURL, response.EffectiveURL)
+	feed.FeedURL = response.EffectiveURL
+}
+
+// isTransientParseError returns true when the document uses a supported feed format but ends unexpectedly,
+// a truncated or partial response is usually fixed by the next refresh.
+// Any other parsing error is permanent and counts towards disabling the feed.
+func isTransientParseError(data string, parseErr error) bool {
+	return parser.DetectFeedFormat(data) != parser.FormatUnknown && strings.Contains(parseErr.Error(), "unexpected EOF")
+}
+
+// ReprocessFeedEntries applies the current scraper and rewrite rules of a feed to its stored entries without fetching the feed.
+// The web pages are downloaded again when the crawler is enabled, one at a time to avoid flooding the website.
+// The processing stops between two entries when the context is done.
+func (h *Handler) ReprocessFeedEntries(ctx context.Context, userID, feedID int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:ReprocessFeedEntries] feedID=%d", feedID))
+
+	feed, storeErr := h.store.FeedByID(userID, feedID)
+	if storeErr != nil {
+		return storeErr
+	}
+
+	if feed == nil {
+		return errors.NewLocalizedError(errNotFound, feedID)
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithFeedID(feedID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entries, storeErr := builder.GetEntries()
+	if storeErr != nil {
+		return storeErr
+	}
+
+	crawler := feed.IsCrawlerEnabled()
+	for i, entry := range entries {
+		if crawler && i > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(reprocessCrawlDelay):
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := processor.ReprocessEntry(feed, entry); err != nil {
+			logger.Error(`[Handler:ReprocessFeedEntries] feed #%d: unable to process entry %q: %v`, feedID, entry.URL, err)
+			continue
+		}
+
+		if err := h.store.UpdateEntryContent(entry); err != nil {
+			return err
+		}
+	}
+
+	logger.Debug("[Handler:ReprocessFeedEntries] feed #%d: %d entries processed", feedID, len(entries))
+	return nil
+}
+
+// UpdateEntries stores the entries of a feed while refreshing it and returns the hashes of the stored entries.
+// When the feed skips duplicate GUIDs, the new entries with a GUID already stored in another feed of the user are not created.
+// The entries are translated in the background afterwards, see TranslateFeedEntries.
+func updateEntries(store *storage.Storage, feed *model.Feed, filter *entryFilter, updateExistingEntries bool) (entryHashes []string, err error) {
+	userID, feedID := feed.UserID, feed.ID
+	var notificationItems []string
+	var newEntries model.Entries
+
+	deduplication := model.EntryDeduplicationDisabled
+	if user, storeErr := store.UserByID(userID); storeErr != nil {
+		logger.Error(`updateEntries: feed #%d: %v`, feedID, storeErr)
+	} else if user != nil {
+		deduplication = user.EntryDeduplication
+	}
+
+	for _, entry := range feed.Entries {
 		entry.UserID = userID
 		entry.FeedID = feedID
 
-		if store.EntryExists(entry) {
-			if updateExistingEntries {
+		if storedContentHash, found := store.EntryContentHash(entry); found {
+			if shouldUpdateEntry(entry, storedContentHash, updateExistingEntries, config.Opts.UpdateUnchangedEntries()) {
 				err = store.UpdateEntry(entry)
 			}
 		} else {
+			if !filter.isAllowed(entry) {
+				logger.Debug(`updateEntries: feed #%d: entry %q filtered out`, feedID, entry.URL)
+				continue
+			}
+
+			if feed.SkipDuplicateGUIDs && store.DuplicateGUIDExists(entry) {
+				logger.Debug(`updateEntries: feed #%d: skipping entry %q, its GUID exists in another feed`, feedID, entry.URL)
+				continue
+			}
+
+			isDuplicate := false
+			if deduplication == model.EntryDeduplicationSkip || deduplication == model.EntryDeduplicationMarkAsRead {
+				isDuplicate = store.DuplicateEntryExists(entry)
+			}
+
+			if isDuplicate && deduplication == model.EntryDeduplicationSkip {
+				// The entry is not stored, so its hash must not be kept for the cleanup.
+				logger.Debug(`updateEntries: feed #%d: skipping duplicate entry %q`, feedID, entry.URL)
+				continue
+			}
+
 			err = store.CreateEntry(entry)
 			if err == nil {
-				mkd := regexp.MustCompile("(\\[|\\*|\\`|\\_)")
-				entry.Title = mkd.ReplaceAllString(entry.Title, "\\$1")
-				tempText := fmt.Sprintf("[%v](%v)", entry.Title, entry.URL)
-				telegramItemMsg = append(telegramItemMsg, tempText)
+				metric.ObserveEntryCreated()
+			}
+
+			if err == nil && isDuplicate {
+				err = store.SetEntriesStatus(userID, []int64{entry.ID}, model.EntryStatusRead)
+			} else if err == nil {
+				tempText := fmt.Sprintf("[%v](%v)", telegram.EscapeMarkdown(entry.Title), entry.URL)
+				notificationItems = append(notificationItems, tempText)
+				newEntries = append(newEntries, entry)
 			}
 		}
 
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		entryHashes = append(entryHashes, entry.Hash)
 	}
 
-	if err := store.CleanupEntries(feedID, entryHashes); err != nil {
-		logger.Error(`updateEntries: feed #%d: %v`, feedID, err)
+	if len(newEntries) > 0 {
+		go sendNotifications(store, userID, feedID, notificationItems, newEntries)
 	}
 
-	go func() {
-		telegram.SendTelegramMsg(store, userID, feedID, telegramItemMsg)
-	}()
+	return entryHashes, nil
+}
 
-	return nil
+// sendNotifications sends the new entries to the notification services of the user.
+// The Telegram chat and the webhook defined in the category of the feed take precedence over the user settings.
+func sendNotifications(store *storage.Storage, userID, feedID int64, notificationItems []string, newEntries model.Entries) {
+	integration, err := store.Integration(userID)
+	if err != nil {
+		logger.Error("[Handler:Notifications] %v", err)
+		return
+	}
+
+	if integration == nil {
+		return
+	}
+
+	feed, err := store.FeedByID(userID, feedID)
+	if err != nil {
+		logger.Error("[Handler:Notifications] %v", err)
+		return
+	}
+
+	if feed == nil {
+		return
+	}
+
+	if feed.Category != nil && feed.Category.ID > 0 {
+		category, err := store.Category(userID, feed.Category.ID)
+		if err != nil {
+			logger.Error("[Handler:Notifications] feed #%d: %v", feedID, err)
+		} else {
+			integration = integration.ForCategory(category)
+		}
+	}
+
+	telegram.SendTelegramMsg(integration, feed, notificationItems)
+	discord.SendDiscordMsg(integration, feed, notificationItems)
+	apprise.SendAppriseMsg(integration, feed, notificationItems)
+	webhook.SendWebhook(integration, feed, newEntries)
+	gotify.SendGotifyMsg(integration, feed, newEntries)
+	ntfy.SendNtfyMsg(integration, feed, newEntries)
+}
+
+// shouldUpdateEntry returns true when an existing entry must be updated.
+// The entries with the same title and content are left untouched unless all the updates are requested.
+func shouldUpdateEntry(entry *model.Entry, storedContentHash string, updateExistingEntries, updateUnchangedEntries bool) bool {
+	if !updateExistingEntries {
+		return false
+	}
+
+	return updateUnchangedEntries || entry.ContentHash != storedContentHash
 }
 
 // NewFeedHandler returns a feed handler.
 func NewFeedHandler(store *storage.Storage) *Handler {
-	return &Handler{store}
+	checker := newIconChecker(iconQueueSize, func(feedID int64, websiteURL, iconURL string, settings icon.RequestSettings) {
+		if err := checkFeedIcon(store, feedID, websiteURL, iconURL, settings); err != nil {
+			logger.Debug("CheckFeedIcon: %v (feedID=%d websiteURL=%s)", err, feedID, websiteURL)
+		}
+	})
+
+	translations := newTranslationQueue(translationQueueSize, func(feed *model.Feed) {
+		processor.TranslateFeedEntries(context.Background(), store, feed)
+	})
+
+	return &Handler{store: store, iconChecker: checker, translationQueue: translations}
 }
 
-func checkFeedIcon(store *storage.Storage, feedID int64, websiteURL string) {
-	if !store.HasIcon(feedID) {
-		icon, err := icon.FindIcon(websiteURL)
-		if err != nil {
-			logger.Debug("CheckFeedIcon: %v (feedID=%d websiteURL=%s)", err, feedID, websiteURL)
-		} else if icon == nil {
-			logger.Debug("CheckFeedIcon: No icon found (feedID=%d websiteURL=%s)", feedID, websiteURL)
-		} else {
-			if err := store.CreateFeedIcon(feedID, icon); err != nil {
-				logger.Debug("CheckFeedIcon: %v (feedID=%d websiteURL=%s)", err, feedID, websiteURL)
+// RefreshFeedIcon downloads the icon of a feed again, ignoring the check interval and the caching headers.
+// The current icon is kept when the download fails.
+func (h *Handler) RefreshFeedIcon(userID, feedID int64) error {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:RefreshFeedIcon] feedID=%d", feedID))
+
+	feed, err := h.store.FeedByID(userID, feedID)
+	if err != nil {
+		return err
+	}
+
+	if feed == nil {
+		return errors.NewLocalizedError(errNotFound, feedID)
+	}
+
+	if err := h.store.ExpireFeedIcon(feedID); err != nil {
+		return err
+	}
+
+	return checkFeedIcon(h.store, feed.ID, feed.SiteURL, feed.IconURL, iconRequestSettings(feed))
+}
+
+// iconRequestSettings returns the network settings of the feed, so the icon is downloaded through the same proxy as the feed.
+func iconRequestSettings(feed *model.Feed) icon.RequestSettings {
+	return icon.RequestSettings{ProxyURL: feed.ProxyURL, Timeout: feed.RequestTimeout}
+}
+
+// checkFeedIcon downloads the icon URL of the feed when defined, otherwise the icon is discovered from the website.
+func checkFeedIcon(store *storage.Storage, feedID int64, websiteURL, iconURL string, settings icon.RequestSettings) error {
+	feedIcon, err := store.FeedIconByFeedID(feedID)
+	if err != nil {
+		return err
+	}
+
+	var etagHeader, lastModifiedHeader string
+	if feedIcon != nil {
+		if time.Since(feedIcon.CheckedAt) < iconCheckInterval {
+			return nil
+		}
+
+		etagHeader = feedIcon.EtagHeader
+		lastModifiedHeader = feedIcon.LastModifiedHeader
+	}
+
+	var remoteIcon *model.Icon
+	if iconURL != "" {
+		remoteIcon, err = icon.FetchIcon(iconURL, etagHeader, lastModifiedHeader, settings)
+	} else {
+		remoteIcon, err = icon.FindIcon(websiteURL, etagHeader, lastModifiedHeader, settings)
+	}
+
+	if err != nil {
+		// The current icon is kept, it is checked again after the interval instead of at each refresh.
+		if feedIcon != nil {
+			if touchErr := store.TouchFeedIcon(feedID); touchErr != nil {
+				logger.Error("CheckFeedIcon: %v (feedID=%d)", touchErr, feedID)
 			}
 		}
+		return err
+	}
+
+	if remoteIcon != nil && config.Opts.ConvertIconsToWebP() {
+		if icon.ConvertToWebP(remoteIcon, config.Opts.IconMaxSize()) {
+			logger.Debug("CheckFeedIcon: Icon converted to WebP (feedID=%d size=%d)", feedID, len(remoteIcon.Content))
+		}
+	}
+
+	switch {
+	case remoteIcon == nil && feedIcon != nil:
+		logger.Debug("CheckFeedIcon: Icon not modified (feedID=%d websiteURL=%s)", feedID, websiteURL)
+		return store.TouchFeedIcon(feedID)
+	case remoteIcon == nil:
+		logger.Debug("CheckFeedIcon: No icon found (feedID=%d websiteURL=%s)", feedID, websiteURL)
+		return nil
+	case feedIcon != nil:
+		return store.UpdateFeedIcon(feedID, remoteIcon)
+	default:
+		return store.CreateFeedIcon(feedID, remoteIcon)
 	}
 }
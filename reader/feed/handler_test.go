@@ -0,0 +1,167 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"strings"
+	"testing"
+
+	"miniflux.app/http/client"
+	"miniflux.app/model"
+	"miniflux.app/reader/parser"
+)
+
+func TestIsHTMLResponse(t *testing.T) {
+	scenarios := map[string]bool{
+		"text/html":                           true,
+		"text/html; charset=utf-8":            true,
+		"application/xhtml+xml":               true,
+		"TEXT/HTML":                           true,
+		"application/rss+xml":                 false,
+		"application/xml; charset=iso-8859-1": false,
+		"":                                    false,
+	}
+
+	for contentType, expected := range scenarios {
+		response := &client.Response{ContentType: contentType}
+		if result := isHTMLResponse(response); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, contentType, result, expected)
+		}
+	}
+}
+
+func TestShouldUpdateEntry(t *testing.T) {
+	entry := &model.Entry{Title: "Title", Content: "Content"}
+	entry.ContentHash = entry.ComputeContentHash()
+
+	scenarios := []struct {
+		storedContentHash      string
+		updateExistingEntries  bool
+		updateUnchangedEntries bool
+		expected               bool
+	}{
+		{entry.ContentHash, true, false, false},
+		{"other hash", true, false, true},
+		{"", true, false, true},
+		{entry.ContentHash, true, true, true},
+		{"other hash", false, false, false},
+		{entry.ContentHash, false, true, false},
+	}
+
+	for _, scenario := range scenarios {
+		result := shouldUpdateEntry(entry, scenario.storedContentHash, scenario.updateExistingEntries, scenario.updateUnchangedEntries)
+		if result != scenario.expected {
+			t.Errorf(`Unexpected result for %+v, got %v`, scenario, result)
+		}
+	}
+}
+
+func TestIsTransientParseError(t *testing.T) {
+	scenarios := map[string]bool{
+		`<?xml version="1.0"?><rss version="2.0"><channel><title>Example</title><item><title>Trunc`: true,
+		`<feed xmlns="http://www.w3.org/2005/Atom"><title>Example</title><entry>`:                   true,
+		`{"version": "https://jsonfeed.org/version/1", "items": [{"id": "1"`:                        true,
+		`{"version": "https://jsonfeed.org/version/1", "items": "invalid"}`:                         false,
+		`<?xml version="1.0"?><rss version="2.0"><channel><title>A <b</title></channel></rss>`:      false,
+		`<!DOCTYPE html><html><body>Service Unavailable</body></html>`:                              false,
+		``: false,
+	}
+
+	for data, expected := range scenarios {
+		_, parseErr := parser.ParseFeed(data)
+		if parseErr == nil {
+			t.Fatalf(`The document should not be parsed: %q`, data)
+		}
+
+		if result := isTransientParseError(data, parseErr); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, data, result, expected)
+		}
+	}
+}
+
+func newTestResponse(contentType, body string) *client.Response {
+	return &client.Response{
+		Body:         strings.NewReader(body),
+		StatusCode:   200,
+		EffectiveURL: "https://example.org/",
+		ContentType:  contentType,
+	}
+}
+
+func TestParseNewFeedWithFeed(t *testing.T) {
+	body := `<?xml version="1.0"?><rss version="2.0"><channel><title>Example</title><link>https://example.org/</link><item><title>Item</title><link>https://example.org/item</link></item></channel></rss>`
+
+	feed, subscriptions, discoveredURL, err := parseNewFeed(newTestResponse("application/rss+xml", body), "https://example.org/")
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if feed == nil || feed.Title != "Example" || len(feed.Entries) != 1 {
+		t.Errorf(`Unexpected feed: %+v`, feed)
+	}
+
+	if subscriptions != nil || discoveredURL != "" {
+		t.Errorf(`Nothing should be discovered, got %v and %q`, subscriptions, discoveredURL)
+	}
+}
+
+func TestParseNewFeedWithOneDiscoveredFeed(t *testing.T) {
+	body := `<html><head><link rel="alternate" type="application/rss+xml" href="/feed.xml"></head><body></body></html>`
+
+	feed, subscriptions, discoveredURL, err := parseNewFeed(newTestResponse("text/html; charset=utf-8", body), "https://example.org/")
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if feed != nil || subscriptions != nil {
+		t.Errorf(`Only the URL of the discovered feed should be returned, got %+v and %v`, feed, subscriptions)
+	}
+
+	if discoveredURL != "https://example.org/feed.xml" {
+		t.Errorf(`Unexpected discovered URL: %q`, discoveredURL)
+	}
+}
+
+func TestParseNewFeedWithSeveralDiscoveredFeeds(t *testing.T) {
+	body := `<html><head>
+		<link rel="alternate" type="application/rss+xml" href="/feed.xml">
+		<link rel="alternate" type="application/atom+xml" href="/atom.xml">
+	</head><body></body></html>`
+
+	feed, subscriptions, discoveredURL, err := parseNewFeed(newTestResponse("text/html", body), "https://example.org/")
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if feed != nil || discoveredURL != "" {
+		t.Errorf(`Only the subscriptions should be returned, got %+v and %q`, feed, discoveredURL)
+	}
+
+	if len(subscriptions) != 2 {
+		t.Errorf(`Two subscriptions should be discovered, got %d`, len(subscriptions))
+	}
+}
+
+func TestParseNewFeedWithoutDiscovery(t *testing.T) {
+	scenarios := []struct {
+		contentType string
+		body        string
+	}{
+		{"application/json", `not a feed`},
+		{"text/html", `<html><head></head><body></body></html>`},
+		{"text/html", `<html><head><link rel="alternate" type="application/rss+xml" href="/"></head><body></body></html>`},
+	}
+
+	for _, scenario := range scenarios {
+		feed, subscriptions, discoveredURL, err := parseNewFeed(newTestResponse(scenario.contentType, scenario.body), "https://example.org/")
+		if err == nil {
+			t.Errorf(`An error should be returned for %q`, scenario.body)
+		}
+
+		if feed != nil || subscriptions != nil || discoveredURL != "" {
+			t.Errorf(`Nothing should be returned for %q`, scenario.body)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"sync"
+
+	"miniflux.app/logger"
+	"miniflux.app/reader/icon"
+)
+
+// iconQueueSize is the maximum number of pending icon checks.
+const iconQueueSize = 1000
+
+type iconCheckRequest struct {
+	feedID     int64
+	websiteURL string
+	iconURL    string
+	settings   icon.RequestSettings
+}
+
+// iconChecker checks feed icons in the background, so the refresh of a feed does not wait for the icon download.
+type iconChecker struct {
+	mutex   sync.Mutex
+	pending map[int64]bool
+	queue   chan iconCheckRequest
+	check   func(feedID int64, websiteURL, iconURL string, settings icon.RequestSettings)
+}
+
+// push queues an icon check, unless a check is already pending for the same feed.
+// The request is dropped when the queue is full, the icon will be checked during the next refresh.
+func (c *iconChecker) push(feedID int64, websiteURL, iconURL string, settings icon.RequestSettings) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.pending[feedID] {
+		return
+	}
+
+	select {
+	case c.queue <- iconCheckRequest{feedID: feedID, websiteURL: websiteURL, iconURL: iconURL, settings: settings}:
+		c.pending[feedID] = true
+	default:
+		logger.Error("[IconChecker] Queue is full, icon check skipped for feed #%d", feedID)
+	}
+}
+
+func (c *iconChecker) run() {
+	for request := range c.queue {
+		c.mutex.Lock()
+		delete(c.pending, request.feedID)
+		c.mutex.Unlock()
+
+		c.check(request.feedID, request.websiteURL, request.iconURL, request.settings)
+	}
+}
+
+func newIconChecker(queueSize int, check func(feedID int64, websiteURL, iconURL string, settings icon.RequestSettings)) *iconChecker {
+	checker := &iconChecker{
+		pending: make(map[int64]bool),
+		queue:   make(chan iconCheckRequest, queueSize),
+		check:   check,
+	}
+
+	go checker.run()
+	return checker
+}
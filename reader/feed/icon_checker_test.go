@@ -0,0 +1,65 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/reader/icon"
+)
+
+func TestIconCheckerDeduplicatesPendingChecks(t *testing.T) {
+	started := make(chan int64)
+	release := make(chan struct{})
+
+	checker := newIconChecker(10, func(feedID int64, websiteURL, iconURL string, settings icon.RequestSettings) {
+		started <- feedID
+		<-release
+	})
+
+	// The first check blocks the worker, the next requests stay in the queue.
+	checker.push(1, "https://example.org/", "", icon.RequestSettings{})
+	<-started
+
+	checker.push(2, "https://example.com/", "", icon.RequestSettings{})
+	checker.push(2, "https://example.com/", "", icon.RequestSettings{})
+	checker.push(1, "https://example.org/", "", icon.RequestSettings{})
+
+	close(release)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatal(`The queued icon checks should be processed`)
+		}
+	}
+
+	select {
+	case feedID := <-started:
+		t.Fatalf(`Unexpected duplicated icon check for feed #%d`, feedID)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestIconCheckerDropsChecksWhenQueueIsFull(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	checker := newIconChecker(1, func(feedID int64, websiteURL, iconURL string, settings icon.RequestSettings) {
+		<-release
+	})
+
+	checker.push(1, "https://example.org/", "", icon.RequestSettings{})
+	checker.push(2, "https://example.org/", "", icon.RequestSettings{})
+	checker.push(3, "https://example.org/", "", icon.RequestSettings{})
+
+	checker.mutex.Lock()
+	defer checker.mutex.Unlock()
+
+	if len(checker.pending) > 2 {
+		t.Errorf(`The icon checks should be dropped when the queue is full, got %d pending checks`, len(checker.pending))
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"fmt"
+
+	"miniflux.app/errors"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/email"
+	"miniflux.app/reader/processor"
+)
+
+var errMailboxDisabled = "The IMAP integration is disabled for this feed"
+
+// CreateMailboxFeed creates the feed receiving the emails of the IMAP integration, unless it already exists.
+// The ID of the feed is saved in the integration settings.
+func (h *Handler) CreateMailboxFeed(integration *model.Integration) error {
+	if integration.IMAPFeedID > 0 && h.store.FeedExists(integration.UserID, integration.IMAPFeedID) {
+		return nil
+	}
+
+	category, err := h.store.FirstCategory(integration.UserID)
+	if err != nil {
+		return err
+	}
+
+	if category == nil {
+		return errors.NewLocalizedError(errCategoryNotFound)
+	}
+
+	feedURL := email.MailboxURL(integration.IMAPServer, integration.IMAPUsername, integration.IMAPMailbox)
+	feed := &model.Feed{
+		UserID:  integration.UserID,
+		FeedURL: feedURL,
+		SiteURL: feedURL,
+		Title:   fmt.Sprintf("%s (%s)", integration.IMAPUsername, integration.IMAPMailbox),
+	}
+	feed.WithCategoryID(category.ID)
+
+	if err := h.store.CreateFeed(feed); err != nil {
+		return err
+	}
+
+	integration.IMAPFeedID = feed.ID
+	return nil
+}
+
+// refreshMailboxFeed imports the unseen emails of the mailbox configured in the IMAP integration of the user.
+func (h *Handler) refreshMailboxFeed(feed *model.Feed, filter *entryFilter, printer *locale.Printer) error {
+	integration, storeErr := h.store.Integration(feed.UserID)
+	if storeErr != nil {
+		return storeErr
+	}
+
+	if !integration.IMAPEnabled || integration.IMAPFeedID != feed.ID {
+		disabledErr := errors.NewLocalizedError(errMailboxDisabled)
+		feed.WithError(disabledErr.Localize(printer))
+		h.store.UpdateFeedError(feed)
+		return disabledErr
+	}
+
+	mailbox := email.NewMailbox(integration.IMAPServer, integration.IMAPUsername, integration.IMAPPassword, integration.IMAPMailbox, integration.IMAPSenderFilter)
+	fetchErr := mailbox.FetchEntries(func(entries model.Entries) error {
+		feed.Entries = entries
+		processor.ProcessFeedEntries(h.store, feed)
+
+		// The messages are downloaded only once, there is nothing to clean up or update afterwards.
+		_, storeErr := updateEntries(h.store, feed, filter, false)
+		return storeErr
+	})
+	if fetchErr != nil {
+		feed.WithError(fetchErr.Error())
+		h.store.UpdateFeedError(feed)
+		return fetchErr
+	}
+
+	if err := h.store.PruneEntries(feed.ID, feed.MaxEntries); err != nil {
+		logger.Error(`[Handler:RefreshFeed] feed #%d: %v`, feed.ID, err)
+	}
+
+	h.translationQueue.push(feed.ID, feed.TranslationLanguage)
+
+	feed.ResetErrorCounter()
+	if storeErr := h.store.UpdateFeed(feed); storeErr != nil {
+		feed.WithError(storeErr.Error())
+		h.store.UpdateFeedError(feed)
+		return storeErr
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"sync"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+// translationQueueSize is the maximum number of feeds waiting for the translation of their entries.
+const translationQueueSize = 1000
+
+// translationQueue translates the entries of the refreshed feeds in the background,
+// so the refresh workers never wait for the translation API.
+type translationQueue struct {
+	mutex     sync.Mutex
+	pending   map[int64]bool
+	queue     chan *model.Feed
+	translate func(feed *model.Feed)
+}
+
+// push queues the translation of the feed entries, unless the feed has no translation language or is already queued.
+// The request is dropped when the queue is full, the entries will be translated after the next refresh.
+func (q *translationQueue) push(feedID int64, translationLanguage string) {
+	if translationLanguage == "" {
+		return
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.pending[feedID] {
+		return
+	}
+
+	select {
+	case q.queue <- &model.Feed{ID: feedID, TranslationLanguage: translationLanguage}:
+		q.pending[feedID] = true
+	default:
+		logger.Error("[TranslationQueue] Queue is full, translation skipped for feed #%d", feedID)
+	}
+}
+
+func (q *translationQueue) run() {
+	for feed := range q.queue {
+		q.mutex.Lock()
+		delete(q.pending, feed.ID)
+		q.mutex.Unlock()
+
+		q.translate(feed)
+	}
+}
+
+func newTranslationQueue(queueSize int, translate func(feed *model.Feed)) *translationQueue {
+	queue := &translationQueue{
+		pending:   make(map[int64]bool),
+		queue:     make(chan *model.Feed, queueSize),
+		translate: translate,
+	}
+
+	go queue.run()
+	return queue
+}
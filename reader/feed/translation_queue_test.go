@@ -0,0 +1,62 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestTranslationQueueDeduplicatesPendingFeeds(t *testing.T) {
+	started := make(chan *model.Feed)
+	release := make(chan struct{})
+
+	queue := newTranslationQueue(10, func(feed *model.Feed) {
+		started <- feed
+		<-release
+	})
+
+	// The first translation blocks the worker, the next requests stay in the queue.
+	queue.push(1, "fr")
+	if feed := <-started; feed.ID != 1 || feed.TranslationLanguage != "fr" {
+		t.Fatalf(`Unexpected feed: %+v`, feed)
+	}
+
+	queue.push(2, "de")
+	queue.push(2, "de")
+	queue.push(1, "fr")
+
+	close(release)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatal(`The queued translations should be processed`)
+		}
+	}
+
+	select {
+	case feed := <-started:
+		t.Fatalf(`Unexpected duplicated translation for feed #%d`, feed.ID)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestTranslationQueueIgnoresFeedsWithoutLanguage(t *testing.T) {
+	queue := newTranslationQueue(10, func(feed *model.Feed) {
+		t.Errorf(`Unexpected translation for feed #%d`, feed.ID)
+	})
+
+	queue.push(1, "")
+
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if len(queue.pending) != 0 {
+		t.Errorf(`The feed should not be queued, got %d pending feeds`, len(queue.pending))
+	}
+}
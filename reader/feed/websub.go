@@ -0,0 +1,144 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package feed // import "miniflux.app/reader/feed"
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/crypto"
+	"miniflux.app/errors"
+	"miniflux.app/http/client"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/parser"
+	"miniflux.app/reader/processor"
+	"miniflux.app/timer"
+)
+
+// PushFeed stores the entries of a feed received from its WebSub hub.
+func (h *Handler) PushFeed(userID, feedID int64, body string) error {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:PushFeed] feedID=%d", feedID))
+	printer := locale.NewPrinter(h.store.UserLanguage(userID))
+
+	originalFeed, storeErr := h.store.FeedByID(userID, feedID)
+	if storeErr != nil {
+		return storeErr
+	}
+
+	if originalFeed == nil {
+		return errors.NewLocalizedError(errNotFound, feedID)
+	}
+
+	if originalFeed.Disabled {
+		logger.Debug("[Handler:PushFeed] Feed #%d is disabled, the content is ignored", feedID)
+		return nil
+	}
+
+	updatedFeed, parseErr := parser.ParseFeed(body)
+	if parseErr != nil {
+		return parseErr
+	}
+
+	filter, filterErr := newEntryFilter(originalFeed)
+	if filterErr != nil {
+		originalFeed.WithError(filterErr.Localize(printer))
+		h.store.UpdateFeedError(originalFeed)
+		return filterErr
+	}
+
+	originalFeed.Entries = updatedFeed.Entries
+	processor.ProcessFeedEntries(h.store, originalFeed)
+
+	// Hubs may only send the new entries, so the entries missing from the payload are not cleaned up.
+	if _, storeErr := updateEntries(h.store, originalFeed, filter, !originalFeed.IsCrawlerEnabled()); storeErr != nil {
+		return storeErr
+	}
+
+	h.translationQueue.push(originalFeed.ID, originalFeed.TranslationLanguage)
+
+	return nil
+}
+
+// subscribeWebSub asks the hub of the feed to push new content, unless the current subscription is still valid.
+func (h *Handler) subscribeWebSub(feed *model.Feed) {
+	if !config.Opts.HasWebSub() || feed.HubURL == "" || feed.TopicURL == "" {
+		return
+	}
+
+	subscription, err := h.store.WebSubSubscription(feed.ID)
+	if err != nil {
+		logger.Error("[Handler:WebSub] %v", err)
+		return
+	}
+
+	if subscription != nil && !subscription.NeedsRenewal() {
+		return
+	}
+
+	// The secret and the callback token are kept while renewing, the hub could still push content with the previous ones.
+	if subscription == nil {
+		subscription = &model.WebSubSubscription{
+			FeedID: feed.ID,
+			UserID: feed.UserID,
+			Secret: crypto.GenerateRandomStringHex(32),
+			Token:  crypto.GenerateRandomStringHex(16),
+		}
+	}
+
+	if err := h.store.CreateWebSubSubscription(subscription); err != nil {
+		logger.Error("[Handler:WebSub] %v", err)
+		return
+	}
+
+	values := url.Values{}
+	values.Set("hub.callback", webSubCallbackURL(feed.ID, subscription.Token))
+	values.Set("hub.mode", "subscribe")
+	values.Set("hub.topic", feed.TopicURL)
+	values.Set("hub.secret", subscription.Secret)
+
+	response, requestErr := client.New(feed.HubURL).PostForm(values)
+	if requestErr != nil {
+		logger.Error("[Handler:WebSub] feed #%d: unable to subscribe to %s: %v", feed.ID, feed.HubURL, requestErr)
+		return
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		logger.Error("[Handler:WebSub] feed #%d: the hub %s refused the subscription (status=%d)", feed.ID, feed.HubURL, response.StatusCode)
+		return
+	}
+
+	logger.Debug("[Handler:WebSub] feed #%d: subscription requested to %s", feed.ID, feed.HubURL)
+}
+
+// scheduleWebSubCheck delays the next poll of a feed that receives push notifications.
+// The feed is still polled once per maximum interval, and before the lease expires to renew the subscription.
+func (h *Handler) scheduleWebSubCheck(feed *model.Feed) {
+	if !config.Opts.HasWebSub() || feed.HubURL == "" {
+		return
+	}
+
+	subscription, err := h.store.WebSubSubscription(feed.ID)
+	if err != nil || subscription == nil || !subscription.IsActive() {
+		return
+	}
+
+	nextCheckAt := time.Now().Add(time.Duration(config.Opts.SchedulerEntryFrequencyMaxInterval()) * time.Minute)
+	if renewAt := subscription.RenewAt(); renewAt.Before(nextCheckAt) {
+		nextCheckAt = renewAt
+	}
+
+	if nextCheckAt.After(feed.NextCheckAt) {
+		feed.NextCheckAt = nextCheckAt
+	}
+}
+
+// webSubCallbackURL contains a random token, so only the hub knows where to confirm the subscription.
+func webSubCallbackURL(feedID int64, token string) string {
+	return fmt.Sprintf("%s/websub/%d/%s", config.Opts.BaseURL(), feedID, token)
+}
@@ -0,0 +1,10 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package greader imports the subscriptions and the read state exported from Google Reader and compatible services.
+
+*/
+package greader // import "miniflux.app/reader/greader"
@@ -0,0 +1,101 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package greader // import "miniflux.app/reader/greader"
+
+import "strings"
+
+// Stream IDs of the Google Reader states.
+const (
+	streamFeedPrefix   = "feed/"
+	stateReadSuffix    = "/state/com.google/read"
+	stateStarredSuffix = "/state/com.google/starred"
+)
+
+// export is a Google Reader JSON document, it combines the subscription list and the items of a stream.
+type export struct {
+	Subscriptions []*exportSubscription `json:"subscriptions"`
+	Items         []*exportItem         `json:"items"`
+}
+
+type exportSubscription struct {
+	ID         string            `json:"id"`
+	Title      string            `json:"title"`
+	HTMLURL    string            `json:"htmlUrl"`
+	Categories []*exportCategory `json:"categories"`
+}
+
+type exportCategory struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+}
+
+type exportItem struct {
+	Title      string        `json:"title"`
+	Categories []string      `json:"categories"`
+	Canonical  []*exportLink `json:"canonical"`
+	Alternate  []*exportLink `json:"alternate"`
+	Origin     struct {
+		StreamID string `json:"streamId"`
+	} `json:"origin"`
+}
+
+type exportLink struct {
+	Href string `json:"href"`
+}
+
+func (s *exportSubscription) feedURL() string {
+	return strings.TrimPrefix(s.ID, streamFeedPrefix)
+}
+
+func (s *exportSubscription) categoryName() string {
+	for _, category := range s.Categories {
+		if category.Label != "" {
+			return category.Label
+		}
+	}
+
+	return ""
+}
+
+func (i *exportItem) entryURL() string {
+	for _, links := range [][]*exportLink{i.Canonical, i.Alternate} {
+		for _, link := range links {
+			if link.Href != "" {
+				return link.Href
+			}
+		}
+	}
+
+	return ""
+}
+
+func (i *exportItem) hasState(suffix string) bool {
+	for _, category := range i.Categories {
+		if strings.HasSuffix(category, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Subscription represents a feed of the export.
+type Subscription struct {
+	Title        string `json:"title"`
+	SiteURL      string `json:"site_url"`
+	FeedURL      string `json:"feed_url"`
+	CategoryName string `json:"category"`
+}
+
+// SubscriptionList is a list of subscriptions.
+type SubscriptionList []*Subscription
+
+// EntryState represents the read and starred state of an exported item.
+type EntryState struct {
+	FeedURL  string
+	EntryURL string
+	Read     bool
+	Starred  bool
+}
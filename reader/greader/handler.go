@@ -0,0 +1,167 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package greader // import "miniflux.app/reader/greader"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+// Handler handles the import of Google Reader exports.
+type Handler struct {
+	store *storage.Storage
+}
+
+// ImportSummary reports the subscriptions created, skipped or failed during an import,
+// and the number of entry states applied, queued or ignored.
+type ImportSummary struct {
+	Created        SubscriptionList `json:"created"`
+	Skipped        SubscriptionList `json:"skipped"`
+	Failed         SubscriptionList `json:"failed"`
+	EntriesUpdated int              `json:"entries_updated"`
+	EntriesQueued  int              `json:"entries_queued"`
+	EntriesIgnored int              `json:"entries_ignored"`
+}
+
+// Import creates the feeds of a Google Reader export and applies the read and starred state of the items.
+//
+// The states of the entries not received yet are queued and applied when the entries are created by the next refreshes.
+// The states of the items that don't belong to a feed of the user are ignored.
+func (h *Handler) Import(userID int64, data io.Reader) (*ImportSummary, error) {
+	subscriptions, states, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultCategory, err := h.store.FirstCategory(userID)
+	if err != nil {
+		logger.Error("[GoogleReader:Import] %v", err)
+		return nil, errors.New("unable to find first category")
+	}
+
+	summary := &ImportSummary{
+		Created: SubscriptionList{},
+		Skipped: SubscriptionList{},
+		Failed:  SubscriptionList{},
+	}
+	categories := make(map[string]*model.Category)
+
+	for _, subscription := range subscriptions {
+		if h.store.FeedURLExists(userID, subscription.FeedURL) {
+			summary.Skipped = append(summary.Skipped, subscription)
+			continue
+		}
+
+		category := defaultCategory
+		if subscription.CategoryName != "" {
+			category, err = h.findOrCreateCategory(userID, subscription.CategoryName, categories)
+			if err != nil {
+				logger.Error("[GoogleReader:Import] %v", err)
+				summary.Failed = append(summary.Failed, subscription)
+				continue
+			}
+		}
+
+		feed := &model.Feed{
+			UserID:   userID,
+			Title:    subscription.Title,
+			FeedURL:  subscription.FeedURL,
+			SiteURL:  subscription.SiteURL,
+			Category: category,
+		}
+
+		if err := h.store.CreateFeed(feed); err != nil {
+			logger.Error("[GoogleReader:Import] %v", err)
+			summary.Failed = append(summary.Failed, subscription)
+			continue
+		}
+
+		summary.Created = append(summary.Created, subscription)
+	}
+
+	if err := h.importEntryStates(userID, states, summary); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+func (h *Handler) importEntryStates(userID int64, states []*EntryState, summary *ImportSummary) error {
+	if len(states) == 0 {
+		return nil
+	}
+
+	feeds, err := h.store.Feeds(userID)
+	if err != nil {
+		return err
+	}
+
+	feedIDs := make(map[string]int64, len(feeds))
+	for _, feed := range feeds {
+		feedIDs[feed.FeedURL] = feed.ID
+	}
+
+	for _, state := range states {
+		feedID, found := feedIDs[state.FeedURL]
+		if !found {
+			summary.EntriesIgnored++
+			continue
+		}
+
+		updated, err := h.store.ApplyEntryState(userID, feedID, state.EntryURL, state.Read, state.Starred)
+		if err != nil {
+			return err
+		}
+
+		if updated {
+			summary.EntriesUpdated++
+			continue
+		}
+
+		if err := h.store.QueueEntryState(userID, feedID, state.EntryURL, state.Read, state.Starred); err != nil {
+			return err
+		}
+
+		summary.EntriesQueued++
+	}
+
+	return nil
+}
+
+// findOrCreateCategory returns the user category with the given title and creates it when missing.
+func (h *Handler) findOrCreateCategory(userID int64, title string, categories map[string]*model.Category) (*model.Category, error) {
+	if category, found := categories[title]; found {
+		return category, nil
+	}
+
+	category, err := h.store.CategoryByTitle(userID, title)
+	if err != nil {
+		return nil, fmt.Errorf(`unable to search category by title %q: %v`, title, err)
+	}
+
+	if category == nil {
+		category = &model.Category{
+			UserID: userID,
+			Title:  title,
+		}
+
+		if err := h.store.CreateCategory(category); err != nil {
+			return nil, fmt.Errorf(`unable to create this category %q: %v`, title, err)
+		}
+	}
+
+	categories[title] = category
+	return category, nil
+}
+
+// NewHandler creates a new handler for Google Reader exports.
+func NewHandler(store *storage.Storage) *Handler {
+	return &Handler{store: store}
+}
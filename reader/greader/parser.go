@@ -0,0 +1,57 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package greader // import "miniflux.app/reader/greader"
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Parse reads a Google Reader JSON export and returns the subscriptions and the state of the items.
+// The items that are neither read nor starred, or without feed or link, are ignored.
+func Parse(data io.Reader) (SubscriptionList, []*EntryState, error) {
+	var document export
+	if err := json.NewDecoder(data).Decode(&document); err != nil {
+		return nil, nil, fmt.Errorf("greader: unable to parse document: %v", err)
+	}
+
+	var subscriptions SubscriptionList
+	for _, subscription := range document.Subscriptions {
+		if !strings.HasPrefix(subscription.ID, streamFeedPrefix) {
+			continue
+		}
+
+		subscriptions = append(subscriptions, &Subscription{
+			Title:        subscription.Title,
+			SiteURL:      subscription.HTMLURL,
+			FeedURL:      subscription.feedURL(),
+			CategoryName: subscription.categoryName(),
+		})
+	}
+
+	var states []*EntryState
+	for _, item := range document.Items {
+		state := &EntryState{
+			FeedURL:  strings.TrimPrefix(item.Origin.StreamID, streamFeedPrefix),
+			EntryURL: item.entryURL(),
+			Read:     item.hasState(stateReadSuffix),
+			Starred:  item.hasState(stateStarredSuffix),
+		}
+
+		if !strings.HasPrefix(item.Origin.StreamID, streamFeedPrefix) || state.EntryURL == "" || (!state.Read && !state.Starred) {
+			continue
+		}
+
+		states = append(states, state)
+	}
+
+	if len(subscriptions) == 0 && len(states) == 0 {
+		return nil, nil, fmt.Errorf("greader: no subscription or item found")
+	}
+
+	return subscriptions, states, nil
+}
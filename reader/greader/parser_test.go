@@ -0,0 +1,76 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package greader // import "miniflux.app/reader/greader"
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestParseExport(t *testing.T) {
+	f, err := os.Open("testdata/export.json")
+	if err != nil {
+		t.Fatalf(`Unable to open file: %v`, err)
+	}
+	defer f.Close()
+
+	subscriptions, states, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscriptions) != 2 {
+		t.Fatalf(`Wrong number of subscriptions: %d instead of %d`, len(subscriptions), 2)
+	}
+
+	expected := Subscription{Title: "Example", SiteURL: "https://example.org/", FeedURL: "https://example.org/feed.xml", CategoryName: "Tech"}
+	if *subscriptions[0] != expected {
+		t.Errorf(`Subscriptions are different: "%v" vs "%v"`, subscriptions[0], expected)
+	}
+
+	if subscriptions[1].CategoryName != "" {
+		t.Errorf(`The subscription should not have a category, got %q`, subscriptions[1].CategoryName)
+	}
+
+	if len(states) != 2 {
+		t.Fatalf(`Wrong number of states: %d instead of %d`, len(states), 2)
+	}
+
+	expectedState := EntryState{FeedURL: "https://example.org/feed.xml", EntryURL: "https://example.org/read", Read: true}
+	if *states[0] != expectedState {
+		t.Errorf(`States are different: "%v" vs "%v"`, states[0], expectedState)
+	}
+
+	expectedState = EntryState{FeedURL: "https://news.example.com/rss", EntryURL: "https://news.example.com/starred", Read: true, Starred: true}
+	if *states[1] != expectedState {
+		t.Errorf(`States are different: "%v" vs "%v"`, states[1], expectedState)
+	}
+}
+
+func TestParseStarredItemsOnly(t *testing.T) {
+	data := `{"id": "user/-/state/com.google/starred", "items": [{"categories": ["user/-/state/com.google/starred"], "alternate": [{"href": "https://example.org/a"}], "origin": {"streamId": "feed/https://example.org/feed.xml"}}]}`
+
+	subscriptions, states, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscriptions) != 0 {
+		t.Errorf(`Wrong number of subscriptions: %d`, len(subscriptions))
+	}
+
+	if len(states) != 1 || !states[0].Starred || states[0].Read {
+		t.Errorf(`The item should be starred and unread: %v`, states)
+	}
+}
+
+func TestParseInvalidExport(t *testing.T) {
+	for _, data := range []string{`not json`, `{}`, `{"items": [{"title": "Unread"}]}`} {
+		if _, _, err := Parse(bytes.NewBufferString(data)); err == nil {
+			t.Errorf(`Parsing %q should fail`, data)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package icon // import "miniflux.app/reader/icon"
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"miniflux.app/url"
+)
+
+// maxIconSize is the largest icon size in pixels considered reasonable, bigger images are only used as a last resort.
+const maxIconSize = 256
+
+// List of icon sources, from the most to the least preferred.
+const (
+	iconSourceDocument = iota
+	iconSourceFavicon
+	iconSourceOpenGraph
+)
+
+type iconCandidate struct {
+	URL    string
+	Size   int
+	Source int
+}
+
+type iconCandidates []*iconCandidate
+
+// add appends a candidate resolved against the base URL, invalid or duplicated URLs are ignored.
+func (c *iconCandidates) add(baseURL, iconURL string, size, source int) {
+	iconURL = strings.TrimSpace(iconURL)
+	if iconURL == "" {
+		return
+	}
+
+	if !strings.HasPrefix(iconURL, "data:") {
+		absoluteURL, err := url.AbsoluteURL(baseURL, iconURL)
+		if err != nil {
+			return
+		}
+		iconURL = absoluteURL
+	}
+
+	for _, candidate := range *c {
+		if candidate.URL == iconURL {
+			if size > candidate.Size {
+				candidate.Size = size
+			}
+			return
+		}
+	}
+
+	*c = append(*c, &iconCandidate{URL: iconURL, Size: size, Source: source})
+}
+
+// ranked returns the candidate URLs sorted by preference.
+//
+// Icons declared by the document come first, the largest ones up to maxIconSize being preferred,
+// followed by oversized icons from the smallest to the largest and icons of unknown size.
+// The default favicon comes next, the Open Graph image is used only when nothing else is available.
+func (c iconCandidates) ranked() []string {
+	sorted := make(iconCandidates, len(c))
+	copy(sorted, c)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Source != b.Source {
+			return a.Source < b.Source
+		}
+
+		if sizeGroup(a.Size) != sizeGroup(b.Size) {
+			return sizeGroup(a.Size) < sizeGroup(b.Size)
+		}
+
+		if a.Size > maxIconSize {
+			return a.Size < b.Size
+		}
+
+		return a.Size > b.Size
+	})
+
+	urls := make([]string, len(sorted))
+	for i, candidate := range sorted {
+		urls[i] = candidate.URL
+	}
+
+	return urls
+}
+
+func sizeGroup(size int) int {
+	switch {
+	case size == 0:
+		return 2
+	case size > maxIconSize:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// parseIconSize returns the largest size declared by a "sizes" attribute, 0 when unknown.
+// Scalable icons declared with "any" are considered as large as maxIconSize.
+func parseIconSize(sizes string) int {
+	largest := 0
+	for _, value := range strings.Fields(strings.ToLower(sizes)) {
+		if value == "any" {
+			return maxIconSize
+		}
+
+		parts := strings.Split(value, "x")
+		if len(parts) != 2 {
+			continue
+		}
+
+		width, err := strconv.Atoi(parts[0])
+		if err != nil {
+			continue
+		}
+
+		height, err := strconv.Atoi(parts[1])
+		if err != nil {
+			continue
+		}
+
+		if size := minInt(width, height); size > largest {
+			largest = size
+		}
+	}
+
+	return largest
+}
+
+// guessIconSize returns the size of an icon declared by a link element.
+func guessIconSize(rel, sizes, mimeType string) int {
+	if size := parseIconSize(sizes); size > 0 {
+		return size
+	}
+
+	if strings.Contains(strings.ToLower(mimeType), "svg") {
+		return maxIconSize
+	}
+
+	// Apple touch icons are 180x180 pixels when the size is not specified.
+	if strings.HasPrefix(rel, "apple-touch-icon") {
+		return 180
+	}
+
+	return 0
+}
+
+type webManifest struct {
+	Icons []struct {
+		Source string `json:"src"`
+		Sizes  string `json:"sizes"`
+		Type   string `json:"type"`
+	} `json:"icons"`
+}
+
+// parseManifest returns the icons declared by a web application manifest.
+func parseManifest(manifestURL string, data io.Reader) (iconCandidates, error) {
+	var manifest webManifest
+	if err := json.NewDecoder(data).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("unable to parse web manifest: %v", err)
+	}
+
+	var candidates iconCandidates
+	for _, manifestIcon := range manifest.Icons {
+		candidates.add(manifestURL, manifestIcon.Source, guessIconSize("", manifestIcon.Sizes, manifestIcon.Type), iconSourceDocument)
+	}
+
+	return candidates, nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
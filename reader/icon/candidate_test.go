@@ -0,0 +1,129 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package icon // import "miniflux.app/reader/icon"
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseIconSize(t *testing.T) {
+	scenarios := map[string]int{
+		"":                    0,
+		"16x16":               16,
+		"16x16 32x32 192x192": 192,
+		"180X180":             180,
+		"32x64":               32,
+		"any":                 maxIconSize,
+		"invalid 48x48":       48,
+		"x32":                 0,
+	}
+
+	for input, expected := range scenarios {
+		if result := parseIconSize(input); result != expected {
+			t.Errorf(`Unexpected size for %q, got %d instead of %d`, input, result, expected)
+		}
+	}
+}
+
+func TestGuessIconSize(t *testing.T) {
+	if size := guessIconSize("icon", "", ""); size != 0 {
+		t.Errorf(`The size of an icon without sizes attribute should be unknown, got %d`, size)
+	}
+
+	if size := guessIconSize("icon", "", "image/svg+xml"); size != maxIconSize {
+		t.Errorf(`A scalable icon should be considered as large as possible, got %d`, size)
+	}
+
+	if size := guessIconSize("apple-touch-icon", "", ""); size != 180 {
+		t.Errorf(`An Apple touch icon should be 180 pixels by default, got %d`, size)
+	}
+
+	if size := guessIconSize("apple-touch-icon", "120x120", ""); size != 120 {
+		t.Errorf(`The sizes attribute should be used when defined, got %d`, size)
+	}
+}
+
+func TestIconCandidatesRanking(t *testing.T) {
+	var candidates iconCandidates
+	candidates.add("https://example.org/", "/og.jpg", 0, iconSourceOpenGraph)
+	candidates.add("https://example.org/", "/favicon.png", 0, iconSourceDocument)
+	candidates.add("https://example.org/", "/icon-16.png", 16, iconSourceDocument)
+	candidates.add("https://example.org/", "/icon-1024.png", 1024, iconSourceDocument)
+	candidates.add("https://example.org/", "/apple-touch-icon.png", 180, iconSourceDocument)
+	candidates.add("https://example.org/", "/icon-512.png", 512, iconSourceDocument)
+	candidates.add("https://example.org/", "/favicon.ico", 0, iconSourceFavicon)
+
+	expected := []string{
+		"https://example.org/apple-touch-icon.png",
+		"https://example.org/icon-16.png",
+		"https://example.org/icon-512.png",
+		"https://example.org/icon-1024.png",
+		"https://example.org/favicon.png",
+		"https://example.org/favicon.ico",
+		"https://example.org/og.jpg",
+	}
+
+	if result := candidates.ranked(); !reflect.DeepEqual(result, expected) {
+		t.Errorf(`Unexpected ranking, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestIconCandidatesIgnoresDuplicates(t *testing.T) {
+	var candidates iconCandidates
+	candidates.add("https://example.org/", "/favicon.ico", 0, iconSourceDocument)
+	candidates.add("https://example.org/", "https://example.org/favicon.ico", 32, iconSourceFavicon)
+	candidates.add("https://example.org/", " ", 0, iconSourceDocument)
+
+	if len(candidates) != 1 {
+		t.Fatalf(`Duplicated and empty URLs should be ignored, got %d candidates`, len(candidates))
+	}
+
+	if candidates[0].Size != 32 || candidates[0].Source != iconSourceDocument {
+		t.Errorf(`The first candidate should be kept with the largest size, got %+v`, candidates[0])
+	}
+}
+
+func TestIconCandidatesKeepsDataURL(t *testing.T) {
+	var candidates iconCandidates
+	candidates.add("https://example.org/", "data:image/png;base64,aWNvbg==", 0, iconSourceDocument)
+
+	if result := candidates.ranked(); len(result) != 1 || result[0] != "data:image/png;base64,aWNvbg==" {
+		t.Errorf(`The data URL should be kept as is, got %v`, result)
+	}
+}
+
+func TestParseManifest(t *testing.T) {
+	data := `{
+		"name": "Example",
+		"icons": [
+			{"src": "icons/192.png", "sizes": "192x192", "type": "image/png"},
+			{"src": "/icons/512.png", "sizes": "512x512", "type": "image/png"},
+			{"src": "icons/logo.svg", "type": "image/svg+xml"}
+		]
+	}`
+
+	candidates, err := parseManifest("https://example.org/app/manifest.json", strings.NewReader(data))
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := []string{
+		"https://example.org/app/icons/logo.svg",
+		"https://example.org/app/icons/192.png",
+		"https://example.org/icons/512.png",
+	}
+
+	if result := candidates.ranked(); !reflect.DeepEqual(result, expected) {
+		t.Errorf(`Unexpected manifest icons, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestParseInvalidManifest(t *testing.T) {
+	if _, err := parseManifest("https://example.org/manifest.json", strings.NewReader("<html>")); err == nil {
+		t.Error(`An invalid manifest should return an error`)
+	}
+}
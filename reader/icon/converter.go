@@ -0,0 +1,121 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package icon // import "miniflux.app/reader/icon"
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	_ "image/jpeg" // Register the JPEG decoder.
+	_ "image/png"  // Register the PNG decoder.
+	"strings"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+)
+
+// maxIconDimension is the largest width or height of an icon decoded for the conversion,
+// the size announced in the header is checked first, so a small file cannot allocate a huge image.
+const maxIconDimension = 2048
+
+// ConvertToWebP re-encodes the icon as a lossless WebP image scaled down to maxSize pixels.
+//
+// SVG, animated, oversized and undecodable icons are left unchanged,
+// as well as the icons that would not be smaller once converted.
+// It returns true if the icon has been converted.
+func ConvertToWebP(icon *model.Icon, maxSize int) bool {
+	if strings.Contains(strings.ToLower(icon.MimeType), "svg") {
+		return false
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(icon.Content))
+	if err != nil || config.Width > maxIconDimension || config.Height > maxIconDimension {
+		return false
+	}
+
+	if format == "gif" {
+		animation, err := gif.DecodeAll(bytes.NewReader(icon.Content))
+		if err != nil || len(animation.Image) > 1 {
+			return false
+		}
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(icon.Content))
+	if err != nil {
+		return false
+	}
+
+	var buffer bytes.Buffer
+	if err := encodeWebP(&buffer, resizeImage(img, maxSize)); err != nil {
+		return false
+	}
+
+	if buffer.Len() >= len(icon.Content) {
+		return false
+	}
+
+	icon.Content = buffer.Bytes()
+	icon.MimeType = "image/webp"
+	icon.Hash = crypto.HashFromBytes(icon.Content)
+	return true
+}
+
+// resizeImage scales down the image to fit in a square of maxSize pixels by averaging the source pixels.
+// The image is only copied when it is already small enough.
+func resizeImage(src image.Image, maxSize int) *image.NRGBA {
+	bounds := src.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+	width, height := srcWidth, srcHeight
+
+	if maxSize > 0 && (width > maxSize || height > maxSize) {
+		if width >= height {
+			height = maxInt(1, height*maxSize/width)
+			width = maxSize
+		} else {
+			width = maxInt(1, width*maxSize/height)
+			height = maxSize
+		}
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*srcHeight/height
+		y1 := bounds.Min.Y + (y+1)*srcHeight/height
+
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*srcWidth/width
+			x1 := bounds.Min.X + (x+1)*srcWidth/width
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r += uint64(pr)
+					g += uint64(pg)
+					b += uint64(pb)
+					a += uint64(pa)
+					count++
+				}
+			}
+
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / count),
+				G: uint16(g / count),
+				B: uint16(b / count),
+				A: uint16(a / count),
+			})
+		}
+	}
+
+	return dst
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
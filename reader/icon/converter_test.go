@@ -0,0 +1,171 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package icon // import "miniflux.app/reader/icon"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"image/png"
+	"math/rand"
+	"testing"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	random := rand.New(rand.NewSource(1))
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(random.Intn(256)), G: uint8(y), B: uint8(x), A: 255})
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		t.Fatalf(`Unable to encode PNG image: %v`, err)
+	}
+	return buffer.Bytes()
+}
+
+func TestConvertToWebP(t *testing.T) {
+	content := encodePNG(t, 256, 128)
+	icon := &model.Icon{MimeType: "image/png", Content: content, Hash: crypto.HashFromBytes(content)}
+
+	if !ConvertToWebP(icon, 64) {
+		t.Fatal(`The icon should be converted`)
+	}
+
+	if icon.MimeType != "image/webp" {
+		t.Errorf(`Unexpected mime type, got %q instead of "image/webp"`, icon.MimeType)
+	}
+
+	if icon.Hash != crypto.HashFromBytes(icon.Content) {
+		t.Error(`The icon hash should be updated`)
+	}
+
+	width, height, _ := decodeWebPHeader(t, icon.Content)
+	if width != 64 || height != 32 {
+		t.Errorf(`The icon should be scaled down to 64x32, got %dx%d`, width, height)
+	}
+}
+
+func TestConvertToWebPKeepsSmallerIcons(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 16, 16))
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		t.Fatalf(`Unable to encode PNG image: %v`, err)
+	}
+
+	content := append([]byte{}, buffer.Bytes()...)
+	icon := &model.Icon{MimeType: "image/png", Content: content}
+	originalSize := len(content)
+
+	if converted := ConvertToWebP(icon, 64); converted != (len(icon.Content) < originalSize) {
+		t.Error(`The icon should be converted only when the WebP image is smaller`)
+	}
+}
+
+func TestConvertToWebPKeepsSVG(t *testing.T) {
+	content := []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="16" height="16"></svg>`)
+	icon := &model.Icon{MimeType: "image/svg+xml", Content: content}
+
+	if ConvertToWebP(icon, 64) || icon.MimeType != "image/svg+xml" || !bytes.Equal(icon.Content, content) {
+		t.Error(`SVG icons should be left unchanged`)
+	}
+}
+
+func TestConvertToWebPKeepsAnimatedGIF(t *testing.T) {
+	animation := &gif.GIF{}
+	for i := 0; i < 2; i++ {
+		frame := image.NewPaletted(image.Rect(0, 0, 16, 16), palette.Plan9)
+		frame.SetColorIndex(i, i, uint8(i+1))
+		animation.Image = append(animation.Image, frame)
+		animation.Delay = append(animation.Delay, 10)
+	}
+
+	var buffer bytes.Buffer
+	if err := gif.EncodeAll(&buffer, animation); err != nil {
+		t.Fatalf(`Unable to encode GIF image: %v`, err)
+	}
+
+	icon := &model.Icon{MimeType: "image/gif", Content: buffer.Bytes()}
+	if ConvertToWebP(icon, 64) || icon.MimeType != "image/gif" {
+		t.Error(`Animated icons should be left unchanged`)
+	}
+}
+
+func TestConvertToWebPKeepsUnsupportedFormats(t *testing.T) {
+	content := []byte{0x00, 0x00, 0x01, 0x00, 0x01, 0x00}
+	icon := &model.Icon{MimeType: "image/x-icon", Content: content}
+
+	if ConvertToWebP(icon, 64) || icon.MimeType != "image/x-icon" || !bytes.Equal(icon.Content, content) {
+		t.Error(`Icons that cannot be decoded should be left unchanged`)
+	}
+}
+
+func TestResizeImageKeepsAspectRatio(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 40, 200))
+	resized := resizeImage(img, 50)
+
+	if resized.Bounds().Dx() != 10 || resized.Bounds().Dy() != 50 {
+		t.Errorf(`Unexpected dimensions, got %dx%d instead of 10x50`, resized.Bounds().Dx(), resized.Bounds().Dy())
+	}
+}
+
+func TestResizeImageAveragesPixels(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 255, A: 255})
+	img.SetNRGBA(1, 0, color.NRGBA{R: 255, A: 255})
+
+	resized := resizeImage(img, 1)
+	if pixel := resized.NRGBAAt(0, 0); pixel.R != 255 || pixel.A != 127 {
+		t.Errorf(`Unexpected pixel value: %+v`, pixel)
+	}
+}
+
+func TestResizeImageWithSmallImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 16, 16))
+	img.SetNRGBA(3, 4, color.NRGBA{G: 200, A: 255})
+
+	resized := resizeImage(img, 64)
+	if resized.Bounds().Dx() != 16 || !bytes.Equal(resized.Pix, img.Pix) {
+		t.Error(`Small images should be copied as is`)
+	}
+}
+
+func TestConvertToWebPIgnoresOversizedIcons(t *testing.T) {
+	// The header announces a huge image, the pixel data is never read.
+	header := make([]byte, 13)
+	binary.BigEndian.PutUint32(header[0:4], 30000)
+	binary.BigEndian.PutUint32(header[4:8], 30000)
+	header[8], header[9] = 8, 6
+
+	var content bytes.Buffer
+	content.WriteString("\x89PNG\r\n\x1a\n")
+	binary.Write(&content, binary.BigEndian, uint32(len(header)))
+	chunk := append([]byte("IHDR"), header...)
+	content.Write(chunk)
+	binary.Write(&content, binary.BigEndian, crc32.ChecksumIEEE(chunk))
+
+	if config, _, err := image.DecodeConfig(bytes.NewReader(content.Bytes())); err != nil || config.Width != 30000 {
+		t.Fatalf(`Invalid test image: %v`, err)
+	}
+
+	icon := &model.Icon{MimeType: "image/png", Content: content.Bytes()}
+	if ConvertToWebP(icon, 64) {
+		t.Error(`An oversized icon should not be converted`)
+	}
+
+	if icon.MimeType != "image/png" {
+		t.Error(`An oversized icon should be left unchanged`)
+	}
+}
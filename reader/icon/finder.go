@@ -5,6 +5,7 @@
 package icon // import "miniflux.app/reader/icon"
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
 	"io"
@@ -15,15 +16,33 @@ import (
 	"miniflux.app/http/client"
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
 	"miniflux.app/url"
 
 	"github.com/PuerkitoBio/goquery"
 )
 
+// RequestSettings are the network settings of the feed, they are applied to all the requests sent to find its icon.
+type RequestSettings struct {
+	ProxyURL string
+	Timeout  int
+}
+
+func (s RequestSettings) newClient(url string) *client.Client {
+	clt := client.New(url)
+	clt.WithProxy(s.ProxyURL)
+	clt.WithTimeout(s.Timeout)
+	return clt
+}
+
 // FindIcon try to find the website's icon.
-func FindIcon(websiteURL string) (*model.Icon, error) {
+//
+// The icons declared by the website are tried from the most to the least preferred,
+// the caching headers are sent along with the icon request,
+// a nil icon is returned when the remote icon has not been modified.
+func FindIcon(websiteURL, etagHeader, lastModifiedHeader string, settings RequestSettings) (*model.Icon, error) {
 	rootURL := url.RootURL(websiteURL)
-	clt := client.New(rootURL)
+	clt := settings.newClient(rootURL)
 	response, err := clt.Get()
 	if err != nil {
 		return nil, fmt.Errorf("unable to download website index page: %v", err)
@@ -33,66 +52,149 @@ func FindIcon(websiteURL string) (*model.Icon, error) {
 		return nil, fmt.Errorf("unable to download website index page: status=%d", response.StatusCode)
 	}
 
-	iconURL, err := parseDocument(rootURL, response.Body)
+	candidates, manifestURL, err := parseDocument(rootURL, response.Body)
 	if err != nil {
 		return nil, err
 	}
 
+	if manifestURL != "" {
+		manifestCandidates, err := fetchManifest(manifestURL, settings)
+		if err != nil {
+			logger.Debug("[FindIcon] %v", err)
+		}
+
+		for _, candidate := range manifestCandidates {
+			candidates.add(manifestURL, candidate.URL, candidate.Size, candidate.Source)
+		}
+	}
+
+	candidates.add(rootURL, "/favicon.ico", 0, iconSourceFavicon)
+
+	for _, iconURL := range candidates.ranked() {
+		logger.Debug("[FindIcon] Fetching icon => %s", iconURL)
+		icon, err := FetchIcon(iconURL, etagHeader, lastModifiedHeader, settings)
+		if err == nil {
+			return icon, nil
+		}
+
+		logger.Debug("[FindIcon] %v", err)
+	}
+
+	return nil, fmt.Errorf("unable to find any icon for %s", websiteURL)
+}
+
+// FetchIcon downloads the icon located at the given URL without any discovery.
+//
+// Data URLs are decoded directly, a nil icon is returned when the remote icon has not been modified.
+// SVG icons are sanitized, an error is returned when nothing safe remains to display.
+func FetchIcon(iconURL, etagHeader, lastModifiedHeader string, settings RequestSettings) (*model.Icon, error) {
+	var icon *model.Icon
+	var err error
+
 	if strings.HasPrefix(iconURL, "data:") {
-		return parseImageDataURL(iconURL)
+		icon, err = parseImageDataURL(iconURL)
+	} else {
+		icon, err = downloadIcon(iconURL, etagHeader, lastModifiedHeader, settings)
 	}
 
-	logger.Debug("[FindIcon] Fetching icon => %s", iconURL)
-	icon, err := downloadIcon(iconURL)
-	if err != nil {
-		return nil, err
+	if err != nil || icon == nil {
+		return icon, err
+	}
+
+	if isSVGIcon(icon) {
+		content, err := sanitizer.SanitizeSVG(icon.Content)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sanitize SVG icon %s: %v", iconURL, err)
+		}
+
+		icon.Content = content
+		icon.MimeType = "image/svg+xml"
+		icon.Hash = crypto.HashFromBytes(content)
 	}
 
 	return icon, nil
 }
 
-func parseDocument(websiteURL string, data io.Reader) (string, error) {
-	queries := []string{
-		"link[rel='shortcut icon']",
-		"link[rel='Shortcut Icon']",
-		"link[rel='icon shortcut']",
-		"link[rel='icon']",
+// isSVGIcon returns true if the icon is declared as an SVG image or looks like one,
+// some servers send SVG files with a generic mime type.
+func isSVGIcon(icon *model.Icon) bool {
+	if strings.Contains(strings.ToLower(icon.MimeType), "svg") {
+		return true
+	}
+
+	head := icon.Content
+	if len(head) > 512 {
+		head = head[:512]
 	}
 
+	head = bytes.ToLower(bytes.TrimSpace(head))
+	return bytes.HasPrefix(head, []byte("<svg")) || (bytes.HasPrefix(head, []byte("<?xml")) && bytes.Contains(head, []byte("<svg")))
+}
+
+// parseDocument returns the icon candidates declared by the document and the URL of its web manifest.
+func parseDocument(websiteURL string, data io.Reader) (iconCandidates, string, error) {
 	doc, err := goquery.NewDocumentFromReader(data)
 	if err != nil {
-		return "", fmt.Errorf("unable to read document: %v", err)
+		return nil, "", fmt.Errorf("unable to read document: %v", err)
 	}
 
-	var iconURL string
-	for _, query := range queries {
-		doc.Find(query).Each(func(i int, s *goquery.Selection) {
-			if href, exists := s.Attr("href"); exists {
-				iconURL = href
-			}
-		})
+	var candidates iconCandidates
+	var manifestURL string
 
-		if iconURL != "" {
-			break
+	doc.Find("link[rel][href]").Each(func(i int, s *goquery.Selection) {
+		href, _ := s.Attr("href")
+		rel, _ := s.Attr("rel")
+
+		for _, token := range strings.Fields(strings.ToLower(rel)) {
+			switch token {
+			case "icon", "apple-touch-icon", "apple-touch-icon-precomposed":
+				sizes, _ := s.Attr("sizes")
+				mimeType, _ := s.Attr("type")
+				candidates.add(websiteURL, href, guessIconSize(token, sizes, mimeType), iconSourceDocument)
+				return
+			case "manifest":
+				if manifestURL == "" {
+					manifestURL, _ = url.AbsoluteURL(websiteURL, strings.TrimSpace(href))
+				}
+				return
+			}
 		}
+	})
+
+	doc.Find(`meta[property="og:image"][content]`).Each(func(i int, s *goquery.Selection) {
+		content, _ := s.Attr("content")
+		candidates.add(websiteURL, content, 0, iconSourceOpenGraph)
+	})
+
+	return candidates, manifestURL, nil
+}
+
+func fetchManifest(manifestURL string, settings RequestSettings) (iconCandidates, error) {
+	clt := settings.newClient(manifestURL)
+	response, err := clt.Get()
+	if err != nil {
+		return nil, fmt.Errorf("unable to download web manifest: %v", err)
 	}
 
-	if iconURL == "" {
-		iconURL = url.RootURL(websiteURL) + "favicon.ico"
-	} else {
-		iconURL, _ = url.AbsoluteURL(websiteURL, iconURL)
+	if response.HasServerFailure() {
+		return nil, fmt.Errorf("unable to download web manifest: status=%d", response.StatusCode)
 	}
 
-	return iconURL, nil
+	return parseManifest(manifestURL, response.Body)
 }
 
-func downloadIcon(iconURL string) (*model.Icon, error) {
-	clt := client.New(iconURL)
+func downloadIcon(iconURL, etagHeader, lastModifiedHeader string, settings RequestSettings) (*model.Icon, error) {
+	clt := settings.newClient(iconURL)
+	clt.WithCacheHeaders(etagHeader, lastModifiedHeader)
 	response, err := clt.Get()
 	if err != nil {
 		return nil, fmt.Errorf("unable to download iconURL: %v", err)
 	}
 
+	if !response.IsModified(etagHeader, lastModifiedHeader) {
+		return nil, nil
+	}
+
 	if response.HasServerFailure() {
 		return nil, fmt.Errorf("unable to download icon: status=%d", response.StatusCode)
 	}
@@ -107,9 +209,11 @@ func downloadIcon(iconURL string) (*model.Icon, error) {
 	}
 
 	icon := &model.Icon{
-		Hash:     crypto.HashFromBytes(body),
-		MimeType: response.ContentType,
-		Content:  body,
+		Hash:               crypto.HashFromBytes(body),
+		MimeType:           response.ContentType,
+		Content:            body,
+		EtagHeader:         response.ETag,
+		LastModifiedHeader: response.LastModified,
 	}
 
 	return icon, nil
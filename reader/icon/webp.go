@@ -0,0 +1,526 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package icon // import "miniflux.app/reader/icon"
+
+import (
+	"encoding/binary"
+	"errors"
+	"image"
+	"io"
+	"math"
+	"sort"
+)
+
+// The encoder below produces lossless WebP images (VP8L bitstream).
+// Neither the standard library nor golang.org/x/image provide a WebP encoder, and the existing encoders rely on cgo.
+// It applies the subtract green and predictor transforms, then entropy codes the pixels without backward references,
+// which is enough to get small files for icons.
+const (
+	webpMaxDimension        = 1 << 14
+	webpGreenAlphabetSize   = 256 + 24
+	webpDistanceAlphabet    = 40
+	webpMaxCodeLength       = 15
+	webpMaxCodeLengthLength = 7
+	webpPredictorBits       = 9
+)
+
+// webpCodeLengthOrder is the order in which the code lengths of the code length code are written.
+var webpCodeLengthOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// List of predictor modes tried by the encoder, a single mode is used for the whole image.
+const (
+	webpPredictorLeft   = 1
+	webpPredictorTop    = 2
+	webpPredictorSelect = 11
+	webpPredictorClamp  = 12
+)
+
+// webpPixel holds the channels in the order of their prefix codes: green, red, blue and alpha.
+type webpPixel [4]uint8
+
+// encodeWebP writes the image as a lossless WebP file.
+func encodeWebP(w io.Writer, img *image.NRGBA) error {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < 1 || height < 1 || width > webpMaxDimension || height > webpMaxDimension {
+		return errors.New("icon: invalid image dimensions for WebP encoding")
+	}
+
+	var bw bitWriter
+	bw.writeBits(0x2f, 8)
+	bw.writeBits(uint32(width-1), 14)
+	bw.writeBits(uint32(height-1), 14)
+	bw.writeBits(boolToBit(hasTransparency(img)), 1)
+	bw.writeBits(0, 3)
+
+	// Subtract green transform.
+	bw.writeBits(1, 1)
+	bw.writeBits(2, 2)
+
+	pixels := make([]webpPixel, 0, width*height)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			offset := img.PixOffset(x, y)
+			r, g, b, a := img.Pix[offset], img.Pix[offset+1], img.Pix[offset+2], img.Pix[offset+3]
+			pixels = append(pixels, webpPixel{g, r - g, b - g, a})
+		}
+	}
+
+	// Predictor transform, the sub-image stores the mode of each block in the green channel.
+	mode, residuals := bestPredictorResiduals(pixels, width)
+	blockCount := ((width + (1 << webpPredictorBits) - 1) >> webpPredictorBits) * ((height + (1 << webpPredictorBits) - 1) >> webpPredictorBits)
+	blocks := make([]webpPixel, blockCount)
+	for i := range blocks {
+		blocks[i] = webpPixel{uint8(mode), 0, 0, 0xff}
+	}
+
+	bw.writeBits(1, 1)
+	bw.writeBits(0, 2)
+	bw.writeBits(webpPredictorBits-2, 3)
+	bw.writeBits(0, 1)
+	writeEntropyCodedPixels(&bw, blocks)
+
+	bw.writeBits(0, 1)
+
+	// No color cache and a single group of prefix codes.
+	bw.writeBits(0, 1)
+	bw.writeBits(0, 1)
+	writeEntropyCodedPixels(&bw, residuals)
+
+	data := bw.bytes()
+	chunkSize := len(data)
+	padding := chunkSize & 1
+
+	header := make([]byte, 20)
+	copy(header[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:8], uint32(12+chunkSize+padding))
+	copy(header[8:12], "WEBP")
+	copy(header[12:16], "VP8L")
+	binary.LittleEndian.PutUint32(header[16:20], uint32(chunkSize))
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	if padding == 1 {
+		data = append(data, 0)
+	}
+
+	_, err := w.Write(data)
+	return err
+}
+
+// writeEntropyCodedPixels writes the prefix codes followed by the pixels.
+func writeEntropyCodedPixels(bw *bitWriter, pixels []webpPixel) {
+	histograms := pixelHistograms(pixels)
+
+	var codes [4]*prefixCode
+	for i, histogram := range histograms {
+		codes[i] = newPrefixCode(histogram, webpMaxCodeLength)
+		codes[i].write(bw)
+	}
+
+	// The distance code is never used since there is no backward reference.
+	newPrefixCode(make([]int, webpDistanceAlphabet), webpMaxCodeLength).write(bw)
+
+	for _, pixel := range pixels {
+		for i, symbol := range pixel {
+			codes[i].writeSymbol(bw, int(symbol))
+		}
+	}
+}
+
+func pixelHistograms(pixels []webpPixel) [4][]int {
+	histograms := [4][]int{
+		make([]int, webpGreenAlphabetSize),
+		make([]int, 256),
+		make([]int, 256),
+		make([]int, 256),
+	}
+
+	for _, pixel := range pixels {
+		for i, symbol := range pixel {
+			histograms[i][symbol]++
+		}
+	}
+
+	return histograms
+}
+
+// bestPredictorResiduals returns the predictor mode giving the lowest entropy and the corresponding residuals.
+func bestPredictorResiduals(pixels []webpPixel, width int) (int, []webpPixel) {
+	bestMode, bestCost := 0, math.Inf(1)
+	var bestResiduals []webpPixel
+
+	for _, mode := range []int{webpPredictorLeft, webpPredictorTop, webpPredictorSelect, webpPredictorClamp} {
+		residuals := predictorResiduals(pixels, width, mode)
+
+		cost := 0.0
+		for _, histogram := range pixelHistograms(residuals) {
+			for _, count := range histogram {
+				if count > 0 {
+					cost -= float64(count) * math.Log2(float64(count)/float64(len(pixels)))
+				}
+			}
+		}
+
+		if cost < bestCost {
+			bestMode, bestCost, bestResiduals = mode, cost, residuals
+		}
+	}
+
+	return bestMode, bestResiduals
+}
+
+// predictorResiduals returns the difference between each pixel and its prediction.
+// The first pixel is predicted as opaque black, the first row from the left pixel and the first column from the top pixel.
+func predictorResiduals(pixels []webpPixel, width, mode int) []webpPixel {
+	residuals := make([]webpPixel, len(pixels))
+	for i, pixel := range pixels {
+		var prediction webpPixel
+		switch {
+		case i == 0:
+			prediction = webpPixel{0, 0, 0, 0xff}
+		case i < width:
+			prediction = pixels[i-1]
+		case i%width == 0:
+			prediction = pixels[i-width]
+		default:
+			prediction = predictPixel(mode, pixels[i-1], pixels[i-width], pixels[i-width-1])
+		}
+
+		for c := range pixel {
+			residuals[i][c] = pixel[c] - prediction[c]
+		}
+	}
+
+	return residuals
+}
+
+func predictPixel(mode int, left, top, topLeft webpPixel) webpPixel {
+	switch mode {
+	case webpPredictorTop:
+		return top
+	case webpPredictorSelect:
+		leftDistance, topDistance := 0, 0
+		for c := range left {
+			leftDistance += absInt(int(top[c]) - int(topLeft[c]))
+			topDistance += absInt(int(left[c]) - int(topLeft[c]))
+		}
+
+		if leftDistance < topDistance {
+			return left
+		}
+		return top
+	case webpPredictorClamp:
+		var prediction webpPixel
+		for c := range left {
+			value := int(left[c]) + int(top[c]) - int(topLeft[c])
+			switch {
+			case value < 0:
+				value = 0
+			case value > 0xff:
+				value = 0xff
+			}
+			prediction[c] = uint8(value)
+		}
+		return prediction
+	default:
+		return left
+	}
+}
+
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+func hasTransparency(img *image.NRGBA) bool {
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if img.Pix[img.PixOffset(x, y)+3] != 0xff {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func boolToBit(value bool) uint32 {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+type bitWriter struct {
+	buffer []byte
+	bits   uint64
+	count  uint
+}
+
+// writeBits writes the n least significant bits of value, least significant bit first.
+func (w *bitWriter) writeBits(value uint32, n uint) {
+	w.bits |= uint64(value) << w.count
+	w.count += n
+	for w.count >= 8 {
+		w.buffer = append(w.buffer, byte(w.bits))
+		w.bits >>= 8
+		w.count -= 8
+	}
+}
+
+func (w *bitWriter) bytes() []byte {
+	if w.count > 0 {
+		w.buffer = append(w.buffer, byte(w.bits))
+		w.bits = 0
+		w.count = 0
+	}
+	return w.buffer
+}
+
+type prefixCode struct {
+	symbols []int
+	lengths []int
+	codes   []uint32
+}
+
+// newPrefixCode builds a canonical prefix code from the symbol frequencies.
+func newPrefixCode(histogram []int, maxLength int) *prefixCode {
+	code := &prefixCode{}
+	for symbol, count := range histogram {
+		if count > 0 {
+			code.symbols = append(code.symbols, symbol)
+		}
+	}
+
+	code.lengths = huffmanCodeLengths(histogram, maxLength)
+	code.codes = canonicalCodes(code.lengths)
+	return code
+}
+
+// isSimple returns true if the code can be written with the compact representation of up to two 8 bits symbols.
+func (c *prefixCode) isSimple() bool {
+	return len(c.symbols) <= 2 && (len(c.symbols) == 0 || c.symbols[len(c.symbols)-1] < 256)
+}
+
+func (c *prefixCode) write(w *bitWriter) {
+	if c.isSimple() {
+		symbols := c.symbols
+		if len(symbols) == 0 {
+			symbols = []int{0}
+		}
+
+		w.writeBits(1, 1)
+		w.writeBits(uint32(len(symbols)-1), 1)
+		if symbols[0] < 2 {
+			w.writeBits(0, 1)
+			w.writeBits(uint32(symbols[0]), 1)
+		} else {
+			w.writeBits(1, 1)
+			w.writeBits(uint32(symbols[0]), 8)
+		}
+
+		if len(symbols) == 2 {
+			w.writeBits(uint32(symbols[1]), 8)
+		}
+		return
+	}
+
+	tokens, extraBits := codeLengthTokens(c.lengths)
+	histogram := make([]int, len(webpCodeLengthOrder))
+	for _, token := range tokens {
+		histogram[token]++
+	}
+
+	lengthCode := newPrefixCode(histogram, webpMaxCodeLengthLength)
+
+	count := 4
+	for i, symbol := range webpCodeLengthOrder {
+		if lengthCode.lengths[symbol] > 0 && i+1 > count {
+			count = i + 1
+		}
+	}
+
+	w.writeBits(0, 1)
+	w.writeBits(uint32(count-4), 4)
+	for _, symbol := range webpCodeLengthOrder[:count] {
+		w.writeBits(uint32(lengthCode.lengths[symbol]), 3)
+	}
+
+	// The code lengths of all the symbols are written.
+	w.writeBits(0, 1)
+
+	for i, token := range tokens {
+		lengthCode.writeSymbol(w, token)
+		switch token {
+		case 17:
+			w.writeBits(uint32(extraBits[i]), 3)
+		case 18:
+			w.writeBits(uint32(extraBits[i]), 7)
+		}
+	}
+}
+
+func (c *prefixCode) writeSymbol(w *bitWriter, symbol int) {
+	// A code with a single symbol does not use any bit.
+	if len(c.symbols) < 2 {
+		return
+	}
+
+	length := uint(c.lengths[symbol])
+	w.writeBits(reverseBits(c.codes[symbol], length), length)
+}
+
+// codeLengthTokens returns the code length symbols of a prefix code, zero runs are written with the repeat codes 17 and 18.
+func codeLengthTokens(lengths []int) (tokens, extraBits []int) {
+	for i := 0; i < len(lengths); {
+		if lengths[i] != 0 {
+			tokens = append(tokens, lengths[i])
+			extraBits = append(extraBits, 0)
+			i++
+			continue
+		}
+
+		run := 1
+		for i+run < len(lengths) && lengths[i+run] == 0 && run < 138 {
+			run++
+		}
+
+		switch {
+		case run >= 11:
+			tokens = append(tokens, 18)
+			extraBits = append(extraBits, run-11)
+		case run >= 3:
+			tokens = append(tokens, 17)
+			extraBits = append(extraBits, run-3)
+		default:
+			tokens = append(tokens, 0)
+			extraBits = append(extraBits, 0)
+			run = 1
+		}
+
+		i += run
+	}
+
+	return tokens, extraBits
+}
+
+type huffmanNode struct {
+	count       int
+	symbol      int
+	left, right *huffmanNode
+}
+
+// huffmanCodeLengths returns the code length of each symbol, limited to maxLength bits.
+// The frequencies of the rare symbols are increased until the tree is shallow enough.
+func huffmanCodeLengths(histogram []int, maxLength int) []int {
+	lengths := make([]int, len(histogram))
+
+	var used []int
+	for symbol, count := range histogram {
+		if count > 0 {
+			used = append(used, symbol)
+		}
+	}
+
+	switch len(used) {
+	case 0:
+		return lengths
+	case 1:
+		lengths[used[0]] = 1
+		return lengths
+	}
+
+	for minCount := 1; ; minCount *= 2 {
+		leaves := make([]*huffmanNode, len(used))
+		for i, symbol := range used {
+			count := histogram[symbol]
+			if count < minCount {
+				count = minCount
+			}
+			leaves[i] = &huffmanNode{count: count, symbol: symbol}
+		}
+
+		sort.SliceStable(leaves, func(i, j int) bool { return leaves[i].count < leaves[j].count })
+
+		var internals []*huffmanNode
+		next := func() *huffmanNode {
+			if len(internals) == 0 || (len(leaves) > 0 && leaves[0].count <= internals[0].count) {
+				node := leaves[0]
+				leaves = leaves[1:]
+				return node
+			}
+			node := internals[0]
+			internals = internals[1:]
+			return node
+		}
+
+		for len(leaves)+len(internals) > 1 {
+			left := next()
+			right := next()
+			internals = append(internals, &huffmanNode{count: left.count + right.count, left: left, right: right})
+		}
+
+		if assignCodeLengths(internals[0], 0, lengths) <= maxLength {
+			return lengths
+		}
+	}
+}
+
+// assignCodeLengths stores the depth of each leaf and returns the depth of the tree.
+func assignCodeLengths(node *huffmanNode, depth int, lengths []int) int {
+	if node.left == nil {
+		lengths[node.symbol] = depth
+		return depth
+	}
+
+	left := assignCodeLengths(node.left, depth+1, lengths)
+	right := assignCodeLengths(node.right, depth+1, lengths)
+	if left > right {
+		return left
+	}
+	return right
+}
+
+// canonicalCodes assigns consecutive codes to the symbols ordered by code length and symbol value.
+func canonicalCodes(lengths []int) []uint32 {
+	var lengthCount [webpMaxCodeLength + 1]uint32
+	for _, length := range lengths {
+		if length > 0 {
+			lengthCount[length]++
+		}
+	}
+
+	var nextCode [webpMaxCodeLength + 1]uint32
+	code := uint32(0)
+	for length := 1; length <= webpMaxCodeLength; length++ {
+		code = (code + lengthCount[length-1]) << 1
+		nextCode[length] = code
+	}
+
+	codes := make([]uint32, len(lengths))
+	for symbol, length := range lengths {
+		if length > 0 {
+			codes[symbol] = nextCode[length]
+			nextCode[length]++
+		}
+	}
+
+	return codes
+}
+
+// reverseBits reverses the n least significant bits, prefix codes are read from the most significant bit.
+func reverseBits(value uint32, n uint) uint32 {
+	var reversed uint32
+	for i := uint(0); i < n; i++ {
+		reversed = (reversed << 1) | (value & 1)
+		value >>= 1
+	}
+	return reversed
+}
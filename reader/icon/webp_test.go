@@ -0,0 +1,146 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package icon // import "miniflux.app/reader/icon"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+func decodeWebPHeader(t *testing.T, data []byte) (width, height int, hasAlpha bool) {
+	if len(data) < 25 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" || string(data[12:16]) != "VP8L" {
+		t.Fatalf(`Invalid WebP header: %v`, data)
+	}
+
+	if size := int(binary.LittleEndian.Uint32(data[4:8])); size != len(data)-8 {
+		t.Fatalf(`Invalid RIFF size, got %d instead of %d`, size, len(data)-8)
+	}
+
+	if len(data)%2 != 0 {
+		t.Fatal(`The RIFF chunks should be padded to an even size`)
+	}
+
+	if data[20] != 0x2f {
+		t.Fatalf(`Invalid VP8L signature: %x`, data[20])
+	}
+
+	bits := binary.LittleEndian.Uint32(data[21:25])
+	return int(bits&0x3fff) + 1, int((bits>>14)&0x3fff) + 1, (bits>>28)&1 == 1
+}
+
+func TestEncodeWebPHeader(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 30, 20))
+	for y := 0; y < 20; y++ {
+		for x := 0; x < 30; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 8), G: uint8(y * 12), B: 128, A: 255})
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := encodeWebP(&buffer, img); err != nil {
+		t.Fatalf(`Encoding failure: %v`, err)
+	}
+
+	width, height, hasAlpha := decodeWebPHeader(t, buffer.Bytes())
+	if width != 30 || height != 20 {
+		t.Errorf(`Unexpected dimensions, got %dx%d instead of 30x20`, width, height)
+	}
+
+	if hasAlpha {
+		t.Error(`An opaque image should not declare an alpha channel`)
+	}
+}
+
+func TestEncodeWebPWithTransparency(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	img.SetNRGBA(1, 1, color.NRGBA{R: 255, A: 128})
+
+	var buffer bytes.Buffer
+	if err := encodeWebP(&buffer, img); err != nil {
+		t.Fatalf(`Encoding failure: %v`, err)
+	}
+
+	if _, _, hasAlpha := decodeWebPHeader(t, buffer.Bytes()); !hasAlpha {
+		t.Error(`A transparent image should declare an alpha channel`)
+	}
+}
+
+func TestEncodeWebPWithInvalidDimensions(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := encodeWebP(&buffer, image.NewNRGBA(image.Rect(0, 0, 0, 10))); err == nil {
+		t.Error(`An empty image should not be encoded`)
+	}
+}
+
+func TestHuffmanCodeLengthsAreLimited(t *testing.T) {
+	// Fibonacci frequencies produce the deepest possible Huffman trees.
+	histogram := make([]int, 40)
+	a, b := 1, 1
+	for i := range histogram {
+		histogram[i] = a
+		a, b = b, a+b
+	}
+
+	for _, maxLength := range []int{webpMaxCodeLength, webpMaxCodeLengthLength} {
+		lengths := huffmanCodeLengths(histogram, maxLength)
+
+		// The code must be complete: the sum of 2^-length is equal to 1.
+		var sum float64
+		for symbol, length := range lengths {
+			if length == 0 || length > maxLength {
+				t.Fatalf(`Invalid code length for symbol %d: %d`, symbol, length)
+			}
+			sum += 1 / float64(uint(1)<<uint(length))
+		}
+
+		if sum != 1 {
+			t.Errorf(`The prefix code should be complete, got a Kraft sum of %f`, sum)
+		}
+	}
+}
+
+func TestHuffmanCodeLengthsWithSingleSymbol(t *testing.T) {
+	lengths := huffmanCodeLengths([]int{0, 0, 5, 0}, webpMaxCodeLength)
+	if !reflect.DeepEqual(lengths, []int{0, 0, 1, 0}) {
+		t.Errorf(`Unexpected code lengths: %v`, lengths)
+	}
+}
+
+func TestCanonicalCodes(t *testing.T) {
+	codes := canonicalCodes([]int{2, 1, 3, 3, 0})
+	expected := []uint32{2, 0, 6, 7, 0}
+	if !reflect.DeepEqual(codes, expected) {
+		t.Errorf(`Unexpected canonical codes, got %v instead of %v`, codes, expected)
+	}
+}
+
+func TestCodeLengthTokens(t *testing.T) {
+	lengths := make([]int, 20)
+	lengths[0] = 4
+	lengths[3] = 2
+	lengths[5] = 3
+
+	tokens, extraBits := codeLengthTokens(lengths)
+	expectedTokens := []int{4, 0, 0, 2, 0, 3, 18}
+	expectedExtraBits := []int{0, 0, 0, 0, 0, 0, 3}
+
+	if !reflect.DeepEqual(tokens, expectedTokens) || !reflect.DeepEqual(extraBits, expectedExtraBits) {
+		t.Errorf(`Unexpected tokens, got %v %v instead of %v %v`, tokens, extraBits, expectedTokens, expectedExtraBits)
+	}
+}
+
+func TestReverseBits(t *testing.T) {
+	if result := reverseBits(0x6, 4); result != 0x6 {
+		t.Errorf(`Unexpected value, got %x instead of 6`, result)
+	}
+
+	if result := reverseBits(0x1, 3); result != 0x4 {
+		t.Errorf(`Unexpected value, got %x instead of 4`, result)
+	}
+}
@@ -17,12 +17,14 @@ import (
 )
 
 type jsonFeed struct {
-	Version string     `json:"version"`
-	Title   string     `json:"title"`
-	SiteURL string     `json:"home_page_url"`
-	FeedURL string     `json:"feed_url"`
-	Author  jsonAuthor `json:"author"`
-	Items   []jsonItem `json:"items"`
+	Version  string       `json:"version"`
+	Title    string       `json:"title"`
+	SiteURL  string       `json:"home_page_url"`
+	FeedURL  string       `json:"feed_url"`
+	Language string       `json:"language"`
+	Author   jsonAuthor   `json:"author"`
+	Authors  []jsonAuthor `json:"authors"`
+	Items    []jsonItem   `json:"items"`
 }
 
 type jsonAuthor struct {
@@ -40,6 +42,7 @@ type jsonItem struct {
 	DatePublished string           `json:"date_published"`
 	DateModified  string           `json:"date_modified"`
 	Author        jsonAuthor       `json:"author"`
+	Authors       []jsonAuthor     `json:"authors"`
 	Attachments   []jsonAttachment `json:"attachments"`
 }
 
@@ -52,7 +55,7 @@ type jsonAttachment struct {
 }
 
 func (j *jsonFeed) GetAuthor() string {
-	return getAuthor(j.Author)
+	return getAuthor(j.Authors, j.Author)
 }
 
 func (j *jsonFeed) Transform() *model.Feed {
@@ -60,6 +63,7 @@ func (j *jsonFeed) Transform() *model.Feed {
 	feed.FeedURL = j.FeedURL
 	feed.SiteURL = j.SiteURL
 	feed.Title = strings.TrimSpace(j.Title)
+	feed.Language = strings.TrimSpace(j.Language)
 
 	if feed.Title == "" {
 		feed.Title = feed.SiteURL
@@ -82,24 +86,44 @@ func (j *jsonFeed) Transform() *model.Feed {
 	return feed
 }
 
-func (j *jsonItem) GetDate() time.Time {
-	for _, value := range []string{j.DatePublished, j.DateModified} {
-		if value != "" {
-			d, err := date.Parse(value)
-			if err != nil {
-				logger.Error("json: %v", err)
-				return time.Now()
-			}
+func (j *jsonItem) GetDateText() string {
+	if j.DatePublished != "" {
+		return j.DatePublished
+	}
+
+	return j.DateModified
+}
+
+// GetUpdatedDate returns the date of the last modification, or the publication date when the entry has never been modified.
+func (j *jsonItem) GetUpdatedDate(published time.Time) time.Time {
+	if j.DateModified != "" {
+		result, err := date.Parse(j.DateModified)
+		if err == nil {
+			return result
+		}
+
+		logger.Error("json: %v", err)
+	}
+
+	return published
+}
 
-			return d
+func (j *jsonItem) GetDate() time.Time {
+	if value := j.GetDateText(); value != "" {
+		d, err := date.Parse(value)
+		if err != nil {
+			logger.Error("json: %v", err)
+			return time.Now()
 		}
+
+		return d
 	}
 
 	return time.Now()
 }
 
 func (j *jsonItem) GetAuthor() string {
-	return getAuthor(j.Author)
+	return getAuthor(j.Authors, j.Author)
 }
 
 func (j *jsonItem) GetHash() string {
@@ -154,20 +178,32 @@ func (j *jsonItem) Transform() *model.Entry {
 	entry := new(model.Entry)
 	entry.URL = j.URL
 	entry.Date = j.GetDate()
+	entry.RawDate = j.GetDateText()
+	entry.UpdatedAt = j.GetUpdatedDate(entry.Date)
 	entry.Author = j.GetAuthor()
 	entry.Hash = j.GetHash()
+	entry.GUID = j.ID
 	entry.Content = j.GetContent()
 	entry.Title = strings.TrimSpace(j.GetTitle())
 	entry.Enclosures = j.GetEnclosures()
 	return entry
 }
 
-func getAuthor(author jsonAuthor) string {
-	if author.Name != "" {
-		return strings.TrimSpace(author.Name)
+// getAuthor returns the names of the JSON Feed 1.1 "authors" list,
+// and falls back to the deprecated "author" object of JSON Feed 1.0.
+func getAuthor(authors []jsonAuthor, author jsonAuthor) string {
+	var names []string
+	for _, author := range authors {
+		if name := strings.TrimSpace(author.Name); name != "" {
+			names = append(names, name)
+		}
 	}
 
-	return ""
+	if len(names) > 0 {
+		return strings.Join(names, ", ")
+	}
+
+	return strings.TrimSpace(author.Name)
 }
 
 func truncate(str string) string {
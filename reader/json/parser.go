@@ -5,20 +5,40 @@
 package json // import "miniflux.app/reader/json"
 
 import (
+	"bytes"
 	"encoding/json"
 	"io"
+	"io/ioutil"
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
+	"miniflux.app/reader/xml"
 )
 
 // Parse returns a normalized feed struct from a JON feed.
 func Parse(data io.Reader) (*model.Feed, *errors.LocalizedError) {
+	buffer, err := ioutil.ReadAll(data)
+	if err != nil {
+		return nil, errors.NewLocalizedError("Unable to parse JSON Feed: %q", err)
+	}
+
 	feed := new(jsonFeed)
-	decoder := json.NewDecoder(data)
+	decoder := json.NewDecoder(bytes.NewReader(buffer))
 	if err := decoder.Decode(&feed); err != nil {
-		return nil, errors.NewLocalizedError("Unable to parse JSON Feed: %q", err)
+		return nil, errors.NewLocalizedError("Unable to parse JSON Feed: %q", withPosition(buffer, err))
 	}
 
 	return feed.Transform(), nil
 }
+
+// withPosition adds the line, the column and an excerpt of the document to syntax and type errors.
+func withPosition(data []byte, err error) error {
+	switch e := err.(type) {
+	case *json.SyntaxError:
+		return xml.NewDecodeError(data, e.Offset, err)
+	case *json.UnmarshalTypeError:
+		return xml.NewDecodeError(data, e.Offset, err)
+	}
+
+	return err
+}
@@ -328,6 +328,38 @@ func TestParseFeedItemWithInvalidDate(t *testing.T) {
 	}
 }
 
+func TestParseFeedItemWithModifiedDate(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1",
+		"title": "My Example Feed",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"items": [
+			{
+				"id": "2347259",
+				"url": "https://example.org/2347259",
+				"content_text": "Cats are neat. \n\nhttps://example.org/cats",
+				"date_published": "2016-02-09T14:22:00-07:00",
+				"date_modified": "2016-02-10T08:00:00-07:00"
+			}
+		]
+	}`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	location, _ := time.LoadLocation("America/Phoenix")
+	if !feed.Entries[0].Date.Equal(time.Date(2016, time.February, 9, 14, 22, 0, 0, location)) {
+		t.Errorf("Incorrect entry date, got: %v", feed.Entries[0].Date)
+	}
+
+	if !feed.Entries[0].UpdatedAt.Equal(time.Date(2016, time.February, 10, 8, 0, 0, 0, location)) {
+		t.Errorf("Incorrect entry updated date, got: %v", feed.Entries[0].UpdatedAt)
+	}
+}
+
 func TestParseFeedItemWithoutID(t *testing.T) {
 	data := `{
 		"version": "https://jsonfeed.org/version/1",
@@ -416,3 +448,114 @@ func TestParseInvalidJSON(t *testing.T) {
 		t.Error("Parse should returns an error")
 	}
 }
+
+func TestParseAuthorsAndLanguage(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1.1",
+		"title": "Example",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"language": "en-US",
+		"authors": [
+			{"name": "Jane Doe"},
+			{"url": "https://example.org/anonymous"},
+			{"name": "John Doe"}
+		],
+		"items": [
+			{
+				"id": "1",
+				"url": "https://example.org/1",
+				"content_text": "Item with its own authors",
+				"authors": [{"name": "Item Author"}]
+			},
+			{
+				"id": "2",
+				"url": "https://example.org/2",
+				"content_text": "Item without author"
+			}
+		]
+	}`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Language != "en-US" {
+		t.Errorf("Incorrect feed language, got: %s", feed.Language)
+	}
+
+	if feed.Entries[0].Author != "Item Author" {
+		t.Errorf("Incorrect entry author, got: %s", feed.Entries[0].Author)
+	}
+
+	if feed.Entries[1].Author != "Jane Doe, John Doe" {
+		t.Errorf("Incorrect entry author, got: %s", feed.Entries[1].Author)
+	}
+}
+
+func TestParseMixedAuthorShapes(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1.1",
+		"title": "Example",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"author": {"name": "Deprecated Feed Author"},
+		"authors": [{"name": "Feed Author"}],
+		"items": [
+			{
+				"id": "1",
+				"url": "https://example.org/1",
+				"content_text": "Item with both shapes",
+				"author": {"name": "Deprecated Item Author"},
+				"authors": [{"name": "Item Author"}]
+			},
+			{
+				"id": "2",
+				"url": "https://example.org/2",
+				"content_text": "Item with the 1.0 shape only",
+				"author": {"name": "Old Item Author"}
+			},
+			{
+				"id": "3",
+				"url": "https://example.org/3",
+				"content_text": "Item with an empty authors list",
+				"author": {"name": "Old Item Author"},
+				"authors": []
+			}
+		]
+	}`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feed.Entries) != 3 {
+		t.Fatalf("Incorrect number of entries, got: %d", len(feed.Entries))
+	}
+
+	if feed.Entries[0].Author != "Item Author" {
+		t.Errorf("Incorrect entry author, got: %s", feed.Entries[0].Author)
+	}
+
+	if feed.Entries[1].Author != "Old Item Author" {
+		t.Errorf("Incorrect entry author, got: %s", feed.Entries[1].Author)
+	}
+
+	if feed.Entries[2].Author != "Old Item Author" {
+		t.Errorf("Incorrect entry author, got: %s", feed.Entries[2].Author)
+	}
+}
+
+func TestParseInvalidJSONWithPosition(t *testing.T) {
+	data := "{\n\t\"version\": \"https://jsonfeed.org/version/1\",\n\t\"title\": \"My Example Feed\",,\n\t\"items\": []\n}"
+	_, err := Parse(bytes.NewBufferString(data))
+	if err == nil {
+		t.Fatal("Parse should returns an error")
+	}
+
+	if message := err.Error(); !strings.Contains(message, "line 3, column 30") {
+		t.Errorf("The error should contain the position, got: %s", message)
+	}
+}
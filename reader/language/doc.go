@@ -0,0 +1,8 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+Package language guesses the language of the entries published by feeds without language information.
+*/
+package language // import "miniflux.app/reader/language"
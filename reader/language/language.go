@@ -0,0 +1,211 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package language // import "miniflux.app/reader/language"
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Texts with fewer letters or words are too short to guess the language reliably.
+const (
+	minLetters = 20
+	minWords   = 20
+)
+
+// The detected language must match at least this fraction of the words of a text written with the Latin script.
+const minStopWordRatio = 0.1
+
+// stopWords contains the most frequent words of the languages written with the Latin script.
+var stopWords = map[string]string{
+	"en": "the and of to is in that it was for with as on are this be by have from not but they you which his her at an were has their will would there what been",
+	"fr": "le la les des et est une un du dans que qui pour pas sur au avec ce il elle sont ne se plus par mais nous vous cette aux été leur",
+	"de": "der die das und ist nicht ein eine zu den mit sich des auf für im dem von auch es sie wird wie bei oder nach noch wurde einen sind aus",
+	"es": "el la los las de que y en es un una por con para del se no al lo como más pero sus su fue este esta son también muy",
+	"it": "il di che e la per un una non sono del della gli le con nel da si è anche ma come questo alla dei più delle ha essere",
+	"pt": "o a os as de que e do da em um uma para com não no na por mais dos das se ao foi são como mas seu sua também",
+	"nl": "de het een en van is dat op te in niet zijn met voor er die maar ook als aan door wordt bij naar nog werd deze worden heeft",
+	"pl": "i w nie na się z do że to jest jak o co od po ale jego za tak przez są dla być czy już tylko przy może który która",
+}
+
+var stopWordLanguages = indexStopWords()
+
+// rightToLeftLanguages contains the languages written with a right-to-left script.
+var rightToLeftLanguages = map[string]bool{
+	"ar": true, "arc": true, "ckb": true, "dv": true, "fa": true, "he": true,
+	"iw": true, "ps": true, "sd": true, "ug": true, "ur": true, "yi": true,
+}
+
+func indexStopWords() map[string][]string {
+	index := make(map[string][]string)
+	for language, words := range stopWords {
+		for _, word := range strings.Fields(words) {
+			index[word] = append(index[word], language)
+		}
+	}
+	return index
+}
+
+// Detect guesses the language of a plain text and returns its ISO 639-1 code.
+//
+// The language is deduced from the script for the texts that are not written with the Latin alphabet,
+// otherwise from the most frequent words of a few languages.
+// An empty string is returned when the text is too short or when the language is ambiguous.
+func Detect(text string) string {
+	scripts := make(map[string]int)
+	letters := 0
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		scripts[script(r)]++
+	}
+
+	if letters < minLetters {
+		return ""
+	}
+
+	dominant, count := "", 0
+	for name, n := range scripts {
+		if n > count || (n == count && name < dominant) {
+			dominant, count = name, n
+		}
+	}
+
+	switch dominant {
+	case "latin":
+		return detectLatinLanguage(text)
+	case "han":
+		// Japanese mixes kanji with kana, Chinese only uses hanzi.
+		if scripts["kana"] > 0 {
+			return "ja"
+		}
+		return "zh"
+	case "kana":
+		return "ja"
+	case "cyrillic":
+		if strings.ContainsAny(strings.ToLower(text), "іїєґ") {
+			return "uk"
+		}
+		return "ru"
+	case "hangul":
+		return "ko"
+	case "arabic":
+		return "ar"
+	case "greek":
+		return "el"
+	case "hebrew":
+		return "he"
+	case "thai":
+		return "th"
+	case "devanagari":
+		return "hi"
+	}
+
+	return ""
+}
+
+// Normalize returns the primary language subtag of a language tag, like "en" for "en-US".
+// An empty string is returned when the tag is not valid.
+func Normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+
+	if len(tag) < 2 || len(tag) > 3 {
+		return ""
+	}
+
+	for _, r := range tag {
+		if r < 'a' || r > 'z' {
+			return ""
+		}
+	}
+
+	return tag
+}
+
+// IsRightToLeft returns true when the language tag designates a language written from right to left, like "ar" or "he-IL".
+func IsRightToLeft(tag string) bool {
+	return rightToLeftLanguages[Normalize(tag)]
+}
+
+// IsRightToLeftText returns true when most letters of the text belong to a right-to-left script.
+func IsRightToLeftText(text string) bool {
+	rightToLeft, leftToRight := 0, 0
+	for _, r := range text {
+		switch {
+		case !unicode.IsLetter(r):
+			continue
+		case unicode.In(r, unicode.Arabic, unicode.Hebrew, unicode.Syriac, unicode.Thaana, unicode.Nko):
+			rightToLeft++
+		default:
+			leftToRight++
+		}
+	}
+
+	return rightToLeft > leftToRight
+}
+
+func detectLatinLanguage(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	if len(words) < minWords {
+		return ""
+	}
+
+	scores := make(map[string]int)
+	for _, word := range words {
+		for _, language := range stopWordLanguages[word] {
+			scores[language]++
+		}
+	}
+
+	best, bestScore, secondScore := "", 0, 0
+	for language, score := range scores {
+		switch {
+		case score > bestScore:
+			best, bestScore, secondScore = language, score, bestScore
+		case score > secondScore:
+			secondScore = score
+		}
+	}
+
+	if bestScore == secondScore || float64(bestScore) < minStopWordRatio*float64(len(words)) {
+		return ""
+	}
+
+	return best
+}
+
+func script(r rune) string {
+	switch {
+	case unicode.Is(unicode.Latin, r):
+		return "latin"
+	case unicode.Is(unicode.Han, r):
+		return "han"
+	case unicode.Is(unicode.Hiragana, r), unicode.Is(unicode.Katakana, r):
+		return "kana"
+	case unicode.Is(unicode.Cyrillic, r):
+		return "cyrillic"
+	case unicode.Is(unicode.Hangul, r):
+		return "hangul"
+	case unicode.Is(unicode.Arabic, r):
+		return "arabic"
+	case unicode.Is(unicode.Greek, r):
+		return "greek"
+	case unicode.Is(unicode.Hebrew, r):
+		return "hebrew"
+	case unicode.Is(unicode.Thai, r):
+		return "thai"
+	case unicode.Is(unicode.Devanagari, r):
+		return "devanagari"
+	}
+	return "other"
+}
@@ -0,0 +1,103 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package language // import "miniflux.app/reader/language"
+
+import "testing"
+
+func TestDetect(t *testing.T) {
+	scenarios := map[string]string{
+		"en": `The new version of the application has been released this week. It brings a lot of improvements for the users and it fixes many bugs that were reported by the community over the last months.`,
+		"fr": `La nouvelle version de l'application est disponible depuis cette semaine. Elle apporte de nombreuses améliorations pour les utilisateurs et corrige plusieurs bogues qui ont été signalés par la communauté.`,
+		"de": `Die neue Version der Anwendung ist seit dieser Woche verfügbar. Sie bringt viele Verbesserungen für die Benutzer und behebt auch einige Fehler, die von der Gemeinschaft in den letzten Monaten gemeldet wurden.`,
+		"es": `La nueva versión de la aplicación está disponible desde esta semana. Trae muchas mejoras para los usuarios y corrige varios errores que fueron reportados por la comunidad durante los últimos meses.`,
+		"it": `La nuova versione dell'applicazione è disponibile da questa settimana. Porta molti miglioramenti per gli utenti e corregge anche alcuni errori che sono stati segnalati dalla comunità negli ultimi mesi.`,
+		"pt": `A nova versão do aplicativo está disponível desde esta semana. Ela traz muitas melhorias para os usuários e também corrige vários erros que foram relatados pela comunidade nos últimos meses.`,
+		"nl": `De nieuwe versie van de applicatie is sinds deze week beschikbaar. Het brengt veel verbeteringen voor de gebruikers en het lost ook een aantal fouten op die door de gemeenschap in de afgelopen maanden werden gemeld.`,
+		"pl": `Nowa wersja aplikacji jest dostępna od tego tygodnia. Przynosi wiele ulepszeń dla użytkowników i naprawia też błędy, które zostały zgłoszone przez społeczność w ciągu ostatnich miesięcy, a to nie jest wszystko.`,
+		"ja": `アプリケーションの新しいバージョンが今週公開されました。多くの改善が含まれており、コミュニティから報告された不具合も修正されています。`,
+		"zh": `该应用程序的新版本已于本周发布。它为用户带来了许多改进，并修复了社区在过去几个月中报告的许多错误。`,
+		"ko": `이번 주에 애플리케이션의 새 버전이 출시되었습니다. 사용자를 위한 많은 개선 사항이 포함되어 있습니다.`,
+		"ru": `Новая версия приложения доступна с этой недели. Она приносит много улучшений для пользователей и исправляет ошибки.`,
+		"uk": `Нова версія застосунку доступна з цього тижня. Вона приносить багато покращень для користувачів і виправляє помилки.`,
+	}
+
+	for expected, text := range scenarios {
+		if result := Detect(text); result != expected {
+			t.Errorf(`Unexpected language for %q, got %q instead of %q`, expected, result, expected)
+		}
+	}
+}
+
+func TestDetectWithShortText(t *testing.T) {
+	for _, text := range []string{"", "Hello world", "The new version is out", "新版本"} {
+		if result := Detect(text); result != "" {
+			t.Errorf(`Short texts should not be labeled, got %q for %q`, result, text)
+		}
+	}
+}
+
+func TestDetectWithoutStopWords(t *testing.T) {
+	text := `Lorem ipsum dolor sit amet consectetur adipiscing elit sed eiusmod tempor incididunt labore dolore magna aliqua enim minim veniam quis nostrud exercitation ullamco laboris nisi aliquip commodo consequat`
+	if result := Detect(text); result != "" {
+		t.Errorf(`The language should not be guessed, got %q`, result)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	scenarios := map[string]string{
+		"en":        "en",
+		"en-US":     "en",
+		" FR_fr ":   "fr",
+		"zh-Hans":   "zh",
+		"":          "",
+		"english":   "",
+		"x":         "",
+		"12":        "",
+		"fil-PH":    "fil",
+		"en-GB-oed": "en",
+	}
+
+	for input, expected := range scenarios {
+		if result := Normalize(input); result != expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
+
+func TestIsRightToLeft(t *testing.T) {
+	scenarios := map[string]bool{
+		"ar":     true,
+		"he-IL":  true,
+		"fa_IR":  true,
+		"ur":     true,
+		"en":     false,
+		"fr-FR":  false,
+		"":       false,
+		"arabic": false,
+	}
+
+	for tag, expected := range scenarios {
+		if result := IsRightToLeft(tag); result != expected {
+			t.Errorf(`Unexpected direction for %q, got %v instead of %v`, tag, result, expected)
+		}
+	}
+}
+
+func TestIsRightToLeftText(t *testing.T) {
+	scenarios := map[string]bool{
+		`صدرت النسخة الجديدة من التطبيق هذا الأسبوع مع Miniflux`: true,
+		`הגרסה החדשה של היישום שוחררה השבוע`:                     true,
+		`The new version of the application has been released`:   false,
+		`Version 2.0 — تحديث`:                                    false,
+		``:                                                       false,
+		`2020-01-01`:                                             false,
+	}
+
+	for text, expected := range scenarios {
+		if result := IsRightToLeftText(text); result != expected {
+			t.Errorf(`Unexpected direction for %q, got %v instead of %v`, text, result, expected)
+		}
+	}
+}
@@ -19,6 +19,22 @@ type Element struct {
 	MediaThumbnails   []Thumbnail     `xml:"http://search.yahoo.com/mrss/ thumbnail"`
 	MediaDescriptions DescriptionList `xml:"http://search.yahoo.com/mrss/ description"`
 	MediaPeerLinks    []PeerLink      `xml:"http://search.yahoo.com/mrss/ peerLink"`
+	MediaRatings      RatingList      `xml:"http://search.yahoo.com/mrss/ rating"`
+}
+
+// MediaRatingIsAdult returns true when the media is rated for adults, found is false when there is no known rating.
+func (e *Element) MediaRatingIsAdult() (adult, found bool) {
+	if adult, found = e.MediaRatings.IsAdult(); found {
+		return adult, found
+	}
+
+	for _, mediaGroup := range e.MediaGroups {
+		if adult, found = mediaGroup.MediaRatings.IsAdult(); found {
+			return adult, found
+		}
+	}
+
+	return false, false
 }
 
 // AllMediaThumbnails returns all thumbnail elements merged together.
@@ -31,6 +47,32 @@ func (e *Element) AllMediaThumbnails() []Thumbnail {
 	return items
 }
 
+// LargestMediaThumbnailURL returns the URL of the widest thumbnail, the height is used when the widths are equal.
+// The first thumbnail is returned when the dimensions are missing.
+func (e *Element) LargestMediaThumbnailURL() string {
+	var largest *Thumbnail
+	largestWidth, largestHeight := 0, 0
+
+	for _, thumbnail := range e.AllMediaThumbnails() {
+		if strings.TrimSpace(thumbnail.URL) == "" {
+			continue
+		}
+
+		width, height := thumbnail.Dimensions()
+		if largest == nil || width > largestWidth || (width == largestWidth && height > largestHeight) {
+			thumbnail := thumbnail
+			largest = &thumbnail
+			largestWidth, largestHeight = width, height
+		}
+	}
+
+	if largest == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(largest.URL)
+}
+
 // AllMediaContents returns all content elements merged together.
 func (e *Element) AllMediaContents() []Content {
 	var items []Content
@@ -74,6 +116,59 @@ type Group struct {
 	MediaThumbnails   []Thumbnail     `xml:"http://search.yahoo.com/mrss/ thumbnail"`
 	MediaDescriptions DescriptionList `xml:"http://search.yahoo.com/mrss/ description"`
 	MediaPeerLinks    []PeerLink      `xml:"http://search.yahoo.com/mrss/ peerLink"`
+	MediaRatings      RatingList      `xml:"http://search.yahoo.com/mrss/ rating"`
+}
+
+// Rating represents a XML element "media:rating".
+// Specs: https://www.rssboard.org/media-rss#media-rating
+type Rating struct {
+	Scheme string `xml:"scheme,attr"`
+	Value  string `xml:",chardata"`
+}
+
+// IsAdult returns true when the rating is restricted to adults, found is false when the scheme or the value is unknown.
+func (r *Rating) IsAdult() (adult, found bool) {
+	value := strings.ToLower(strings.TrimSpace(r.Value))
+
+	switch strings.ToLower(strings.TrimSpace(r.Scheme)) {
+	case "", "urn:simple":
+		switch value {
+		case "adult":
+			return true, true
+		case "nonadult":
+			return false, true
+		}
+	case "urn:mpaa":
+		switch value {
+		case "r", "nc-17", "x":
+			return true, true
+		case "g", "pg", "pg-13":
+			return false, true
+		}
+	case "urn:v-chip":
+		switch value {
+		case "tv-ma":
+			return true, true
+		case "tv-y", "tv-y7", "tv-y7-fv", "tv-g", "tv-pg", "tv-14":
+			return false, true
+		}
+	}
+
+	return false, false
+}
+
+// RatingList represents a list of "media:rating" XML elements.
+type RatingList []Rating
+
+// IsAdult returns the first known rating of the list.
+func (l RatingList) IsAdult() (adult, found bool) {
+	for _, rating := range l {
+		if adult, found = rating.IsAdult(); found {
+			return adult, found
+		}
+	}
+
+	return false, false
 }
 
 // Content represents a XML element "media:content".
@@ -113,7 +208,16 @@ func (mc *Content) Size() int64 {
 
 // Thumbnail represents a XML element "media:thumbnail".
 type Thumbnail struct {
-	URL string `xml:"url,attr"`
+	URL    string `xml:"url,attr"`
+	Width  string `xml:"width,attr"`
+	Height string `xml:"height,attr"`
+}
+
+// Dimensions returns the width and the height of the thumbnail, a missing or invalid dimension is 0.
+func (t *Thumbnail) Dimensions() (width, height int) {
+	width, _ = strconv.Atoi(strings.TrimSpace(t.Width))
+	height, _ = strconv.Atoi(strings.TrimSpace(t.Height))
+	return width, height
 }
 
 // MimeType returns the attachment mime type.
@@ -108,3 +108,73 @@ func TestFirstDescription(t *testing.T) {
 		t.Errorf(`Unexpected description`)
 	}
 }
+
+func TestThumbnailDimensions(t *testing.T) {
+	scenarios := []struct {
+		width, height                 string
+		expectedWidth, expectedHeight int
+	}{
+		{"640", "360", 640, 360},
+		{" 120 ", "90", 120, 90},
+		{"", "", 0, 0},
+		{"invalid", "720", 0, 720},
+	}
+
+	for _, scenario := range scenarios {
+		thumbnail := &Thumbnail{Width: scenario.width, Height: scenario.height}
+		width, height := thumbnail.Dimensions()
+		if width != scenario.expectedWidth || height != scenario.expectedHeight {
+			t.Errorf(`Unexpected dimensions for %q x %q, got %d x %d`, scenario.width, scenario.height, width, height)
+		}
+	}
+}
+
+func TestLargestMediaThumbnailURL(t *testing.T) {
+	element := &Element{
+		MediaThumbnails: []Thumbnail{
+			{URL: "https://example.org/small.jpg", Width: "120", Height: "90"},
+			{URL: "https://example.org/tall.jpg", Width: "480", Height: "720"},
+		},
+		MediaGroups: []Group{
+			{MediaThumbnails: []Thumbnail{{URL: "https://example.org/wide.jpg", Width: "480", Height: "360"}}},
+		},
+	}
+
+	if result := element.LargestMediaThumbnailURL(); result != "https://example.org/tall.jpg" {
+		t.Errorf(`Unexpected thumbnail, got %q`, result)
+	}
+
+	element = &Element{MediaThumbnails: []Thumbnail{{URL: " https://example.org/first.jpg "}, {URL: "https://example.org/second.jpg"}}}
+	if result := element.LargestMediaThumbnailURL(); result != "https://example.org/first.jpg" {
+		t.Errorf(`The first thumbnail should be used without dimensions, got %q`, result)
+	}
+
+	element = &Element{}
+	if result := element.LargestMediaThumbnailURL(); result != "" {
+		t.Errorf(`No thumbnail should be returned, got %q`, result)
+	}
+}
+
+func TestRatingIsAdult(t *testing.T) {
+	scenarios := []struct {
+		scheme, value string
+		adult, found  bool
+	}{
+		{"", "adult", true, true},
+		{"urn:simple", "nonadult", false, true},
+		{"urn:mpaa", "NC-17", true, true},
+		{"urn:mpaa", "pg", false, true},
+		{"urn:v-chip", "tv-ma", true, true},
+		{"urn:v-chip", "tv-14", false, true},
+		{"urn:icra", "r (cz 1 lz 1 nz 1 oz 1 vz 1)", false, false},
+		{"", "unknown", false, false},
+	}
+
+	for _, scenario := range scenarios {
+		rating := &Rating{Scheme: scenario.scheme, Value: scenario.value}
+		adult, found := rating.IsAdult()
+		if adult != scenario.adult || found != scenario.found {
+			t.Errorf(`Unexpected result for %q %q, got %v/%v instead of %v/%v`, scenario.scheme, scenario.value, adult, found, scenario.adult, scenario.found)
+		}
+	}
+}
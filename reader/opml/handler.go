@@ -33,64 +33,124 @@ func (h *Handler) Export(userID int64) (string, error) {
 			FeedURL:      feed.FeedURL,
 			SiteURL:      feed.SiteURL,
 			CategoryName: feed.Category.Title,
+			ScraperRules: feed.ScraperRules,
+			RewriteRules: feed.RewriteRules,
+			Crawler:      feed.CrawlerMode == model.CrawlerModeEnabled,
+			UserAgent:    feed.UserAgent,
 		})
 	}
 
 	return Serialize(subscriptions), nil
 }
 
+// ImportSummary reports which subscriptions have been created, skipped or failed during an import.
+type ImportSummary struct {
+	Created SubcriptionList `json:"created"`
+	Skipped SubcriptionList `json:"skipped"`
+	Failed  SubcriptionList `json:"failed"`
+}
+
 // Import parses and create feeds from an OPML import.
-func (h *Handler) Import(userID int64, data io.Reader) error {
-	subscriptions, err := Parse(data)
+//
+// Feeds already subscribed by the user are skipped and feeds that cannot be saved are reported as failed,
+// the import continues with the next subscription in both cases.
+func (h *Handler) Import(userID int64, data io.Reader) (*ImportSummary, error) {
+	subscriptions, parseErr := Parse(data)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	defaultCategory, err := h.store.FirstCategory(userID)
 	if err != nil {
-		return err
+		logger.Error("[OPML:Import] %v", err)
+		return nil, errors.New("unable to find first category")
 	}
 
+	summary := &ImportSummary{
+		Created: SubcriptionList{},
+		Skipped: SubcriptionList{},
+		Failed:  SubcriptionList{},
+	}
+	categories := make(map[string]*model.Category)
+
 	for _, subscription := range subscriptions {
-		if !h.store.FeedURLExists(userID, subscription.FeedURL) {
-			var category *model.Category
-			var err error
-
-			if subscription.CategoryName == "" {
-				category, err = h.store.FirstCategory(userID)
-				if err != nil {
-					logger.Error("[OPML:Import] %v", err)
-					return errors.New("unable to find first category")
-				}
-			} else {
-				category, err = h.store.CategoryByTitle(userID, subscription.CategoryName)
-				if err != nil {
-					logger.Error("[OPML:Import] %v", err)
-					return errors.New("unable to search category by title")
-				}
-
-				if category == nil {
-					category = &model.Category{
-						UserID: userID,
-						Title:  subscription.CategoryName,
-					}
-
-					err := h.store.CreateCategory(category)
-					if err != nil {
-						logger.Error("[OPML:Import] %v", err)
-						return fmt.Errorf(`unable to create this category: %q`, subscription.CategoryName)
-					}
-				}
-			}
-
-			feed := &model.Feed{
-				UserID:   userID,
-				Title:    subscription.Title,
-				FeedURL:  subscription.FeedURL,
-				SiteURL:  subscription.SiteURL,
-				Category: category,
-			}
-
-			h.store.CreateFeed(feed)
+		switch status, _ := h.importSubscription(userID, subscription, defaultCategory, categories); status {
+		case model.ImportItemStatusCreated:
+			summary.Created = append(summary.Created, subscription)
+		case model.ImportItemStatusSkipped:
+			summary.Skipped = append(summary.Skipped, subscription)
+		default:
+			summary.Failed = append(summary.Failed, subscription)
+		}
+	}
+
+	return summary, nil
+}
+
+// importSubscription creates the feed of a subscription and returns the import status, the feed is nil unless it has been created.
+func (h *Handler) importSubscription(userID int64, subscription *Subcription, defaultCategory *model.Category, categories map[string]*model.Category) (string, *model.Feed) {
+	// Same duplicate detection as the feed creation, the subscriptions repeated in the file are skipped as well.
+	if h.store.FeedURLExists(userID, subscription.FeedURL) {
+		return model.ImportItemStatusSkipped, nil
+	}
+
+	category := defaultCategory
+	if subscription.CategoryName != "" {
+		var err error
+		category, err = h.findOrCreateCategory(userID, subscription.CategoryName, categories)
+		if err != nil {
+			logger.Error("[OPML:Import] %v", err)
+			return model.ImportItemStatusFailed, nil
+		}
+	}
+
+	feed := &model.Feed{
+		UserID:       userID,
+		Title:        subscription.Title,
+		FeedURL:      subscription.FeedURL,
+		SiteURL:      subscription.SiteURL,
+		Category:     category,
+		ScraperRules: subscription.ScraperRules,
+		RewriteRules: subscription.RewriteRules,
+		UserAgent:    subscription.UserAgent,
+	}
+
+	if subscription.Crawler {
+		feed.CrawlerMode = model.CrawlerModeEnabled
+	}
+
+	if err := h.store.CreateFeed(feed); err != nil {
+		logger.Error("[OPML:Import] %v", err)
+		return model.ImportItemStatusFailed, nil
+	}
+
+	return model.ImportItemStatusCreated, feed
+}
+
+// findOrCreateCategory returns the user category with the given title and creates it when missing.
+func (h *Handler) findOrCreateCategory(userID int64, title string, categories map[string]*model.Category) (*model.Category, error) {
+	if category, found := categories[title]; found {
+		return category, nil
+	}
+
+	category, err := h.store.CategoryByTitle(userID, title)
+	if err != nil {
+		return nil, fmt.Errorf(`unable to search category by title %q: %v`, title, err)
+	}
+
+	if category == nil {
+		category = &model.Category{
+			UserID: userID,
+			Title:  title,
+		}
+
+		if err := h.store.CreateCategory(category); err != nil {
+			return nil, fmt.Errorf(`unable to create this category %q: %v`, title, err)
 		}
 	}
 
-	return nil
+	categories[title] = category
+	return category, nil
 }
 
 // NewHandler creates a new handler for OPML files.
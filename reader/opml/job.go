@@ -0,0 +1,82 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package opml // import "miniflux.app/reader/opml"
+
+import (
+	"io"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+// CreateImportJob parses an OPML file and saves the subscriptions to import them in the background.
+func (h *Handler) CreateImportJob(userID int64, data io.Reader) (*model.ImportJob, error) {
+	subscriptions, parseErr := Parse(data)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	items := make([]*model.ImportJobItem, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		items = append(items, &model.ImportJobItem{
+			Title:        subscription.Title,
+			FeedURL:      subscription.FeedURL,
+			SiteURL:      subscription.SiteURL,
+			CategoryName: subscription.CategoryName,
+			ScraperRules: subscription.ScraperRules,
+			RewriteRules: subscription.RewriteRules,
+			Crawler:      subscription.Crawler,
+			UserAgent:    subscription.UserAgent,
+		})
+	}
+
+	job := &model.ImportJob{UserID: userID}
+	if err := h.store.CreateImportJob(job, items); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// ImportNextJobItem imports the next pending subscription of the background jobs, the created feed is passed to onCreate.
+// The progress is saved after each subscription, so an interrupted job resumes where it stopped.
+// It returns false when there is nothing left to import.
+func (h *Handler) ImportNextJobItem(onCreate func(feed *model.Feed)) (bool, error) {
+	var createdFeed *model.Feed
+	found, err := h.store.ImportNextJobItem(func(job *model.ImportJob, item *model.ImportJobItem) error {
+		defaultCategory, err := h.store.FirstCategory(job.UserID)
+		if err != nil || defaultCategory == nil {
+			logger.Error("[OPML:ImportJob] Job #%d: unable to find first category: %v", job.ID, err)
+			job.Status = model.ImportJobStatusFailed
+			job.ErrorMsg = "unable to find first category"
+			item.Status = model.ImportItemStatusFailed
+			return nil
+		}
+
+		subscription := &Subcription{
+			Title:        item.Title,
+			FeedURL:      item.FeedURL,
+			SiteURL:      item.SiteURL,
+			CategoryName: item.CategoryName,
+			ScraperRules: item.ScraperRules,
+			RewriteRules: item.RewriteRules,
+			Crawler:      item.Crawler,
+			UserAgent:    item.UserAgent,
+		}
+
+		item.Status, createdFeed = h.importSubscription(job.UserID, subscription, defaultCategory, make(map[string]*model.Category))
+		return nil
+	})
+
+	if err != nil {
+		return found, err
+	}
+
+	if createdFeed != nil && onCreate != nil {
+		onCreate(createdFeed)
+	}
+
+	return found, nil
+}
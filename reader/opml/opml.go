@@ -6,20 +6,65 @@ package opml // import "miniflux.app/reader/opml"
 
 import (
 	"encoding/xml"
+	"strconv"
+)
+
+// The Miniflux namespace is used for the feed settings that are not part of the OPML specification.
+const (
+	minifluxNamespace = "https://miniflux.app/opml"
+	minifluxPrefix    = "miniflux"
 )
 
 type opml struct {
-	XMLName  xml.Name  `xml:"opml"`
-	Version  string    `xml:"version,attr"`
-	Outlines []outline `xml:"body>outline"`
+	XMLName   xml.Name  `xml:"opml"`
+	Version   string    `xml:"version,attr"`
+	Namespace string    `xml:"xmlns:miniflux,attr,omitempty"`
+	Outlines  []outline `xml:"body>outline"`
 }
 
 type outline struct {
-	Title    string    `xml:"title,attr,omitempty"`
-	Text     string    `xml:"text,attr"`
-	FeedURL  string    `xml:"xmlUrl,attr,omitempty"`
-	SiteURL  string    `xml:"htmlUrl,attr,omitempty"`
-	Outlines []outline `xml:"outline,omitempty"`
+	Title        string    `xml:"title,attr,omitempty"`
+	Text         string    `xml:"text,attr"`
+	FeedURL      string    `xml:"xmlUrl,attr,omitempty"`
+	SiteURL      string    `xml:"htmlUrl,attr,omitempty"`
+	ScraperRules string    `xml:"https://miniflux.app/opml scraperRules,attr,omitempty"`
+	RewriteRules string    `xml:"https://miniflux.app/opml rewriteRules,attr,omitempty"`
+	Crawler      string    `xml:"https://miniflux.app/opml crawler,attr,omitempty"`
+	UserAgent    string    `xml:"https://miniflux.app/opml userAgent,attr,omitempty"`
+	Outlines     []outline `xml:"outline,omitempty"`
+}
+
+// MarshalXML writes the Miniflux attributes with the prefix declared on the root element,
+// the standard encoder would declare the namespace again on each outline.
+func (o outline) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = appendAttr(start.Attr, "title", o.Title)
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "text"}, Value: o.Text})
+	start.Attr = appendAttr(start.Attr, "xmlUrl", o.FeedURL)
+	start.Attr = appendAttr(start.Attr, "htmlUrl", o.SiteURL)
+	start.Attr = appendAttr(start.Attr, minifluxPrefix+":scraperRules", o.ScraperRules)
+	start.Attr = appendAttr(start.Attr, minifluxPrefix+":rewriteRules", o.RewriteRules)
+	start.Attr = appendAttr(start.Attr, minifluxPrefix+":crawler", o.Crawler)
+	start.Attr = appendAttr(start.Attr, minifluxPrefix+":userAgent", o.UserAgent)
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, child := range o.Outlines {
+		if err := e.EncodeElement(child, xml.StartElement{Name: xml.Name{Local: "outline"}}); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+func appendAttr(attrs []xml.Attr, name, value string) []xml.Attr {
+	if value == "" {
+		return attrs
+	}
+
+	return append(attrs, xml.Attr{Name: xml.Name{Local: name}, Value: value})
 }
 
 func (o *outline) GetTitle() string {
@@ -50,6 +95,12 @@ func (o *outline) GetSiteURL() string {
 	return o.FeedURL
 }
 
+// IsCrawlerEnabled returns false when the attribute is missing or invalid.
+func (o *outline) IsCrawlerEnabled() bool {
+	enabled, err := strconv.ParseBool(o.Crawler)
+	return err == nil && enabled
+}
+
 func (o *outline) Append(subscriptions SubcriptionList, category string) SubcriptionList {
 	if o.FeedURL != "" {
 		subscriptions = append(subscriptions, &Subcription{
@@ -57,6 +108,10 @@ func (o *outline) Append(subscriptions SubcriptionList, category string) Subcrip
 			FeedURL:      o.FeedURL,
 			SiteURL:      o.GetSiteURL(),
 			CategoryName: category,
+			ScraperRules: o.ScraperRules,
+			RewriteRules: o.RewriteRules,
+			Crawler:      o.IsCrawlerEnabled(),
+			UserAgent:    o.UserAgent,
 		})
 	}
 
@@ -234,3 +234,35 @@ func TestParseInvalidXML(t *testing.T) {
 		t.Error("Parse should generate an error")
 	}
 }
+
+func TestParseOpmlWithFeedSettings(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<opml version="2.0" xmlns:miniflux="https://miniflux.app/opml">
+		<body>
+			<outline text="Category 1">
+				<outline text="Feed 1" xmlUrl="http://example.org/feed1/" miniflux:scraperRules="#content" miniflux:rewriteRules="add_youtube_video" miniflux:crawler="true" miniflux:userAgent="Bot"/>
+				<outline text="Feed 2" xmlUrl="http://example.org/feed2/" miniflux:crawler="invalid"/>
+			</outline>
+		</body>
+	</opml>
+	`
+
+	var expected SubcriptionList
+	expected = append(expected, &Subcription{Title: "Feed 1", FeedURL: "http://example.org/feed1/", SiteURL: "http://example.org/feed1/", CategoryName: "Category 1", ScraperRules: "#content", RewriteRules: "add_youtube_video", Crawler: true, UserAgent: "Bot"})
+	expected = append(expected, &Subcription{Title: "Feed 2", FeedURL: "http://example.org/feed2/", SiteURL: "http://example.org/feed2/", CategoryName: "Category 1"})
+
+	subscriptions, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscriptions) != 2 {
+		t.Fatalf("Wrong number of subscriptions: %d instead of %d", len(subscriptions), 2)
+	}
+
+	for i := 0; i < len(subscriptions); i++ {
+		if !subscriptions[i].Equals(expected[i]) {
+			t.Errorf(`Subscription are different: "%v" vs "%v"`, subscriptions[i], expected[i])
+		}
+	}
+}
@@ -7,6 +7,7 @@ package opml // import "miniflux.app/reader/opml"
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -64,3 +65,42 @@ func TestNormalizedCategoriesOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestSerializeWithFeedSettings(t *testing.T) {
+	var subscriptions SubcriptionList
+	subscriptions = append(subscriptions, &Subcription{
+		Title:        "Feed 1",
+		FeedURL:      "http://example.org/feed/1",
+		SiteURL:      "http://example.org/1",
+		CategoryName: "Category 1",
+		ScraperRules: `article > div.content, #main`,
+		RewriteRules: "add_image_title",
+		Crawler:      true,
+		UserAgent:    "Custom Bot {{version}}",
+	})
+	subscriptions = append(subscriptions, &Subcription{Title: "Feed 2", FeedURL: "http://example.org/feed/2", SiteURL: "http://example.org/2", CategoryName: "Category 1"})
+
+	output := Serialize(subscriptions)
+	if !strings.Contains(output, `xmlns:miniflux="https://miniflux.app/opml"`) {
+		t.Errorf(`The namespace is not declared: %s`, output)
+	}
+
+	if !strings.Contains(output, `miniflux:rewriteRules="add_image_title"`) {
+		t.Errorf(`The rewrite rules are not serialized: %s`, output)
+	}
+
+	feeds, err := Parse(bytes.NewBufferString(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feeds) != 2 {
+		t.Fatalf("Wrong number of subscriptions: %d instead of %d", len(feeds), 2)
+	}
+
+	for i := range subscriptions {
+		if !feeds[i].Equals(subscriptions[i]) {
+			t.Errorf(`Subscription are different: "%v" vs "%v"`, feeds[i], subscriptions[i])
+		}
+	}
+}
@@ -6,16 +6,22 @@ package opml // import "miniflux.app/reader/opml"
 
 // Subcription represents a feed that will be imported or exported.
 type Subcription struct {
-	Title        string
-	SiteURL      string
-	FeedURL      string
-	CategoryName string
+	Title        string `json:"title"`
+	SiteURL      string `json:"site_url"`
+	FeedURL      string `json:"feed_url"`
+	CategoryName string `json:"category"`
+	ScraperRules string `json:"scraper_rules"`
+	RewriteRules string `json:"rewrite_rules"`
+	Crawler      bool   `json:"crawler"`
+	UserAgent    string `json:"user_agent"`
 }
 
 // Equals compare two subscriptions.
 func (s Subcription) Equals(subscription *Subcription) bool {
 	return s.Title == subscription.Title && s.SiteURL == subscription.SiteURL &&
-		s.FeedURL == subscription.FeedURL && s.CategoryName == subscription.CategoryName
+		s.FeedURL == subscription.FeedURL && s.CategoryName == subscription.CategoryName &&
+		s.ScraperRules == subscription.ScraperRules && s.RewriteRules == subscription.RewriteRules &&
+		s.Crawler == subscription.Crawler && s.UserAgent == subscription.UserAgent
 }
 
 // SubcriptionList is a list of subscriptions.
@@ -0,0 +1,57 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package parser // import "miniflux.app/reader/parser"
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	htmlDocumentRegex    = regexp.MustCompile(`(?i)<(html|body)[\s>]`)
+	preElementRegex      = regexp.MustCompile(`(?is)<pre(?:\s[^>]*)?>(.*?)</pre>`)
+	embeddedRootTagRegex = regexp.MustCompile(`<(rss|feed|rdf:RDF)[\s>]`)
+	embeddedFeedFormats  = map[string]bool{FormatAtom: true, FormatRSS: true, FormatRDF: true}
+)
+
+// extractEmbeddedFeed returns the XML feed embedded in an HTML page, either escaped in the only <pre> element
+// of the page or inserted as is in the document. An empty string is returned when the page doesn't contain a feed.
+func extractEmbeddedFeed(data string) string {
+	if !htmlDocumentRegex.MatchString(data) {
+		return ""
+	}
+
+	// Pages with several <pre> elements are usually articles showing code samples, not a wrapped feed.
+	if matches := preElementRegex.FindAllStringSubmatch(data, -1); len(matches) > 0 {
+		if len(matches) == 1 {
+			if candidate := strings.TrimSpace(html.UnescapeString(matches[0][1])); isEmbeddedFeed(candidate) {
+				return candidate
+			}
+		}
+		return ""
+	}
+
+	match := embeddedRootTagRegex.FindStringSubmatchIndex(data)
+	if match == nil {
+		return ""
+	}
+
+	closingTag := "</" + data[match[2]:match[3]] + ">"
+	end := strings.LastIndex(data, closingTag)
+	if end < match[0] {
+		return ""
+	}
+
+	if candidate := data[match[0] : end+len(closingTag)]; isEmbeddedFeed(candidate) {
+		return candidate
+	}
+
+	return ""
+}
+
+func isEmbeddedFeed(data string) bool {
+	return embeddedFeedFormats[DetectFeedFormat(data)]
+}
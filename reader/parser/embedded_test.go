@@ -0,0 +1,84 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package parser // import "miniflux.app/reader/parser"
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestParseFeedEmbeddedInPreElement(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/embedded_pre.html")
+	if err != nil {
+		t.Fatalf(`Unable to read file: %v`, err)
+	}
+
+	feed, parseErr := ParseFeed(string(data))
+	if parseErr != nil {
+		t.Fatalf(`Unable to parse the embedded feed: %v`, parseErr)
+	}
+
+	if feed.Title != "Example Feed" {
+		t.Errorf(`Incorrect title, got: %s`, feed.Title)
+	}
+
+	if len(feed.Entries) != 1 {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	if feed.Entries[0].Title != "Atom-Powered Robots Run Amok" {
+		t.Errorf(`Incorrect entry title, got: %s`, feed.Entries[0].Title)
+	}
+}
+
+func TestParseFeedEmbeddedInDocumentBody(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/embedded_body.html")
+	if err != nil {
+		t.Fatalf(`Unable to read file: %v`, err)
+	}
+
+	feed, parseErr := ParseFeed(string(data))
+	if parseErr != nil {
+		t.Fatalf(`Unable to parse the embedded feed: %v`, parseErr)
+	}
+
+	if feed.Title != "Example Feed" {
+		t.Errorf(`Incorrect title, got: %s`, feed.Title)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+}
+
+func TestParseFeedIgnoresCodeSamples(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/embedded_code_samples.html")
+	if err != nil {
+		t.Fatalf(`Unable to read file: %v`, err)
+	}
+
+	if _, parseErr := ParseFeed(string(data)); parseErr == nil {
+		t.Error(`A page showing several feed samples must not be parsed as a feed`)
+	}
+}
+
+func TestExtractEmbeddedFeedWithoutHTMLDocument(t *testing.T) {
+	data := `<pre>&lt;rss version="2.0"&gt;&lt;channel&gt;&lt;/channel&gt;&lt;/rss&gt;</pre>`
+	if result := extractEmbeddedFeed(data); result != "" {
+		t.Errorf(`Only HTML documents should be inspected, got %q`, result)
+	}
+}
+
+func TestExtractEmbeddedFeedWithOrdinaryHTML(t *testing.T) {
+	data := `<html><body><pre>some code</pre><p>Subscribe to our <a href="/feed">feed</a></p></body></html>`
+	if result := extractEmbeddedFeed(data); result != "" {
+		t.Errorf(`No feed should be extracted from an ordinary page, got %q`, result)
+	}
+
+	data = `<html><body><feedback>Send us your feedback</feedback></body></html>`
+	if result := extractEmbeddedFeed(data); result != "" {
+		t.Errorf(`No feed should be extracted from an ordinary page, got %q`, result)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/xml"
 	"strings"
 
+	"miniflux.app/reader/reddit"
 	rxml "miniflux.app/reader/xml"
 )
 
@@ -17,12 +18,17 @@ const (
 	FormatRSS     = "rss"
 	FormatAtom    = "atom"
 	FormatJSON    = "json"
+	FormatReddit  = "reddit"
 	FormatUnknown = "unknown"
 )
 
 // DetectFeedFormat tries to guess the feed format from input data.
 func DetectFeedFormat(data string) string {
 	if strings.HasPrefix(strings.TrimSpace(data), "{") {
+		// Reddit listings are recognized by their structure, they are not JSON Feeds.
+		if reddit.IsListing([]byte(data)) {
+			return FormatReddit
+		}
 		return FormatJSON
 	}
 
@@ -67,6 +67,15 @@ func TestDetectJSON(t *testing.T) {
 	}
 }
 
+func TestDetectReddit(t *testing.T) {
+	data := `{"kind": "Listing", "data": {"children": [{"kind": "t3", "data": {"title": "Post"}}]}}`
+	format := DetectFeedFormat(data)
+
+	if format != FormatReddit {
+		t.Errorf(`Wrong format detected: %q instead of %q`, format, FormatReddit)
+	}
+}
+
 func TestDetectUnknown(t *testing.T) {
 	data := `
 	<!DOCTYPE html> <html> </html>
@@ -12,11 +12,26 @@ import (
 	"miniflux.app/reader/atom"
 	"miniflux.app/reader/json"
 	"miniflux.app/reader/rdf"
+	"miniflux.app/reader/reddit"
 	"miniflux.app/reader/rss"
 )
 
 // ParseFeed analyzes the input data and returns a normalized feed object.
+// When the data cannot be parsed, a feed embedded in an HTML page is used as a fallback.
 func ParseFeed(data string) (*model.Feed, *errors.LocalizedError) {
+	feed, err := parseFeed(data)
+	if err != nil {
+		if embeddedFeed := extractEmbeddedFeed(data); embeddedFeed != "" {
+			if feed, embeddedErr := parseFeed(embeddedFeed); embeddedErr == nil {
+				return feed, nil
+			}
+		}
+	}
+
+	return feed, err
+}
+
+func parseFeed(data string) (*model.Feed, *errors.LocalizedError) {
 	switch DetectFeedFormat(data) {
 	case FormatAtom:
 		return atom.Parse(strings.NewReader(data))
@@ -24,6 +39,8 @@ func ParseFeed(data string) (*model.Feed, *errors.LocalizedError) {
 		return rss.Parse(strings.NewReader(data))
 	case FormatJSON:
 		return json.Parse(strings.NewReader(data))
+	case FormatReddit:
+		return reddit.Parse(strings.NewReader(data))
 	case FormatRDF:
 		return rdf.Parse(strings.NewReader(data))
 	default:
@@ -129,6 +129,34 @@ func TestParseJson(t *testing.T) {
 	}
 }
 
+func TestParseReddit(t *testing.T) {
+	data := `{
+		"kind": "Listing",
+		"data": {
+			"children": [
+				{
+					"kind": "t3",
+					"data": {
+						"name": "t3_abc123",
+						"title": "Hello Reddit",
+						"permalink": "/r/golang/comments/abc123/hello_reddit/",
+						"subreddit_name_prefixed": "r/golang"
+					}
+				}
+			]
+		}
+	}`
+
+	feed, err := ParseFeed(data)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if feed.Title != "r/golang" {
+		t.Errorf("Incorrect title, got: %s", feed.Title)
+	}
+}
+
 func TestParseUnknownFeed(t *testing.T) {
 	data := `
 		<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Transitional//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-transitional.dtd">
@@ -178,6 +206,18 @@ func TestDifferentEncodingWithResponse(t *testing.T) {
 
 		// UTF-8 encoding defined only in RDF document.
 		{"rdf_UTF8.xml", "application/rss+xml", 1, "Mega-Deal: IBM übernimmt Red Hat"},
+
+		// Shift_JIS encoding defined only in XML document.
+		{"encoding_SHIFT_JIS.xml", "application/rss+xml", 0, "東京で新しい美術館がオープンしました"},
+
+		// No encoding in XML, but Shift_JIS defined in HTTP Content-Type header.
+		{"no_encoding_SHIFT_JIS.xml", "application/rss+xml; charset=Shift_JIS", 1, "今日の天気は晴れです"},
+
+		// No encoding information, GB2312 detected from the content.
+		{"no_encoding_GB2312.xml", "text/xml", 0, "中国经济保持稳定增长"},
+
+		// No encoding information and no Content-Type header, EUC-JP detected from the content.
+		{"no_encoding_EUC-JP.xml", "", 1, "今日の天気は晴れです"},
 	}
 
 	for _, tc := range unicodeTestCases {
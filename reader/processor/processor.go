@@ -5,27 +5,55 @@
 package processor
 
 import (
+	"strings"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/client"
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/reader/date"
+	"miniflux.app/reader/language"
+	"miniflux.app/reader/readingtime"
 	"miniflux.app/reader/rewrite"
 	"miniflux.app/reader/sanitizer"
 	"miniflux.app/reader/scraper"
 	"miniflux.app/storage"
+	"miniflux.app/url"
 )
 
 // ProcessFeedEntries downloads original web page for entries and apply filters.
 func ProcessFeedEntries(store *storage.Storage, feed *model.Feed) {
+	processFeedEntries(store, feed, feed.IsCrawlerEnabled())
+}
+
+// PreviewFeedEntries applies the filters to the entries of a feed that is not stored, the web pages are never downloaded.
+func PreviewFeedEntries(feed *model.Feed) {
+	processFeedEntries(nil, feed, false)
+}
+
+func processFeedEntries(store *storage.Storage, feed *model.Feed, crawler bool) {
 	for _, entry := range feed.Entries {
 		logger.Debug("[Feed #%d] Processing entry %s", feed.ID, entry.URL)
 
-		if feed.Crawler {
-			if !store.EntryURLExists(feed.ID, entry.URL) {
-				content, err := scraper.Fetch(entry.URL, feed.ScraperRules, feed.UserAgent)
+		entry.URL = rewrite.RewriteEntryURL(entry.URL, feed.RewriteRules)
+		entry.Date = entryDate(feed, entry)
+		summary := entry.Content
+		crawled := false
+
+		// The existing entries are not updated when the crawler is enabled.
+		keepStoredEntry := false
+
+		if crawler {
+			keepStoredEntry = store.EntryURLExists(feed.ID, entry.URL)
+			if !keepStoredEntry {
+				content, err := scraper.FetchWithClient(newWebPageClient(feed, entry.URL), feed.ScraperRules)
 				if err != nil {
 					logger.Error(`[Filter] Unable to crawl this entry: %q => %v`, entry.URL, err)
 				} else if content != "" {
 					// We replace the entry content only if the scraper doesn't return any error.
 					entry.Content = content
+					crawled = true
 				}
 			}
 		}
@@ -34,22 +62,163 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed) {
 
 		// The sanitizer should always run at the end of the process to make sure unsafe HTML is filtered.
 		entry.Content = sanitizer.Sanitize(entry.URL, entry.Content)
+		entry.ContentHash = entry.ComputeContentHash()
+
+		// The summary keeps the content provided by the feed, even when it has been replaced by the crawler.
+		if crawled {
+			entry.Summary = sanitizer.Sanitize(entry.URL, rewrite.Rewriter(entry.URL, summary, feed.RewriteRules))
+		} else {
+			entry.Summary = entry.Content
+		}
+
+		// The translation happens in the background once the entry is stored, see TranslateFeedEntries.
+		entry.Language = entryLanguage(feed, entry)
+
+		if isRightToLeftEntry(entry) {
+			entry.Content = wrapRightToLeftContent(entry.Content)
+			entry.Summary = wrapRightToLeftContent(entry.Summary)
+		}
+
+		entry.ReadingTime = readingtime.EstimateReadingTime(entry.Content, config.Opts.ReadingTimeWordsPerMinute())
 	}
 }
 
+// entryDate returns the date of the entry parsed with the layouts of the feed, when the common date formats don't match.
+// The date found by the feed parser is kept when the layouts don't match either.
+func entryDate(feed *model.Feed, entry *model.Entry) time.Time {
+	if feed.DateLayouts == "" || entry.RawDate == "" {
+		return entry.Date
+	}
+
+	result, err := date.ParseWithLayouts(entry.RawDate, strings.Split(feed.DateLayouts, "\n"))
+	if err != nil {
+		logger.Error("[Processor] Feed #%d: %v", feed.ID, err)
+		return entry.Date
+	}
+
+	return result
+}
+
+// entryLanguage returns the language declared by the feed, or the detected language when the feed does not declare any.
+func entryLanguage(feed *model.Feed, entry *model.Entry) string {
+	if lang := language.Normalize(feed.Language); lang != "" {
+		return lang
+	}
+
+	if !config.Opts.HasLanguageDetection() {
+		return ""
+	}
+
+	return language.Detect(entry.Title + "\n" + sanitizer.StripTags(entry.Content))
+}
+
+// isRightToLeftEntry returns true when the entry is written in a right-to-left language.
+// The direction is deduced from the text when the language of the entry is unknown.
+func isRightToLeftEntry(entry *model.Entry) bool {
+	if entry.Language != "" {
+		return language.IsRightToLeft(entry.Language)
+	}
+
+	return language.IsRightToLeftText(entry.Title + "\n" + sanitizer.StripTags(entry.Content))
+}
+
+// wrapRightToLeftContent sets the direction of the content, the wrapper is added after the sanitizer
+// because the div elements are not kept in the entries.
+func wrapRightToLeftContent(content string) string {
+	if content == "" {
+		return content
+	}
+
+	return `<div dir="rtl">` + content + `</div>`
+}
+
+// unwrapRightToLeftContent removes the wrapper added by wrapRightToLeftContent, the rewrite rules expect the content of the feed.
+func unwrapRightToLeftContent(content string) string {
+	if strings.HasPrefix(content, `<div dir="rtl">`) && strings.HasSuffix(content, `</div>`) {
+		return strings.TrimSuffix(strings.TrimPrefix(content, `<div dir="rtl">`), `</div>`)
+	}
+
+	return content
+}
+
+// ReprocessEntry applies the current scraper and rewrite rules of the feed to a stored entry.
+// The web page is downloaded again when the crawler is enabled, otherwise the rewrite rules are applied to the stored content.
+func ReprocessEntry(feed *model.Feed, entry *model.Entry) error {
+	content := unwrapRightToLeftContent(entry.Content)
+
+	if feed.IsCrawlerEnabled() {
+		fetchedContent, err := scraper.FetchWithClient(newWebPageClient(feed, entry.URL), feed.ScraperRules)
+		if err != nil {
+			return err
+		}
+
+		if fetchedContent != "" {
+			if entry.Summary == "" {
+				entry.Summary = entry.Content
+			}
+			content = fetchedContent
+		}
+	}
+
+	content = rewrite.Rewriter(entry.URL, content, feed.RewriteRules)
+	entry.Content = sanitizer.Sanitize(entry.URL, content)
+	if isRightToLeftEntry(entry) {
+		entry.Content = wrapRightToLeftContent(entry.Content)
+	}
+
+	entry.ReadingTime = readingtime.EstimateReadingTime(entry.Content, config.Opts.ReadingTimeWordsPerMinute())
+	return nil
+}
+
 // ProcessEntryWebPage downloads the entry web page and apply rewrite rules.
+// The content provided by the feed is kept as summary.
 func ProcessEntryWebPage(entry *model.Entry) error {
-	content, err := scraper.Fetch(entry.URL, entry.Feed.ScraperRules, entry.Feed.UserAgent)
+	content, err := FetchEntryContent(entry)
 	if err != nil {
 		return err
 	}
 
-	content = rewrite.Rewriter(entry.URL, content, entry.Feed.RewriteRules)
-	content = sanitizer.Sanitize(entry.URL, content)
-
 	if content != "" {
+		if entry.Summary == "" {
+			entry.Summary = entry.Content
+		}
 		entry.Content = content
+		entry.ReadingTime = readingtime.EstimateReadingTime(entry.Content, config.Opts.ReadingTimeWordsPerMinute())
 	}
 
 	return nil
 }
+
+// FetchEntryContent downloads the entry web page with the settings of its feed,
+// applies the scraper and rewrite rules, and returns the sanitized content without modifying the entry.
+// The feed credentials and cookie are only sent when the entry is hosted on the same domain as the feed.
+func FetchEntryContent(entry *model.Entry) (string, error) {
+	clt := newWebPageClient(entry.Feed, entry.URL)
+
+	if url.Domain(entry.URL) == url.Domain(entry.Feed.FeedURL) {
+		if entry.Feed.UsesBearerToken() {
+			clt.WithAuthorizationHeader("Bearer", entry.Feed.AuthToken)
+		} else {
+			clt.WithCredentials(entry.Feed.Username, entry.Feed.Password)
+		}
+		clt.WithCookie(entry.Feed.Cookie)
+	}
+
+	content, err := scraper.FetchWithClient(clt, entry.Feed.ScraperRules)
+	if err != nil {
+		return "", err
+	}
+
+	content = rewrite.Rewriter(entry.URL, content, entry.Feed.RewriteRules)
+	return sanitizer.Sanitize(entry.URL, content), nil
+}
+
+// newWebPageClient returns a client that downloads the web page of an entry
+// through the proxy of the feed, with the same user agent and time limit as the feed requests.
+func newWebPageClient(feed *model.Feed, entryURL string) *client.Client {
+	clt := client.New(entryURL)
+	clt.WithUserAgent(client.ExpandUserAgent(feed.UserAgent, feed.Title))
+	clt.WithProxy(feed.ProxyURL)
+	clt.WithTimeout(feed.RequestTimeout)
+	return clt
+}
@@ -0,0 +1,263 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package processor
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+)
+
+const englishContent = `<p>The new version of the application has been released this week. It brings a lot of improvements for the users and it fixes many bugs that were reported by the community.</p>`
+
+func parseConfig(t *testing.T) {
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+}
+
+func TestReprocessEntryWithoutCrawler(t *testing.T) {
+	os.Clearenv()
+	parseConfig(t)
+
+	feed := &model.Feed{RewriteRules: "nl2br"}
+	entry := &model.Entry{URL: "https://example.org/article", Content: "A\nB", Summary: "A\nB"}
+
+	if err := ReprocessEntry(feed, entry); err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if entry.Content != "A<br>B" {
+		t.Errorf(`The rewrite rules should be applied to the stored content, got %q`, entry.Content)
+	}
+
+	if entry.Summary != "A\nB" {
+		t.Errorf(`The summary should not change, got %q`, entry.Summary)
+	}
+
+	if entry.ReadingTime != 1 {
+		t.Errorf(`The reading time should be updated, got %d`, entry.ReadingTime)
+	}
+}
+
+func TestEntryDateWithFeedLayouts(t *testing.T) {
+	now := time.Now()
+	entry := &model.Entry{Date: now, RawDate: "01.03.2020 um 10:30"}
+
+	result := entryDate(&model.Feed{DateLayouts: "2006-01-02\r\n02.01.2006 um 15:04"}, entry)
+	if expected := time.Date(2020, time.March, 1, 10, 30, 0, 0, time.UTC); !result.Equal(expected) {
+		t.Errorf(`Unexpected date, got %v instead of %v`, result, expected)
+	}
+
+	if result := entryDate(&model.Feed{}, entry); !result.Equal(now) {
+		t.Errorf(`The date should not change when the feed has no layout, got %v`, result)
+	}
+
+	if result := entryDate(&model.Feed{DateLayouts: "2006-01-02"}, entry); !result.Equal(now) {
+		t.Errorf(`The date should not change when no layout matches, got %v`, result)
+	}
+}
+
+func TestEntryLanguageDeclaredByFeed(t *testing.T) {
+	os.Clearenv()
+	parseConfig(t)
+
+	feed := &model.Feed{Language: "fr-FR"}
+	if lang := entryLanguage(feed, &model.Entry{Content: englishContent}); lang != "fr" {
+		t.Errorf(`The language of the feed should be used, got %q`, lang)
+	}
+}
+
+func TestEntryLanguageDetected(t *testing.T) {
+	os.Clearenv()
+	parseConfig(t)
+
+	if lang := entryLanguage(&model.Feed{}, &model.Entry{Title: "Release", Content: englishContent}); lang != "en" {
+		t.Errorf(`The language should be detected, got %q`, lang)
+	}
+}
+
+func TestEntryLanguageWithDetectionDisabled(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("DISABLE_LANGUAGE_DETECTION", "1")
+	parseConfig(t)
+
+	if lang := entryLanguage(&model.Feed{}, &model.Entry{Content: englishContent}); lang != "" {
+		t.Errorf(`The language should not be detected, got %q`, lang)
+	}
+}
+
+func TestRightToLeftEntry(t *testing.T) {
+	if !isRightToLeftEntry(&model.Entry{Language: "he", Content: englishContent}) {
+		t.Error(`The entries written in Hebrew should be right-to-left`)
+	}
+
+	if isRightToLeftEntry(&model.Entry{Language: "en", Content: `<p>صدرت النسخة الجديدة من التطبيق هذا الأسبوع</p>`}) {
+		t.Error(`The declared language should be used when it is known`)
+	}
+
+	if !isRightToLeftEntry(&model.Entry{Title: "Miniflux", Content: `<p>صدرت النسخة الجديدة من التطبيق هذا الأسبوع</p>`}) {
+		t.Error(`The direction should be detected from the text when the language is unknown`)
+	}
+
+	if isRightToLeftEntry(&model.Entry{Content: englishContent}) {
+		t.Error(`English entries should not be right-to-left`)
+	}
+}
+
+func TestReprocessRightToLeftEntry(t *testing.T) {
+	os.Clearenv()
+	parseConfig(t)
+
+	entry := &model.Entry{URL: "https://example.org/", Language: "ar", Content: `<div dir="rtl"><p>مرحبا</p></div>`}
+	if err := ReprocessEntry(&model.Feed{}, entry); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<div dir="rtl"><p>مرحبا</p></div>`
+	if entry.Content != expected {
+		t.Errorf(`The content should be wrapped only once, got %q instead of %q`, entry.Content, expected)
+	}
+}
+
+func TestPreviewFeedEntriesWithCrawler(t *testing.T) {
+	os.Clearenv()
+	parseConfig(t)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`<html><body><article>Crawled content</article></body></html>`))
+	}))
+	defer server.Close()
+
+	feed := &model.Feed{CrawlerMode: model.CrawlerModeEnabled, RewriteRules: "nl2br"}
+	feed.Entries = model.Entries{&model.Entry{URL: server.URL + "/article", Content: "A\nB"}}
+
+	PreviewFeedEntries(feed)
+
+	if requests != 0 {
+		t.Errorf(`The web page should not be downloaded, got %d requests`, requests)
+	}
+
+	entry := feed.Entries[0]
+	if entry.Content != "A<br>B" {
+		t.Errorf(`The rewrite rules should be applied to the feed content, got %q`, entry.Content)
+	}
+
+	if entry.Summary != entry.Content {
+		t.Errorf(`The summary should be the feed content, got %q`, entry.Summary)
+	}
+
+	if entry.ContentHash == "" {
+		t.Error(`The content hash should be computed`)
+	}
+}
+
+func TestProcessEntryWebPageKeepsSummary(t *testing.T) {
+	os.Clearenv()
+	parseConfig(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body><article><p>Crawled content</p></article></body></html>`))
+	}))
+	defer server.Close()
+
+	scenarios := []struct {
+		summary  string
+		expected string
+	}{
+		{"", "Feed content"},
+		{"Stored summary", "Stored summary"},
+	}
+
+	for _, scenario := range scenarios {
+		entry := &model.Entry{URL: server.URL + "/article", Content: "Feed content", Summary: scenario.summary, Feed: &model.Feed{}}
+
+		if err := ProcessEntryWebPage(entry); err != nil {
+			t.Fatalf(`Unexpected error: %v`, err)
+		}
+
+		if !strings.Contains(entry.Content, "Crawled content") {
+			t.Errorf(`The content should be replaced by the web page, got %q`, entry.Content)
+		}
+
+		if entry.Summary != scenario.expected {
+			t.Errorf(`The summary should be %q, got %q`, scenario.expected, entry.Summary)
+		}
+	}
+}
+
+func TestReprocessEntryTwice(t *testing.T) {
+	os.Clearenv()
+	parseConfig(t)
+
+	scenarios := []struct {
+		url     string
+		rules   string
+		content string
+	}{
+		{"https://example.org/document.pdf", "add_image_title,nl2br", "<img src=\"https://example.org/image.png\" title=\"Title\">\nText"},
+		{"https://www.youtube.com/watch?v=1234", "add_youtube_video_using_invidious_player", "Video"},
+		{"https://invidio.us/watch?v=1234", "add_invidious_video", "Video"},
+		{"https://example.org/document.pdf", "", "<p>مرحبا بكم في هذا المقال الذي يتحدث عن الإصدار الجديد</p>"},
+	}
+
+	for _, scenario := range scenarios {
+		feed := &model.Feed{RewriteRules: scenario.rules}
+		entry := &model.Entry{URL: scenario.url, Content: scenario.content}
+
+		if err := ReprocessEntry(feed, entry); err != nil {
+			t.Fatalf(`Unexpected error: %v`, err)
+		}
+		content := entry.Content
+
+		if err := ReprocessEntry(feed, entry); err != nil {
+			t.Fatalf(`Unexpected error: %v`, err)
+		}
+
+		if entry.Content != content {
+			t.Errorf(`The content should not change when the entry is processed again with %q, got %q instead of %q`, scenario.rules, entry.Content, content)
+		}
+	}
+}
+
+func TestFetchEntryContentThroughProxy(t *testing.T) {
+	os.Clearenv()
+	parseConfig(t)
+
+	var proxiedURL string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURL = r.URL.String()
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><body><article><p>Crawled content</p></article></body></html>`))
+	}))
+	defer proxy.Close()
+
+	feed := &model.Feed{FeedURL: "http://example.org/feed.xml", ProxyURL: proxy.URL}
+	entry := &model.Entry{URL: "http://articles.example.com/article", Feed: feed}
+
+	content, err := FetchEntryContent(entry)
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if proxiedURL != entry.URL {
+		t.Errorf(`The request should be sent to the proxy of the feed, got %q`, proxiedURL)
+	}
+
+	if !strings.Contains(content, "Crawled content") {
+		t.Errorf(`Unexpected content: %q`, content)
+	}
+}
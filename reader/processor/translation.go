@@ -0,0 +1,185 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package processor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/integration/libretranslate"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/language"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/storage"
+)
+
+const (
+	// The API is not called again before this delay when it refuses a request without Retry-After header.
+	defaultTranslationRetryDelay = time.Minute
+
+	// maxTranslationWait is the longest a translation waits for its turn, the entry is translated after a next refresh otherwise.
+	maxTranslationWait = 30 * time.Second
+
+	// translationBatchSize is the maximum number of entries translated after a refresh of the feed.
+	translationBatchSize = 100
+)
+
+var errTranslationPaused = errors.New("too many translation requests, the entry will be translated after a next refresh")
+
+// translationThrottle spaces the requests sent to the translation API, it is shared by all the feeds.
+var translationThrottle = &throttle{}
+
+type throttle struct {
+	mu           sync.Mutex
+	next         time.Time
+	blockedUntil time.Time
+}
+
+// reserve returns the delay to wait before sending the next request.
+// ok is false while the requests are blocked or when the delay would exceed maxWait, nothing is reserved in that case.
+func (t *throttle) reserve(now time.Time, interval, maxWait time.Duration) (delay time.Duration, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if now.Before(t.blockedUntil) {
+		return 0, false
+	}
+
+	if t.next.Before(now) {
+		t.next = now
+	}
+
+	delay = t.next.Sub(now)
+	if delay > maxWait {
+		return 0, false
+	}
+
+	t.next = t.next.Add(interval)
+	return delay, true
+}
+
+// block rejects the requests until the given time.
+func (t *throttle) block(until time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if until.After(t.blockedUntil) {
+		t.blockedUntil = until
+	}
+}
+
+// TranslateFeedEntries translates the stored entries of the feed that are not translated to the language of the feed yet.
+// It runs in the background after a refresh, so only the new and modified entries are missing a translation.
+// The remaining entries are translated after the next refresh when the API refuses the requests.
+func TranslateFeedEntries(ctx context.Context, store *storage.Storage, feed *model.Feed) {
+	target := language.Normalize(feed.TranslationLanguage)
+	if config.Opts.TranslationAPIURL() == "" || target == "" {
+		return
+	}
+
+	entries, err := store.UntranslatedEntries(feed.ID, target, translationBatchSize)
+	if err != nil {
+		logger.Error("[Processor] Feed #%d: %v", feed.ID, err)
+		return
+	}
+
+	for _, entry := range entries {
+		if err := translateEntry(ctx, store, feed, entry); err != nil {
+			logger.Debug("[Processor] Feed #%d: %d entries not translated: %v", feed.ID, len(entries), err)
+			return
+		}
+	}
+}
+
+// translateEntry stores the content of the entry translated to the language of the feed.
+// The stored translation of an entry with the same content hash is reused, the API is called only for new content.
+// An error is returned only when the next entries can't be translated either.
+func translateEntry(ctx context.Context, store *storage.Storage, feed *model.Feed, entry *model.Entry) error {
+	target := translationLanguage(feed, entry)
+	if target == "" {
+		return nil
+	}
+
+	if translatedContent, found := store.EntryTranslation(feed.ID, entry.ContentHash, target); found {
+		entry.TranslatedContent = translatedContent
+		entry.TranslationLanguage = target
+	} else {
+		translatedContent, err := translate(ctx, entry.Content, entry.Language, target)
+		if err != nil && (err == errTranslationPaused || err == ctx.Err()) {
+			return err
+		} else if err != nil {
+			logger.Error("[Processor] Feed #%d: unable to translate entry %q: %v", feed.ID, entry.URL, err)
+			return nil
+		}
+
+		entry.TranslatedContent = sanitizer.Sanitize(entry.URL, translatedContent)
+		entry.TranslationLanguage = target
+
+		// The stored translation is reused as is, so the direction is set before storing it.
+		if language.IsRightToLeft(target) {
+			entry.TranslatedContent = wrapRightToLeftContent(entry.TranslatedContent)
+		}
+	}
+
+	if err := store.UpdateEntryTranslation(feed.ID, entry); err != nil {
+		logger.Error("[Processor] Feed #%d: %v", feed.ID, err)
+	}
+
+	return nil
+}
+
+// translationLanguage returns the language the entry must be translated to,
+// or an empty string when the translation is disabled or the entry is already written in this language.
+func translationLanguage(feed *model.Feed, entry *model.Entry) string {
+	if config.Opts.TranslationAPIURL() == "" || entry.Content == "" {
+		return ""
+	}
+
+	target := language.Normalize(feed.TranslationLanguage)
+	if target == "" || language.Normalize(entry.Language) == target {
+		return ""
+	}
+
+	return target
+}
+
+// translate sends the content to the translation API, the requests are spaced to respect the configured rate
+// and they are suspended when the API answers that too many requests have been sent.
+// The wait for a slot is bounded and stops when the context is done.
+func translate(ctx context.Context, content, source, target string) (string, error) {
+	var interval time.Duration
+	if requestsPerMinute := config.Opts.TranslationRequestsPerMinute(); requestsPerMinute > 0 {
+		interval = time.Minute / time.Duration(requestsPerMinute)
+	}
+
+	delay, ok := translationThrottle.reserve(time.Now(), interval, maxTranslationWait)
+	if !ok {
+		return "", errTranslationPaused
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case <-timer.C:
+	}
+
+	clt := libretranslate.NewClient(config.Opts.TranslationAPIURL(), config.Opts.TranslationAPIKey())
+	translatedContent, err := clt.Translate(content, source, target)
+	if rateLimitErr, ok := err.(*libretranslate.RateLimitError); ok {
+		retryAfter := rateLimitErr.RetryAfter
+		if retryAfter <= 0 {
+			retryAfter = defaultTranslationRetryDelay
+		}
+		translationThrottle.block(time.Now().Add(retryAfter))
+	}
+
+	return translatedContent, err
+}
@@ -0,0 +1,140 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package processor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestThrottleSpacesRequests(t *testing.T) {
+	now := time.Now()
+	th := &throttle{}
+
+	if delay, ok := th.reserve(now, 2*time.Second, time.Minute); !ok || delay != 0 {
+		t.Errorf(`The first request should be sent right away, got %v and %v`, delay, ok)
+	}
+
+	if delay, ok := th.reserve(now, 2*time.Second, time.Minute); !ok || delay != 2*time.Second {
+		t.Errorf(`The second request should wait for the interval, got %v and %v`, delay, ok)
+	}
+
+	if delay, ok := th.reserve(now.Add(time.Minute), 2*time.Second, time.Minute); !ok || delay != 0 {
+		t.Errorf(`A request sent after the interval should not wait, got %v and %v`, delay, ok)
+	}
+}
+
+func TestThrottleLimitsWait(t *testing.T) {
+	now := time.Now()
+	th := &throttle{}
+
+	for i := 0; i < 3; i++ {
+		if _, ok := th.reserve(now, 10*time.Second, 20*time.Second); !ok {
+			t.Fatalf(`The request #%d should wait for its turn`, i)
+		}
+	}
+
+	if _, ok := th.reserve(now, 10*time.Second, 20*time.Second); ok {
+		t.Error(`A request should not wait longer than the limit`)
+	}
+
+	// The rejected request does not delay the next ones.
+	if delay, ok := th.reserve(now.Add(10*time.Second), 10*time.Second, 20*time.Second); !ok || delay != 20*time.Second {
+		t.Errorf(`Unexpected delay, got %v and %v`, delay, ok)
+	}
+}
+
+func TestTranslateWithCanceledContext(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("TRANSLATION_API_URL", "http://localhost:5000")
+	os.Setenv("TRANSLATION_REQUESTS_PER_MINUTE", "6")
+	parseConfig(t)
+
+	defer func(original *throttle) { translationThrottle = original }(translationThrottle)
+	translationThrottle = &throttle{next: time.Now().Add(10 * time.Second)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := translate(ctx, "<p>Bonjour</p>", "fr", "en"); err != context.Canceled {
+		t.Errorf(`The wait should stop when the context is canceled, got %v`, err)
+	}
+}
+
+func TestThrottleBlocksRequests(t *testing.T) {
+	now := time.Now()
+	th := &throttle{}
+	th.block(now.Add(time.Minute))
+
+	if _, ok := th.reserve(now, 0, time.Minute); ok {
+		t.Error(`The requests should be rejected while the throttle is blocked`)
+	}
+
+	if _, ok := th.reserve(now.Add(2*time.Minute), 0, time.Minute); !ok {
+		t.Error(`The requests should be accepted once the delay is over`)
+	}
+}
+
+func TestTranslationLanguage(t *testing.T) {
+	os.Clearenv()
+	parseConfig(t)
+
+	feed := &model.Feed{TranslationLanguage: "en-US"}
+	entry := &model.Entry{Content: "<p>Bonjour</p>", Language: "fr"}
+	if lang := translationLanguage(feed, entry); lang != "" {
+		t.Errorf(`The entries should not be translated without translation API, got %q`, lang)
+	}
+
+	os.Setenv("TRANSLATION_API_URL", "http://localhost:5000")
+	parseConfig(t)
+
+	if lang := translationLanguage(feed, entry); lang != "en" {
+		t.Errorf(`The entry should be translated to the language of the feed, got %q`, lang)
+	}
+
+	if lang := translationLanguage(&model.Feed{}, entry); lang != "" {
+		t.Errorf(`The entries should not be translated when the feed has no translation language, got %q`, lang)
+	}
+
+	if lang := translationLanguage(&model.Feed{TranslationLanguage: "fr"}, entry); lang != "" {
+		t.Errorf(`The entries already written in the target language should not be translated, got %q`, lang)
+	}
+}
+
+func TestTranslateWithRateLimit(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	os.Clearenv()
+	os.Setenv("TRANSLATION_API_URL", ts.URL)
+	os.Setenv("TRANSLATION_REQUESTS_PER_MINUTE", "0")
+	parseConfig(t)
+
+	defer func(original *throttle) { translationThrottle = original }(translationThrottle)
+	translationThrottle = &throttle{}
+
+	if _, err := translate(context.Background(), "<p>Bonjour</p>", "fr", "en"); err == nil {
+		t.Fatal(`An error should be returned when the API refuses the request`)
+	}
+
+	if _, err := translate(context.Background(), "<p>Bonjour</p>", "fr", "en"); err != errTranslationPaused {
+		t.Errorf(`The translation should be paused, got %v`, err)
+	}
+
+	if requests != 1 {
+		t.Errorf(`The API should not be called while the translation is paused, got %d requests`, requests)
+	}
+}
@@ -15,9 +15,7 @@ import (
 // Parse returns a normalized feed struct from a RDF feed.
 func Parse(data io.Reader) (*model.Feed, *errors.LocalizedError) {
 	feed := new(rdfFeed)
-	decoder := xml.NewDecoder(data)
-	err := decoder.Decode(feed)
-	if err != nil {
+	if err := xml.Decode(data, feed); err != nil {
 		return nil, errors.NewLocalizedError("Unable to parse RDF feed: %q", err)
 	}
 
@@ -289,6 +289,41 @@ func TestParseItemRelativeURL(t *testing.T) {
 	}
 }
 
+func TestParseItemRelativeURLWithXMLBase(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" xmlns="http://purl.org/rss/1.0/" xml:base="http://example.org/blog/">
+	  <channel>
+			<title>Example</title>
+			<link>http://example.org</link>
+	  </channel>
+
+	  <item>
+			<title>Title</title>
+			<description>Test</description>
+			<link>something.html</link>
+	  </item>
+
+	  <item xml:base="/archives/">
+			<title>Title</title>
+			<description>Test</description>
+			<link>other.html</link>
+	  </item>
+	</rdf:RDF>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].URL != "http://example.org/blog/something.html" {
+		t.Errorf("Incorrect entry url, got: %s", feed.Entries[0].URL)
+	}
+
+	if feed.Entries[1].URL != "http://example.org/archives/other.html" {
+		t.Errorf("Incorrect entry url, got: %s", feed.Entries[1].URL)
+	}
+}
+
 func TestParseItemWithoutLink(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 
@@ -21,6 +21,7 @@ type rdfFeed struct {
 	XMLName xml.Name  `xml:"RDF"`
 	Title   string    `xml:"channel>title"`
 	Link    string    `xml:"channel>link"`
+	BaseURL string    `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
 	Items   []rdfItem `xml:"item"`
 	DublinCoreFeedElement
 }
@@ -29,6 +30,7 @@ func (r *rdfFeed) Transform() *model.Feed {
 	feed := new(model.Feed)
 	feed.Title = sanitizer.StripTags(r.Title)
 	feed.SiteURL = r.Link
+	feedBaseURL := url.ResolveBaseURL(feed.SiteURL, r.BaseURL)
 
 	for _, item := range r.Items {
 		entry := item.Transform()
@@ -40,7 +42,7 @@ func (r *rdfFeed) Transform() *model.Feed {
 		if entry.URL == "" {
 			entry.URL = feed.SiteURL
 		} else {
-			entryURL, err := url.AbsoluteURL(feed.SiteURL, entry.URL)
+			entryURL, err := url.AbsoluteURL(url.ResolveBaseURL(feedBaseURL, item.BaseURL), entry.URL)
 			if err == nil {
 				entry.URL = entryURL
 			}
@@ -56,6 +58,7 @@ type rdfItem struct {
 	Title       string `xml:"title"`
 	Link        string `xml:"link"`
 	Description string `xml:"description"`
+	BaseURL     string `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
 	DublinCoreEntryElement
 }
 
@@ -67,6 +70,8 @@ func (r *rdfItem) Transform() *model.Entry {
 	entry.Content = r.entryContent()
 	entry.Hash = r.entryHash()
 	entry.Date = r.entryDate()
+	entry.RawDate = r.DublinCoreDate
+	entry.UpdatedAt = entry.Date
 	return entry
 }
 
@@ -0,0 +1,51 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package readingtime // import "miniflux.app/reader/readingtime"
+
+import (
+	"math"
+	"unicode"
+
+	"miniflux.app/reader/sanitizer"
+)
+
+// Chinese, Japanese and Korean texts do not separate words with spaces, the characters are counted instead.
+const cjkCharactersPerMinute = 500
+
+// EstimateReadingTime returns the number of minutes required to read the HTML content.
+func EstimateReadingTime(content string, wordsPerMinute int) int {
+	if wordsPerMinute <= 0 {
+		return 0
+	}
+
+	words, cjkCharacters := countWords(sanitizer.StripTags(content))
+	minutes := float64(words)/float64(wordsPerMinute) + float64(cjkCharacters)/cjkCharactersPerMinute
+	return int(math.Ceil(minutes))
+}
+
+// countWords returns the number of words delimited by spaces and the number of CJK characters of the text.
+func countWords(text string) (words, cjkCharacters int) {
+	inWord := false
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			cjkCharacters++
+			inWord = false
+		case unicode.IsSpace(r):
+			inWord = false
+		default:
+			if !inWord {
+				words++
+			}
+			inWord = true
+		}
+	}
+
+	return words, cjkCharacters
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package readingtime // import "miniflux.app/reader/readingtime"
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEstimateReadingTime(t *testing.T) {
+	content := "<p>" + strings.Repeat("word ", 530) + "</p>"
+	if minutes := EstimateReadingTime(content, 265); minutes != 2 {
+		t.Errorf(`Unexpected reading time, got %d minutes`, minutes)
+	}
+
+	if minutes := EstimateReadingTime(content, 100); minutes != 6 {
+		t.Errorf(`The reading time should depend on the reading speed, got %d minutes`, minutes)
+	}
+}
+
+func TestEstimateReadingTimeRoundsUp(t *testing.T) {
+	if minutes := EstimateReadingTime("<p>A very short entry.</p>", 265); minutes != 1 {
+		t.Errorf(`Unexpected reading time, got %d minutes`, minutes)
+	}
+}
+
+func TestEstimateReadingTimeWithoutContent(t *testing.T) {
+	if minutes := EstimateReadingTime("<img src=\"https://example.org/image.png\">", 265); minutes != 0 {
+		t.Errorf(`Unexpected reading time, got %d minutes`, minutes)
+	}
+}
+
+func TestEstimateReadingTimeIgnoresTags(t *testing.T) {
+	content := `<a href="https://example.org/" title="some long title with many words">` + strings.Repeat("word ", 200) + `</a>`
+	if minutes := EstimateReadingTime(content, 200); minutes != 1 {
+		t.Errorf(`The attributes should not be counted, got %d minutes`, minutes)
+	}
+}
+
+func TestEstimateReadingTimeWithCJKContent(t *testing.T) {
+	content := "<p>" + strings.Repeat("该应用程序的新版本已于本周发布", 100) + "</p>"
+	if minutes := EstimateReadingTime(content, 265); minutes != 3 {
+		t.Errorf(`The CJK characters should be counted, got %d minutes`, minutes)
+	}
+}
+
+func TestCountWords(t *testing.T) {
+	words, cjkCharacters := countWords("Hello world, 你好世界 and\tgoodbye\n")
+	if words != 4 || cjkCharacters != 4 {
+		t.Errorf(`Unexpected counters, got %d words and %d CJK characters`, words, cjkCharacters)
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package reddit handles the JSON listings returned by Reddit, like https://www.reddit.com/r/golang/.json.
+
+*/
+package reddit // import "miniflux.app/reader/reddit"
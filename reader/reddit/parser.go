@@ -0,0 +1,47 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package reddit // import "miniflux.app/reader/reddit"
+
+import (
+	"encoding/json"
+	"io"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+// IsListing returns true if the JSON document is a Reddit listing of posts.
+func IsListing(data []byte) bool {
+	var document struct {
+		Kind string `json:"kind"`
+		Data struct {
+			Children []struct {
+				Kind string `json:"kind"`
+			} `json:"children"`
+		} `json:"data"`
+	}
+
+	if err := json.Unmarshal(data, &document); err != nil || document.Kind != "Listing" {
+		return false
+	}
+
+	for _, child := range document.Data.Children {
+		if child.Kind != postKind {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Parse returns a normalized feed struct from a Reddit listing.
+func Parse(data io.Reader) (*model.Feed, *errors.LocalizedError) {
+	listing := new(redditListing)
+	if err := json.NewDecoder(data).Decode(listing); err != nil {
+		return nil, errors.NewLocalizedError("Unable to parse Reddit listing: %q", err)
+	}
+
+	return listing.Transform(), nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package reddit // import "miniflux.app/reader/reddit"
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+const listing = `{
+	"kind": "Listing",
+	"data": {
+		"children": [
+			{
+				"kind": "t3",
+				"data": {
+					"name": "t3_abc123",
+					"title": "Go 1.15 &amp; beyond",
+					"author": "gopher",
+					"permalink": "/r/golang/comments/abc123/go_115_beyond/",
+					"url": "https://blog.golang.org/go1.15",
+					"is_self": false,
+					"selftext_html": null,
+					"thumbnail": "https://b.thumbs.redditmedia.com/image.jpg",
+					"subreddit_name_prefixed": "r/golang",
+					"created_utc": 1597000000.0
+				}
+			},
+			{
+				"kind": "t3",
+				"data": {
+					"name": "t3_def456",
+					"title": "Question about channels",
+					"author": "newbie",
+					"permalink": "/r/golang/comments/def456/question_about_channels/",
+					"url": "https://www.reddit.com/r/golang/comments/def456/question_about_channels/",
+					"is_self": true,
+					"selftext_html": "&lt;div class=\"md\"&gt;&lt;p&gt;How do I close a channel?&lt;/p&gt;&lt;/div&gt;",
+					"thumbnail": "self",
+					"subreddit_name_prefixed": "r/golang",
+					"created_utc": 1597000100.0
+				}
+			}
+		]
+	}
+}`
+
+func TestIsListing(t *testing.T) {
+	if !IsListing([]byte(listing)) {
+		t.Error(`The Reddit listing should be detected`)
+	}
+
+	if IsListing([]byte(`{"version": "https://jsonfeed.org/version/1", "title": "My Example Feed", "items": []}`)) {
+		t.Error(`A JSON Feed should not be detected as a Reddit listing`)
+	}
+
+	if IsListing([]byte(`{"kind": "Listing", "data": {"children": [{"kind": "t1", "data": {}}]}}`)) {
+		t.Error(`A listing of comments should not be detected as a listing of posts`)
+	}
+}
+
+func TestParseListing(t *testing.T) {
+	feed, err := Parse(bytes.NewBufferString(listing))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "r/golang" {
+		t.Errorf(`Incorrect title, got: %q`, feed.Title)
+	}
+
+	if feed.SiteURL != "https://www.reddit.com/r/golang/" {
+		t.Errorf(`Incorrect site URL, got: %q`, feed.SiteURL)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.Title != "Go 1.15 & beyond" {
+		t.Errorf(`Incorrect entry title, got: %q`, entry.Title)
+	}
+
+	if entry.URL != "https://www.reddit.com/r/golang/comments/abc123/go_115_beyond/" {
+		t.Errorf(`Incorrect entry URL, got: %q`, entry.URL)
+	}
+
+	if entry.Author != "gopher" {
+		t.Errorf(`Incorrect entry author, got: %q`, entry.Author)
+	}
+
+	if !entry.Date.Equal(time.Unix(1597000000, 0)) {
+		t.Errorf(`Incorrect entry date, got: %v`, entry.Date)
+	}
+
+	if entry.Hash == "" || entry.GUID != "t3_abc123" {
+		t.Errorf(`Incorrect entry identifier, got: %q`, entry.GUID)
+	}
+
+	expected := `<p><img src="https://b.thumbs.redditmedia.com/image.jpg" alt="Go 1.15 &amp; beyond"></p><p><a href="https://blog.golang.org/go1.15">https://blog.golang.org/go1.15</a></p>`
+	if entry.Content != expected {
+		t.Errorf(`Incorrect entry content, got: %q`, entry.Content)
+	}
+
+	expected = `<div class="md"><p>How do I close a channel?</p></div>`
+	if feed.Entries[1].Content != expected {
+		t.Errorf(`Incorrect self post content, got: %q`, feed.Entries[1].Content)
+	}
+}
+
+func TestParseInvalidListing(t *testing.T) {
+	if _, err := Parse(bytes.NewBufferString(`{"kind": "Listing", "data": [}`)); err == nil {
+		t.Error(`Parse should return an error`)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package reddit // import "miniflux.app/reader/reddit"
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+)
+
+const (
+	baseURL  = "https://www.reddit.com"
+	postKind = "t3"
+)
+
+type redditListing struct {
+	Kind string `json:"kind"`
+	Data struct {
+		Children []redditChild `json:"children"`
+	} `json:"data"`
+}
+
+type redditChild struct {
+	Kind string     `json:"kind"`
+	Data redditPost `json:"data"`
+}
+
+type redditPost struct {
+	Name         string  `json:"name"`
+	Title        string  `json:"title"`
+	Author       string  `json:"author"`
+	Permalink    string  `json:"permalink"`
+	URL          string  `json:"url"`
+	IsSelf       bool    `json:"is_self"`
+	SelfTextHTML string  `json:"selftext_html"`
+	Thumbnail    string  `json:"thumbnail"`
+	Subreddit    string  `json:"subreddit_name_prefixed"`
+	CreatedUTC   float64 `json:"created_utc"`
+}
+
+func (r *redditListing) Transform() *model.Feed {
+	feed := &model.Feed{Title: "Reddit", SiteURL: baseURL}
+
+	for _, child := range r.Data.Children {
+		if child.Kind != postKind {
+			continue
+		}
+
+		post := child.Data
+		if post.Subreddit != "" && feed.SiteURL == baseURL {
+			feed.Title = post.Subreddit
+			feed.SiteURL = baseURL + "/" + post.Subreddit + "/"
+		}
+
+		feed.Entries = append(feed.Entries, post.Transform())
+	}
+
+	return feed
+}
+
+func (p *redditPost) Transform() *model.Entry {
+	entry := &model.Entry{
+		URL:     baseURL + p.Permalink,
+		Title:   strings.TrimSpace(html.UnescapeString(p.Title)),
+		Author:  p.Author,
+		Content: p.content(),
+		GUID:    p.Name,
+		Hash:    crypto.Hash(p.Name),
+		Date:    time.Unix(int64(p.CreatedUTC), 0),
+	}
+
+	if p.CreatedUTC == 0 {
+		entry.Date = time.Now()
+	}
+
+	if entry.Title == "" {
+		entry.Title = entry.URL
+	}
+
+	return entry
+}
+
+// content returns the text of the post, the thumbnail and the link shared by the post.
+func (p *redditPost) content() string {
+	var builder strings.Builder
+
+	// The HTML of the posts is escaped in the JSON document.
+	if p.SelfTextHTML != "" {
+		builder.WriteString(html.UnescapeString(p.SelfTextHTML))
+	}
+
+	// The thumbnail contains a keyword like "self" or "default" when there is no image.
+	if strings.HasPrefix(p.Thumbnail, "https://") || strings.HasPrefix(p.Thumbnail, "http://") {
+		builder.WriteString(fmt.Sprintf(`<p><img src="%s" alt="%s"></p>`, html.EscapeString(p.Thumbnail), html.EscapeString(html.UnescapeString(p.Title))))
+	}
+
+	if !p.IsSelf && p.URL != "" {
+		link := html.EscapeString(html.UnescapeString(p.URL))
+		builder.WriteString(fmt.Sprintf(`<p><a href="%s">%s</a></p>`, link, link))
+	}
+
+	return builder.String()
+}
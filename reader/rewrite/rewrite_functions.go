@@ -11,16 +11,32 @@ import (
 	"regexp"
 	"strings"
 
+	"miniflux.app/config"
+	url_helper "miniflux.app/url"
+
 	"github.com/PuerkitoBio/goquery"
 )
 
 var (
-	youtubeRegex  = regexp.MustCompile(`youtube\.com/watch\?v=(.*)`)
+	youtubeRegex  = regexp.MustCompile(`^https?://(?:www\.|m\.)?youtube\.com/watch\?(?:.*&)?v=([\w-]+)`)
 	invidioRegex  = regexp.MustCompile(`invidio\.us\/watch\?v=(.*)`)
 	imgRegex      = regexp.MustCompile(`<img [^>]+>`)
 	textLinkRegex = regexp.MustCompile(`(?mi)(\bhttps?:\/\/[-A-Z0-9+&@#\/%?=~_|!:,.;]*[-A-Z0-9+&@#\/%=~_|])`)
+
+	youtubeLinkRegex = regexp.MustCompile(`(href|src)="((?:https?:)?//(?:www\.|m\.)?(?:youtube\.com|youtube-nocookie\.com|youtu\.be)/[^"]*)"`)
 )
 
+// youtubeParameters lists the query parameters kept when a YouTube link is rewritten:
+// the video, the playlist and the position in the video or the playlist.
+var youtubeParameters = map[string]bool{
+	"v":     true,
+	"list":  true,
+	"index": true,
+	"t":     true,
+	"start": true,
+	"end":   true,
+}
+
 func addImageTitle(entryURL, entryContent string) string {
 	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
 	if err != nil {
@@ -139,21 +155,31 @@ func addDynamicImage(entryURL, entryContent string) string {
 	return entryContent
 }
 
-func addYoutubeVideo(entryURL, entryContent string) string {
+// youtubeVideoID returns the ID of the video when the URL is a YouTube watch page.
+func youtubeVideoID(entryURL string) string {
 	matches := youtubeRegex.FindStringSubmatch(entryURL)
-
 	if len(matches) == 2 {
-		video := `<iframe width="650" height="350" frameborder="0" src="https://www.youtube-nocookie.com/embed/` + matches[1] + `" allowfullscreen></iframe>`
+		return matches[1]
+	}
+	return ""
+}
+
+func addYoutubeVideo(entryURL, entryContent string) string {
+	videoID := youtubeVideoID(entryURL)
+
+	// The player is not added twice when the content already embeds the video.
+	if videoID != "" && !strings.Contains(entryContent, "/embed/"+videoID) {
+		video := `<iframe width="650" height="350" frameborder="0" src="` + config.Opts.YouTubeEmbedURL() + videoID + `" allowfullscreen></iframe>`
 		return video + `<br>` + entryContent
 	}
 	return entryContent
 }
 
 func addYoutubeVideoUsingInvidiousPlayer(entryURL, entryContent string) string {
-	matches := youtubeRegex.FindStringSubmatch(entryURL)
+	videoID := youtubeVideoID(entryURL)
 
-	if len(matches) == 2 {
-		video := `<iframe width="650" height="350" frameborder="0" src="https://invidio.us/embed/` + matches[1] + `" allowfullscreen></iframe>`
+	if videoID != "" && !strings.Contains(entryContent, "invidio.us/embed/"+videoID) {
+		video := `<iframe width="650" height="350" frameborder="0" src="https://invidio.us/embed/` + videoID + `" allowfullscreen></iframe>`
 		return video + `<br>` + entryContent
 	}
 	return entryContent
@@ -162,20 +188,88 @@ func addYoutubeVideoUsingInvidiousPlayer(entryURL, entryContent string) string {
 func addInvidiousVideo(entryURL, entryContent string) string {
 	matches := invidioRegex.FindStringSubmatch(entryURL)
 	fmt.Println(matches)
-	if len(matches) == 2 {
+	if len(matches) == 2 && !strings.Contains(entryContent, "invidio.us/embed/"+matches[1]) {
 		video := `<iframe width="650" height="350" frameborder="0" src="https://invidio.us/embed/` + matches[1] + `" allowfullscreen></iframe>`
 		return video + `<br>` + entryContent
 	}
 	return entryContent
 }
 
+// rewriteYoutubeLinks replaces the YouTube links and embedded players by their equivalent on an Invidious or Piped instance.
+func rewriteYoutubeLinks(entryContent, instanceURL string) string {
+	if instanceURL == "" {
+		return entryContent
+	}
+
+	return youtubeLinkRegex.ReplaceAllStringFunc(entryContent, func(match string) string {
+		parts := youtubeLinkRegex.FindStringSubmatch(match)
+		link := rewriteYoutubeURL(html.UnescapeString(parts[2]), instanceURL)
+		if link == "" {
+			return match
+		}
+
+		return parts[1] + `="` + html.EscapeString(link) + `"`
+	})
+}
+
+// rewriteYoutubeURL returns the URL of the watch, playlist or embed page on the instance,
+// or an empty string when the link is not a video or a playlist.
+func rewriteYoutubeURL(link, instanceURL string) string {
+	if strings.HasPrefix(link, "//") {
+		link = "https:" + link
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+
+	host := strings.TrimPrefix(strings.TrimPrefix(u.Hostname(), "www."), "m.")
+	query := u.Query()
+
+	var path string
+	switch {
+	case host == "youtu.be" && strings.Trim(u.Path, "/") != "":
+		query.Set("v", strings.Trim(u.Path, "/"))
+		path = "/watch"
+	case host == "youtube.com" && u.Path == "/watch" && query.Get("v") != "":
+		path = "/watch"
+	case host == "youtube.com" && u.Path == "/playlist" && query.Get("list") != "":
+		path = "/playlist"
+	case (host == "youtube.com" || host == "youtube-nocookie.com") && strings.HasPrefix(u.Path, "/embed/"):
+		path = u.Path
+	default:
+		return ""
+	}
+
+	for key := range query {
+		if !youtubeParameters[key] {
+			query.Del(key)
+		}
+	}
+
+	result := strings.TrimSuffix(instanceURL, "/") + path
+	if len(query) > 0 {
+		result += "?" + query.Encode()
+	}
+
+	return result
+}
+
 func addPDFLink(entryURL, entryContent string) string {
-	if strings.HasSuffix(entryURL, ".pdf") {
+	if strings.HasSuffix(entryURL, ".pdf") && !hasPDFLink(entryContent) {
 		return fmt.Sprintf(`<a href="%s">PDF</a><br>%s`, entryURL, entryContent)
 	}
 	return entryContent
 }
 
+// hasPDFLink returns true when the content already starts with the link added by addPDFLink,
+// the sanitizer may have added attributes to the link since then.
+func hasPDFLink(entryContent string) bool {
+	end := strings.Index(entryContent, ">PDF</a><br>")
+	return strings.HasPrefix(entryContent, `<a href="`) && end != -1 && !strings.Contains(entryContent[:end], "</a>")
+}
+
 func replaceTextLinks(input string) string {
 	return textLinkRegex.ReplaceAllString(input, `<a href="${1}">${1}</a>`)
 }
@@ -183,3 +277,36 @@ func replaceTextLinks(input string) string {
 func replaceLineFeeds(input string) string {
 	return strings.Replace(input, "\n", "<br>", -1)
 }
+
+// removeTrackingParameters drops the given query parameters from the URL.
+// A parameter ending with "*" matches every parameter with the same prefix.
+// The order and encoding of the other parameters, as well as the fragment, are left untouched.
+func removeTrackingParameters(entryURL string, parameters []string) string {
+	u, err := url.Parse(entryURL)
+	if err != nil || u.RawQuery == "" {
+		return entryURL
+	}
+
+	var query []string
+	removed := false
+	for _, pair := range strings.Split(u.RawQuery, "&") {
+		key := strings.SplitN(pair, "=", 2)[0]
+		if unescapedKey, err := url.QueryUnescape(key); err == nil {
+			key = unescapedKey
+		}
+
+		if url_helper.IsTrackingParameter(key, parameters) {
+			removed = true
+			continue
+		}
+
+		query = append(query, pair)
+	}
+
+	if !removed {
+		return entryURL
+	}
+
+	u.RawQuery = strings.Join(query, "&")
+	return u.String()
+}
@@ -7,18 +7,14 @@ package rewrite // import "miniflux.app/reader/rewrite"
 import (
 	"strings"
 
+	"miniflux.app/config"
 	"miniflux.app/logger"
 	"miniflux.app/url"
 )
 
 // Rewriter modify item contents with a set of rewriting rules.
 func Rewriter(entryURL, entryContent, customRewriteRules string) string {
-	rulesList := getPredefinedRewriteRules(entryURL)
-	if customRewriteRules != "" {
-		rulesList = customRewriteRules
-	}
-
-	rules := strings.Split(rulesList, ",")
+	rules := getRewriteRules(entryURL, customRewriteRules)
 	rules = append(rules, "add_pdf_download_link")
 
 	logger.Debug(`[Rewrite] Applying rules %v for %q`, rules, entryURL)
@@ -37,6 +33,8 @@ func Rewriter(entryURL, entryContent, customRewriteRules string) string {
 			entryContent = addInvidiousVideo(entryURL, entryContent)
 		case "add_youtube_video_using_invidious_player":
 			entryContent = addYoutubeVideoUsingInvidiousPlayer(entryURL, entryContent)
+		case "rewrite_youtube_links":
+			entryContent = rewriteYoutubeLinks(entryContent, config.Opts.YouTubeFrontendURL())
 		case "add_pdf_download_link":
 			entryContent = addPDFLink(entryURL, entryContent)
 		case "nl2br":
@@ -49,6 +47,27 @@ func Rewriter(entryURL, entryContent, customRewriteRules string) string {
 	return entryContent
 }
 
+// RewriteEntryURL modify the entry URL with the rewriting rules that apply to links.
+func RewriteEntryURL(entryURL, customRewriteRules string) string {
+	for _, rule := range getRewriteRules(entryURL, customRewriteRules) {
+		switch strings.TrimSpace(rule) {
+		case "remove_tracking_parameters":
+			entryURL = removeTrackingParameters(entryURL, config.Opts.TrackingParameters())
+		}
+	}
+
+	return entryURL
+}
+
+func getRewriteRules(entryURL, customRewriteRules string) []string {
+	rulesList := getPredefinedRewriteRules(entryURL)
+	if customRewriteRules != "" {
+		rulesList = customRewriteRules
+	}
+
+	return strings.Split(rulesList, ",")
+}
+
 func getPredefinedRewriteRules(entryURL string) string {
 	urlDomain := url.Domain(entryURL)
 	for domain, rules := range predefinedRules {
@@ -4,7 +4,12 @@
 
 package rewrite // import "miniflux.app/reader/rewrite"
 
-import "testing"
+import (
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+)
 
 func TestReplaceTextLinks(t *testing.T) {
 	scenarios := map[string]string{
@@ -36,6 +41,15 @@ func TestRewriteWithNoMatchingRule(t *testing.T) {
 }
 
 func TestRewriteWithYoutubeLink(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
 	output := Rewriter("https://www.youtube.com/watch?v=1234", "Video Description", ``)
 	expected := `<iframe width="650" height="350" frameborder="0" src="https://www.youtube-nocookie.com/embed/1234" allowfullscreen></iframe><br>Video Description`
 
@@ -44,6 +58,91 @@ func TestRewriteWithYoutubeLink(t *testing.T) {
 	}
 }
 
+func TestRewriteWithYoutubeLinkAndCustomEmbedURL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("YOUTUBE_EMBED_URL", "https://www.youtube.com/embed/")
+	defer os.Clearenv()
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	output := Rewriter("https://www.youtube.com/watch?v=dQw4w9WgXcQ", `<img src="https://i4.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg">`, ``)
+	expected := `<iframe width="650" height="350" frameborder="0" src="https://www.youtube.com/embed/dQw4w9WgXcQ" allowfullscreen></iframe><br><img src="https://i4.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg">`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteWithYoutubeLinkAndParameters(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	output := Rewriter("https://www.youtube.com/watch?feature=youtu.be&v=dQw4w9WgXcQ&t=42", "Video Description", ``)
+	expected := `<iframe width="650" height="350" frameborder="0" src="https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ" allowfullscreen></iframe><br>Video Description`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteWithYoutubeLinkAlreadyEmbedded(t *testing.T) {
+	content := `<iframe src="https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ"></iframe>`
+	output := Rewriter("https://www.youtube.com/watch?v=dQw4w9WgXcQ", content, ``)
+
+	if content != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, content)
+	}
+}
+
+func TestRewriteWithYoutubeChannelLink(t *testing.T) {
+	output := Rewriter("https://www.youtube.com/channel/UC123", "Channel Description", ``)
+	expected := `Channel Description`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestRewriteYoutubeLinks(t *testing.T) {
+	instanceURL := "https://invidious.example.org/"
+	scenarios := map[string]string{
+		`<a href="https://www.youtube.com/watch?v=dQw4w9WgXcQ">Video</a>`:                            `<a href="https://invidious.example.org/watch?v=dQw4w9WgXcQ">Video</a>`,
+		`<a href="https://m.youtube.com/watch?v=dQw4w9WgXcQ&amp;t=42&amp;feature=share">Video</a>`:   `<a href="https://invidious.example.org/watch?t=42&amp;v=dQw4w9WgXcQ">Video</a>`,
+		`<a href="https://www.youtube.com/watch?v=dQw4w9WgXcQ&amp;list=PL123&amp;index=2">Video</a>`: `<a href="https://invidious.example.org/watch?index=2&amp;list=PL123&amp;v=dQw4w9WgXcQ">Video</a>`,
+		`<a href="https://youtu.be/dQw4w9WgXcQ?t=1m30s">Video</a>`:                                   `<a href="https://invidious.example.org/watch?t=1m30s&amp;v=dQw4w9WgXcQ">Video</a>`,
+		`<a href="https://www.youtube.com/playlist?list=PL123">Playlist</a>`:                         `<a href="https://invidious.example.org/playlist?list=PL123">Playlist</a>`,
+		`<iframe src="https://www.youtube.com/embed/dQw4w9WgXcQ?start=42"></iframe>`:                 `<iframe src="https://invidious.example.org/embed/dQw4w9WgXcQ?start=42"></iframe>`,
+		`<iframe src="//www.youtube-nocookie.com/embed/dQw4w9WgXcQ"></iframe>`:                       `<iframe src="https://invidious.example.org/embed/dQw4w9WgXcQ"></iframe>`,
+		`<a href="https://www.youtube.com/channel/UC123">Channel</a>`:                                `<a href="https://www.youtube.com/channel/UC123">Channel</a>`,
+		`<a href="https://example.org/watch?v=dQw4w9WgXcQ">Not YouTube</a>`:                          `<a href="https://example.org/watch?v=dQw4w9WgXcQ">Not YouTube</a>`,
+	}
+
+	for input, expected := range scenarios {
+		actual := rewriteYoutubeLinks(input, instanceURL)
+		if actual != expected {
+			t.Errorf(`Unexpected output for %q, got %q instead of %q`, input, actual, expected)
+		}
+	}
+}
+
+func TestRewriteYoutubeLinksWithoutInstance(t *testing.T) {
+	input := `<a href="https://www.youtube.com/watch?v=dQw4w9WgXcQ">Video</a>`
+	if output := rewriteYoutubeLinks(input, ""); output != input {
+		t.Errorf(`The content should not be modified without instance, got %q`, output)
+	}
+}
+
 func TestRewriteWithInexistingCustomRule(t *testing.T) {
 	output := Rewriter("https://www.youtube.com/watch?v=1234", `Video Description`, `some rule`)
 	expected := `Video Description`
@@ -117,6 +216,26 @@ func TestRewriteWithPDFLink(t *testing.T) {
 	}
 }
 
+func TestRewriteWithPDFLinkAlreadyAdded(t *testing.T) {
+	description := `<a href="https://example.org/document.pdf" rel="noopener noreferrer" target="_blank">PDF</a><br>test`
+	output := Rewriter("https://example.org/document.pdf", description, ``)
+
+	if output != description {
+		t.Errorf(`The PDF link should not be added twice, got "%s"`, output)
+	}
+}
+
+func TestRewriteWithInvidiousPlayerAlreadyAdded(t *testing.T) {
+	description := `<iframe width="650" height="350" frameborder="0" src="https://invidio.us/embed/1234" allowfullscreen></iframe><br>Video Description`
+
+	for _, entryURL := range []string{"https://www.youtube.com/watch?v=1234", "https://invidio.us/watch?v=1234"} {
+		output := Rewriter(entryURL, description, `add_youtube_video_using_invidious_player,add_invidious_video`)
+		if output != description {
+			t.Errorf(`The player should not be added twice for %q, got "%s"`, entryURL, output)
+		}
+	}
+}
+
 func TestRewriteWithNoLazyImage(t *testing.T) {
 	description := `<img src="https://example.org/image.jpg" alt="Image"><noscript><p>Some text</p></noscript>`
 	output := Rewriter("https://example.org/article", description, "add_dynamic_image")
@@ -176,3 +295,48 @@ func TestConvertTextLinkRewriteRule(t *testing.T) {
 		t.Errorf(`Not expected output: got %q instead of %q`, output, expected)
 	}
 }
+
+func TestRemoveTrackingParameters(t *testing.T) {
+	parameters := []string{"utm_*", "fbclid"}
+	scenarios := map[string]string{
+		"https://example.org/article":                                      "https://example.org/article",
+		"https://example.org/article?id=1":                                 "https://example.org/article?id=1",
+		"https://example.org/article?utm_source=rss&utm_medium=feed":       "https://example.org/article",
+		"https://example.org/article?b=2&utm_source=rss&a=1&fbclid=abc":    "https://example.org/article?b=2&a=1",
+		"https://example.org/article?UTM_Campaign=x&q=a%20b#section-2":     "https://example.org/article?q=a%20b#section-2",
+		"https://example.org/article?fbclid#comments":                      "https://example.org/article#comments",
+		"https://example.org/article?utm=1&my_utm_source=2&fbclid_extra=3": "https://example.org/article?utm=1&my_utm_source=2&fbclid_extra=3",
+		"https://example.org/article?%75tm_source=rss&page=2":              "https://example.org/article?page=2",
+	}
+
+	for input, expected := range scenarios {
+		actual := removeTrackingParameters(input, parameters)
+		if actual != expected {
+			t.Errorf(`Unexpected URL for %q, got %q instead of %q`, input, actual, expected)
+		}
+	}
+}
+
+func TestRewriteEntryURLWithTrackingParametersRule(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	input := "https://example.org/article?id=1&utm_source=rss&gclid=abc#top"
+
+	output := RewriteEntryURL(input, "remove_tracking_parameters")
+	expected := "https://example.org/article?id=1#top"
+	if output != expected {
+		t.Errorf(`Unexpected URL, got %q instead of %q`, output, expected)
+	}
+
+	output = RewriteEntryURL(input, "add_image_title")
+	if output != input {
+		t.Errorf(`The URL should not be modified without the rule, got %q`, output)
+	}
+}
@@ -15,9 +15,7 @@ import (
 // Parse returns a normalized feed struct from a RSS feed.
 func Parse(data io.Reader) (*model.Feed, *errors.LocalizedError) {
 	feed := new(rssFeed)
-	decoder := xml.NewDecoder(data)
-	err := decoder.Decode(feed)
-	if err != nil {
+	if err := xml.Decode(data, feed); err != nil {
 		return nil, errors.NewLocalizedError("Unable to parse RSS feed: %q", err)
 	}
 
@@ -6,6 +6,7 @@ package rss // import "miniflux.app/reader/rss"
 
 import (
 	"bytes"
+	"io/ioutil"
 	"testing"
 	"time"
 )
@@ -63,6 +64,10 @@ func TestParseRss2Sample(t *testing.T) {
 		t.Errorf("Incorrect title, got: %s", feed.Title)
 	}
 
+	if feed.Language != "en-us" {
+		t.Errorf("Incorrect language, got: %s", feed.Language)
+	}
+
 	if feed.FeedURL != "" {
 		t.Errorf("Incorrect feed URL, got: %s", feed.FeedURL)
 	}
@@ -84,6 +89,10 @@ func TestParseRss2Sample(t *testing.T) {
 		t.Errorf("Incorrect entry hash, got: %s", feed.Entries[0].Hash)
 	}
 
+	if feed.Entries[0].GUID != "http://liftoff.msfc.nasa.gov/2003/06/03.html#item573" {
+		t.Errorf("Incorrect entry GUID, got: %s", feed.Entries[0].GUID)
+	}
+
 	if feed.Entries[0].URL != "http://liftoff.msfc.nasa.gov/news/2003/news-starcity.asp" {
 		t.Errorf("Incorrect entry URL, got: %s", feed.Entries[0].URL)
 	}
@@ -892,6 +901,110 @@ func TestParseEntryWithRelativeURL(t *testing.T) {
 	}
 }
 
+func TestParseEntryWithRelativeURLAndXMLBase(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xml:base="https://example.org/blog/">
+		<channel>
+			<link>https://example.org/</link>
+			<item xml:base="posts/">
+				<title>Item 1</title>
+				<link>item1.html</link>
+				<enclosure url="audio/item1.mp3" length="12345" type="audio/mpeg"/>
+			</item>
+			<item>
+				<title>Item 2</title>
+				<link>item2.html</link>
+				<enclosure url="//cdn.example.org/item2.mp3" length="12345" type="audio/mpeg"/>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].URL != "https://example.org/blog/posts/item1.html" {
+		t.Errorf("Incorrect entry URL, got: %s", feed.Entries[0].URL)
+	}
+
+	if feed.Entries[0].Enclosures[0].URL != "https://example.org/blog/posts/audio/item1.mp3" {
+		t.Errorf("Incorrect enclosure URL, got: %s", feed.Entries[0].Enclosures[0].URL)
+	}
+
+	if feed.Entries[1].URL != "https://example.org/blog/item2.html" {
+		t.Errorf("Incorrect entry URL, got: %s", feed.Entries[1].URL)
+	}
+
+	if feed.Entries[1].Enclosures[0].URL != "https://cdn.example.org/item2.mp3" {
+		t.Errorf("Incorrect enclosure URL, got: %s", feed.Entries[1].Enclosures[0].URL)
+	}
+}
+
+func TestParseEntryWithProtocolRelativeURL(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<link>http://example.org/</link>
+			<item>
+				<link>//www.example.org/item.html</link>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].URL != "https://www.example.org/item.html" {
+		t.Errorf("Incorrect entry URL, got: %s", feed.Entries[0].URL)
+	}
+}
+
+func TestParseEntryWithPermalinkGUID(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<link>https://example.org/</link>
+			<item>
+				<title>Item 1</title>
+				<guid isPermaLink="true">/posts/item1.html</guid>
+			</item>
+			<item>
+				<title>Item 2</title>
+				<guid>https://example.org/posts/item2.html</guid>
+			</item>
+			<item>
+				<title>Item 3</title>
+				<guid>item3</guid>
+			</item>
+			<item>
+				<title>Item 4</title>
+				<guid isPermaLink="false">https://example.org/posts/item4.html</guid>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"https://example.org/posts/item1.html",
+		"https://example.org/posts/item2.html",
+		"https://example.org/",
+		"https://example.org/",
+	}
+
+	for i, entryURL := range expected {
+		if feed.Entries[i].URL != entryURL {
+			t.Errorf("Incorrect URL for entry #%d, got: %s", i, feed.Entries[i].URL)
+		}
+	}
+}
+
 func TestParseEntryWithCommentsURL(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 		<rss version="2.0" xmlns:slash="http://purl.org/rss/1.0/modules/slash/">
@@ -1053,6 +1166,98 @@ func TestParseEntryWithMediaGroup(t *testing.T) {
 	}
 }
 
+func TestParseEntryThumbnailURL(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/media_thumbnails.xml")
+	if err != nil {
+		t.Fatalf(`Unable to read file: %v`, err)
+	}
+
+	feed, parseErr := Parse(bytes.NewReader(data))
+	if parseErr != nil {
+		t.Fatal(parseErr)
+	}
+
+	expectedThumbnails := []string{
+		"https://example.org/videos/1/large.jpg",
+		"https://example.org/videos/2/hq.jpg",
+		"https://example.org/videos/3/first.jpg",
+		"https://example.org/videos/4/with-width.jpg",
+		"",
+	}
+
+	if len(feed.Entries) != len(expectedThumbnails) {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	for index, expected := range expectedThumbnails {
+		if feed.Entries[index].ThumbnailURL != expected {
+			t.Errorf(`Unexpected thumbnail for entry #%d, got %q instead of %q`, index, feed.Entries[index].ThumbnailURL, expected)
+		}
+	}
+}
+
+func TestParseEntryExplicitFlags(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/explicit_flags.xml")
+	if err != nil {
+		t.Fatalf(`Unable to read file: %v`, err)
+	}
+
+	feed, parseErr := Parse(bytes.NewReader(data))
+	if parseErr != nil {
+		t.Fatal(parseErr)
+	}
+
+	expectedFlags := []bool{true, false, true, false, false, false, true, false, true, true, true}
+	if len(feed.Entries) != len(expectedFlags) {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	for index, expected := range expectedFlags {
+		if feed.Entries[index].Explicit != expected {
+			t.Errorf(`Unexpected explicit flag for %q, got %v instead of %v`, feed.Entries[index].Title, feed.Entries[index].Explicit, expected)
+		}
+	}
+}
+
+func TestParseEntryWithoutExplicitFlags(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<media:rating>adult</media:rating>
+			<item><title>Rated by the channel</title></item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !feed.Entries[0].Explicit {
+		t.Error(`The channel media rating should be used when the item has no flag`)
+	}
+
+	data = `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<item><title>No flag</title></item>
+		</channel>
+		</rss>`
+
+	feed, err = Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].Explicit {
+		t.Error(`An entry without flag should not be explicit`)
+	}
+}
+
 func TestParseEntryWithMediaContent(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 		<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">
@@ -1254,3 +1459,221 @@ func TestEntryDescriptionFromGooglePlayDescription(t *testing.T) {
 		t.Errorf(`Unexpected podcast content, got %q instead of %q`, result, expected)
 	}
 }
+
+func TestParseFeedWithSyndicationHints(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:sy="http://purl.org/rss/1.0/modules/syndication/">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<sy:updatePeriod>hourly</sy:updatePeriod>
+			<sy:updateFrequency>2</sy:updateFrequency>
+			<item>
+				<title>Test</title>
+				<link>https://example.org/item</link>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.UpdateInterval != 30 {
+		t.Errorf(`Incorrect update interval, got: %d`, feed.UpdateInterval)
+	}
+}
+
+func TestParseFeedWithSyndicationPeriodOnly(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:sy="http://purl.org/rss/1.0/modules/syndication/">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<sy:updatePeriod>daily</sy:updatePeriod>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.UpdateInterval != 1440 {
+		t.Errorf(`Incorrect update interval, got: %d`, feed.UpdateInterval)
+	}
+}
+
+func TestParseFeedWithoutSyndicationHints(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.UpdateInterval != 0 {
+		t.Errorf(`Incorrect update interval, got: %d`, feed.UpdateInterval)
+	}
+}
+
+func TestParseFeedWithTTL(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<ttl>60</ttl>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.TTL != 60 {
+		t.Errorf(`Incorrect TTL, got: %d`, feed.TTL)
+	}
+}
+
+func TestParseFeedWithoutTTL(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.TTL != 0 {
+		t.Errorf(`Incorrect TTL, got: %d`, feed.TTL)
+	}
+}
+
+func TestParseFeedWithInvalidTTL(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<ttl>invalid</ttl>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.TTL != 0 {
+		t.Errorf(`Incorrect TTL, got: %d`, feed.TTL)
+	}
+}
+
+func TestParseEntryWithPodcastTranscripts(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+		<rss version="2.0" xmlns:podcast="https://podcastindex.org/namespace/1.0">
+		<channel>
+			<title>Podcast Example</title>
+			<link>http://www.example.com/index.html</link>
+			<item>
+				<title>Episode 1</title>
+				<link>http://www.example.com/episode1.html</link>
+				<enclosure url="http://www.example.com/episode1.mp3" length="12345" type="audio/mpeg"/>
+				<podcast:transcript url="https://example.com/episode1/transcript.vtt" type="text/vtt" language="en"/>
+				<podcast:transcript url="https://example.com/episode1/transcript.srt" type="application/srt" language="es"/>
+				<podcast:transcript url="https://example.com/episode1/transcript.vtt" type="text/vtt" language="en"/>
+				<podcast:transcript url="" type="text/html"/>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transcripts := feed.Entries[0].Transcripts
+	if len(transcripts) != 2 {
+		t.Fatalf(`Incorrect number of transcripts, got: %d`, len(transcripts))
+	}
+
+	if transcripts[0].URL != "https://example.com/episode1/transcript.vtt" || transcripts[0].MimeType != "text/vtt" || transcripts[0].Language != "en" {
+		t.Errorf(`Incorrect first transcript: %+v`, transcripts[0])
+	}
+
+	if transcripts[1].URL != "https://example.com/episode1/transcript.srt" || transcripts[1].MimeType != "application/srt" || transcripts[1].Language != "es" {
+		t.Errorf(`Incorrect second transcript: %+v`, transcripts[1])
+	}
+
+	if len(feed.Entries[0].Enclosures) != 1 {
+		t.Errorf(`Transcripts should not be stored as enclosures, got %d enclosures`, len(feed.Entries[0].Enclosures))
+	}
+}
+
+func TestParseFeedWithWebSubLinks(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<atom:link rel="hub" href="invalid hub" />
+			<atom:link rel="hub" href="https://pubsubhubbub.appspot.com/" />
+			<atom:link rel="hub" href="https://websub.example.org/" />
+			<atom:link rel="self" type="application/rss+xml" href="https://example.org/rss.xml" />
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.HubURL != "https://pubsubhubbub.appspot.com/" {
+		t.Errorf(`Incorrect hub URL, got: %q`, feed.HubURL)
+	}
+
+	if feed.TopicURL != "https://example.org/rss.xml" {
+		t.Errorf(`Incorrect topic URL, got: %q`, feed.TopicURL)
+	}
+
+	if feed.FeedURL != "https://example.org/rss.xml" {
+		t.Errorf(`Incorrect feed URL, got: %q`, feed.FeedURL)
+	}
+}
+
+func TestParseFeedWithoutWebSubLinks(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.HubURL != "" {
+		t.Errorf(`Incorrect hub URL, got: %q`, feed.HubURL)
+	}
+
+	if feed.TopicURL != "" {
+		t.Errorf(`Incorrect topic URL, got: %q`, feed.TopicURL)
+	}
+}
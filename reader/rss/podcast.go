@@ -4,25 +4,42 @@
 
 package rss // import "miniflux.app/reader/rss"
 
-import "strings"
+import (
+	"strings"
+
+	"miniflux.app/model"
+)
 
 // PodcastFeedElement represents iTunes and GooglePlay feed XML elements.
 // Specs:
 // - https://github.com/simplepie/simplepie-ng/wiki/Spec:-iTunes-Podcast-RSS
 // - https://developers.google.com/search/reference/podcast/rss-feed
 type PodcastFeedElement struct {
-	ItunesAuthor     string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>author"`
-	Subtitle         string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>subtitle"`
-	Summary          string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>summary"`
-	PodcastOwner     PodcastOwner `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>owner"`
-	GooglePlayAuthor string       `xml:"http://www.google.com/schemas/play-podcasts/1.0 channel>author"`
+	ItunesAuthor       string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>author"`
+	Subtitle           string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>subtitle"`
+	Summary            string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>summary"`
+	PodcastOwner       PodcastOwner `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>owner"`
+	GooglePlayAuthor   string       `xml:"http://www.google.com/schemas/play-podcasts/1.0 channel>author"`
+	ItunesExplicit     string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>explicit"`
+	GooglePlayExplicit string       `xml:"http://www.google.com/schemas/play-podcasts/1.0 channel>explicit"`
 }
 
-// PodcastEntryElement represents iTunes and GooglePlay entry XML elements.
+// PodcastEntryElement represents iTunes, GooglePlay and Podcast Namespace entry XML elements.
 type PodcastEntryElement struct {
-	Subtitle              string `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd subtitle"`
-	Summary               string `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd summary"`
-	GooglePlayDescription string `xml:"http://www.google.com/schemas/play-podcasts/1.0 description"`
+	Subtitle              string              `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd subtitle"`
+	Summary               string              `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd summary"`
+	GooglePlayDescription string              `xml:"http://www.google.com/schemas/play-podcasts/1.0 description"`
+	Transcripts           []PodcastTranscript `xml:"https://podcastindex.org/namespace/1.0 transcript"`
+	ItunesExplicit        string              `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd explicit"`
+	GooglePlayExplicit    string              `xml:"http://www.google.com/schemas/play-podcasts/1.0 explicit"`
+}
+
+// PodcastTranscript represents a transcript of the Podcast Namespace.
+// Specs: https://github.com/Podcastindex-org/podcast-namespace/blob/main/docs/1.0.md#transcript
+type PodcastTranscript struct {
+	URL      string `xml:"url,attr"`
+	Type     string `xml:"type,attr"`
+	Language string `xml:"language,attr"`
 }
 
 // PodcastOwner represents contact information for the podcast owner.
@@ -54,6 +71,31 @@ func (e *PodcastFeedElement) PodcastAuthor() string {
 	return strings.TrimSpace(author)
 }
 
+// PodcastExplicit returns true when the podcast is flagged as explicit, found is false when there is no flag.
+func (e *PodcastFeedElement) PodcastExplicit() (explicit, found bool) {
+	return parseExplicitFlag(e.ItunesExplicit, e.GooglePlayExplicit)
+}
+
+// PodcastExplicit returns true when the episode is flagged as explicit, found is false when there is no flag.
+func (e *PodcastEntryElement) PodcastExplicit() (explicit, found bool) {
+	return parseExplicitFlag(e.ItunesExplicit, e.GooglePlayExplicit)
+}
+
+// parseExplicitFlag returns the first known value, both the current ("true"/"false")
+// and the deprecated ("yes"/"explicit"/"clean") iTunes values are supported.
+func parseExplicitFlag(values ...string) (explicit, found bool) {
+	for _, value := range values {
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "true", "yes", "explicit":
+			return true, true
+		case "false", "no", "clean":
+			return false, true
+		}
+	}
+
+	return false, false
+}
+
 // PodcastDescription returns the description of the podcast.
 func (e *PodcastEntryElement) PodcastDescription() string {
 	description := ""
@@ -68,3 +110,25 @@ func (e *PodcastEntryElement) PodcastDescription() string {
 	}
 	return strings.TrimSpace(description)
 }
+
+// PodcastTranscripts returns the list of transcripts of the episode.
+func (e *PodcastEntryElement) PodcastTranscripts() model.TranscriptList {
+	transcripts := make(model.TranscriptList, 0)
+	duplicates := make(map[string]bool)
+
+	for _, transcript := range e.Transcripts {
+		transcriptURL := strings.TrimSpace(transcript.URL)
+		if transcriptURL == "" || duplicates[transcriptURL] {
+			continue
+		}
+
+		duplicates[transcriptURL] = true
+		transcripts = append(transcripts, &model.Transcript{
+			URL:      transcriptURL,
+			MimeType: strings.TrimSpace(transcript.Type),
+			Language: strings.TrimSpace(transcript.Language),
+		})
+	}
+
+	return transcripts
+}
@@ -22,17 +22,21 @@ import (
 
 // Specs: https://cyber.harvard.edu/rss/rss.html
 type rssFeed struct {
-	XMLName        xml.Name  `xml:"rss"`
-	Version        string    `xml:"version,attr"`
-	Title          string    `xml:"channel>title"`
-	Links          []rssLink `xml:"channel>link"`
-	Language       string    `xml:"channel>language"`
-	Description    string    `xml:"channel>description"`
-	PubDate        string    `xml:"channel>pubDate"`
-	ManagingEditor string    `xml:"channel>managingEditor"`
-	Webmaster      string    `xml:"channel>webMaster"`
-	Items          []rssItem `xml:"channel>item"`
+	XMLName        xml.Name         `xml:"rss"`
+	Version        string           `xml:"version,attr"`
+	Title          string           `xml:"channel>title"`
+	Links          []rssLink        `xml:"channel>link"`
+	Language       string           `xml:"channel>language"`
+	Description    string           `xml:"channel>description"`
+	PubDate        string           `xml:"channel>pubDate"`
+	ManagingEditor string           `xml:"channel>managingEditor"`
+	Webmaster      string           `xml:"channel>webMaster"`
+	TTL            string           `xml:"channel>ttl"`
+	BaseURL        string           `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
+	Items          []rssItem        `xml:"channel>item"`
+	MediaRatings   media.RatingList `xml:"http://search.yahoo.com/mrss/ channel>rating"`
 	PodcastFeedElement
+	SyndicationFeedElement
 }
 
 func (r *rssFeed) Transform() *model.Feed {
@@ -45,6 +49,13 @@ func (r *rssFeed) Transform() *model.Feed {
 		feed.Title = feed.SiteURL
 	}
 
+	feed.HubURL = r.hubURL()
+	feed.TopicURL = r.topicURL()
+	feed.UpdateInterval = r.UpdateInterval()
+	feed.TTL = r.ttl()
+	feed.Language = strings.TrimSpace(r.Language)
+	feedBaseURL := url.ResolveBaseURL(feed.SiteURL, r.BaseURL)
+
 	for _, item := range r.Items {
 		entry := item.Transform()
 		if entry.Author == "" {
@@ -52,15 +63,26 @@ func (r *rssFeed) Transform() *model.Feed {
 		}
 		entry.Author = sanitizer.StripTags(entry.Author)
 
+		if _, found := item.entryExplicit(); !found {
+			entry.Explicit = r.feedExplicit()
+		}
+
+		entryBaseURL := url.ResolveBaseURL(feedBaseURL, item.BaseURL)
 		if entry.URL == "" {
 			entry.URL = feed.SiteURL
 		} else {
-			entryURL, err := url.AbsoluteURL(feed.SiteURL, entry.URL)
+			entryURL, err := url.AbsoluteURL(entryBaseURL, entry.URL)
 			if err == nil {
 				entry.URL = entryURL
 			}
 		}
 
+		for _, enclosure := range entry.Enclosures {
+			if enclosureURL, err := url.AbsoluteURL(entryBaseURL, enclosure.URL); err == nil {
+				enclosure.URL = enclosureURL
+			}
+		}
+
 		if entry.Title == "" {
 			entry.Title = entry.URL
 		}
@@ -71,6 +93,15 @@ func (r *rssFeed) Transform() *model.Feed {
 	return feed
 }
 
+func (r *rssFeed) ttl() int {
+	ttl, err := strconv.Atoi(strings.TrimSpace(r.TTL))
+	if err != nil || ttl < 0 {
+		return 0
+	}
+
+	return ttl
+}
+
 func (r *rssFeed) siteURL() string {
 	for _, element := range r.Links {
 		if element.XMLName.Space == "" {
@@ -84,13 +115,58 @@ func (r *rssFeed) siteURL() string {
 func (r *rssFeed) feedURL() string {
 	for _, element := range r.Links {
 		if element.XMLName.Space == "http://www.w3.org/2005/Atom" {
-			return strings.TrimSpace(element.Href)
+			if rel := strings.ToLower(strings.TrimSpace(element.Rel)); rel == "" || rel == "self" {
+				return strings.TrimSpace(element.Href)
+			}
+		}
+	}
+
+	return ""
+}
+
+// hubURL returns the first valid WebSub hub declared by the feed.
+func (r *rssFeed) hubURL() string {
+	for _, element := range r.atomLinksWithRelation("hub") {
+		if url.IsHTTPURL(element) {
+			return element
 		}
 	}
 
 	return ""
 }
 
+// topicURL returns the self link of the feed, used as topic by WebSub hubs.
+func (r *rssFeed) topicURL() string {
+	for _, element := range r.atomLinksWithRelation("self") {
+		return element
+	}
+
+	return ""
+}
+
+func (r *rssFeed) atomLinksWithRelation(relation string) []string {
+	var links []string
+	for _, element := range r.Links {
+		if element.XMLName.Space == "http://www.w3.org/2005/Atom" && strings.ToLower(strings.TrimSpace(element.Rel)) == relation {
+			if link := strings.TrimSpace(element.Href); link != "" {
+				links = append(links, link)
+			}
+		}
+	}
+
+	return links
+}
+
+// feedExplicit returns the explicit flag of the channel, used by the items without their own flag.
+func (r *rssFeed) feedExplicit() bool {
+	if explicit, found := r.PodcastExplicit(); found {
+		return explicit
+	}
+
+	adult, _ := r.MediaRatings.IsAdult()
+	return adult
+}
+
 func (r rssFeed) feedAuthor() string {
 	author := r.PodcastAuthor()
 	switch {
@@ -142,8 +218,31 @@ func (enclosure *rssEnclosure) Size() int64 {
 	return size
 }
 
+type rssGUID struct {
+	Data        string `xml:",chardata"`
+	IsPermaLink string `xml:"isPermaLink,attr"`
+}
+
+// permalink returns the GUID when it is the URL of the item.
+func (g *rssGUID) permalink() string {
+	guid := strings.TrimSpace(g.Data)
+
+	switch strings.ToLower(strings.TrimSpace(g.IsPermaLink)) {
+	case "true":
+		return guid
+	case "":
+		// Many feeds do not set isPermaLink="false" when the GUID is not a link.
+		if url.IsHTTPURL(guid) {
+			return guid
+		}
+	}
+
+	return ""
+}
+
 type rssItem struct {
-	GUID           string           `xml:"guid"`
+	GUID           rssGUID          `xml:"guid"`
+	BaseURL        string           `xml:"http://www.w3.org/XML/1998/namespace base,attr"`
 	Title          []rssTitle       `xml:"title"`
 	Links          []rssLink        `xml:"link"`
 	Description    string           `xml:"description"`
@@ -162,20 +261,38 @@ func (r *rssItem) Transform() *model.Entry {
 	entry.URL = r.entryURL()
 	entry.CommentsURL = r.entryCommentsURL()
 	entry.Date = r.entryDate()
+	entry.RawDate = r.entryDateText()
+	entry.UpdatedAt = entry.Date
 	entry.Author = r.entryAuthor()
 	entry.Hash = r.entryHash()
+	entry.GUID = r.GUID.Data
 	entry.Content = r.entryContent()
 	entry.Title = r.entryTitle()
 	entry.Enclosures = r.entryEnclosures()
+	entry.ThumbnailURL = r.LargestMediaThumbnailURL()
+	entry.Transcripts = r.PodcastTranscripts()
+	entry.Explicit, _ = r.entryExplicit()
 	return entry
 }
 
-func (r *rssItem) entryDate() time.Time {
-	value := r.PubDate
+func (r *rssItem) entryExplicit() (explicit, found bool) {
+	if explicit, found = r.PodcastExplicit(); found {
+		return explicit, found
+	}
+
+	return r.MediaRatingIsAdult()
+}
+
+func (r *rssItem) entryDateText() string {
 	if r.DublinCoreDate != "" {
-		value = r.DublinCoreDate
+		return r.DublinCoreDate
 	}
 
+	return r.PubDate
+}
+
+func (r *rssItem) entryDate() time.Time {
+	value := r.entryDateText()
 	if value != "" {
 		result, err := date.Parse(value)
 		if err != nil {
@@ -219,7 +336,7 @@ func (r *rssItem) entryAuthor() string {
 }
 
 func (r *rssItem) entryHash() string {
-	for _, value := range []string{r.GUID, r.entryURL()} {
+	for _, value := range []string{r.GUID.Data, r.entryURL()} {
 		if value != "" {
 			return crypto.Hash(value)
 		}
@@ -273,7 +390,7 @@ func (r *rssItem) entryURL() string {
 		}
 	}
 
-	return ""
+	return r.GUID.permalink()
 }
 
 func (r *rssItem) entryEnclosures() model.EnclosureList {
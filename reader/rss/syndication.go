@@ -0,0 +1,53 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package rss // import "miniflux.app/reader/rss"
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SyndicationFeedElement represents the update hints of the RSS Syndication module.
+// Specs: http://web.resource.org/rss/1.0/modules/syndication/
+type SyndicationFeedElement struct {
+	UpdatePeriod    string `xml:"http://purl.org/rss/1.0/modules/syndication/ channel>updatePeriod"`
+	UpdateFrequency string `xml:"http://purl.org/rss/1.0/modules/syndication/ channel>updateFrequency"`
+}
+
+// UpdateInterval returns the number of minutes between two updates, or 0 if the feed doesn't give any hint.
+func (s *SyndicationFeedElement) UpdateInterval() int {
+	period := strings.ToLower(strings.TrimSpace(s.UpdatePeriod))
+	frequency := strings.TrimSpace(s.UpdateFrequency)
+	if period == "" && frequency == "" {
+		return 0
+	}
+
+	var periodMinutes int
+	switch period {
+	case "hourly":
+		periodMinutes = 60
+	case "", "daily":
+		periodMinutes = 24 * 60
+	case "weekly":
+		periodMinutes = 7 * 24 * 60
+	case "monthly":
+		periodMinutes = 30 * 24 * 60
+	case "yearly":
+		periodMinutes = 365 * 24 * 60
+	default:
+		return 0
+	}
+
+	updates := 1
+	if frequency != "" {
+		value, err := strconv.Atoi(frequency)
+		if err != nil || value < 1 {
+			return 0
+		}
+		updates = value
+	}
+
+	return periodMinutes / updates
+}
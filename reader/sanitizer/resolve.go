@@ -0,0 +1,66 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sanitizer // import "miniflux.app/reader/sanitizer"
+
+import (
+	"bytes"
+	"io"
+
+	"miniflux.app/url"
+
+	"golang.org/x/net/html"
+)
+
+// ResolveURLs converts the relative links and resources of the HTML fragment to absolute URLs.
+// The rest of the input is kept as is, the input is returned unchanged when it cannot be tokenized.
+func ResolveURLs(baseURL, input string) string {
+	tokenizer := html.NewTokenizer(bytes.NewBufferString(input))
+	var buffer bytes.Buffer
+
+	for {
+		if tokenizer.Next() == html.ErrorToken {
+			err := tokenizer.Err()
+			if err == io.EOF {
+				return buffer.String()
+			}
+
+			return input
+		}
+
+		// The raw data must be copied before reading the token.
+		raw := string(tokenizer.Raw())
+		token := tokenizer.Token()
+
+		switch token.Type {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if resolveAttributes(baseURL, token.Attr) {
+				buffer.WriteString(token.String())
+			} else {
+				buffer.WriteString(raw)
+			}
+		default:
+			buffer.WriteString(raw)
+		}
+	}
+}
+
+// resolveAttributes returns true when at least one attribute has been modified.
+func resolveAttributes(baseURL string, attributes []html.Attribute) bool {
+	modified := false
+
+	for i := range attributes {
+		if !isExternalResourceAttribute(attributes[i].Key) || attributes[i].Val == "" {
+			continue
+		}
+
+		value, err := url.AbsoluteURL(baseURL, attributes[i].Val)
+		if err == nil && value != attributes[i].Val {
+			attributes[i].Val = value
+			modified = true
+		}
+	}
+
+	return modified
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sanitizer // import "miniflux.app/reader/sanitizer"
+
+import "testing"
+
+func TestResolveURLs(t *testing.T) {
+	input := `<p>This <a href="../test.html" title="A &amp; B">link is relative</a> and this <a href="https://example.com/">one</a> is not: <img src="image.png"/></p>`
+	expected := `<p>This <a href="https://example.org/test.html" title="A &amp; B">link is relative</a> and this <a href="https://example.com/">one</a> is not: <img src="https://example.org/blog/image.png"/></p>`
+	output := ResolveURLs("https://example.org/blog/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestResolveURLsKeepsTextUnchanged(t *testing.T) {
+	input := `Fish &amp; chips <em>are</em> "good" &lt;3`
+	output := ResolveURLs("https://example.org/", input)
+
+	if input != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, input, output)
+	}
+}
@@ -9,8 +9,12 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync/atomic"
 
+	"miniflux.app/config"
+	"miniflux.app/reader/srcset"
 	"miniflux.app/url"
 
 	"golang.org/x/net/html"
@@ -38,6 +42,10 @@ func Sanitize(baseURL, input string) string {
 		}
 
 		token := tokenizer.Token()
+		if token.Data == "img" {
+			token.Attr = promoteLazyLoadAttributes(token.Attr)
+		}
+
 		switch token.Type {
 		case html.TextToken:
 			if blacklistedTagDepth > 0 {
@@ -46,7 +54,7 @@ func Sanitize(baseURL, input string) string {
 
 			buffer.WriteString(html.EscapeString(token.Data))
 		case html.StartTagToken:
-			tagName := token.DataAtom.String()
+			tagName := getTagName(token)
 
 			if !isPixelTracker(tagName, token.Attr) && isValidTag(tagName) {
 				attrNames, htmlAttributes := sanitizeAttributes(baseURL, tagName, token.Attr)
@@ -64,14 +72,14 @@ func Sanitize(baseURL, input string) string {
 				blacklistedTagDepth++
 			}
 		case html.EndTagToken:
-			tagName := token.DataAtom.String()
+			tagName := getTagName(token)
 			if isValidTag(tagName) && inList(tagName, tagStack) {
 				buffer.WriteString(fmt.Sprintf("</%s>", tagName))
 			} else if isBlacklistedTag(tagName) {
 				blacklistedTagDepth--
 			}
 		case html.SelfClosingTagToken:
-			tagName := token.DataAtom.String()
+			tagName := getTagName(token)
 			if !isPixelTracker(tagName, token.Attr) && isValidTag(tagName) {
 				attrNames, htmlAttributes := sanitizeAttributes(baseURL, tagName, token.Attr)
 
@@ -87,6 +95,88 @@ func Sanitize(baseURL, input string) string {
 	}
 }
 
+// promoteLazyLoadAttributes replaces the placeholder of lazy-loaded images by the real image,
+// the attributes like "data-src" are used by scripts that are not executed in the entries.
+func promoteLazyLoadAttributes(attributes []html.Attribute) []html.Attribute {
+	lazyAttributes := []struct {
+		name       string
+		candidates []string
+	}{
+		{"src", []string{"data-src", "data-original", "data-lazy-src"}},
+		{"srcset", []string{"data-srcset", "data-lazy-srcset"}},
+	}
+
+	for _, lazyAttribute := range lazyAttributes {
+		attributeName := lazyAttribute.name
+
+		var value string
+		for _, candidate := range lazyAttribute.candidates {
+			if value = getAttributeValue(attributes, candidate); value != "" {
+				break
+			}
+		}
+
+		if value == "" {
+			continue
+		}
+
+		found := false
+		for i := range attributes {
+			if attributes[i].Key == attributeName {
+				attributes[i].Val = value
+				found = true
+			}
+		}
+
+		if !found {
+			attributes = append(attributes, html.Attribute{Key: attributeName, Val: value})
+		}
+	}
+
+	return attributes
+}
+
+func getAttributeValue(attributes []html.Attribute, name string) string {
+	for _, attribute := range attributes {
+		if attribute.Key == name {
+			return strings.TrimSpace(attribute.Val)
+		}
+	}
+
+	return ""
+}
+
+// sanitizeSrcset returns the image candidates of a srcset attribute with absolute and safe URLs.
+// A malformed attribute is removed.
+func sanitizeSrcset(baseURL, value string) string {
+	candidates, err := srcset.Parse(value)
+	if err != nil {
+		return ""
+	}
+
+	var safeCandidates srcset.Candidates
+	for _, candidate := range candidates {
+		link, err := url.AbsoluteURL(baseURL, candidate.URL)
+		if err != nil || !hasValidURIScheme(link) || isBlacklistedResource(link) {
+			continue
+		}
+
+		candidate.URL = link
+		safeCandidates = append(safeCandidates, candidate)
+	}
+
+	return safeCandidates.String()
+}
+
+// getTagName returns the name of the element, MathML elements like "mrow" are not known by the tokenizer.
+func getTagName(token html.Token) string {
+	if token.DataAtom != 0 {
+		return token.DataAtom.String()
+	}
+
+	return token.Data
+}
+
 func sanitizeAttributes(baseURL, tagName string, attributes []html.Attribute) ([]string, string) {
 	var htmlAttrs, attrNames []string
 	var err error
@@ -98,6 +188,20 @@ func sanitizeAttributes(baseURL, tagName string, attributes []html.Attribute) ([
 			continue
 		}
 
+		if attribute.Key == "dir" {
+			value = strings.ToLower(strings.TrimSpace(value))
+			if value != "ltr" && value != "rtl" && value != "auto" {
+				continue
+			}
+		}
+
+		if attribute.Key == "srcset" {
+			value = sanitizeSrcset(baseURL, value)
+			if value == "" {
+				continue
+			}
+		}
+
 		if isExternalResourceAttribute(attribute.Key) {
 			if tagName == "iframe" {
 				if isValidIframeSource(attribute.Val) {
@@ -152,7 +256,12 @@ func isValidTag(tagName string) bool {
 		}
 	}
 
-	return false
+	if _, found := getMathMLWhitelist()[tagName]; found && config.Opts.AllowMathML() {
+		return true
+	}
+
+	_, found := getExtraTagWhitelist()[tagName]
+	return found
 }
 
 func isValidAttribute(tagName, attributeName string) bool {
@@ -164,7 +273,11 @@ func isValidAttribute(tagName, attributeName string) bool {
 		}
 	}
 
-	return false
+	if attributes, found := getMathMLWhitelist()[tagName]; found && inList(attributeName, attributes) {
+		return true
+	}
+
+	return inList(attributeName, getExtraTagWhitelist()[tagName])
 }
 
 func isExternalResourceAttribute(attribute string) bool {
@@ -176,22 +289,60 @@ func isExternalResourceAttribute(attribute string) bool {
 	}
 }
 
+// isPixelTracker returns true when the image is used to track the readers:
+// images hidden or smaller than 2x2 pixels, and images loaded from known tracking services.
+// Images without dimensions are not considered as trackers.
 func isPixelTracker(tagName string, attributes []html.Attribute) bool {
-	if tagName == "img" {
-		hasHeight := false
-		hasWidth := false
-
-		for _, attribute := range attributes {
-			if attribute.Key == "height" && attribute.Val == "1" {
-				hasHeight = true
-			}
+	if tagName != "img" {
+		return false
+	}
 
-			if attribute.Key == "width" && attribute.Val == "1" {
-				hasWidth = true
+	var width, height = -1, -1
+	for _, attribute := range attributes {
+		switch attribute.Key {
+		case "width":
+			width = parseDimension(attribute.Val)
+		case "height":
+			height = parseDimension(attribute.Val)
+		case "src":
+			if isTrackingPixelSource(attribute.Val) {
+				return config.Opts.RemoveTrackingPixels()
 			}
 		}
+	}
+
+	if width == 0 || height == 0 || (width == 1 && height == 1) {
+		return config.Opts.RemoveTrackingPixels()
+	}
+
+	return false
+}
+
+// parseDimension returns the number of pixels of a width or height attribute, or -1 when the value is unknown.
+func parseDimension(value string) int {
+	dimension, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(value), "px"))
+	if err != nil || dimension < 0 {
+		return -1
+	}
+
+	return dimension
+}
 
-		return hasHeight && hasWidth
+func isTrackingPixelSource(src string) bool {
+	blacklist := []string{
+		"google-analytics.com/collect",
+		"pixel.wp.com",
+		"pixel.quantserve.com",
+		"pixel.mathtag.com",
+		"sb.scorecardresearch.com",
+		"www.facebook.com/tr",
+		"feeds.feedblitz.com/~/i/",
+	}
+
+	for _, element := range blacklist {
+		if strings.Contains(src, element) {
+			return true
+		}
 	}
 
 	return false
@@ -318,46 +469,53 @@ func isValidIframeSource(src string) bool {
 		}
 	}
 
-	return false
+	// Allow the YouTube players defined in the configuration.
+	if frontendURL := config.Opts.YouTubeFrontendURL(); frontendURL != "" && strings.HasPrefix(src, strings.TrimSuffix(frontendURL, "/")+"/") {
+		return true
+	}
+
+	return strings.HasPrefix(src, strings.TrimSuffix(config.Opts.YouTubeEmbedURL(), "/")+"/")
 }
 
 func getTagWhitelist() map[string][]string {
 	whitelist := make(map[string][]string)
-	whitelist["img"] = []string{"alt", "title", "src"}
+	whitelist["img"] = []string{"alt", "title", "src", "srcset"}
 	whitelist["audio"] = []string{"src"}
 	whitelist["video"] = []string{"poster", "height", "width", "src"}
 	whitelist["source"] = []string{"src", "type"}
-	whitelist["dt"] = []string{}
-	whitelist["dd"] = []string{}
-	whitelist["dl"] = []string{}
-	whitelist["table"] = []string{}
-	whitelist["caption"] = []string{}
+	whitelist["dt"] = []string{"dir"}
+	whitelist["dd"] = []string{"dir"}
+	whitelist["dl"] = []string{"dir"}
+	whitelist["table"] = []string{"dir"}
+	whitelist["caption"] = []string{"dir"}
 	whitelist["thead"] = []string{}
 	whitelist["tfooter"] = []string{}
 	whitelist["tr"] = []string{}
-	whitelist["td"] = []string{"rowspan", "colspan"}
-	whitelist["th"] = []string{"rowspan", "colspan"}
-	whitelist["h1"] = []string{}
-	whitelist["h2"] = []string{}
-	whitelist["h3"] = []string{}
-	whitelist["h4"] = []string{}
-	whitelist["h5"] = []string{}
-	whitelist["h6"] = []string{}
+	whitelist["td"] = []string{"rowspan", "colspan", "dir"}
+	whitelist["th"] = []string{"rowspan", "colspan", "dir"}
+	whitelist["h1"] = []string{"dir"}
+	whitelist["h2"] = []string{"dir"}
+	whitelist["h3"] = []string{"dir"}
+	whitelist["h4"] = []string{"dir"}
+	whitelist["h5"] = []string{"dir"}
+	whitelist["h6"] = []string{"dir"}
 	whitelist["strong"] = []string{}
 	whitelist["em"] = []string{}
 	whitelist["code"] = []string{}
-	whitelist["pre"] = []string{}
-	whitelist["blockquote"] = []string{}
+	whitelist["pre"] = []string{"dir"}
+	whitelist["blockquote"] = []string{"dir"}
 	whitelist["q"] = []string{"cite"}
-	whitelist["p"] = []string{}
-	whitelist["ul"] = []string{}
-	whitelist["li"] = []string{}
-	whitelist["ol"] = []string{}
+	whitelist["p"] = []string{"dir"}
+	whitelist["ul"] = []string{"dir"}
+	whitelist["li"] = []string{"dir"}
+	whitelist["ol"] = []string{"dir"}
 	whitelist["br"] = []string{}
 	whitelist["del"] = []string{}
 	whitelist["a"] = []string{"href", "title"}
-	whitelist["figure"] = []string{}
-	whitelist["figcaption"] = []string{}
+	whitelist["figure"] = []string{"dir"}
+	whitelist["figcaption"] = []string{"dir"}
+	whitelist["details"] = []string{"open", "dir"}
+	whitelist["summary"] = []string{"dir"}
 	whitelist["cite"] = []string{}
 	whitelist["time"] = []string{"datetime"}
 	whitelist["abbr"] = []string{"title"}
@@ -380,6 +538,80 @@ func getTagWhitelist() map[string][]string {
 	return whitelist
 }
 
+// extraTagWhitelist is the parsed value of SANITIZER_EXTRA_ALLOWED_TAGS, with the raw value it was parsed from.
+type extraTagWhitelist struct {
+	definitions string
+	whitelist   map[string][]string
+}
+
+var extraTagWhitelistCache atomic.Value
+
+// getExtraTagWhitelist returns the elements and attributes added to the whitelist with SANITIZER_EXTRA_ALLOWED_TAGS.
+// The value is parsed once and parsed again only when the configuration changes.
+func getExtraTagWhitelist() map[string][]string {
+	definitions := config.Opts.SanitizerExtraAllowedTags()
+	if cached, ok := extraTagWhitelistCache.Load().(*extraTagWhitelist); ok && cached.definitions == definitions {
+		return cached.whitelist
+	}
+
+	whitelist := parseExtraTagWhitelist(definitions)
+	extraTagWhitelistCache.Store(&extraTagWhitelist{definitions: definitions, whitelist: whitelist})
+	return whitelist
+}
+
+// parseExtraTagWhitelist parses a list of elements separated by semicolons, each one optionally followed by a colon and
+// a comma-separated list of attributes, for example "mark;span:lang,dir".
+// The elements and attributes able to execute code or to load content are never allowed.
+func parseExtraTagWhitelist(definitions string) map[string][]string {
+	whitelist := make(map[string][]string)
+
+	for _, definition := range strings.Split(definitions, ";") {
+		parts := strings.SplitN(definition, ":", 2)
+		tagName := strings.ToLower(strings.TrimSpace(parts[0]))
+		if tagName == "" || isForbiddenExtraTag(tagName) {
+			continue
+		}
+
+		attributes := whitelist[tagName]
+		if len(parts) == 2 {
+			for _, attributeName := range strings.Split(parts[1], ",") {
+				attributeName = strings.ToLower(strings.TrimSpace(attributeName))
+				if attributeName != "" && !isForbiddenExtraAttribute(attributeName) {
+					attributes = append(attributes, attributeName)
+				}
+			}
+		}
+
+		whitelist[tagName] = attributes
+	}
+
+	return whitelist
+}
+
+func isForbiddenExtraTag(tagName string) bool {
+	switch tagName {
+	case "script", "noscript", "style", "template", "iframe", "frame", "frameset", "object", "embed", "applet",
+		"form", "input", "button", "select", "textarea", "base", "link", "meta", "html", "head", "body", "title",
+		"svg", "math":
+		return true
+	default:
+		return isBlacklistedTag(tagName)
+	}
+}
+
+func isForbiddenExtraAttribute(attributeName string) bool {
+	if strings.HasPrefix(attributeName, "on") || strings.Contains(attributeName, ":") {
+		return true
+	}
+
+	switch attributeName {
+	case "style", "srcdoc", "action", "formaction", "background", "data", "codebase", "manifest", "ping", "is":
+		return true
+	default:
+		return false
+	}
+}
+
 func inList(needle string, haystack []string) bool {
 	for _, element := range haystack {
 		if element == needle {
@@ -393,12 +625,58 @@ func inList(needle string, haystack []string) bool {
 func rewriteIframeURL(link string) string {
 	matches := youtubeEmbedRegex.FindStringSubmatch(link)
 	if len(matches) == 2 {
-		return `https://www.youtube-nocookie.com/embed/` + matches[1]
+		return config.Opts.YouTubeEmbedURL() + matches[1]
 	}
 
 	return link
 }
 
+// getMathMLWhitelist returns the presentation MathML elements allowed when MathML is enabled.
+// The elements and attributes able to load resources or execute code are excluded:
+// "maction", "annotation-xml", "href", "definitionurl", "style" and the event handlers.
+func getMathMLWhitelist() map[string][]string {
+	tokenAttributes := []string{"mathvariant", "mathsize", "dir"}
+	operatorAttributes := []string{"mathvariant", "mathsize", "dir", "form", "fence", "separator", "stretchy", "symmetric", "largeop", "movablelimits", "accent", "lspace", "rspace", "minsize", "maxsize"}
+	scriptAttributes := []string{"subscriptshift", "superscriptshift"}
+	underOverAttributes := []string{"accent", "accentunder", "align"}
+	tableAttributes := []string{"align", "rowalign", "columnalign", "rowspacing", "columnspacing", "rowlines", "columnlines", "frame", "framespacing", "equalrows", "equalcolumns", "displaystyle"}
+	sizeAttributes := []string{"width", "height", "depth", "lspace", "voffset"}
+
+	whitelist := make(map[string][]string)
+	whitelist["math"] = []string{"display", "xmlns", "alttext"}
+	whitelist["mi"] = tokenAttributes
+	whitelist["mn"] = tokenAttributes
+	whitelist["mtext"] = tokenAttributes
+	whitelist["ms"] = append([]string{"lquote", "rquote"}, tokenAttributes...)
+	whitelist["mo"] = operatorAttributes
+	whitelist["mspace"] = []string{"width", "height", "depth"}
+	whitelist["mrow"] = []string{"dir"}
+	whitelist["mfrac"] = []string{"linethickness", "numalign", "denomalign", "bevelled"}
+	whitelist["msqrt"] = []string{}
+	whitelist["mroot"] = []string{}
+	whitelist["mstyle"] = []string{"displaystyle", "scriptlevel", "mathvariant", "mathsize"}
+	whitelist["merror"] = []string{}
+	whitelist["mpadded"] = sizeAttributes
+	whitelist["mphantom"] = []string{}
+	whitelist["menclose"] = []string{"notation"}
+	whitelist["mfenced"] = []string{"open", "close", "separators"}
+	whitelist["msub"] = scriptAttributes
+	whitelist["msup"] = scriptAttributes
+	whitelist["msubsup"] = scriptAttributes
+	whitelist["munder"] = underOverAttributes
+	whitelist["mover"] = underOverAttributes
+	whitelist["munderover"] = underOverAttributes
+	whitelist["mmultiscripts"] = scriptAttributes
+	whitelist["mprescripts"] = []string{}
+	whitelist["none"] = []string{}
+	whitelist["mtable"] = tableAttributes
+	whitelist["mtr"] = []string{"rowalign", "columnalign"}
+	whitelist["mtd"] = []string{"rowalign", "columnalign", "rowspan", "columnspan"}
+	whitelist["semantics"] = []string{}
+	whitelist["annotation"] = []string{"encoding"}
+	return whitelist
+}
+
 // Blacklisted tags remove the tag and all descendants.
 func isBlacklistedTag(tagName string) bool {
 	blacklist := []string{
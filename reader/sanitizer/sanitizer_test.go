@@ -4,7 +4,26 @@
 
 package sanitizer // import "miniflux.app/reader/sanitizer"
 
-import "testing"
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+func init() {
+	config.Opts = config.NewOptions()
+}
+
+func parseConfig(t *testing.T) {
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+}
 
 func TestValidInput(t *testing.T) {
 	input := `<p>This is a <strong>text</strong> with an image: <img src="http://example.org/" alt="Test" loading="lazy">.</p>`
@@ -104,6 +123,8 @@ func TestInvalidNestedTag(t *testing.T) {
 }
 
 func TestInvalidIFrame(t *testing.T) {
+	parseConfig(t)
+
 	input := `<iframe src="http://example.org/"></iframe>`
 	expected := ``
 	output := Sanitize("http://example.org/", input)
@@ -362,6 +383,8 @@ func TestBlacklistedLink(t *testing.T) {
 }
 
 func TestPixelTracker(t *testing.T) {
+	parseConfig(t)
+
 	input := `<p><img src="https://tracker1.example.org/" height="1" width="1"> and <img src="https://tracker2.example.org/" height="1" width="1"/></p>`
 	expected := `<p> and </p>`
 	output := Sanitize("http://example.org/", input)
@@ -371,6 +394,122 @@ func TestPixelTracker(t *testing.T) {
 	}
 }
 
+func TestPixelTrackerPatterns(t *testing.T) {
+	os.Clearenv()
+	parseConfig(t)
+
+	scenarios := map[string]string{
+		`<img src="https://example.org/pixel.gif" width="0" height="0">`:                     ``,
+		`<img src="https://example.org/pixel.gif" width="1px" height="1px">`:                 ``,
+		`<img src="https://example.org/pixel.gif" width="1">`:                                `<img src="https://example.org/pixel.gif" loading="lazy">`,
+		`<img src="https://pixel.wp.com/b.gif?host=example.org">`:                            ``,
+		`<img src="https://www.google-analytics.com/collect?v=1&amp;tid=UA-1">`:              ``,
+		`<img src="https://example.org/favicon.png" width="16" height="16" alt="Icon">`:      `<img src="https://example.org/favicon.png" alt="Icon" loading="lazy">`,
+		`<img src="https://example.org/image.png" alt="Image without dimensions">`:           `<img src="https://example.org/image.png" alt="Image without dimensions" loading="lazy">`,
+		`<img src="https://example.org/image.png" width="auto" height="1" alt="Auto width">`: `<img src="https://example.org/image.png" alt="Auto width" loading="lazy">`,
+	}
+
+	for input, expected := range scenarios {
+		if output := Sanitize("http://example.org/", input); output != expected {
+			t.Errorf(`Wrong output for %q, got %q instead of %q`, input, output, expected)
+		}
+	}
+}
+
+func TestPixelTrackerWhenDisabled(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("REMOVE_TRACKING_PIXELS", "0")
+	defer os.Clearenv()
+	parseConfig(t)
+
+	input := `<img src="https://tracker.example.org/" height="1" width="1">`
+	expected := `<img src="https://tracker.example.org/" loading="lazy">`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestDetailsAndSummary(t *testing.T) {
+	input := `<details open ontoggle="alert(1)"><summary onclick="alert(1)">Title</summary><p>Text</p><details><summary>Nested</summary>Nested text</details></details>`
+	expected := `<details open=""><summary>Title</summary><p>Text</p><details><summary>Nested</summary>Nested text</details></details>`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestFigureAndFigcaption(t *testing.T) {
+	input := `<figure class="image" onmouseover="alert(1)"><img src="https://example.org/image.png" alt="Image"><figcaption style="color: red">Caption <em>text</em></figcaption></figure>`
+	expected := `<figure><img src="https://example.org/image.png" alt="Image" loading="lazy"><figcaption>Caption <em>text</em></figcaption></figure>`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestMathML(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ALLOW_MATHML", "1")
+	defer os.Clearenv()
+	parseConfig(t)
+
+	input, err := ioutil.ReadFile("testdata/mathml.html")
+	if err != nil {
+		t.Fatalf(`Unable to read file: %v`, err)
+	}
+
+	expected, err := ioutil.ReadFile("testdata/mathml.html-result")
+	if err != nil {
+		t.Fatalf(`Unable to read file: %v`, err)
+	}
+
+	output := Sanitize("http://example.org/", string(input))
+	if output != string(expected) {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestMathMLWhenDisabled(t *testing.T) {
+	os.Clearenv()
+	parseConfig(t)
+
+	input, err := ioutil.ReadFile("testdata/mathml.html")
+	if err != nil {
+		t.Fatalf(`Unable to read file: %v`, err)
+	}
+
+	output := Sanitize("http://example.org/", string(input))
+	if strings.Contains(output, "<math") || strings.Contains(output, "<mi>") {
+		t.Errorf(`MathML elements should be removed by default, got %q`, output)
+	}
+
+	if !strings.Contains(output, "<p>The quadratic formula:</p>") {
+		t.Errorf(`The HTML content should be kept, got %q`, output)
+	}
+}
+
+func TestLazyLoadedImages(t *testing.T) {
+	scenarios := map[string]string{
+		`<img src="data:image/gif;base64,R0lGODlhAQABAAAAACw=" data-src="/image.jpg" alt="Image">`:         `<img src="http://example.org/image.jpg" alt="Image" loading="lazy">`,
+		`<img src="placeholder.gif" data-original="https://cdn.example.org/image.jpg">`:                    `<img src="https://cdn.example.org/image.jpg" loading="lazy">`,
+		`<img data-lazy-src="https://cdn.example.org/image.jpg">`:                                          `<img src="https://cdn.example.org/image.jpg" loading="lazy">`,
+		`<img src="/placeholder.gif" data-src="/image.jpg" data-srcset="/image.jpg 1x, /image@2x.jpg 2x">`: `<img src="http://example.org/image.jpg" srcset="http://example.org/image.jpg 1x, http://example.org/image@2x.jpg 2x" loading="lazy">`,
+		`<img src="/image.jpg" srcset="/small.jpg 480w, javascript:alert(1) 800w, /large.jpg 1080w">`:      `<img src="http://example.org/image.jpg" srcset="http://example.org/small.jpg 480w, http://example.org/large.jpg 1080w" loading="lazy">`,
+		`<img src="/image.jpg" srcset="/w_400,c_fill/img.jpg 400w, /w_800,c_fill/img.jpg 800w">`:           `<img src="http://example.org/image.jpg" srcset="http://example.org/w_400,c_fill/img.jpg 400w, http://example.org/w_800,c_fill/img.jpg 800w" loading="lazy">`,
+		`<img src="/image.jpg" data-src="">`:                                                               `<img src="http://example.org/image.jpg" loading="lazy">`,
+	}
+
+	for input, expected := range scenarios {
+		if output := Sanitize("http://example.org/", input); output != expected {
+			t.Errorf(`Wrong output for %q, got %q instead of %q`, input, output, expected)
+		}
+	}
+}
+
 func TestXmlEntities(t *testing.T) {
 	input := `<pre>echo "test" &gt; /etc/hosts</pre>`
 	expected := `<pre>echo &#34;test&#34; &gt; /etc/hosts</pre>`
@@ -392,6 +531,8 @@ func TestEspaceAttributes(t *testing.T) {
 }
 
 func TestReplaceYoutubeURL(t *testing.T) {
+	parseConfig(t)
+
 	input := `<iframe src="http://www.youtube.com/embed/test123?version=3&#038;rel=1&#038;fs=1&#038;autohide=2&#038;showsearch=0&#038;showinfo=1&#038;iv_load_policy=1&#038;wmode=transparent"></iframe>`
 	expected := `<iframe src="https://www.youtube-nocookie.com/embed/test123?version=3&amp;rel=1&amp;fs=1&amp;autohide=2&amp;showsearch=0&amp;showinfo=1&amp;iv_load_policy=1&amp;wmode=transparent" sandbox="allow-scripts allow-same-origin allow-popups" loading="lazy"></iframe>`
 	output := Sanitize("http://example.org/", input)
@@ -402,6 +543,8 @@ func TestReplaceYoutubeURL(t *testing.T) {
 }
 
 func TestReplaceSecureYoutubeURL(t *testing.T) {
+	parseConfig(t)
+
 	input := `<iframe src="https://www.youtube.com/embed/test123"></iframe>`
 	expected := `<iframe src="https://www.youtube-nocookie.com/embed/test123" sandbox="allow-scripts allow-same-origin allow-popups" loading="lazy"></iframe>`
 	output := Sanitize("http://example.org/", input)
@@ -412,6 +555,8 @@ func TestReplaceSecureYoutubeURL(t *testing.T) {
 }
 
 func TestReplaceSecureYoutubeURLWithParameters(t *testing.T) {
+	parseConfig(t)
+
 	input := `<iframe src="https://www.youtube.com/embed/test123?rel=0&amp;controls=0"></iframe>`
 	expected := `<iframe src="https://www.youtube-nocookie.com/embed/test123?rel=0&amp;controls=0" sandbox="allow-scripts allow-same-origin allow-popups" loading="lazy"></iframe>`
 	output := Sanitize("http://example.org/", input)
@@ -432,6 +577,8 @@ func TestReplaceYoutubeURLAlreadyReplaced(t *testing.T) {
 }
 
 func TestReplaceProtocolRelativeYoutubeURL(t *testing.T) {
+	parseConfig(t)
+
 	input := `<iframe src="//www.youtube.com/embed/Bf2W84jrGqs" width="560" height="314" allowfullscreen="allowfullscreen"></iframe>`
 	expected := `<iframe src="https://www.youtube-nocookie.com/embed/Bf2W84jrGqs" width="560" height="314" allowfullscreen="allowfullscreen" sandbox="allow-scripts allow-same-origin allow-popups" loading="lazy"></iframe>`
 	output := Sanitize("http://example.org/", input)
@@ -441,6 +588,61 @@ func TestReplaceProtocolRelativeYoutubeURL(t *testing.T) {
 	}
 }
 
+func TestReplaceYoutubeURLWithCustomEmbedURL(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("YOUTUBE_EMBED_URL", "https://www.youtube.com/embed/")
+	defer os.Clearenv()
+	parseConfig(t)
+
+	input := `<iframe src="//www.youtube.com/embed/Bf2W84jrGqs"></iframe>`
+	expected := `<iframe src="https://www.youtube.com/embed/Bf2W84jrGqs" sandbox="allow-scripts allow-same-origin allow-popups" loading="lazy"></iframe>`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestCustomYoutubeEmbedURLIframe(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("YOUTUBE_EMBED_URL", "https://yt.example.com")
+	defer os.Clearenv()
+	parseConfig(t)
+
+	input := `<iframe src="https://yt.example.com/embed/Bf2W84jrGqs"></iframe>`
+	expected := `<iframe src="https://yt.example.com/embed/Bf2W84jrGqs" sandbox="allow-scripts allow-same-origin allow-popups" loading="lazy"></iframe>`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+
+	input = `<iframe src="https://yt.example.com.evil.org/embed/Bf2W84jrGqs"></iframe>`
+	if output := Sanitize("http://example.org/", input); output != "" {
+		t.Errorf(`Another domain starting with the embed URL should not be allowed, got %q`, output)
+	}
+}
+
+func TestYoutubeFrontendIframe(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("YOUTUBE_FRONTEND_URL", "https://invidious.example.org")
+	defer os.Clearenv()
+	parseConfig(t)
+
+	input := `<iframe src="https://invidious.example.org/embed/Bf2W84jrGqs"></iframe>`
+	expected := `<iframe src="https://invidious.example.org/embed/Bf2W84jrGqs" sandbox="allow-scripts allow-same-origin allow-popups" loading="lazy"></iframe>`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+
+	input = `<iframe src="https://invidious.example.org.example.com/embed/Bf2W84jrGqs"></iframe>`
+	if output := Sanitize("http://example.org/", input); output != "" {
+		t.Errorf(`Another domain starting with the instance URL should not be allowed, got %q`, output)
+	}
+}
+
 func TestReplaceIframeURL(t *testing.T) {
 	input := `<iframe src="https://player.vimeo.com/video/123456?title=0&amp;byline=0"></iframe>`
 	expected := `<iframe src="https://player.vimeo.com/video/123456?title=0&amp;byline=0" sandbox="allow-scripts allow-same-origin allow-popups" loading="lazy"></iframe>`
@@ -480,3 +682,95 @@ func TestReplaceStyle(t *testing.T) {
 		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
 	}
 }
+
+func TestExtraAllowedTags(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SANITIZER_EXTRA_ALLOWED_TAGS", "mark; span:lang, DIR ;p:class")
+	defer os.Clearenv()
+	parseConfig(t)
+
+	input := `<p class="note" id="intro">Some <mark>highlighted</mark> <span lang="fr" dir="ltr" title="Text">texte</span> and <font color="red">colored</font> text.</p>`
+	expected := `<p class="note">Some <mark>highlighted</mark> <span lang="fr" dir="ltr">texte</span> and colored text.</p>`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestExtraAllowedTagsWhenDisabled(t *testing.T) {
+	os.Clearenv()
+	parseConfig(t)
+
+	input := `<p>Some <mark>highlighted</mark> <span lang="fr">texte</span>.</p>`
+	expected := `<p>Some highlighted texte.</p>`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestExtraAllowedTagsCannotAllowDangerousContent(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SANITIZER_EXTRA_ALLOWED_TAGS", "script;style;form:action;object:data;span:onclick,style,lang;a:onmouseover,xlink:href")
+	defer os.Clearenv()
+	parseConfig(t)
+
+	input := `<script>alert(1)</script><style>p{}</style><form action="/login"><span onclick="alert(1)" style="color: red" lang="en">Text</span></form><object data="file.swf"></object><a href="https://example.org/" onmouseover="alert(1)">Link</a>`
+	expected := `<span lang="en">Text</span><a href="https://example.org/" rel="noopener noreferrer" target="_blank" referrerpolicy="no-referrer">Link</a>`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestExtraAllowedAttributesAreSanitized(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SANITIZER_EXTRA_ALLOWED_TAGS", "track:src,kind")
+	defer os.Clearenv()
+	parseConfig(t)
+
+	input := `<video src="https://example.org/video.mp4"><track src="javascript:alert(1)" kind="captions"><track src="/captions.vtt" kind="captions"></video>`
+	expected := `<video src="https://example.org/video.mp4" controls><track kind="captions"><track src="http://example.org/captions.vtt" kind="captions"></video>`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestDirectionAttribute(t *testing.T) {
+	input := `<p dir="rtl">مرحبا</p><blockquote dir="LTR">Quote</blockquote><ul dir="auto"><li dir="rtl">Item</li></ul><p dir="javascript:alert(1)">Text</p><span dir="rtl">Text</span>`
+	expected := `<p dir="rtl">مرحبا</p><blockquote dir="ltr">Quote</blockquote><ul dir="auto"><li dir="rtl">Item</li></ul><p>Text</p>Text`
+	output := Sanitize("http://example.org/", input)
+
+	if expected != output {
+		t.Errorf(`Wrong output: "%s" != "%s"`, expected, output)
+	}
+}
+
+func TestExtraAllowedTagsAreParsedOnce(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SANITIZER_EXTRA_ALLOWED_TAGS", "mark")
+	defer os.Clearenv()
+	parseConfig(t)
+
+	whitelist := getExtraTagWhitelist()
+	if _, found := whitelist["mark"]; !found {
+		t.Fatalf(`The extra tags should be allowed, got %v`, whitelist)
+	}
+
+	whitelist["mark"] = []string{"cached"}
+	if attributes := getExtraTagWhitelist()["mark"]; len(attributes) != 1 || attributes[0] != "cached" {
+		t.Errorf(`The extra tags should not be parsed again, got %v`, attributes)
+	}
+
+	os.Setenv("SANITIZER_EXTRA_ALLOWED_TAGS", "span:lang")
+	parseConfig(t)
+
+	if _, found := getExtraTagWhitelist()["mark"]; found {
+		t.Error(`The extra tags should be parsed again when the configuration changes`)
+	}
+}
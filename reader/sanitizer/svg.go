@@ -0,0 +1,195 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sanitizer // import "miniflux.app/reader/sanitizer"
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var (
+	// unsafeCSSRegex matches the style rules loading external resources or executing code.
+	unsafeCSSRegex = regexp.MustCompile(`(?i)@import|expression\s*\(|url\s*\(\s*['"]?\s*[^#'"\s)]`)
+
+	svgTextEscaper      = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	svgAttributeEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+)
+
+// svgAllowedElements contains the SVG elements kept by SanitizeSVG, scripts, animations and foreign objects are not allowed.
+var svgAllowedElements = map[string]bool{
+	"svg":                 true,
+	"g":                   true,
+	"defs":                true,
+	"title":               true,
+	"desc":                true,
+	"symbol":              true,
+	"use":                 true,
+	"style":               true,
+	"path":                true,
+	"rect":                true,
+	"circle":              true,
+	"ellipse":             true,
+	"line":                true,
+	"polyline":            true,
+	"polygon":             true,
+	"text":                true,
+	"tspan":               true,
+	"textPath":            true,
+	"image":               true,
+	"linearGradient":      true,
+	"radialGradient":      true,
+	"stop":                true,
+	"clipPath":            true,
+	"mask":                true,
+	"pattern":             true,
+	"marker":              true,
+	"filter":              true,
+	"feBlend":             true,
+	"feColorMatrix":       true,
+	"feComponentTransfer": true,
+	"feComposite":         true,
+	"feDropShadow":        true,
+	"feFlood":             true,
+	"feFuncA":             true,
+	"feFuncB":             true,
+	"feFuncG":             true,
+	"feFuncR":             true,
+	"feGaussianBlur":      true,
+	"feMerge":             true,
+	"feMergeNode":         true,
+	"feMorphology":        true,
+	"feOffset":            true,
+}
+
+// svgDrawableElements contains the elements producing something visible.
+var svgDrawableElements = map[string]bool{
+	"path":     true,
+	"rect":     true,
+	"circle":   true,
+	"ellipse":  true,
+	"line":     true,
+	"polyline": true,
+	"polygon":  true,
+	"text":     true,
+	"use":      true,
+	"image":    true,
+}
+
+// SanitizeSVG returns the SVG image without scripts, event handlers, animations, foreign objects and external references.
+//
+// Unknown elements are removed with their content, comments, processing instructions and directives are removed as well.
+// An error is returned when the document is not a valid SVG image or when nothing remains to draw.
+func SanitizeSVG(input []byte) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(input))
+
+	var buffer bytes.Buffer
+	var elements []string
+	removedDepth := 0
+	drawableCount := 0
+
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("sanitizer: invalid SVG image: %v", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := svgQualifiedName(t.Name)
+			if len(elements) == 0 && name != "svg" {
+				return nil, fmt.Errorf("sanitizer: unexpected root element %q", name)
+			}
+
+			elements = append(elements, name)
+			if removedDepth > 0 || !svgAllowedElements[name] {
+				removedDepth++
+				continue
+			}
+
+			if svgDrawableElements[name] {
+				drawableCount++
+			}
+
+			buffer.WriteString("<" + name)
+			for _, attribute := range t.Attr {
+				if isValidSVGAttribute(name, attribute) {
+					buffer.WriteString(" " + svgQualifiedName(attribute.Name) + `="` + svgAttributeEscaper.Replace(attribute.Value) + `"`)
+				}
+			}
+			buffer.WriteString(">")
+		case xml.EndElement:
+			name := svgQualifiedName(t.Name)
+			if len(elements) == 0 || elements[len(elements)-1] != name {
+				return nil, fmt.Errorf("sanitizer: unexpected closing element %q", name)
+			}
+
+			elements = elements[:len(elements)-1]
+			if removedDepth > 0 {
+				removedDepth--
+				continue
+			}
+
+			buffer.WriteString("</" + name + ">")
+		case xml.CharData:
+			if removedDepth > 0 || len(elements) == 0 {
+				continue
+			}
+
+			if elements[len(elements)-1] == "style" && unsafeCSSRegex.Match(t) {
+				continue
+			}
+
+			buffer.WriteString(svgTextEscaper.Replace(string(t)))
+		}
+	}
+
+	if len(elements) > 0 {
+		return nil, errors.New("sanitizer: unexpected end of SVG image")
+	}
+
+	if drawableCount == 0 {
+		return nil, errors.New("sanitizer: nothing to draw in SVG image")
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func isValidSVGAttribute(element string, attribute xml.Attr) bool {
+	name := svgQualifiedName(attribute.Name)
+	value := strings.ToLower(strings.TrimSpace(attribute.Value))
+
+	switch {
+	case strings.HasPrefix(strings.ToLower(name), "on"):
+		return false
+	case name == "href" || name == "xlink:href":
+		// Only internal references and embedded raster images are allowed.
+		if strings.HasPrefix(value, "#") {
+			return true
+		}
+		return element == "image" && strings.HasPrefix(value, "data:image/") && !strings.HasPrefix(value, "data:image/svg")
+	case name == "xmlns" || name == "xmlns:xlink" || name == "xml:space":
+		return true
+	case attribute.Name.Space != "":
+		return false
+	}
+
+	return !strings.Contains(value, "javascript:") && !unsafeCSSRegex.MatchString(attribute.Value)
+}
+
+func svgQualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
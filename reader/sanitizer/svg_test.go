@@ -0,0 +1,88 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package sanitizer // import "miniflux.app/reader/sanitizer"
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestSanitizeSVGFixtures(t *testing.T) {
+	for _, filename := range []string{"icon.svg", "malicious.svg"} {
+		input, err := ioutil.ReadFile("testdata/" + filename)
+		if err != nil {
+			t.Fatalf(`Unable to read file: %v`, err)
+		}
+
+		expected, err := ioutil.ReadFile("testdata/" + filename + "-result")
+		if err != nil {
+			t.Fatalf(`Unable to read file: %v`, err)
+		}
+
+		output, err := SanitizeSVG(input)
+		if err != nil {
+			t.Fatalf(`Unable to sanitize %s: %v`, filename, err)
+		}
+
+		if string(output) != string(expected) {
+			t.Errorf(`Wrong output for %s: "%s" != "%s"`, filename, expected, output)
+		}
+	}
+}
+
+func TestSanitizeSVGRemovesUnsafeContent(t *testing.T) {
+	input, err := ioutil.ReadFile("testdata/malicious.svg")
+	if err != nil {
+		t.Fatalf(`Unable to read file: %v`, err)
+	}
+
+	output, err := SanitizeSVG(input)
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	for _, fragment := range []string{"script", "javascript", "onload", "onclick", "foreignObject", "@import", "https://example.org", "data:image/svg", "<set", "<animate"} {
+		if strings.Contains(string(output), fragment) {
+			t.Errorf(`The fragment %q should be removed, got %q`, fragment, output)
+		}
+	}
+}
+
+func TestSanitizeSVGWithScriptOnly(t *testing.T) {
+	input := `<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`
+	if _, err := SanitizeSVG([]byte(input)); err == nil {
+		t.Error(`An SVG image without drawable elements should return an error`)
+	}
+}
+
+func TestSanitizeSVGWithInvalidRootElement(t *testing.T) {
+	input := `<html><svg><rect width="1" height="1"/></svg></html>`
+	if _, err := SanitizeSVG([]byte(input)); err == nil {
+		t.Error(`A document without SVG root element should return an error`)
+	}
+}
+
+func TestSanitizeSVGWithInvalidXML(t *testing.T) {
+	for _, input := range []string{`<svg><rect></svg>`, `<svg><rect/>`, `not an image`} {
+		if _, err := SanitizeSVG([]byte(input)); err == nil {
+			t.Errorf(`The input %q should return an error`, input)
+		}
+	}
+}
+
+func TestSanitizeSVGEscapesText(t *testing.T) {
+	input := `<svg><text x="1" title="a &quot;b&quot;">1 &lt; 2 &amp; 3</text></svg>`
+	expected := `<svg><text x="1" title="a &quot;b&quot;">1 &lt; 2 &amp; 3</text></svg>`
+
+	output, err := SanitizeSVG([]byte(input))
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if string(output) != expected {
+		t.Errorf(`Wrong output: %q != %q`, expected, output)
+	}
+}
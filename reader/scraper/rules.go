@@ -4,6 +4,14 @@
 
 package scraper // import "miniflux.app/reader/scraper"
 
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
 // List of predefined scraper rules (alphabetically sorted)
 // domain => CSS selectors
 var predefinedRules = map[string]string{
@@ -47,3 +55,60 @@ var predefinedRules = map[string]string{
 	"zdnet.com":           "div.storyBody",
 	"openingsource.org":   "article.suxing-popup-gallery",
 }
+
+// LoadRules reads additional scraper rules from a file and merges them with the predefined rules.
+//
+// Each line contains a domain and its CSS selectors separated by an equal sign,
+// empty lines and lines starting with "#" are ignored.
+// A rule defined in the file replaces the predefined rule of the same domain.
+func LoadRules(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return fmt.Errorf("scraper: unable to open rules file: %v", err)
+	}
+	defer f.Close()
+
+	rules, err := parseRules(f)
+	if err != nil {
+		return err
+	}
+
+	for domain, selectors := range rules {
+		predefinedRules[domain] = selectors
+	}
+
+	return nil
+}
+
+func parseRules(r io.Reader) (map[string]string, error) {
+	rules := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.SplitN(line, "=", 2)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("scraper: invalid rule on line %d", lineNumber)
+		}
+
+		domain := strings.ToLower(strings.TrimSpace(fields[0]))
+		selectors := strings.TrimSpace(fields[1])
+		if domain == "" || selectors == "" {
+			return nil, fmt.Errorf("scraper: invalid rule on line %d", lineNumber)
+		}
+
+		rules[domain] = selectors
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scraper: unable to read rules file: %v", err)
+	}
+
+	return rules, nil
+}
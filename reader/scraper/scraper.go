@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"strings"
 
 	"miniflux.app/http/client"
@@ -25,6 +26,11 @@ func Fetch(websiteURL, rules, userAgent string) (string, error) {
 		clt.WithUserAgent(userAgent)
 	}
 
+	return FetchWithClient(clt, rules)
+}
+
+// FetchWithClient downloads a web page with the given HTTP client and returns relevant contents.
+func FetchWithClient(clt *client.Client, rules string) (string, error) {
 	response, err := clt.Get()
 	if err != nil {
 		return "", err
@@ -43,7 +49,7 @@ func Fetch(websiteURL, rules, userAgent string) (string, error) {
 	}
 
 	// The entry URL could redirect somewhere else.
-	websiteURL = response.EffectiveURL
+	websiteURL := response.EffectiveURL
 
 	if rules == "" {
 		rules = getPredefinedScraperRules(websiteURL)
@@ -82,16 +88,23 @@ func scrapContent(page io.Reader, rules string) (string, error) {
 	return contents, nil
 }
 
+// getPredefinedScraperRules returns the rules of the most specific domain matching the website hostname.
 func getPredefinedScraperRules(websiteURL string) string {
-	urlDomain := url.Domain(websiteURL)
+	hostname := strings.ToLower(url.Domain(websiteURL))
+	if host, _, err := net.SplitHostPort(hostname); err == nil {
+		hostname = host
+	}
 
-	for domain, rules := range predefinedRules {
-		if strings.Contains(urlDomain, domain) {
-			return rules
+	matchedDomain := ""
+	for domain := range predefinedRules {
+		if hostname == domain || strings.HasSuffix(hostname, "."+domain) {
+			if len(domain) > len(matchedDomain) {
+				matchedDomain = domain
+			}
 		}
 	}
 
-	return ""
+	return predefinedRules[matchedDomain]
 }
 
 func isWhitelistedContentType(contentType string) bool {
@@ -7,6 +7,7 @@ package scraper // import "miniflux.app/reader/scraper"
 import (
 	"bytes"
 	"io/ioutil"
+	"os"
 	"strings"
 	"testing"
 )
@@ -25,6 +26,95 @@ func TestGetPredefinedRules(t *testing.T) {
 	}
 }
 
+func TestGetPredefinedRulesWithSubdomains(t *testing.T) {
+	if getPredefinedScraperRules("https://blog.example.org/article") != "" {
+		t.Fatal("A rule not defined should not return anything")
+	}
+
+	if getPredefinedScraperRules("https://www.news.bbc.co.uk:443/article") != predefinedRules["bbc.co.uk"] {
+		t.Error("Subdomains should use the rule of their parent domain")
+	}
+
+	if getPredefinedScraperRules("https://notphoronix.com/") != "" {
+		t.Error("A domain should not match another domain with the same suffix")
+	}
+}
+
+func TestGetPredefinedRulesWithMostSpecificDomain(t *testing.T) {
+	predefinedRules["blog.github.com"] = "div.post"
+	defer delete(predefinedRules, "blog.github.com")
+
+	if result := getPredefinedScraperRules("https://blog.github.com/2020-01-01-post/"); result != "div.post" {
+		t.Errorf(`Unexpected rules for blog.github.com, got %q instead of "div.post"`, result)
+	}
+
+	if result := getPredefinedScraperRules("https://github.com/"); result != predefinedRules["github.com"] {
+		t.Errorf(`Unexpected rules for github.com, got %q`, result)
+	}
+}
+
+func TestParseRules(t *testing.T) {
+	data := `# Community rules
+example.org = article.content
+
+News.Example.com=div[itemprop=articleBody]
+`
+
+	rules, err := parseRules(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if len(rules) != 2 {
+		t.Fatalf(`Unexpected number of rules, got %d instead of 2`, len(rules))
+	}
+
+	if rules["example.org"] != "article.content" {
+		t.Errorf(`Unexpected rule for example.org, got %q`, rules["example.org"])
+	}
+
+	if rules["news.example.com"] != "div[itemprop=articleBody]" {
+		t.Errorf(`Unexpected rule for news.example.com, got %q`, rules["news.example.com"])
+	}
+}
+
+func TestParseInvalidRules(t *testing.T) {
+	for _, data := range []string{"example.org", "example.org=", "=article"} {
+		if _, err := parseRules(strings.NewReader(data)); err == nil {
+			t.Errorf(`Parsing %q should fail`, data)
+		}
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	f, err := ioutil.TempFile("", "scraper_rules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+
+	f.WriteString("example.org=article.content\nphoronix.com=div.article\n")
+	f.Close()
+
+	originalRule := predefinedRules["phoronix.com"]
+	defer func() {
+		predefinedRules["phoronix.com"] = originalRule
+		delete(predefinedRules, "example.org")
+	}()
+
+	if err := LoadRules(f.Name()); err != nil {
+		t.Fatalf(`Unable to load rules: %v`, err)
+	}
+
+	if result := getPredefinedScraperRules("https://www.example.org/"); result != "article.content" {
+		t.Errorf(`Unexpected rules for example.org, got %q`, result)
+	}
+
+	if result := getPredefinedScraperRules("https://www.phoronix.com/"); result != "div.article" {
+		t.Errorf(`The rules file should override predefined rules, got %q`, result)
+	}
+}
+
 func TestWhitelistedContentTypes(t *testing.T) {
 	scenarios := map[string]bool{
 		"text/html":                            true,
@@ -0,0 +1,88 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package srcset // import "miniflux.app/reader/srcset"
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var descriptorRegex = regexp.MustCompile(`^(\d+w|\d+(\.\d+)?x|\d+h)$`)
+
+// Candidate is an image candidate of a srcset attribute: an URL and an optional descriptor like "2x" or "480w".
+type Candidate struct {
+	URL        string
+	Descriptor string
+}
+
+// Candidates are the image candidates of a srcset attribute.
+type Candidates []*Candidate
+
+// String returns the srcset attribute of the image candidates.
+func (s Candidates) String() string {
+	var parts []string
+	for _, candidate := range s {
+		if candidate.Descriptor == "" {
+			parts = append(parts, candidate.URL)
+		} else {
+			parts = append(parts, candidate.URL+" "+candidate.Descriptor)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Parse returns the image candidates of a srcset attribute.
+// A comma separates two candidates only after a whitespace or at the end of an URL, so the URLs may contain commas.
+// An error is returned when a candidate is malformed.
+func Parse(srcset string) (Candidates, error) {
+	var candidates Candidates
+	input := srcset
+
+	for {
+		input = strings.TrimLeft(input, " \t\n\r\f,")
+		if input == "" {
+			break
+		}
+
+		end := strings.IndexAny(input, " \t\n\r\f")
+		if end == -1 {
+			end = len(input)
+		}
+
+		candidate := &Candidate{URL: input[:end]}
+		input = input[end:]
+
+		// An URL followed by a comma does not have any descriptor.
+		if strings.HasSuffix(candidate.URL, ",") {
+			candidate.URL = strings.TrimRight(candidate.URL, ",")
+		} else {
+			end = strings.Index(input, ",")
+			if end == -1 {
+				end = len(input)
+			}
+
+			candidate.Descriptor = strings.TrimSpace(input[:end])
+			input = input[end:]
+		}
+
+		if candidate.URL == "" {
+			return nil, errors.New("srcset: empty image URL")
+		}
+
+		if candidate.Descriptor != "" && !descriptorRegex.MatchString(candidate.Descriptor) {
+			return nil, errors.New("srcset: invalid descriptor " + candidate.Descriptor)
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	if len(candidates) == 0 {
+		return nil, errors.New("srcset: no image candidate")
+	}
+
+	return candidates, nil
+}
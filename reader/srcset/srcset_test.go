@@ -0,0 +1,58 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package srcset // import "miniflux.app/reader/srcset"
+
+import "testing"
+
+func TestParseSrcset(t *testing.T) {
+	scenarios := map[string]string{
+		`https://example.org/image.png`:                                                                                        `https://example.org/image.png`,
+		`https://example.org/image.png 1x, https://example.org/image@2x.png 2x`:                                                `https://example.org/image.png 1x, https://example.org/image@2x.png 2x`,
+		`https://example.org/small.png 480w,https://example.org/large.png 1080w`:                                               `https://example.org/small.png 480w, https://example.org/large.png 1080w`,
+		"  https://example.org/image.png   1.5x ,\n https://example.org/b.png 2x ":                                             `https://example.org/image.png 1.5x, https://example.org/b.png 2x`,
+		`https://example.org/image.png, https://example.org/image@2x.png 2x`:                                                   `https://example.org/image.png, https://example.org/image@2x.png 2x`,
+		`https://example.org/image,w_480.png 480w`:                                                                             `https://example.org/image,w_480.png 480w`,
+		`https://res.cloudinary.com/demo/w_400,c_fill/img.jpg 400w, https://res.cloudinary.com/demo/w_800,c_fill/img.jpg 800w`: `https://res.cloudinary.com/demo/w_400,c_fill/img.jpg 400w, https://res.cloudinary.com/demo/w_800,c_fill/img.jpg 800w`,
+	}
+
+	for input, expected := range scenarios {
+		candidates, err := Parse(input)
+		if err != nil {
+			t.Errorf(`Unable to parse %q: %v`, input, err)
+			continue
+		}
+
+		if result := candidates.String(); result != expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
+
+func TestParseDescriptors(t *testing.T) {
+	candidates, err := Parse(`https://example.org/a.png 480w, https://example.org/b.png 2x`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(candidates) != 2 {
+		t.Fatalf(`Unexpected number of candidates, got %d`, len(candidates))
+	}
+
+	if candidates[0].URL != "https://example.org/a.png" || candidates[0].Descriptor != "480w" {
+		t.Errorf(`Unexpected first candidate, got %+v`, candidates[0])
+	}
+
+	if candidates[1].URL != "https://example.org/b.png" || candidates[1].Descriptor != "2x" {
+		t.Errorf(`Unexpected second candidate, got %+v`, candidates[1])
+	}
+}
+
+func TestParseMalformedSrcset(t *testing.T) {
+	for _, input := range []string{``, ` , `, `https://example.org/image.png large`, `https://example.org/image.png 2x 480w`} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf(`A malformed srcset should return an error: %q`, input)
+		}
+	}
+}
@@ -26,13 +26,14 @@ var (
 )
 
 // FindSubscriptions downloads and try to find one or more subscriptions from an URL.
-func FindSubscriptions(websiteURL, userAgent, username, password string) (Subscriptions, *errors.LocalizedError) {
+func FindSubscriptions(websiteURL, userAgent, cookie, username, password string) (Subscriptions, *errors.LocalizedError) {
 	websiteURL = findYoutubeChannelFeed(websiteURL)
 	websiteURL = parseYoutubeVideoPage(websiteURL)
 
 	request := client.New(websiteURL)
 	request.WithCredentials(username, password)
-	request.WithUserAgent(userAgent)
+	request.WithUserAgent(client.ExpandUserAgent(userAgent, ""))
+	request.WithCookie(cookie)
 	response, err := browser.Exec(request)
 	if err != nil {
 		return nil, err
@@ -55,7 +56,12 @@ func FindSubscriptions(websiteURL, userAgent, username, password string) (Subscr
 		return subscriptions, err
 	}
 
-	return tryWellKnownUrls(websiteURL, userAgent, username, password)
+	return tryWellKnownUrls(websiteURL, userAgent, cookie, username, password)
+}
+
+// FindSubscriptionsInWebPage returns the feeds advertised by an already downloaded HTML page.
+func FindSubscriptionsInWebPage(websiteURL, body string) (Subscriptions, *errors.LocalizedError) {
+	return parseWebPage(websiteURL, strings.NewReader(body))
 }
 
 func parseWebPage(websiteURL string, data io.Reader) (Subscriptions, *errors.LocalizedError) {
@@ -131,7 +137,7 @@ func parseYoutubeVideoPage(websiteURL string) string {
 	return websiteURL
 }
 
-func tryWellKnownUrls(websiteURL, userAgent, username, password string) (Subscriptions, *errors.LocalizedError) {
+func tryWellKnownUrls(websiteURL, userAgent, cookie, username, password string) (Subscriptions, *errors.LocalizedError) {
 	var subscriptions Subscriptions
 	knownURLs := map[string]string{
 		"/atom.xml": "atom",
@@ -152,7 +158,8 @@ func tryWellKnownUrls(websiteURL, userAgent, username, password string) (Subscri
 		}
 		request := client.New(fullURL)
 		request.WithCredentials(username, password)
-		request.WithUserAgent(userAgent)
+		request.WithUserAgent(client.ExpandUserAgent(userAgent, ""))
+		request.WithCookie(cookie)
 		response, err := request.Get()
 		if err != nil {
 			continue
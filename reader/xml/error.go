@@ -0,0 +1,89 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package xml // import "miniflux.app/reader/xml"
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxSnippetLength is the number of bytes kept on each side of the error position.
+const maxSnippetLength = 40
+
+// DecodeError wraps a decoding error with its position in the document.
+type DecodeError struct {
+	Err     error
+	Line    int
+	Column  int
+	Snippet string
+}
+
+func (e *DecodeError) Error() string {
+	if e.Snippet == "" {
+		return fmt.Sprintf("%v (line %d, column %d)", e.Err, e.Line, e.Column)
+	}
+
+	return fmt.Sprintf("%v (line %d, column %d, near: %s)", e.Err, e.Line, e.Column, e.Snippet)
+}
+
+// NewDecodeError returns the error with the line, the column and a short excerpt of the data around the given byte offset.
+func NewDecodeError(data []byte, offset int64, err error) *DecodeError {
+	if offset < 0 {
+		offset = 0
+	}
+
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+
+	before := data[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := utf8.RuneCount(before[bytes.LastIndexByte(before, '\n')+1:]) + 1
+
+	return &DecodeError{Err: err, Line: line, Column: column, Snippet: snippet(data, int(offset))}
+}
+
+func snippet(data []byte, offset int) string {
+	start := offset - maxSnippetLength
+	if start < 0 {
+		start = 0
+	}
+
+	end := offset + maxSnippetLength
+	if end > len(data) {
+		end = len(data)
+	}
+
+	// Do not cut multi-byte characters.
+	for start > 0 && !utf8.RuneStart(data[start]) {
+		start--
+	}
+
+	for end < len(data) && !utf8.RuneStart(data[end]) {
+		end++
+	}
+
+	return strings.Join(strings.Fields(string(data[start:end])), " ")
+}
+
+// Decode decodes the whole document into v and reports the position of the failure when the document is invalid.
+func Decode(data io.Reader, v interface{}) error {
+	buffer, err := ioutil.ReadAll(data)
+	if err != nil {
+		return err
+	}
+
+	decoder := NewDecoder(bytes.NewReader(buffer))
+	if err := decoder.Decode(v); err != nil {
+		// The offset is relative to the data read by the decoder, it is approximate when the document is converted from another charset.
+		return NewDecodeError(buffer, decoder.InputOffset(), err)
+	}
+
+	return nil
+}
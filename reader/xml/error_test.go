@@ -0,0 +1,80 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package xml // import "miniflux.app/reader/xml"
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewDecodeError(t *testing.T) {
+	data := []byte("line 1\nline 2\nsome été content")
+	err := NewDecodeError(data, int64(strings.Index(string(data), "content")), errors.New("some error"))
+
+	if err.Line != 3 {
+		t.Errorf(`Unexpected line, got %d instead of 3`, err.Line)
+	}
+
+	if err.Column != 10 {
+		t.Errorf(`Unexpected column, got %d instead of 10`, err.Column)
+	}
+
+	expected := `some error (line 3, column 10, near: line 1 line 2 some été content)`
+	if err.Error() != expected {
+		t.Errorf(`Unexpected error message, got %q instead of %q`, err.Error(), expected)
+	}
+}
+
+func TestNewDecodeErrorWithLongDocument(t *testing.T) {
+	data := []byte(strings.Repeat("é", 500) + "<invalid>" + strings.Repeat("a", 500))
+	err := NewDecodeError(data, int64(strings.Index(string(data), "<invalid>")), errors.New("some error"))
+
+	if len(err.Snippet) > 2*maxSnippetLength+4 {
+		t.Errorf(`The snippet should be truncated, got %d bytes`, len(err.Snippet))
+	}
+
+	if !strings.Contains(err.Snippet, "<invalid>") {
+		t.Errorf(`The snippet should contain the error position, got %q`, err.Snippet)
+	}
+
+	if !strings.HasPrefix(err.Snippet, "é") {
+		t.Errorf(`The snippet should not cut multi-byte characters, got %q`, err.Snippet)
+	}
+}
+
+func TestNewDecodeErrorWithOutOfRangeOffset(t *testing.T) {
+	err := NewDecodeError([]byte("abc"), 10, errors.New("some error"))
+	if err.Line != 1 || err.Column != 4 {
+		t.Errorf(`Unexpected position, got line %d and column %d`, err.Line, err.Column)
+	}
+}
+
+func TestDecodeWithInvalidDocument(t *testing.T) {
+	type myxml struct {
+		Title string `xml:"title"`
+	}
+
+	data := "<?xml version=\"1.0\"?>\n<rss>\n<title>Title</title>\n<item></ item></rss>"
+
+	var x myxml
+	err := Decode(strings.NewReader(data), &x)
+	if err == nil {
+		t.Fatal(`Decoding an invalid document should fail`)
+	}
+
+	decodeErr, ok := err.(*DecodeError)
+	if !ok {
+		t.Fatalf(`Unexpected error type: %T`, err)
+	}
+
+	if decodeErr.Line != 4 {
+		t.Errorf(`Unexpected line, got %d instead of 4`, decodeErr.Line)
+	}
+
+	if !strings.Contains(decodeErr.Snippet, "</ item>") {
+		t.Errorf(`The snippet should contain the invalid tag, got %q`, decodeErr.Snippet)
+	}
+}
@@ -17,10 +17,12 @@ import (
 	"miniflux.app/config"
 	"miniflux.app/fever"
 	"miniflux.app/logger"
+	"miniflux.app/metric"
 	"miniflux.app/reader/feed"
 	"miniflux.app/storage"
 	"miniflux.app/ui"
 	"miniflux.app/version"
+	"miniflux.app/websub"
 	"miniflux.app/worker"
 
 	"github.com/gorilla/mux"
@@ -172,6 +174,7 @@ func setupHandler(store *storage.Storage, feedHandler *feed.Handler, pool *worke
 	router.Use(middleware)
 
 	fever.Serve(router, store)
+	websub.Serve(router, store, feedHandler, config.Opts.WorkerPoolSize())
 	api.Serve(router, store, pool, feedHandler)
 	ui.Serve(router, store, pool, feedHandler)
 
@@ -182,5 +185,9 @@ func setupHandler(store *storage.Storage, feedHandler *feed.Handler, pool *worke
 		w.Write([]byte(version.Version))
 	}).Name("version")
 
+	if config.Opts.HasMetricsCollector() {
+		router.Handle("/metrics", metric.Handler(metric.DefaultRegistry, config.Opts.MetricsUsername(), config.Opts.MetricsPassword())).Name("metrics").Methods(http.MethodGet)
+	}
+
 	return router
 }
@@ -9,10 +9,19 @@ import (
 
 	"miniflux.app/config"
 	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/opml"
 	"miniflux.app/storage"
 	"miniflux.app/worker"
 )
 
+// importPollingInterval is the delay between two checks for new import jobs.
+const importPollingInterval = 10 * time.Second
+
+// backfillBatchSize is the number of entries updated per transaction by the URL hash backfill.
+// It is independent of the refresh batch size: the backfill runs once over the whole entries table.
+const backfillBatchSize = 1000
+
 // Serve starts the internal scheduler.
 func Serve(store *storage.Storage, pool *worker.Pool) {
 	logger.Info(`Starting scheduler...`)
@@ -28,8 +37,36 @@ func Serve(store *storage.Storage, pool *worker.Pool) {
 		store,
 		config.Opts.CleanupFrequencyHours(),
 		config.Opts.CleanupArchiveReadDays(),
+		config.Opts.CleanupMarkReadDays(),
 		config.Opts.CleanupRemoveSessionsDays(),
 	)
+
+	go importScheduler(store, pool, config.Opts.ImportRateLimit())
+
+	go backfillEntryURLHashes(store, backfillBatchSize)
+}
+
+// backfillEntryURLHashes computes the URL hash of the entries stored before the deduplication across feeds existed.
+// The entries are updated batch by batch and the task stops once all the entries have a hash.
+func backfillEntryURLHashes(store *storage.Storage, batchSize int) {
+	total := 0
+	for {
+		count, err := store.BackfillEntryURLHashes(batchSize)
+		if err != nil {
+			logger.Error("[Scheduler:Backfill] %v", err)
+			return
+		}
+
+		if count == 0 {
+			break
+		}
+
+		total += count
+	}
+
+	if total > 0 {
+		logger.Info("[Scheduler:Backfill] Computed the URL hash of %d entries", total)
+	}
 }
 
 func feedScheduler(store *storage.Storage, pool *worker.Pool, frequency, batchSize int) {
@@ -45,7 +82,38 @@ func feedScheduler(store *storage.Storage, pool *worker.Pool, frequency, batchSi
 	}
 }
 
-func cleanupScheduler(store *storage.Storage, frequency int, archiveDays int, sessionsDays int) {
+// importScheduler runs the background OPML imports one subscription at a time, at the pace of the rate limit.
+// The users take turns, so a large import does not delay the imports of the other users.
+// The imported feeds are refreshed by the worker pool.
+func importScheduler(store *storage.Storage, pool *worker.Pool, rateLimit int) {
+	var interval time.Duration
+	if rateLimit > 0 {
+		interval = time.Minute / time.Duration(rateLimit)
+	}
+
+	handler := opml.NewHandler(store)
+	for {
+		found, err := handler.ImportNextJobItem(func(feed *model.Feed) {
+			pool.Push(model.JobList{{UserID: feed.UserID, FeedID: feed.ID, FeedURL: feed.FeedURL}})
+		})
+		if err != nil {
+			logger.Error("[Scheduler:Import] %v", err)
+		}
+
+		if err := store.CompleteImportJobs(); err != nil {
+			logger.Error("[Scheduler:Import] %v", err)
+		}
+
+		if !found || err != nil {
+			time.Sleep(importPollingInterval)
+			continue
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+func cleanupScheduler(store *storage.Storage, frequency int, archiveDays int, markReadDays int, sessionsDays int) {
 	c := time.Tick(time.Duration(frequency) * time.Hour)
 	for range c {
 		nbSessions := store.CleanOldSessions(sessionsDays)
@@ -55,5 +123,15 @@ func cleanupScheduler(store *storage.Storage, frequency int, archiveDays int, se
 		if err := store.ArchiveEntries(archiveDays); err != nil {
 			logger.Error("[Scheduler:Cleanup] %v", err)
 		}
+
+		// The states imported for entries not received during the archive period are unlikely to be used.
+		nbStates := store.CleanOldPendingEntryStates(archiveDays)
+		logger.Info("[Scheduler:Cleanup] Cleaned %d pending entry states", nbStates)
+
+		if nbEntries, err := store.MarkOldEntriesAsRead(markReadDays); err != nil {
+			logger.Error("[Scheduler:Cleanup] %v", err)
+		} else {
+			logger.Info("[Scheduler:Cleanup] Marked %d old entries as read", nbEntries)
+		}
 	}
 }
@@ -32,8 +32,8 @@ func (s *Storage) CategoryExists(userID, categoryID int64) bool {
 func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 AND id=$2`
-	err := s.db.QueryRow(query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title)
+	query := `SELECT id, user_id, title, crawler, mark_read_after_days, telegram_chat_id, webhook_url, position FROM categories WHERE user_id=$1 AND id=$2`
+	err := s.db.QueryRow(query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title, &category.Crawler, &category.MarkReadAfterDays, &category.TelegramChatID, &category.WebhookURL, &category.Position)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -47,10 +47,10 @@ func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 
 // FirstCategory returns the first category for the given user.
 func (s *Storage) FirstCategory(userID int64) (*model.Category, error) {
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 ORDER BY title ASC LIMIT 1`
+	query := `SELECT id, user_id, title, crawler, mark_read_after_days, telegram_chat_id, webhook_url, position FROM categories WHERE user_id=$1 ORDER BY title ASC LIMIT 1`
 
 	var category model.Category
-	err := s.db.QueryRow(query, userID).Scan(&category.ID, &category.UserID, &category.Title)
+	err := s.db.QueryRow(query, userID).Scan(&category.ID, &category.UserID, &category.Title, &category.Crawler, &category.MarkReadAfterDays, &category.TelegramChatID, &category.WebhookURL, &category.Position)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -66,8 +66,8 @@ func (s *Storage) FirstCategory(userID int64) (*model.Category, error) {
 func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 AND title=$2`
-	err := s.db.QueryRow(query, userID, title).Scan(&category.ID, &category.UserID, &category.Title)
+	query := `SELECT id, user_id, title, crawler, mark_read_after_days, telegram_chat_id, webhook_url, position FROM categories WHERE user_id=$1 AND title=$2`
+	err := s.db.QueryRow(query, userID, title).Scan(&category.ID, &category.UserID, &category.Title, &category.Crawler, &category.MarkReadAfterDays, &category.TelegramChatID, &category.WebhookURL, &category.Position)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -81,7 +81,7 @@ func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category,
 
 // Categories returns all categories that belongs to the given user.
 func (s *Storage) Categories(userID int64) (model.Categories, error) {
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 ORDER BY title ASC`
+	query := `SELECT id, user_id, title, crawler, mark_read_after_days, telegram_chat_id, webhook_url, position FROM categories WHERE user_id=$1 ORDER BY position=0, position ASC, title ASC`
 	rows, err := s.db.Query(query, userID)
 	if err != nil {
 		return nil, fmt.Errorf(`store: unable to fetch categories: %v`, err)
@@ -91,7 +91,7 @@ func (s *Storage) Categories(userID int64) (model.Categories, error) {
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title); err != nil {
+		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.Crawler, &category.MarkReadAfterDays, &category.TelegramChatID, &category.WebhookURL, &category.Position); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
@@ -108,11 +108,16 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 			c.id,
 			c.user_id,
 			c.title,
+			c.crawler,
+			c.mark_read_after_days,
+			c.telegram_chat_id,
+			c.webhook_url,
+			c.position,
 			(SELECT count(*) FROM feeds WHERE feeds.category_id=c.id) AS count
 		FROM categories c
 		WHERE
 			user_id=$1
-		ORDER BY c.title ASC
+		ORDER BY c.position=0, c.position ASC, c.title ASC
 	`
 
 	rows, err := s.db.Query(query, userID)
@@ -124,7 +129,7 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.FeedCount); err != nil {
+		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.Crawler, &category.MarkReadAfterDays, &category.TelegramChatID, &category.WebhookURL, &category.Position, &category.FeedCount); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
@@ -138,9 +143,9 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 func (s *Storage) CreateCategory(category *model.Category) error {
 	query := `
 		INSERT INTO categories
-			(user_id, title)
+			(user_id, title, crawler, mark_read_after_days, telegram_chat_id, webhook_url)
 		VALUES
-			($1, $2)
+			($1, $2, $3, $4, $5, $6)
 		RETURNING
 			id
 	`
@@ -148,6 +153,10 @@ func (s *Storage) CreateCategory(category *model.Category) error {
 		query,
 		category.UserID,
 		category.Title,
+		category.Crawler,
+		category.MarkReadAfterDays,
+		category.TelegramChatID,
+		category.WebhookURL,
 	).Scan(&category.ID)
 
 	if err != nil {
@@ -159,10 +168,14 @@ func (s *Storage) CreateCategory(category *model.Category) error {
 
 // UpdateCategory updates an existing category.
 func (s *Storage) UpdateCategory(category *model.Category) error {
-	query := `UPDATE categories SET title=$1 WHERE id=$2 AND user_id=$3`
+	query := `UPDATE categories SET title=$1, crawler=$2, mark_read_after_days=$3, telegram_chat_id=$4, webhook_url=$5 WHERE id=$6 AND user_id=$7`
 	_, err := s.db.Exec(
 		query,
 		category.Title,
+		category.Crawler,
+		category.MarkReadAfterDays,
+		category.TelegramChatID,
+		category.WebhookURL,
 		category.ID,
 		category.UserID,
 	)
@@ -174,6 +187,12 @@ func (s *Storage) UpdateCategory(category *model.Category) error {
 	return nil
 }
 
+// ReorderCategories sets the position of the categories in the given order.
+// The other categories of the user are placed after, in their current order.
+func (s *Storage) ReorderCategories(userID int64, categoryIDs []int64) error {
+	return s.reorder("categories", "position=0, position ASC, title ASC", userID, categoryIDs)
+}
+
 // RemoveCategory deletes a category.
 func (s *Storage) RemoveCategory(userID, categoryID int64) error {
 	query := `DELETE FROM categories WHERE id = $1 AND user_id = $2`
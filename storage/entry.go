@@ -12,6 +12,7 @@ import (
 	"miniflux.app/crypto"
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/url"
 
 	"github.com/lib/pq"
 )
@@ -35,7 +36,7 @@ func (s *Storage) NewEntryQueryBuilder(userID int64) *EntryQueryBuilder {
 	return NewEntryQueryBuilder(s, userID)
 }
 
-// UpdateEntryContent updates entry content.
+// UpdateEntryContent updates entry content and summary.
 func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 	tx, err := s.db.Begin()
 	if err != nil {
@@ -46,11 +47,13 @@ func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 		UPDATE
 			entries
 		SET
-			content=$1
+			content=$1,
+			summary=$2,
+			reading_time=$3
 		WHERE
-			id=$2 AND user_id=$3
+			id=$4 AND user_id=$5
 	`
-	_, err = tx.Exec(query, entry.Content, entry.ID, entry.UserID)
+	_, err = tx.Exec(query, entry.Content, entry.Summary, entry.ReadingTime, entry.ID, entry.UserID)
 	if err != nil {
 		tx.Rollback()
 		return fmt.Errorf(`store: unable to update content of entry #%d: %v`, entry.ID, err)
@@ -77,9 +80,9 @@ func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 func (s *Storage) CreateEntry(entry *model.Entry) error {
 	query := `
 		INSERT INTO entries
-			(title, hash, url, comments_url, published_at, content, author, user_id, feed_id, changed_at, document_vectors)
+			(title, hash, url, comments_url, published_at, content, author, user_id, feed_id, url_hash, content_hash, summary, language, reading_time, updated_at, changed_at, document_vectors, thumbnail_url, explicit, translated_content, translation_language)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($6, '') for 1000000)), 'B'))
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, now(), setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($6, '') for 1000000)), 'B'), $16, $17, $18, $19)
 		RETURNING
 			id, status
 	`
@@ -94,12 +97,26 @@ func (s *Storage) CreateEntry(entry *model.Entry) error {
 		entry.Author,
 		entry.UserID,
 		entry.FeedID,
+		entryURLHash(entry.URL),
+		entry.ContentHash,
+		entry.Summary,
+		entry.Language,
+		entry.ReadingTime,
+		entry.UpdatedAt,
+		entry.ThumbnailURL,
+		entry.Explicit,
+		entry.TranslatedContent,
+		entry.TranslationLanguage,
 	).Scan(&entry.ID, &entry.Status)
 
 	if err != nil {
 		return fmt.Errorf(`store: unable to create entry %q (feed #%d): %v`, entry.URL, entry.FeedID, err)
 	}
 
+	if err := s.applyPendingEntryState(entry); err != nil {
+		return err
+	}
+
 	for i := 0; i < len(entry.Enclosures); i++ {
 		entry.Enclosures[i].EntryID = entry.ID
 		entry.Enclosures[i].UserID = entry.UserID
@@ -109,12 +126,13 @@ func (s *Storage) CreateEntry(entry *model.Entry) error {
 		}
 	}
 
-	return nil
+	return s.UpdateTranscripts(entry)
 }
 
 // UpdateEntry updates an entry when a feed is refreshed.
 // Note: we do not update the published date because some feeds do not contains any date,
 // it default to time.Now() which could change the order of items on the history page.
+// The date of the last modification is updated instead, and the translation is kept only while the content is unchanged.
 func (s *Storage) UpdateEntry(entry *model.Entry) error {
 	query := `
 		UPDATE
@@ -125,6 +143,16 @@ func (s *Storage) UpdateEntry(entry *model.Entry) error {
 			comments_url=$3,
 			content=$4,
 			author=$5,
+			url_hash=$9,
+			content_hash=$10,
+			summary=$11,
+			language=$12,
+			reading_time=$13,
+			updated_at=$14,
+			thumbnail_url=$15,
+			explicit=$16,
+			translated_content=CASE WHEN content_hash=$10 THEN translated_content ELSE '' END,
+			translation_language=CASE WHEN content_hash=$10 THEN translation_language ELSE '' END,
 			document_vectors = setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($4, '') for 1000000)), 'B')
 		WHERE
 			user_id=$6 AND feed_id=$7 AND hash=$8
@@ -141,6 +169,14 @@ func (s *Storage) UpdateEntry(entry *model.Entry) error {
 		entry.UserID,
 		entry.FeedID,
 		entry.Hash,
+		entryURLHash(entry.URL),
+		entry.ContentHash,
+		entry.Summary,
+		entry.Language,
+		entry.ReadingTime,
+		entry.UpdatedAt,
+		entry.ThumbnailURL,
+		entry.Explicit,
 	).Scan(&entry.ID)
 
 	if err != nil {
@@ -152,9 +188,71 @@ func (s *Storage) UpdateEntry(entry *model.Entry) error {
 		enclosure.EntryID = entry.ID
 	}
 
+	if err := s.UpdateTranscripts(entry); err != nil {
+		return err
+	}
+
 	return s.UpdateEnclosures(entry.Enclosures)
 }
 
+// EntryTranslation returns the translation of an entry of the feed with the same content hash, if any.
+func (s *Storage) EntryTranslation(feedID int64, contentHash, language string) (translatedContent string, found bool) {
+	query := `
+		SELECT
+			translated_content
+		FROM
+			entries
+		WHERE
+			feed_id=$1 AND content_hash=$2 AND translation_language=$3 AND translated_content <> ''
+		LIMIT 1
+	`
+	err := s.db.QueryRow(query, feedID, contentHash, language).Scan(&translatedContent)
+	return translatedContent, err == nil
+}
+
+// UntranslatedEntries returns the most recent entries of the feed that are not translated to the given language.
+func (s *Storage) UntranslatedEntries(feedID int64, language string, limit int) (model.Entries, error) {
+	query := `
+		SELECT
+			id, hash, url, content, content_hash, language
+		FROM
+			entries
+		WHERE
+			feed_id=$1 AND status <> $2 AND content <> '' AND language <> $3 AND
+			(translation_language <> $3 OR translated_content = '')
+		ORDER BY
+			published_at DESC
+		LIMIT $4
+	`
+	rows, err := s.db.Query(query, feedID, model.EntryStatusRemoved, language, limit)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch untranslated entries of feed #%d: %v`, feedID, err)
+	}
+	defer rows.Close()
+
+	entries := make(model.Entries, 0)
+	for rows.Next() {
+		entry := &model.Entry{FeedID: feedID}
+		if err := rows.Scan(&entry.ID, &entry.Hash, &entry.URL, &entry.Content, &entry.ContentHash, &entry.Language); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch untranslated entry row: %v`, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// UpdateEntryTranslation stores the translation of an entry that already exists.
+// The translation of unchanged entries would be lost otherwise because they are not updated when the feed is refreshed.
+func (s *Storage) UpdateEntryTranslation(feedID int64, entry *model.Entry) error {
+	query := `UPDATE entries SET translated_content=$1, translation_language=$2 WHERE feed_id=$3 AND hash=$4`
+	if _, err := s.db.Exec(query, entry.TranslatedContent, entry.TranslationLanguage, feedID, entry.Hash); err != nil {
+		return fmt.Errorf(`store: unable to update the translation of entry %q: %v`, entry.URL, err)
+	}
+
+	return nil
+}
+
 // EntryExists checks if an entry already exists based on its hash when refreshing a feed.
 func (s *Storage) EntryExists(entry *model.Entry) bool {
 	var result int
@@ -163,6 +261,41 @@ func (s *Storage) EntryExists(entry *model.Entry) bool {
 	return result == 1
 }
 
+// EntryContentHash returns the content hash of the stored entry, found is false when the entry does not exist.
+func (s *Storage) EntryContentHash(entry *model.Entry) (contentHash string, found bool) {
+	query := `SELECT content_hash FROM entries WHERE user_id=$1 AND feed_id=$2 AND hash=$3`
+	if err := s.db.QueryRow(query, entry.UserID, entry.FeedID, entry.Hash).Scan(&contentHash); err != nil {
+		return "", false
+	}
+
+	return contentHash, true
+}
+
+// DuplicateEntryExists checks if an entry with the same normalized URL exists in another feed of the user.
+func (s *Storage) DuplicateEntryExists(entry *model.Entry) bool {
+	if entry.URL == "" {
+		return false
+	}
+
+	var result bool
+	query := `SELECT true FROM entries WHERE user_id=$1 AND feed_id<>$2 AND url_hash=$3 LIMIT 1`
+	s.db.QueryRow(query, entry.UserID, entry.FeedID, entryURLHash(entry.URL)).Scan(&result)
+	return result
+}
+
+// DuplicateGUIDExists checks if an entry with exactly the same GUID exists in another feed of the user.
+// The entry hash is computed from the GUID when the feed provides one, so the hashes are compared.
+func (s *Storage) DuplicateGUIDExists(entry *model.Entry) bool {
+	if entry.GUID == "" {
+		return false
+	}
+
+	var result bool
+	query := `SELECT true FROM entries WHERE user_id=$1 AND feed_id<>$2 AND hash=$3 LIMIT 1`
+	s.db.QueryRow(query, entry.UserID, entry.FeedID, crypto.Hash(entry.GUID)).Scan(&result)
+	return result
+}
+
 // CleanupEntries deletes from the database entries marked as "removed" and not visible anymore in the feed.
 func (s *Storage) CleanupEntries(feedID int64, entryHashes []string) error {
 	query := `
@@ -180,6 +313,45 @@ func (s *Storage) CleanupEntries(feedID int64, entryHashes []string) error {
 	return nil
 }
 
+// BackfillEntryURLHashes stores the URL hash of a batch of entries created before the hash was computed.
+// It returns the number of updated entries, zero once all the entries have a hash.
+func (s *Storage) BackfillEntryURLHashes(batchSize int) (int, error) {
+	rows, err := s.db.Query(`SELECT id, url FROM entries WHERE url_hash='' LIMIT $1`, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to fetch entries without URL hash: %v`, err)
+	}
+
+	hashes := make(map[int64]string)
+	for rows.Next() {
+		var entryID int64
+		var entryURL string
+		if err := rows.Scan(&entryID, &entryURL); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf(`store: unable to fetch entries without URL hash: %v`, err)
+		}
+		hashes[entryID] = entryURLHash(entryURL)
+	}
+	rows.Close()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	for entryID, hash := range hashes {
+		if _, err := tx.Exec(`UPDATE entries SET url_hash=$1 WHERE id=$2`, hash, entryID); err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf(`store: unable to update URL hash of entry #%d: %v`, entryID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return len(hashes), nil
+}
+
 // ArchiveEntries changes the status of read items to "removed" after specified days.
 func (s *Storage) ArchiveEntries(days int) error {
 	if days < 0 {
@@ -202,6 +374,69 @@ func (s *Storage) ArchiveEntries(days int) error {
 	return nil
 }
 
+// MarkOldEntriesAsRead changes the status of unread items to "read" when they are older than the number of days
+// defined for their feed, their category or the given default value. Starred items are never changed.
+func (s *Storage) MarkOldEntriesAsRead(defaultDays int) (int64, error) {
+	query := `
+		UPDATE
+			entries
+		SET
+			status=$1,
+			changed_at=now()
+		WHERE
+			id=ANY(
+				SELECT
+					e.id
+				FROM
+					entries e
+				JOIN
+					feeds f ON f.id=e.feed_id
+				JOIN
+					categories c ON c.id=f.category_id
+				WHERE
+					e.status=$2 AND
+					e.starred is false AND
+					COALESCE(NULLIF(f.mark_read_after_days, 0), NULLIF(c.mark_read_after_days, 0), $3::int) > 0 AND
+					e.published_at < now() - COALESCE(NULLIF(f.mark_read_after_days, 0), NULLIF(c.mark_read_after_days, 0), $3::int) * interval '1 day'
+				LIMIT 5000
+			)
+	`
+	result, err := s.db.Exec(query, model.EntryStatusRead, model.EntryStatusUnread, defaultDays)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to mark old entries as read: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to mark old entries as read: %v`, err)
+	}
+
+	return count, nil
+}
+
+// PruneEntries changes the status of the oldest read items of a feed to "removed" when the feed has more than maxEntries visible entries.
+func (s *Storage) PruneEntries(feedID int64, maxEntries int) error {
+	if maxEntries <= 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE
+			entries
+		SET
+			status=$1
+		WHERE
+			id=ANY(SELECT id FROM entries WHERE feed_id=$2 AND status<>$1 ORDER BY published_at DESC, id DESC OFFSET $3)
+		AND
+			status=$4 AND starred is false AND share_code=''
+	`
+	if _, err := s.db.Exec(query, model.EntryStatusRemoved, feedID, maxEntries, model.EntryStatusRead); err != nil {
+		return fmt.Errorf(`store: unable to prune entries of feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
 // SetEntriesStatus update the status of the given list of entries.
 func (s *Storage) SetEntriesStatus(userID int64, entryIDs []int64, status string) error {
 	query := `UPDATE entries SET status=$1, changed_at=now() WHERE user_id=$2 AND id=ANY($3)`
@@ -222,6 +457,31 @@ func (s *Storage) SetEntriesStatus(userID int64, entryIDs []int64, status string
 	return nil
 }
 
+// MarkEntriesAsRead marks the unread entries of the list as read and returns the number of updated entries.
+// The entries are updated in a single statement, so either all of them or none are changed.
+func (s *Storage) MarkEntriesAsRead(userID int64, entryIDs []int64) (int64, error) {
+	query := `
+		UPDATE
+			entries
+		SET
+			status=$1,
+			changed_at=now()
+		WHERE
+			user_id=$2 AND id=ANY($3) AND status=$4
+	`
+	result, err := s.db.Exec(query, model.EntryStatusRead, userID, pq.Array(entryIDs), model.EntryStatusUnread)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to mark entries as read %v: %v`, entryIDs, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to mark entries as read %v: %v`, entryIDs, err)
+	}
+
+	return count, nil
+}
+
 // ToggleBookmark toggles entry bookmark value.
 func (s *Storage) ToggleBookmark(userID int64, entryID int64) error {
 	query := `UPDATE entries SET starred = NOT starred, changed_at=now() WHERE user_id=$1 AND id=$2`
@@ -366,3 +626,7 @@ func (s *Storage) UnshareEntry(userID int64, entryID int64) (err error) {
 	}
 	return
 }
+
+func entryURLHash(entryURL string) string {
+	return crypto.Hash(url.Normalize(entryURL))
+}
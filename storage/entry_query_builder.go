@@ -24,6 +24,9 @@ type EntryQueryBuilder struct {
 	direction  string
 	limit      int
 	offset     int
+
+	searchArg     int
+	snippetLength int
 }
 
 // WithSearchQuery adds full-text search query to the condition.
@@ -32,6 +35,7 @@ func (e *EntryQueryBuilder) WithSearchQuery(query string) *EntryQueryBuilder {
 		nArgs := len(e.args) + 1
 		e.conditions = append(e.conditions, fmt.Sprintf("e.document_vectors @@ plainto_tsquery($%d)", nArgs))
 		e.args = append(e.args, query)
+		e.searchArg = nArgs
 
 		// 0.0000001 = 0.1 / (seconds_in_a_day)
 		e.WithOrder(fmt.Sprintf("ts_rank(document_vectors, plainto_tsquery($%d)) - extract (epoch from now() - published_at)::float * 0.0000001", nArgs))
@@ -40,12 +44,47 @@ func (e *EntryQueryBuilder) WithSearchQuery(query string) *EntryQueryBuilder {
 	return e
 }
 
+// WithSearchSnippet returns a highlighted excerpt of the content matching the full-text search query.
+// The snippet contains at most maxWords words, 0 disables the snippets.
+func (e *EntryQueryBuilder) WithSearchSnippet(maxWords int) *EntryQueryBuilder {
+	e.snippetLength = maxWords
+	return e
+}
+
 // WithStarred adds starred filter.
 func (e *EntryQueryBuilder) WithStarred() *EntryQueryBuilder {
 	e.conditions = append(e.conditions, "e.starred is true")
 	return e
 }
 
+// WithTag adds a filter to return only the entries labeled with the given tag name.
+func (e *EntryQueryBuilder) WithTag(name string) *EntryQueryBuilder {
+	if name != "" {
+		e.conditions = append(e.conditions, fmt.Sprintf("e.id IN (SELECT et.entry_id FROM entry_tags et JOIN tags t ON t.id=et.tag_id WHERE t.user_id=e.user_id AND t.name=$%d)", len(e.args)+1))
+		e.args = append(e.args, name)
+	}
+	return e
+}
+
+// WithSavedSearch adds the filters of a saved search, the removed entries are excluded when no status is defined.
+func (e *EntryQueryBuilder) WithSavedSearch(search *model.SavedSearch) *EntryQueryBuilder {
+	e.WithSearchQuery(search.SearchQuery)
+	e.WithFeedID(search.FeedID)
+	e.WithCategoryID(search.CategoryID)
+
+	if search.Status != "" {
+		e.WithStatus(search.Status)
+	} else {
+		e.WithoutStatus(model.EntryStatusRemoved)
+	}
+
+	if search.Starred {
+		e.WithStarred()
+	}
+
+	return e
+}
+
 // BeforeDate adds a condition < published_at
 func (e *EntryQueryBuilder) BeforeDate(date time.Time) *EntryQueryBuilder {
 	e.conditions = append(e.conditions, fmt.Sprintf("e.published_at < $%d", len(e.args)+1))
@@ -155,6 +194,20 @@ func (e *EntryQueryBuilder) WithDirection(direction string) *EntryQueryBuilder {
 	return e
 }
 
+// WithFeedSortOrder sorts the entries by publication date according to the feed sort order.
+// The current sorting is kept when the feed has no sort order.
+func (e *EntryQueryBuilder) WithFeedSortOrder(sortOrder string) *EntryQueryBuilder {
+	switch sortOrder {
+	case model.FeedSortOrderPublishedAsc:
+		e.order = "published_at"
+		e.direction = "asc"
+	case model.FeedSortOrderPublishedDesc:
+		e.order = "published_at"
+		e.direction = "desc"
+	}
+	return e
+}
+
 // WithLimit set the limit.
 func (e *EntryQueryBuilder) WithLimit(limit int) *EntryQueryBuilder {
 	e.limit = limit
@@ -197,6 +250,11 @@ func (e *EntryQueryBuilder) GetEntry() (*model.Entry, error) {
 		return nil, err
 	}
 
+	entries[0].Transcripts, err = e.store.GetTranscripts(entries[0].ID)
+	if err != nil {
+		return nil, err
+	}
+
 	return entries[0], nil
 }
 
@@ -209,25 +267,36 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			e.feed_id,
 			e.hash,
 			e.published_at at time zone u.timezone,
+			e.updated_at at time zone u.timezone,
 			e.title,
 			e.url,
 			e.comments_url,
 			e.author,
 			e.share_code,
 			e.content,
+			e.summary,
+			e.language,
+			e.translated_content,
+			e.translation_language,
+			e.reading_time,
+			e.thumbnail_url,
+			e.explicit,
 			e.status,
 			e.starred,
 			f.title as feed_title,
 			f.feed_url,
 			f.site_url,
 			f.checked_at,
-			f.category_id, c.title as category_title,
+			f.category_id, c.title as category_title, c.crawler as category_crawler,
 			f.scraper_rules,
 			f.rewrite_rules,
-			f.crawler,
+			f.crawler_mode,
 			f.user_agent,
+			f.sort_order,
 			fi.icon_id,
-			u.timezone
+			u.timezone,
+			array(SELECT t.name FROM entry_tags et JOIN tags t ON t.id=et.tag_id WHERE et.entry_id=e.id ORDER BY t.name),
+			%s
 		FROM
 			entries e
 		LEFT JOIN
@@ -241,9 +310,10 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 		WHERE %s %s
 	`
 
+	snippet := e.buildSnippet()
 	condition := e.buildCondition()
 	sorting := e.buildSorting()
-	query = fmt.Sprintf(query, condition, sorting)
+	query = fmt.Sprintf(query, snippet, condition, sorting)
 
 	rows, err := e.store.db.Query(query, e.args...)
 	if err != nil {
@@ -256,6 +326,7 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 		var entry model.Entry
 		var iconID interface{}
 		var tz string
+		var tags pq.StringArray
 
 		entry.Feed = &model.Feed{}
 		entry.Feed.Category = &model.Category{}
@@ -267,12 +338,20 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.FeedID,
 			&entry.Hash,
 			&entry.Date,
+			&entry.UpdatedAt,
 			&entry.Title,
 			&entry.URL,
 			&entry.CommentsURL,
 			&entry.Author,
 			&entry.ShareCode,
 			&entry.Content,
+			&entry.Summary,
+			&entry.Language,
+			&entry.TranslatedContent,
+			&entry.TranslationLanguage,
+			&entry.ReadingTime,
+			&entry.ThumbnailURL,
+			&entry.Explicit,
 			&entry.Status,
 			&entry.Starred,
 			&entry.Feed.Title,
@@ -281,12 +360,16 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.Feed.CheckedAt,
 			&entry.Feed.Category.ID,
 			&entry.Feed.Category.Title,
+			&entry.Feed.Category.Crawler,
 			&entry.Feed.ScraperRules,
 			&entry.Feed.RewriteRules,
-			&entry.Feed.Crawler,
+			&entry.Feed.CrawlerMode,
 			&entry.Feed.UserAgent,
+			&entry.Feed.SortOrder,
 			&iconID,
 			&tz,
+			&tags,
+			&entry.Snippet,
 		)
 
 		if err != nil {
@@ -299,14 +382,18 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			entry.Feed.Icon.IconID = iconID.(int64)
 		}
 
+		entry.Tags = tags
+
 		// Make sure that timestamp fields contains timezone information (API)
 		entry.Date = timezone.Convert(tz, entry.Date)
+		entry.UpdatedAt = timezone.Convert(tz, entry.UpdatedAt)
 		entry.Feed.CheckedAt = timezone.Convert(tz, entry.Feed.CheckedAt)
 
 		entry.Feed.ID = entry.FeedID
 		entry.Feed.UserID = entry.UserID
 		entry.Feed.Icon.FeedID = entry.FeedID
 		entry.Feed.Category.UserID = entry.UserID
+		entry.Feed.Crawler = entry.Feed.IsCrawlerEnabled()
 		entries = append(entries, &entry)
 	}
 
@@ -341,6 +428,25 @@ func (e *EntryQueryBuilder) GetEntryIDs() ([]int64, error) {
 	return entryIDs, nil
 }
 
+// buildSnippet returns the expression of the search snippet, the HTML tags are removed from the content before highlighting the matching words.
+func (e *EntryQueryBuilder) buildSnippet() string {
+	if e.searchArg == 0 || e.snippetLength <= 0 {
+		return "''"
+	}
+
+	maxWords := e.snippetLength
+	if maxWords < 2 {
+		maxWords = 2
+	}
+
+	return fmt.Sprintf(
+		`ts_headline(regexp_replace(coalesce(e.content, ''), '<[^>]*>', ' ', 'g'), plainto_tsquery($%d), 'StartSel=<mark>, StopSel=</mark>, MaxFragments=1, MaxWords=%d, MinWords=%d')`,
+		e.searchArg,
+		maxWords,
+		maxWords/2,
+	)
+}
+
 func (e *EntryQueryBuilder) buildCondition() string {
 	return strings.Join(e.conditions, " AND ")
 }
@@ -0,0 +1,99 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// ApplyEntryState marks as read and/or starred the entries of the feed with the given URL, the removed entries stay removed.
+// Found is false when the feed doesn't have any entry with this URL.
+func (s *Storage) ApplyEntryState(userID, feedID int64, entryURL string, read, starred bool) (found bool, err error) {
+	query := `
+		UPDATE
+			entries
+		SET
+			status=CASE WHEN $4::bool AND status='unread' THEN 'read' ELSE status END,
+			starred=starred OR $5,
+			changed_at=now()
+		WHERE
+			user_id=$1 AND feed_id=$2 AND url_hash=$3
+	`
+	result, err := s.db.Exec(query, userID, feedID, entryURLHash(entryURL), read, starred)
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to update the state of entry %q: %v`, entryURL, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to update the state of entry %q: %v`, entryURL, err)
+	}
+
+	return count > 0, nil
+}
+
+// QueueEntryState stores the state of an entry not received yet, the state is applied when the entry is created.
+func (s *Storage) QueueEntryState(userID, feedID int64, entryURL string, read, starred bool) error {
+	query := `
+		INSERT INTO pending_entry_states
+			(user_id, feed_id, url_hash, read, starred)
+		VALUES
+			($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, feed_id, url_hash) DO UPDATE SET
+			read=pending_entry_states.read OR EXCLUDED.read,
+			starred=pending_entry_states.starred OR EXCLUDED.starred
+	`
+	if _, err := s.db.Exec(query, userID, feedID, entryURLHash(entryURL), read, starred); err != nil {
+		return fmt.Errorf(`store: unable to queue the state of entry %q: %v`, entryURL, err)
+	}
+
+	return nil
+}
+
+// CleanOldPendingEntryStates removes the queued states of the entries not received after the given number of days.
+func (s *Storage) CleanOldPendingEntryStates(days int) int64 {
+	query := `DELETE FROM pending_entry_states WHERE created_at < now() - interval '%d days'`
+	result, err := s.db.Exec(fmt.Sprintf(query, days))
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
+
+// applyPendingEntryState applies to a new entry the state queued during an import.
+func (s *Storage) applyPendingEntryState(entry *model.Entry) error {
+	var read, starred bool
+	query := `
+		DELETE FROM
+			pending_entry_states
+		WHERE
+			user_id=$1 AND feed_id=$2 AND url_hash=$3
+		RETURNING
+			read, starred
+	`
+	err := s.db.QueryRow(query, entry.UserID, entry.FeedID, entryURLHash(entry.URL)).Scan(&read, &starred)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil
+	case err != nil:
+		return fmt.Errorf(`store: unable to fetch the pending state of entry %q: %v`, entry.URL, err)
+	}
+
+	if _, err := s.ApplyEntryState(entry.UserID, entry.FeedID, entry.URL, read, starred); err != nil {
+		return err
+	}
+
+	if read && entry.Status == model.EntryStatusUnread {
+		entry.Status = model.EntryStatusRead
+	}
+	entry.Starred = entry.Starred || starred
+
+	return nil
+}
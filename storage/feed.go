@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 
+	"miniflux.app/config"
 	"miniflux.app/model"
 	"miniflux.app/timezone"
 )
@@ -27,14 +28,42 @@ var feedListQuery = `
 		f.parsing_error_msg,
 		f.scraper_rules,
 		f.rewrite_rules,
-		f.crawler,
+		f.crawler_mode,
 		f.user_agent,
 		f.username,
 		f.password,
 		f.ignore_http_cache,
 		f.disabled,
+		f.author_blocklist_rules,
+		f.author_keeplist_rules,
+		f.author_match_mode,
+		f.translation_language,
+		f.language,
+		f.proxy_url,
+		f.position,
+		f.refresh_interval,
+		f.mark_read_after_days,
+		f.sort_order,
+		f.icon_url,
+		f.auth_token,
+		f.auth_scheme,
+		f.date_layouts,
+		f.disabled_reason,
+		f.skip_duplicate_guids,
+		f.last_status_code,
+		f.request_timeout,
+		f.cookie,
+		f.max_entries,
+		f.hub_url,
+		f.topic_url,
+		f.blocklist_rules,
+		f.keeplist_rules,
+		f.ttl,
+		f.update_interval,
+		f.notify_telegram,
 		f.category_id,
 		c.title as category_title,
+		c.crawler as category_crawler,
 		fi.icon_id,
 		u.timezone
 	FROM
@@ -48,7 +77,7 @@ var feedListQuery = `
 	WHERE
 		f.user_id=$1
 	ORDER BY
-		f.parsing_error_count DESC, lower(f.title) ASC
+		f.position=0, f.position ASC, f.parsing_error_count DESC, lower(f.title) ASC
 `
 
 // FeedExists checks if the given feed exists.
@@ -67,6 +96,14 @@ func (s *Storage) FeedURLExists(userID int64, feedURL string) bool {
 	return result
 }
 
+// AnotherFeedURLExists checks if another feed of the user already uses this feed URL.
+func (s *Storage) AnotherFeedURLExists(userID, feedID int64, feedURL string) bool {
+	var result bool
+	query := `SELECT true FROM feeds WHERE id <> $1 AND user_id=$2 AND feed_url=$3`
+	s.db.QueryRow(query, feedID, userID, feedURL).Scan(&result)
+	return result
+}
+
 // CountFeeds returns the number of feeds that belongs to the given user.
 func (s *Storage) CountFeeds(userID int64) int {
 	var result int
@@ -78,11 +115,18 @@ func (s *Storage) CountFeeds(userID int64) int {
 	return result
 }
 
-// CountErrorFeeds returns the number of feeds with parse errors that belong to the given user.
+// CountErrorFeeds returns the number of feeds no longer refreshed because of errors that belong to the given user.
 func (s *Storage) CountErrorFeeds(userID int64) int {
-	query := `SELECT count(*) FROM feeds WHERE user_id=$1 AND parsing_error_count>=$2`
+	query := `
+		SELECT
+			count(*)
+		FROM
+			feeds
+		WHERE
+			user_id=$1 AND (($2 > 0 AND parsing_error_count>=$2) OR disabled_reason <> '')
+	`
 	var result int
-	err := s.db.QueryRow(query, userID, maxParsingError).Scan(&result)
+	err := s.db.QueryRow(query, userID, config.Opts.PollingParsingErrorLimit()).Scan(&result)
 	if err != nil {
 		return 0
 	}
@@ -128,14 +172,42 @@ func (s *Storage) FeedsByCategoryWithCounters(userID, categoryID int64) (model.F
 			f.parsing_error_msg,
 			f.scraper_rules,
 			f.rewrite_rules,
-			f.crawler,
+			f.crawler_mode,
 			f.user_agent,
 			f.username,
 			f.password,
 			f.ignore_http_cache,
 			f.disabled,
+			f.author_blocklist_rules,
+			f.author_keeplist_rules,
+			f.author_match_mode,
+			f.translation_language,
+			f.language,
+			f.proxy_url,
+			f.position,
+			f.refresh_interval,
+			f.mark_read_after_days,
+			f.sort_order,
+			f.icon_url,
+			f.auth_token,
+			f.auth_scheme,
+			f.date_layouts,
+			f.disabled_reason,
+			f.skip_duplicate_guids,
+			f.last_status_code,
+			f.request_timeout,
+			f.cookie,
+			f.max_entries,
+			f.hub_url,
+			f.topic_url,
+			f.blocklist_rules,
+			f.keeplist_rules,
+			f.ttl,
+			f.update_interval,
+			f.notify_telegram,
 			f.category_id,
 			c.title as category_title,
+			c.crawler as category_crawler,
 			fi.icon_id,
 			u.timezone
 		FROM
@@ -149,7 +221,7 @@ func (s *Storage) FeedsByCategoryWithCounters(userID, categoryID int64) (model.F
 		WHERE
 			f.user_id=$1 AND f.category_id=$2
 		ORDER BY
-			f.parsing_error_count DESC, lower(f.title) ASC
+			f.position=0, f.position ASC, f.parsing_error_count DESC, lower(f.title) ASC
 	`
 
 	counterQuery := `
@@ -237,14 +309,42 @@ func (s *Storage) fetchFeeds(feedQuery, counterQuery string, args ...interface{}
 			&feed.ParsingErrorMsg,
 			&feed.ScraperRules,
 			&feed.RewriteRules,
-			&feed.Crawler,
+			&feed.CrawlerMode,
 			&feed.UserAgent,
 			&feed.Username,
 			&feed.Password,
 			&feed.IgnoreHTTPCache,
 			&feed.Disabled,
+			&feed.AuthorBlocklistRules,
+			&feed.AuthorKeeplistRules,
+			&feed.AuthorMatchMode,
+			&feed.TranslationLanguage,
+			&feed.Language,
+			&feed.ProxyURL,
+			&feed.Position,
+			&feed.RefreshInterval,
+			&feed.MarkReadAfterDays,
+			&feed.SortOrder,
+			&feed.IconURL,
+			&feed.AuthToken,
+			&feed.AuthScheme,
+			&feed.DateLayouts,
+			&feed.DisabledReason,
+			&feed.SkipDuplicateGUIDs,
+			&feed.LastStatusCode,
+			&feed.RequestTimeout,
+			&feed.Cookie,
+			&feed.MaxEntries,
+			&feed.HubURL,
+			&feed.TopicURL,
+			&feed.BlocklistRules,
+			&feed.KeeplistRules,
+			&feed.TTL,
+			&feed.UpdateInterval,
+			&feed.NotifyTelegram,
 			&feed.Category.ID,
 			&feed.Category.Title,
+			&feed.Category.Crawler,
 			&iconID,
 			&tz,
 		)
@@ -269,6 +369,7 @@ func (s *Storage) fetchFeeds(feedQuery, counterQuery string, args ...interface{}
 
 		feed.CheckedAt = timezone.Convert(tz, feed.CheckedAt)
 		feed.Category.UserID = feed.UserID
+		feed.Crawler = feed.IsCrawlerEnabled()
 		feeds = append(feeds, &feed)
 	}
 
@@ -321,14 +422,42 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 			f.parsing_error_msg,
 			f.scraper_rules,
 			f.rewrite_rules,
-			f.crawler,
+			f.crawler_mode,
 			f.user_agent,
 			f.username,
 			f.password,
 			f.ignore_http_cache,
 			f.disabled,
+			f.author_blocklist_rules,
+			f.author_keeplist_rules,
+			f.author_match_mode,
+			f.translation_language,
+			f.language,
+			f.proxy_url,
+			f.position,
+			f.refresh_interval,
+			f.mark_read_after_days,
+			f.sort_order,
+			f.icon_url,
+			f.auth_token,
+			f.auth_scheme,
+			f.date_layouts,
+			f.disabled_reason,
+			f.skip_duplicate_guids,
+			f.last_status_code,
+			f.request_timeout,
+			f.cookie,
+			f.max_entries,
+			f.hub_url,
+			f.topic_url,
+			f.blocklist_rules,
+			f.keeplist_rules,
+			f.ttl,
+			f.update_interval,
+			f.notify_telegram,
 			f.category_id,
 			c.title as category_title,
+			c.crawler as category_crawler,
 			fi.icon_id,
 			u.timezone
 		FROM feeds f
@@ -352,14 +481,42 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 		&feed.ParsingErrorMsg,
 		&feed.ScraperRules,
 		&feed.RewriteRules,
-		&feed.Crawler,
+		&feed.CrawlerMode,
 		&feed.UserAgent,
 		&feed.Username,
 		&feed.Password,
 		&feed.IgnoreHTTPCache,
 		&feed.Disabled,
+		&feed.AuthorBlocklistRules,
+		&feed.AuthorKeeplistRules,
+		&feed.AuthorMatchMode,
+		&feed.TranslationLanguage,
+		&feed.Language,
+		&feed.ProxyURL,
+		&feed.Position,
+		&feed.RefreshInterval,
+		&feed.MarkReadAfterDays,
+		&feed.SortOrder,
+		&feed.IconURL,
+		&feed.AuthToken,
+		&feed.AuthScheme,
+		&feed.DateLayouts,
+		&feed.DisabledReason,
+		&feed.SkipDuplicateGUIDs,
+		&feed.LastStatusCode,
+		&feed.RequestTimeout,
+		&feed.Cookie,
+		&feed.MaxEntries,
+		&feed.HubURL,
+		&feed.TopicURL,
+		&feed.BlocklistRules,
+		&feed.KeeplistRules,
+		&feed.TTL,
+		&feed.UpdateInterval,
+		&feed.NotifyTelegram,
 		&feed.Category.ID,
 		&feed.Category.Title,
+		&feed.Category.Crawler,
 		&iconID,
 		&tz,
 	)
@@ -376,6 +533,7 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 	}
 
 	feed.CheckedAt = timezone.Convert(tz, feed.CheckedAt)
+	feed.Crawler = feed.IsCrawlerEnabled()
 	return &feed, nil
 }
 
@@ -390,16 +548,29 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 			user_id,
 			etag_header,
 			last_modified_header,
-			crawler,
+			crawler_mode,
 			user_agent,
 			username,
 			password,
 			disabled,
 			scraper_rules,
-			rewrite_rules
+			rewrite_rules,
+			update_interval,
+			ttl,
+			hub_url,
+			topic_url,
+			cookie,
+			last_status_code,
+			disabled_reason,
+			icon_url,
+			sort_order,
+			mark_read_after_days,
+			refresh_interval,
+			proxy_url,
+			language
 		)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27)
 		RETURNING
 			id
 	`
@@ -412,13 +583,26 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.UserID,
 		feed.EtagHeader,
 		feed.LastModifiedHeader,
-		feed.Crawler,
+		feed.CrawlerMode,
 		feed.UserAgent,
 		feed.Username,
 		feed.Password,
 		feed.Disabled,
 		feed.ScraperRules,
 		feed.RewriteRules,
+		feed.UpdateInterval,
+		feed.TTL,
+		feed.HubURL,
+		feed.TopicURL,
+		feed.Cookie,
+		feed.LastStatusCode,
+		feed.DisabledReason,
+		feed.IconURL,
+		feed.SortOrder,
+		feed.MarkReadAfterDays,
+		feed.RefreshInterval,
+		feed.ProxyURL,
+		feed.Language,
 	).Scan(&feed.ID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to create feed %q: %v`, feed.FeedURL, err)
@@ -456,15 +640,41 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 			parsing_error_count=$9,
 			scraper_rules=$10,
 			rewrite_rules=$11,
-			crawler=$12,
+			crawler_mode=$12,
 			user_agent=$13,
 			username=$14,
 			password=$15,
 			disabled=$16,
 			next_check_at=$17,
-			ignore_http_cache=$18
+			ignore_http_cache=$18,
+			notify_telegram=$19,
+			update_interval=$20,
+			ttl=$21,
+			blocklist_rules=$22,
+			keeplist_rules=$23,
+			hub_url=$24,
+			topic_url=$25,
+			max_entries=$26,
+			cookie=$27,
+			request_timeout=$28,
+			last_status_code=$29,
+			skip_duplicate_guids=$30,
+			disabled_reason=$31,
+			date_layouts=$32,
+			auth_scheme=$33,
+			auth_token=$34,
+			icon_url=$35,
+			sort_order=$36,
+			mark_read_after_days=$37,
+			refresh_interval=$38,
+			proxy_url=$39,
+			translation_language=$40,
+			author_blocklist_rules=$41,
+			author_keeplist_rules=$42,
+			author_match_mode=$43,
+			language=$44
 		WHERE
-			id=$19 AND user_id=$20
+			id=$45 AND user_id=$46
 	`
 	_, err = s.db.Exec(query,
 		feed.FeedURL,
@@ -478,13 +688,39 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		feed.ParsingErrorCount,
 		feed.ScraperRules,
 		feed.RewriteRules,
-		feed.Crawler,
+		feed.CrawlerMode,
 		feed.UserAgent,
 		feed.Username,
 		feed.Password,
 		feed.Disabled,
 		feed.NextCheckAt,
 		feed.IgnoreHTTPCache,
+		feed.NotifyTelegram,
+		feed.UpdateInterval,
+		feed.TTL,
+		feed.BlocklistRules,
+		feed.KeeplistRules,
+		feed.HubURL,
+		feed.TopicURL,
+		feed.MaxEntries,
+		feed.Cookie,
+		feed.RequestTimeout,
+		feed.LastStatusCode,
+		feed.SkipDuplicateGUIDs,
+		feed.DisabledReason,
+		feed.DateLayouts,
+		feed.AuthScheme,
+		feed.AuthToken,
+		feed.IconURL,
+		feed.SortOrder,
+		feed.MarkReadAfterDays,
+		feed.RefreshInterval,
+		feed.ProxyURL,
+		feed.TranslationLanguage,
+		feed.AuthorBlocklistRules,
+		feed.AuthorKeeplistRules,
+		feed.AuthorMatchMode,
+		feed.Language,
 		feed.ID,
 		feed.UserID,
 	)
@@ -505,15 +741,21 @@ func (s *Storage) UpdateFeedError(feed *model.Feed) (err error) {
 			parsing_error_msg=$1,
 			parsing_error_count=$2,
 			checked_at=$3,
-			next_check_at=$4
+			next_check_at=$4,
+			last_status_code=$5,
+			disabled=$6,
+			disabled_reason=$7
 		WHERE
-			id=$5 AND user_id=$6
+			id=$8 AND user_id=$9
 	`
 	_, err = s.db.Exec(query,
 		feed.ParsingErrorMsg,
 		feed.ParsingErrorCount,
 		feed.CheckedAt,
 		feed.NextCheckAt,
+		feed.LastStatusCode,
+		feed.Disabled,
+		feed.DisabledReason,
 		feed.ID,
 		feed.UserID,
 	)
@@ -525,6 +767,12 @@ func (s *Storage) UpdateFeedError(feed *model.Feed) (err error) {
 	return nil
 }
 
+// ReorderFeeds sets the position of the feeds in the given order.
+// The other feeds of the user are placed after, in their current order.
+func (s *Storage) ReorderFeeds(userID int64, feedIDs []int64) error {
+	return s.reorder("feeds", "position=0, position ASC, parsing_error_count DESC, lower(title) ASC", userID, feedIDs)
+}
+
 // RemoveFeed removes a feed.
 func (s *Storage) RemoveFeed(userID, feedID int64) error {
 	query := `DELETE FROM feeds WHERE id = $1 AND user_id = $2`
@@ -94,23 +94,144 @@ func (s *Storage) CreateIcon(icon *model.Icon) error {
 	return nil
 }
 
+// FeedIconByFeedID returns the icon association of a feed with its caching headers.
+func (s *Storage) FeedIconByFeedID(feedID int64) (*model.FeedIcon, error) {
+	query := `
+		SELECT
+			feed_id,
+			icon_id,
+			etag_header,
+			last_modified_header,
+			checked_at
+		FROM feed_icons
+		WHERE feed_id=$1
+	`
+	var feedIcon model.FeedIcon
+	err := s.db.QueryRow(query, feedID).Scan(
+		&feedIcon.FeedID,
+		&feedIcon.IconID,
+		&feedIcon.EtagHeader,
+		&feedIcon.LastModifiedHeader,
+		&feedIcon.CheckedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch feed icon: %v`, err)
+	}
+
+	return &feedIcon, nil
+}
+
 // CreateFeedIcon creates an icon and associate the icon to the given feed.
 func (s *Storage) CreateFeedIcon(feedID int64, icon *model.Icon) error {
-	err := s.IconByHash(icon)
+	if err := s.findOrCreateIcon(icon); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO feed_icons
+			(feed_id, icon_id, etag_header, last_modified_header)
+		VALUES
+			($1, $2, $3, $4)
+	`
+	_, err := s.db.Exec(query, feedID, icon.ID, icon.EtagHeader, icon.LastModifiedHeader)
 	if err != nil {
+		return fmt.Errorf(`store: unable to create feed icon: %v`, err)
+	}
+
+	return nil
+}
+
+// UpdateFeedIcon replaces the icon of the given feed.
+// The previous icon is removed when no other feed uses it.
+func (s *Storage) UpdateFeedIcon(feedID int64, icon *model.Icon) error {
+	if err := s.findOrCreateIcon(icon); err != nil {
 		return err
 	}
 
-	if icon.ID == 0 {
-		err := s.CreateIcon(icon)
-		if err != nil {
-			return err
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	var previousIconID int64
+	err = tx.QueryRow(`SELECT icon_id FROM feed_icons WHERE feed_id=$1 FOR UPDATE`, feedID).Scan(&previousIconID)
+	if err != nil && err != sql.ErrNoRows {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to fetch feed icon: %v`, err)
+	}
+
+	query := `
+		UPDATE
+			feed_icons
+		SET
+			icon_id=$1,
+			etag_header=$2,
+			last_modified_header=$3,
+			checked_at=now()
+		WHERE
+			feed_id=$4
+	`
+	if _, err := tx.Exec(query, icon.ID, icon.EtagHeader, icon.LastModifiedHeader, feedID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to update feed icon: %v`, err)
+	}
+
+	if previousIconID != 0 && previousIconID != icon.ID {
+		query = `DELETE FROM icons WHERE id=$1 AND NOT EXISTS (SELECT 1 FROM feed_icons WHERE icon_id=$1)`
+		if _, err := tx.Exec(query, previousIconID); err != nil {
+			tx.Rollback()
+			return fmt.Errorf(`store: unable to remove icon #%d: %v`, previousIconID, err)
 		}
 	}
 
-	_, err = s.db.Exec(`INSERT INTO feed_icons (feed_id, icon_id) VALUES ($1, $2)`, feedID, icon.ID)
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// ExpireFeedIcon forces the revalidation of a feed icon during the next refresh.
+func (s *Storage) ExpireFeedIcon(feedID int64) error {
+	query := `
+		UPDATE
+			feed_icons
+		SET
+			etag_header='',
+			last_modified_header='',
+			checked_at='epoch'
+		WHERE
+			feed_id=$1
+	`
+	_, err := s.db.Exec(query, feedID)
 	if err != nil {
-		return fmt.Errorf(`store: unable to create feed icon: %v`, err)
+		return fmt.Errorf(`store: unable to expire feed icon: %v`, err)
+	}
+
+	return nil
+}
+
+// TouchFeedIcon updates the last check date of a feed icon that has not been modified.
+func (s *Storage) TouchFeedIcon(feedID int64) error {
+	_, err := s.db.Exec(`UPDATE feed_icons SET checked_at=now() WHERE feed_id=$1`, feedID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update feed icon: %v`, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) findOrCreateIcon(icon *model.Icon) error {
+	if err := s.IconByHash(icon); err != nil {
+		return err
+	}
+
+	if icon.ID == 0 {
+		return s.CreateIcon(icon)
 	}
 
 	return nil
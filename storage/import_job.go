@@ -0,0 +1,237 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+const importJobColumns = `id, user_id, status, total, created, skipped, failed, error_msg, created_at, updated_at`
+
+// CreateImportJob stores a new import job and the subscriptions to import.
+func (s *Storage) CreateImportJob(job *model.ImportJob, items []*model.ImportJobItem) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	query := `
+		INSERT INTO import_jobs
+			(user_id, status, total)
+		VALUES
+			($1, $2, $3)
+		RETURNING
+			` + importJobColumns
+
+	err = tx.QueryRow(query, job.UserID, model.ImportJobStatusPending, len(items)).Scan(
+		&job.ID,
+		&job.UserID,
+		&job.Status,
+		&job.Total,
+		&job.Created,
+		&job.Skipped,
+		&job.Failed,
+		&job.ErrorMsg,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to create import job: %v`, err)
+	}
+
+	query = `
+		INSERT INTO import_job_items
+			(job_id, title, feed_url, site_url, category, scraper_rules, rewrite_rules, crawler, user_agent)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+	for _, item := range items {
+		item.JobID = job.ID
+		item.Status = model.ImportItemStatusPending
+		_, err := tx.Exec(
+			query,
+			item.JobID,
+			item.Title,
+			item.FeedURL,
+			item.SiteURL,
+			item.CategoryName,
+			item.ScraperRules,
+			item.RewriteRules,
+			item.Crawler,
+			item.UserAgent,
+		)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf(`store: unable to create import job item %q: %v`, item.FeedURL, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to create import job: %v`, err)
+	}
+
+	return nil
+}
+
+// ImportJob returns an import job of the user.
+func (s *Storage) ImportJob(userID, jobID int64) (*model.ImportJob, error) {
+	query := `SELECT ` + importJobColumns + ` FROM import_jobs WHERE user_id=$1 AND id=$2`
+	return s.fetchImportJob(query, userID, jobID)
+}
+
+func (s *Storage) fetchImportJob(query string, args ...interface{}) (*model.ImportJob, error) {
+	var job model.ImportJob
+	err := s.db.QueryRow(query, args...).Scan(
+		&job.ID,
+		&job.UserID,
+		&job.Status,
+		&job.Total,
+		&job.Created,
+		&job.Skipped,
+		&job.Failed,
+		&job.ErrorMsg,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch import job: %v`, err)
+	default:
+		return &job, nil
+	}
+}
+
+// ImportNextJobItem claims the next pending subscription and passes it to the import function,
+// the result and the progress of the job are saved in the same transaction.
+//
+// Users take turns: the item comes from the user whose import made progress least recently.
+// The item stays locked during the import, so several instances never import the same subscription.
+// It returns false when there is nothing to import.
+func (s *Storage) ImportNextJobItem(importItem func(job *model.ImportJob, item *model.ImportJobItem) error) (bool, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	query := `
+		SELECT
+			i.id, i.job_id, i.title, i.feed_url, i.site_url, i.category, i.scraper_rules, i.rewrite_rules, i.crawler, i.user_agent, i.status,
+			j.user_id
+		FROM
+			import_job_items i
+		JOIN
+			import_jobs j ON j.id=i.job_id
+		WHERE
+			i.status=$1 AND j.status IN ($2, $3)
+		ORDER BY
+			(SELECT max(u.updated_at) FROM import_jobs u WHERE u.user_id=j.user_id AND u.status IN ($2, $3)) ASC,
+			i.job_id ASC,
+			i.id ASC
+		LIMIT 1
+		FOR UPDATE OF i SKIP LOCKED
+	`
+
+	var item model.ImportJobItem
+	job := &model.ImportJob{}
+	err = tx.QueryRow(query, model.ImportItemStatusPending, model.ImportJobStatusPending, model.ImportJobStatusRunning).Scan(
+		&item.ID,
+		&item.JobID,
+		&item.Title,
+		&item.FeedURL,
+		&item.SiteURL,
+		&item.CategoryName,
+		&item.ScraperRules,
+		&item.RewriteRules,
+		&item.Crawler,
+		&item.UserAgent,
+		&item.Status,
+		&job.UserID,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		tx.Rollback()
+		return false, nil
+	case err != nil:
+		tx.Rollback()
+		return false, fmt.Errorf(`store: unable to fetch import job item: %v`, err)
+	}
+
+	job.ID = item.JobID
+	job.Status = model.ImportJobStatusRunning
+	if err := importItem(job, &item); err != nil {
+		tx.Rollback()
+		return true, err
+	}
+
+	query = `UPDATE import_job_items SET status=$1 WHERE id=$2`
+	if _, err := tx.Exec(query, item.Status, item.ID); err != nil {
+		tx.Rollback()
+		return true, fmt.Errorf(`store: unable to update import job item #%d: %v`, item.ID, err)
+	}
+
+	// The counters are incremented, other instances may import the remaining items of the same job.
+	var created, skipped, failed int
+	switch item.Status {
+	case model.ImportItemStatusCreated:
+		created = 1
+	case model.ImportItemStatusSkipped:
+		skipped = 1
+	default:
+		failed = 1
+	}
+
+	query = `
+		UPDATE
+			import_jobs
+		SET
+			status=$1,
+			error_msg=$2,
+			created=created + $3,
+			skipped=skipped + $4,
+			failed=failed + $5,
+			updated_at=now()
+		WHERE
+			id=$6
+	`
+	_, err = tx.Exec(query, job.Status, job.ErrorMsg, created, skipped, failed, job.ID)
+	if err != nil {
+		tx.Rollback()
+		return true, fmt.Errorf(`store: unable to update import job #%d: %v`, job.ID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return true, fmt.Errorf(`store: unable to update import job #%d: %v`, job.ID, err)
+	}
+
+	return true, nil
+}
+
+// CompleteImportJobs marks the jobs without pending subscriptions as completed.
+func (s *Storage) CompleteImportJobs() error {
+	query := `
+		UPDATE
+			import_jobs j
+		SET
+			status=$1,
+			updated_at=now()
+		WHERE
+			j.status IN ($2, $3) AND
+			NOT EXISTS (SELECT 1 FROM import_job_items i WHERE i.job_id=j.id AND i.status=$4)
+	`
+	_, err := s.db.Exec(query, model.ImportJobStatusCompleted, model.ImportJobStatusPending, model.ImportJobStatusRunning, model.ImportItemStatusPending)
+	if err != nil {
+		return fmt.Errorf(`store: unable to complete import jobs: %v`, err)
+	}
+
+	return nil
+}
@@ -72,7 +72,43 @@ func (s *Storage) Integration(userID int64) (*model.Integration, error) {
 			pocket_consumer_key,
 			telegram_enabled,
 			telegram_token,
-			telegram_chat_id
+			telegram_chat_id,
+			telegram_topic_id,
+			discord_enabled,
+			discord_webhook_url,
+			webhook_enabled,
+			webhook_url,
+			webhook_secret,
+			pinboard_mark_read_on_save,
+			instapaper_mark_read_on_save,
+			wallabag_mark_read_on_save,
+			nunux_keeper_mark_read_on_save,
+			pocket_mark_read_on_save,
+			shaarli_enabled,
+			shaarli_url,
+			shaarli_api_secret,
+			shaarli_mark_read_on_save,
+			imap_enabled,
+			imap_server,
+			imap_username,
+			imap_password,
+			imap_mailbox,
+			imap_sender_filter,
+			imap_feed_id,
+			gotify_enabled,
+			gotify_url,
+			gotify_token,
+			gotify_priority,
+			ntfy_enabled,
+			ntfy_url,
+			ntfy_topic,
+			ntfy_token,
+			ntfy_priority,
+			apprise_enabled,
+			apprise_url,
+			apprise_services_url,
+			apprise_config_key,
+			apprise_tag
 		FROM
 			integrations
 		WHERE
@@ -107,6 +143,42 @@ func (s *Storage) Integration(userID int64) (*model.Integration, error) {
 		&integration.TelegramEnabled,
 		&integration.TelegramToken,
 		&integration.TelegramChatID,
+		&integration.TelegramTopicID,
+		&integration.DiscordEnabled,
+		&integration.DiscordWebhookURL,
+		&integration.WebhookEnabled,
+		&integration.WebhookURL,
+		&integration.WebhookSecret,
+		&integration.PinboardMarkReadOnSave,
+		&integration.InstapaperMarkReadOnSave,
+		&integration.WallabagMarkReadOnSave,
+		&integration.NunuxKeeperMarkReadOnSave,
+		&integration.PocketMarkReadOnSave,
+		&integration.ShaarliEnabled,
+		&integration.ShaarliURL,
+		&integration.ShaarliAPISecret,
+		&integration.ShaarliMarkReadOnSave,
+		&integration.IMAPEnabled,
+		&integration.IMAPServer,
+		&integration.IMAPUsername,
+		&integration.IMAPPassword,
+		&integration.IMAPMailbox,
+		&integration.IMAPSenderFilter,
+		&integration.IMAPFeedID,
+		&integration.GotifyEnabled,
+		&integration.GotifyURL,
+		&integration.GotifyToken,
+		&integration.GotifyPriority,
+		&integration.NtfyEnabled,
+		&integration.NtfyURL,
+		&integration.NtfyTopic,
+		&integration.NtfyToken,
+		&integration.NtfyPriority,
+		&integration.AppriseEnabled,
+		&integration.AppriseURL,
+		&integration.AppriseServicesURL,
+		&integration.AppriseConfigKey,
+		&integration.AppriseTag,
 	)
 	switch {
 	case err == sql.ErrNoRows:
@@ -149,9 +221,45 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 			pocket_consumer_key=$23,
 			telegram_enabled=$24,
 			telegram_token=$25,
-			telegram_chat_id=$26
+			telegram_chat_id=$26,
+			telegram_topic_id=$27,
+			discord_enabled=$28,
+			discord_webhook_url=$29,
+			webhook_enabled=$30,
+			webhook_url=$31,
+			webhook_secret=$32,
+			pinboard_mark_read_on_save=$33,
+			instapaper_mark_read_on_save=$34,
+			wallabag_mark_read_on_save=$35,
+			nunux_keeper_mark_read_on_save=$36,
+			pocket_mark_read_on_save=$37,
+			shaarli_enabled=$38,
+			shaarli_url=$39,
+			shaarli_api_secret=$40,
+			shaarli_mark_read_on_save=$41,
+			imap_enabled=$42,
+			imap_server=$43,
+			imap_username=$44,
+			imap_password=$45,
+			imap_mailbox=$46,
+			imap_sender_filter=$47,
+			imap_feed_id=$48,
+			gotify_enabled=$49,
+			gotify_url=$50,
+			gotify_token=$51,
+			gotify_priority=$52,
+			ntfy_enabled=$53,
+			ntfy_url=$54,
+			ntfy_topic=$55,
+			ntfy_token=$56,
+			ntfy_priority=$57,
+			apprise_enabled=$58,
+			apprise_url=$59,
+			apprise_services_url=$60,
+			apprise_config_key=$61,
+			apprise_tag=$62
 		WHERE
-			user_id=$27
+			user_id=$63
 	`
 	_, err := s.db.Exec(
 		query,
@@ -181,6 +289,42 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 		integration.TelegramEnabled,
 		integration.TelegramToken,
 		integration.TelegramChatID,
+		integration.TelegramTopicID,
+		integration.DiscordEnabled,
+		integration.DiscordWebhookURL,
+		integration.WebhookEnabled,
+		integration.WebhookURL,
+		integration.WebhookSecret,
+		integration.PinboardMarkReadOnSave,
+		integration.InstapaperMarkReadOnSave,
+		integration.WallabagMarkReadOnSave,
+		integration.NunuxKeeperMarkReadOnSave,
+		integration.PocketMarkReadOnSave,
+		integration.ShaarliEnabled,
+		integration.ShaarliURL,
+		integration.ShaarliAPISecret,
+		integration.ShaarliMarkReadOnSave,
+		integration.IMAPEnabled,
+		integration.IMAPServer,
+		integration.IMAPUsername,
+		integration.IMAPPassword,
+		integration.IMAPMailbox,
+		integration.IMAPSenderFilter,
+		integration.IMAPFeedID,
+		integration.GotifyEnabled,
+		integration.GotifyURL,
+		integration.GotifyToken,
+		integration.GotifyPriority,
+		integration.NtfyEnabled,
+		integration.NtfyURL,
+		integration.NtfyTopic,
+		integration.NtfyToken,
+		integration.NtfyPriority,
+		integration.AppriseEnabled,
+		integration.AppriseURL,
+		integration.AppriseServicesURL,
+		integration.AppriseConfigKey,
+		integration.AppriseTag,
 		integration.UserID,
 	)
 
@@ -212,7 +356,7 @@ func (s *Storage) HasSaveEntry(userID int64) (result bool) {
 		WHERE
 			user_id=$1
 		AND
-			(pinboard_enabled='t' OR instapaper_enabled='t' OR wallabag_enabled='t' OR nunux_keeper_enabled='t' OR pocket_enabled='t')
+			(pinboard_enabled='t' OR instapaper_enabled='t' OR wallabag_enabled='t' OR nunux_keeper_enabled='t' OR pocket_enabled='t' OR shaarli_enabled='t')
 	`
 	if err := s.db.QueryRow(query, userID).Scan(&result); err != nil {
 		result = false
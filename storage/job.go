@@ -7,24 +7,24 @@ package storage // import "miniflux.app/storage"
 import (
 	"fmt"
 
+	"miniflux.app/config"
 	"miniflux.app/model"
 )
 
-const maxParsingError = 3
-
 // NewBatch returns a serie of jobs.
 func (s *Storage) NewBatch(batchSize int) (jobs model.JobList, err error) {
 	query := `
 		SELECT
 			id,
-			user_id
+			user_id,
+			feed_url
 		FROM
 			feeds
 		WHERE
-			parsing_error_count < $1 AND disabled is false AND next_check_at < now()
+			($1 = 0 OR parsing_error_count < $1) AND disabled is false AND next_check_at < now()
 		ORDER BY next_check_at ASC LIMIT %d
 	`
-	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), maxParsingError)
+	return s.fetchBatchRows(fmt.Sprintf(query, batchSize), config.Opts.PollingParsingErrorLimit())
 }
 
 // NewUserBatch returns a serie of jobs but only for a given user.
@@ -34,7 +34,8 @@ func (s *Storage) NewUserBatch(userID int64, batchSize int) (jobs model.JobList,
 	query := `
 		SELECT
 			id,
-			user_id
+			user_id,
+			feed_url
 		FROM
 			feeds
 		WHERE
@@ -53,7 +54,7 @@ func (s *Storage) fetchBatchRows(query string, args ...interface{}) (jobs model.
 
 	for rows.Next() {
 		var job model.Job
-		if err := rows.Scan(&job.FeedID, &job.UserID); err != nil {
+		if err := rows.Scan(&job.FeedID, &job.UserID, &job.FeedURL); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch job: %v`, err)
 		}
 
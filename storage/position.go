@@ -0,0 +1,52 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// reorder renumbers the rows of the user from 1, the given IDs come first and the other rows keep the order defined by orderBy.
+// The rows are locked during the transaction to avoid duplicate positions when the items are reordered concurrently.
+// The IDs that don't belong to the user are ignored.
+func (s *Storage) reorder(table, orderBy string, userID int64, ids []int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	if _, err := tx.Exec(fmt.Sprintf(`SELECT id FROM %s WHERE user_id=$1 FOR UPDATE`, table), userID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to lock %s: %v`, table, err)
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE %[1]s t
+		SET
+			position=o.position
+		FROM (
+			SELECT
+				id,
+				row_number() OVER (ORDER BY array_position($2::bigint[], id) ASC NULLS LAST, %[2]s) AS position
+			FROM %[1]s
+			WHERE user_id=$1
+		) o
+		WHERE
+			t.id=o.id AND t.user_id=$1
+	`, table, orderBy)
+
+	if _, err := tx.Exec(query, userID, pq.Array(ids)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to reorder %s: %v`, table, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to reorder %s: %v`, table, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// AnotherSavedSearchExists checks if another saved search exists with the same title.
+func (s *Storage) AnotherSavedSearchExists(userID, searchID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM saved_searches WHERE user_id=$1 AND id != $2 AND title=$3`
+	s.db.QueryRow(query, userID, searchID, title).Scan(&result)
+	return result
+}
+
+// SavedSearch returns a saved search from the database.
+func (s *Storage) SavedSearch(userID, searchID int64) (*model.SavedSearch, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			title,
+			search_query,
+			COALESCE(feed_id, 0),
+			COALESCE(category_id, 0),
+			status,
+			starred,
+			created_at
+		FROM saved_searches
+		WHERE user_id=$1 AND id=$2
+	`
+	var search model.SavedSearch
+	err := s.db.QueryRow(query, userID, searchID).Scan(
+		&search.ID,
+		&search.UserID,
+		&search.Title,
+		&search.SearchQuery,
+		&search.FeedID,
+		&search.CategoryID,
+		&search.Status,
+		&search.Starred,
+		&search.CreatedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch saved search: %v`, err)
+	default:
+		return &search, nil
+	}
+}
+
+// SavedSearches returns all the saved searches of a user sorted by title.
+func (s *Storage) SavedSearches(userID int64) (model.SavedSearches, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			title,
+			search_query,
+			COALESCE(feed_id, 0),
+			COALESCE(category_id, 0),
+			status,
+			starred,
+			created_at
+		FROM saved_searches
+		WHERE user_id=$1
+		ORDER BY title ASC
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch saved searches: %v`, err)
+	}
+	defer rows.Close()
+
+	searches := make(model.SavedSearches, 0)
+	for rows.Next() {
+		var search model.SavedSearch
+		if err := rows.Scan(
+			&search.ID,
+			&search.UserID,
+			&search.Title,
+			&search.SearchQuery,
+			&search.FeedID,
+			&search.CategoryID,
+			&search.Status,
+			&search.Starred,
+			&search.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch saved search row: %v`, err)
+		}
+
+		searches = append(searches, &search)
+	}
+
+	return searches, nil
+}
+
+// CreateSavedSearch creates a new saved search.
+func (s *Storage) CreateSavedSearch(search *model.SavedSearch) error {
+	query := `
+		INSERT INTO saved_searches
+			(user_id, title, search_query, feed_id, category_id, status, starred)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		search.UserID,
+		search.Title,
+		search.SearchQuery,
+		nullableInt64(search.FeedID),
+		nullableInt64(search.CategoryID),
+		search.Status,
+		search.Starred,
+	).Scan(&search.ID, &search.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create saved search: %v`, err)
+	}
+
+	return nil
+}
+
+// UpdateSavedSearch updates an existing saved search.
+func (s *Storage) UpdateSavedSearch(search *model.SavedSearch) error {
+	query := `
+		UPDATE
+			saved_searches
+		SET
+			title=$1,
+			search_query=$2,
+			feed_id=$3,
+			category_id=$4,
+			status=$5,
+			starred=$6
+		WHERE
+			id=$7 AND user_id=$8
+		RETURNING
+			created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		search.Title,
+		search.SearchQuery,
+		nullableInt64(search.FeedID),
+		nullableInt64(search.CategoryID),
+		search.Status,
+		search.Starred,
+		search.ID,
+		search.UserID,
+	).Scan(&search.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to update saved search: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveSavedSearch deletes a saved search.
+func (s *Storage) RemoveSavedSearch(userID, searchID int64) error {
+	query := `DELETE FROM saved_searches WHERE id=$1 AND user_id=$2`
+	result, err := s.db.Exec(query, searchID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this saved search: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this saved search: %v`, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no saved search has been removed`)
+	}
+
+	return nil
+}
+
+// nullableInt64 stores zero values as NULL, for optional foreign keys.
+func nullableInt64(value int64) interface{} {
+	if value == 0 {
+		return nil
+	}
+	return value
+}
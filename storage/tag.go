@@ -0,0 +1,160 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"miniflux.app/model"
+)
+
+// TagExists checks if the given tag exists into the database.
+func (s *Storage) TagExists(userID, tagID int64) bool {
+	var result bool
+	query := `SELECT true FROM tags WHERE user_id=$1 AND id=$2`
+	s.db.QueryRow(query, userID, tagID).Scan(&result)
+	return result
+}
+
+// Tags returns all the tags of a user with the number of tagged entries.
+func (s *Storage) Tags(userID int64) (model.Tags, error) {
+	query := `
+		SELECT
+			t.id,
+			t.user_id,
+			t.name,
+			(SELECT count(*) FROM entry_tags et WHERE et.tag_id=t.id) AS count
+		FROM tags t
+		WHERE t.user_id=$1
+		ORDER BY t.name ASC
+	`
+	return s.fetchTags(query, userID)
+}
+
+// EntryTags returns the tags of an entry.
+func (s *Storage) EntryTags(userID, entryID int64) (model.Tags, error) {
+	query := `
+		SELECT
+			t.id,
+			t.user_id,
+			t.name,
+			(SELECT count(*) FROM entry_tags et WHERE et.tag_id=t.id) AS count
+		FROM tags t
+		JOIN entry_tags et ON et.tag_id=t.id
+		WHERE t.user_id=$1 AND et.entry_id=$2
+		ORDER BY t.name ASC
+	`
+	return s.fetchTags(query, userID, entryID)
+}
+
+// AddEntryTags attaches the tags to an entry, the missing tags are created.
+// The names must be normalized, the tags already attached to the entry are ignored.
+func (s *Storage) AddEntryTags(userID, entryID int64, names []string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	query := `
+		INSERT INTO tags
+			(user_id, name)
+		SELECT
+			$1, unnest($2::text[])
+		ON CONFLICT (user_id, name) DO NOTHING
+	`
+	if _, err := tx.Exec(query, userID, pq.Array(names)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to create tags %v: %v`, names, err)
+	}
+
+	query = `
+		INSERT INTO entry_tags
+			(entry_id, tag_id)
+		SELECT
+			e.id, t.id
+		FROM entries e
+		JOIN tags t ON t.user_id=e.user_id
+		WHERE e.user_id=$1 AND e.id=$2 AND t.name=ANY($3)
+		ON CONFLICT (entry_id, tag_id) DO NOTHING
+	`
+	if _, err := tx.Exec(query, userID, entryID, pq.Array(names)); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to tag entry #%d: %v`, entryID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to tag entry #%d: %v`, entryID, err)
+	}
+
+	return nil
+}
+
+// RemoveEntryTag detaches a tag from an entry, the tag itself is kept.
+func (s *Storage) RemoveEntryTag(userID, entryID, tagID int64) error {
+	query := `
+		DELETE FROM entry_tags
+		WHERE
+			entry_id=$1 AND
+			tag_id=(SELECT id FROM tags WHERE user_id=$2 AND id=$3)
+	`
+	result, err := s.db.Exec(query, entryID, userID, tagID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove tag #%d from entry #%d: %v`, tagID, entryID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove tag #%d from entry #%d: %v`, tagID, entryID, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no tag has been removed`)
+	}
+
+	return nil
+}
+
+// RemoveTag deletes a tag and detaches it from all the entries.
+func (s *Storage) RemoveTag(userID, tagID int64) error {
+	query := `DELETE FROM tags WHERE id=$1 AND user_id=$2`
+	result, err := s.db.Exec(query, tagID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this tag: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this tag: %v`, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no tag has been removed`)
+	}
+
+	return nil
+}
+
+func (s *Storage) fetchTags(query string, args ...interface{}) (model.Tags, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch tags: %v`, err)
+	}
+	defer rows.Close()
+
+	tags := make(model.Tags, 0)
+	for rows.Next() {
+		var tag model.Tag
+		if err := rows.Scan(&tag.ID, &tag.UserID, &tag.Name, &tag.EntryCount); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch tag row: %v`, err)
+		}
+
+		tags = append(tags, &tag)
+	}
+
+	return tags, nil
+}
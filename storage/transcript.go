@@ -0,0 +1,91 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// GetTranscripts returns all transcripts for the given entry.
+func (s *Storage) GetTranscripts(entryID int64) (model.TranscriptList, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			entry_id,
+			url,
+			mime_type,
+			language
+		FROM
+			entry_transcripts
+		WHERE
+			entry_id = $1
+		ORDER BY id ASC
+	`
+
+	rows, err := s.db.Query(query, entryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch transcripts: %v`, err)
+	}
+	defer rows.Close()
+
+	transcripts := make(model.TranscriptList, 0)
+	for rows.Next() {
+		var transcript model.Transcript
+		err := rows.Scan(
+			&transcript.ID,
+			&transcript.UserID,
+			&transcript.EntryID,
+			&transcript.URL,
+			&transcript.MimeType,
+			&transcript.Language,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch transcript row: %v`, err)
+		}
+
+		transcripts = append(transcripts, &transcript)
+	}
+
+	return transcripts, nil
+}
+
+// UpdateTranscripts adds the missing transcripts of an entry.
+func (s *Storage) UpdateTranscripts(entry *model.Entry) error {
+	query := `
+		INSERT INTO entry_transcripts
+			(url, mime_type, language, entry_id, user_id)
+		VALUES
+			($1, $2, $3, $4, $5)
+		ON CONFLICT (entry_id, url) DO NOTHING
+	`
+
+	for _, transcript := range entry.Transcripts {
+		if transcript.URL == "" {
+			continue
+		}
+
+		transcript.EntryID = entry.ID
+		transcript.UserID = entry.UserID
+
+		_, err := s.db.Exec(
+			query,
+			transcript.URL,
+			transcript.MimeType,
+			transcript.Language,
+			transcript.EntryID,
+			transcript.UserID,
+		)
+
+		if err != nil {
+			return fmt.Errorf(`store: unable to create transcript %q: %v`, transcript.URL, err)
+		}
+	}
+
+	return nil
+}
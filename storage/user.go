@@ -65,7 +65,7 @@ func (s *Storage) CreateUser(user *model.User) (err error) {
 		VALUES
 			(LOWER($1), $2, $3, $4)
 		RETURNING
-			id, username, is_admin, language, theme, timezone, entry_direction, entries_per_page, keyboard_shortcuts, show_reading_time
+			id, username, is_admin, language, theme, timezone, entry_direction, entries_per_page, keyboard_shortcuts, show_reading_time, entry_deduplication, mark_read_on_scroll
 	`
 
 	err = s.db.QueryRow(query, user.Username, password, user.IsAdmin, extra).Scan(
@@ -79,6 +79,8 @@ func (s *Storage) CreateUser(user *model.User) (err error) {
 		&user.EntriesPerPage,
 		&user.KeyboardShortcuts,
 		&user.ShowReadingTime,
+		&user.EntryDeduplication,
+		&user.MarkReadOnScroll,
 	)
 	if err != nil {
 		return fmt.Errorf(`store: unable to create user: %v`, err)
@@ -128,9 +130,11 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				entry_direction=$7,
 				entries_per_page=$8,
 				keyboard_shortcuts=$9,
-				show_reading_time=$10
+				show_reading_time=$10,
+				entry_deduplication=$11,
+				mark_read_on_scroll=$12
 			WHERE
-				id=$11
+				id=$13
 		`
 
 		_, err = s.db.Exec(
@@ -145,6 +149,8 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.EntriesPerPage,
 			user.KeyboardShortcuts,
 			user.ShowReadingTime,
+			user.EntryDeduplication,
+			user.MarkReadOnScroll,
 			user.ID,
 		)
 		if err != nil {
@@ -161,9 +167,11 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				entry_direction=$6,
 				entries_per_page=$7,
 				keyboard_shortcuts=$8,
-				show_reading_time=$9
+				show_reading_time=$9,
+				entry_deduplication=$10,
+				mark_read_on_scroll=$11
 			WHERE
-				id=$10
+				id=$12
 		`
 
 		_, err := s.db.Exec(
@@ -177,6 +185,8 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.EntriesPerPage,
 			user.KeyboardShortcuts,
 			user.ShowReadingTime,
+			user.EntryDeduplication,
+			user.MarkReadOnScroll,
 			user.ID,
 		)
 
@@ -216,6 +226,8 @@ func (s *Storage) UserByID(userID int64) (*model.User, error) {
 			entries_per_page,
 			keyboard_shortcuts,
 			show_reading_time,
+			entry_deduplication,
+			mark_read_on_scroll,
 			last_login_at,
 			extra
 		FROM
@@ -240,6 +252,8 @@ func (s *Storage) UserByUsername(username string) (*model.User, error) {
 			entries_per_page,
 			keyboard_shortcuts,
 			show_reading_time,
+			entry_deduplication,
+			mark_read_on_scroll,
 			last_login_at,
 			extra
 		FROM
@@ -264,6 +278,8 @@ func (s *Storage) UserByExtraField(field, value string) (*model.User, error) {
 			entries_per_page,
 			keyboard_shortcuts,
 			show_reading_time,
+			entry_deduplication,
+			mark_read_on_scroll,
 			last_login_at,
 			extra
 		FROM
@@ -288,6 +304,8 @@ func (s *Storage) UserByAPIKey(token string) (*model.User, error) {
 			u.entries_per_page,
 			u.keyboard_shortcuts,
 			u.show_reading_time,
+			u.entry_deduplication,
+			u.mark_read_on_scroll,
 			u.last_login_at,
 			u.extra
 		FROM
@@ -315,6 +333,8 @@ func (s *Storage) fetchUser(query string, args ...interface{}) (*model.User, err
 		&user.EntriesPerPage,
 		&user.KeyboardShortcuts,
 		&user.ShowReadingTime,
+		&user.EntryDeduplication,
+		&user.MarkReadOnScroll,
 		&user.LastLoginAt,
 		&extra,
 	)
@@ -381,6 +401,8 @@ func (s *Storage) Users() (model.Users, error) {
 			entries_per_page,
 			keyboard_shortcuts,
 			show_reading_time,
+			entry_deduplication,
+			mark_read_on_scroll,
 			last_login_at,
 			extra
 		FROM
@@ -408,6 +430,8 @@ func (s *Storage) Users() (model.Users, error) {
 			&user.EntriesPerPage,
 			&user.KeyboardShortcuts,
 			&user.ShowReadingTime,
+			&user.EntryDeduplication,
+			&user.MarkReadOnScroll,
 			&user.LastLoginAt,
 			&extra,
 		)
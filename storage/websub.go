@@ -0,0 +1,109 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// WebSubSubscription returns the WebSub subscription of a feed.
+func (s *Storage) WebSubSubscription(feedID int64) (*model.WebSubSubscription, error) {
+	query := `
+		SELECT
+			feed_id,
+			user_id,
+			secret,
+			token,
+			pending,
+			lease_seconds,
+			expires_at
+		FROM
+			websub_subscriptions
+		WHERE
+			feed_id=$1
+	`
+
+	var subscription model.WebSubSubscription
+	err := s.db.QueryRow(query, feedID).Scan(
+		&subscription.FeedID,
+		&subscription.UserID,
+		&subscription.Secret,
+		&subscription.Token,
+		&subscription.Pending,
+		&subscription.LeaseSeconds,
+		&subscription.ExpiresAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch WebSub subscription of feed #%d: %v`, feedID, err)
+	default:
+		return &subscription, nil
+	}
+}
+
+// CreateWebSubSubscription stores a new subscription request, it stays pending until the hub verifies it.
+// The current lease is kept while renewing, so the hub can still push content until then.
+func (s *Storage) CreateWebSubSubscription(subscription *model.WebSubSubscription) error {
+	query := `
+		INSERT INTO websub_subscriptions
+			(feed_id, user_id, secret, token, pending, lease_seconds, expires_at)
+		VALUES
+			($1, $2, $3, $4, 't', 0, now())
+		ON CONFLICT (feed_id) DO UPDATE SET
+			secret=EXCLUDED.secret,
+			token=EXCLUDED.token,
+			pending='t'
+		RETURNING
+			pending, lease_seconds, expires_at
+	`
+	err := s.db.QueryRow(
+		query,
+		subscription.FeedID,
+		subscription.UserID,
+		subscription.Secret,
+		subscription.Token,
+	).Scan(&subscription.Pending, &subscription.LeaseSeconds, &subscription.ExpiresAt)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create WebSub subscription of feed #%d: %v`, subscription.FeedID, err)
+	}
+
+	return nil
+}
+
+// UpdateWebSubLease records the lease granted by the hub, the subscription is no longer pending.
+func (s *Storage) UpdateWebSubLease(feedID int64, leaseSeconds int) error {
+	query := `
+		UPDATE
+			websub_subscriptions
+		SET
+			pending='f',
+			lease_seconds=$1,
+			expires_at=now() + $1 * interval '1 second'
+		WHERE
+			feed_id=$2
+	`
+	if _, err := s.db.Exec(query, leaseSeconds, feedID); err != nil {
+		return fmt.Errorf(`store: unable to update WebSub lease of feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+// RemoveWebSubSubscription deletes the WebSub subscription of a feed.
+func (s *Storage) RemoveWebSubSubscription(feedID int64) error {
+	query := `DELETE FROM websub_subscriptions WHERE feed_id=$1`
+	if _, err := s.db.Exec(query, feedID); err != nil {
+		return fmt.Errorf(`store: unable to remove WebSub subscription of feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
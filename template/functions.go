@@ -17,6 +17,7 @@ import (
 	"miniflux.app/http/route"
 	"miniflux.app/locale"
 	"miniflux.app/model"
+	"miniflux.app/reader/srcset"
 	"miniflux.app/timezone"
 	"miniflux.app/url"
 
@@ -55,12 +56,18 @@ func (f *funcMap) Map() template.FuncMap {
 			return template.HTML(str)
 		},
 		"proxyFilter": func(data string) string {
-			return imageProxyFilter(f.router, data)
+			return mediaProxyFilter(f.router, data)
 		},
 		"proxyURL": func(link string) string {
-			proxyImages := config.Opts.ProxyImages()
+			if shouldProxy("image", link) {
+				return proxify(f.router, link)
+			}
 
-			if proxyImages == "all" || (proxyImages != "none" && !url.IsHTTPS(link)) {
+			return link
+		},
+		"proxyMediaURL": func(mimeType, link string) string {
+			mediaType := strings.SplitN(mimeType, "/", 2)[0]
+			if shouldProxy(mediaType, link) {
 				return proxify(f.router, link)
 			}
 
@@ -92,9 +99,6 @@ func (f *funcMap) Map() template.FuncMap {
 		"plural": func(key string, n int, args ...interface{}) string {
 			return ""
 		},
-		"timeToRead": func(content string) int {
-			return 0
-		},
 	}
 }
 
@@ -180,9 +184,8 @@ func elapsedTime(printer *locale.Printer, tz string, t time.Time) string {
 	}
 }
 
-func imageProxyFilter(router *mux.Router, data string) string {
-	proxyImages := config.Opts.ProxyImages()
-	if proxyImages == "none" {
+func mediaProxyFilter(router *mux.Router, data string) string {
+	if config.Opts.ProxyImages() == "none" {
 		return data
 	}
 
@@ -192,17 +195,71 @@ func imageProxyFilter(router *mux.Router, data string) string {
 	}
 
 	doc.Find("img").Each(func(i int, img *goquery.Selection) {
-		if srcAttr, ok := img.Attr("src"); ok {
-			if proxyImages == "all" || !url.IsHTTPS(srcAttr) {
-				img.SetAttr("src", proxify(router, srcAttr))
-			}
-		}
+		proxifyAttribute(router, img, "src", "image")
+		proxifySrcset(router, img)
+	})
+
+	for _, mediaType := range []string{"audio", "video"} {
+		doc.Find(mediaType).Each(func(i int, media *goquery.Selection) {
+			proxifyAttribute(router, media, "src", mediaType)
+			media.Find("source").Each(func(i int, source *goquery.Selection) {
+				proxifyAttribute(router, source, "src", mediaType)
+			})
+		})
+	}
+
+	doc.Find("video").Each(func(i int, video *goquery.Selection) {
+		proxifyAttribute(router, video, "poster", "image")
 	})
 
 	output, _ := doc.Find("body").First().Html()
 	return output
 }
 
+func proxifyAttribute(router *mux.Router, element *goquery.Selection, attribute, mediaType string) {
+	if link, ok := element.Attr(attribute); ok && shouldProxy(mediaType, link) {
+		element.SetAttr(attribute, proxify(router, link))
+	}
+}
+
+// proxifySrcset proxifies each image candidate of the srcset attribute,
+// malformed values are left unchanged.
+func proxifySrcset(router *mux.Router, element *goquery.Selection) {
+	value, ok := element.Attr("srcset")
+	if !ok {
+		return
+	}
+
+	candidates, err := srcset.Parse(value)
+	if err != nil {
+		return
+	}
+
+	for _, candidate := range candidates {
+		if shouldProxy("image", candidate.URL) {
+			candidate.URL = proxify(router, candidate.URL)
+		}
+	}
+
+	element.SetAttr("srcset", candidates.String())
+}
+
+// shouldProxy returns true if the given media type must be proxied according to PROXY_IMAGES and PROXY_MEDIA_TYPES.
+func shouldProxy(mediaType, link string) bool {
+	proxyImages := config.Opts.ProxyImages()
+	if proxyImages == "none" || link == "" {
+		return false
+	}
+
+	for _, proxyMediaType := range config.Opts.ProxyMediaTypes() {
+		if proxyMediaType == mediaType {
+			return proxyImages == "all" || url.IsHTTP(link)
+		}
+	}
+
+	return false
+}
+
 func proxify(router *mux.Router, link string) string {
 	// We use base64 url encoding to avoid slash in the URL.
 	return route.Path(router, "proxy", "encodedURL", base64.URLEncoding.EncodeToString([]byte(link)))
@@ -221,9 +278,3 @@ func formatFileSize(b int64) string {
 	return fmt.Sprintf("%.1f %ciB",
 		float64(b)/float64(div), "KMGTPE"[exp])
 }
-
-func timeToRead(content string) int {
-	nbOfWords := len(strings.Fields(content))
-
-	return int(math.Ceil(float64(nbOfWords) / 265))
-}
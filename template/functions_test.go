@@ -146,7 +146,7 @@ func TestProxyFilterWithHttpDefault(t *testing.T) {
 	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
+	output := mediaProxyFilter(r, input)
 	expected := `<p><img src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
 
 	if expected != output {
@@ -169,7 +169,7 @@ func TestProxyFilterWithHttpsDefault(t *testing.T) {
 	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
+	output := mediaProxyFilter(r, input)
 	expected := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
 
 	if expected != output {
@@ -177,6 +177,98 @@ func TestProxyFilterWithHttpsDefault(t *testing.T) {
 	}
 }
 
+func TestProxyFilterWithDataURIHttpOnly(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "http-only")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<p><img src="data:image/png;base64,iVBORw0KGgo=" alt="Test"/></p>`
+	output := mediaProxyFilter(r, input)
+	expected := `<p><img src="data:image/png;base64,iVBORw0KGgo=" alt="Test"/></p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestProxyFilterWithRelativeURLHttpOnly(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "http-only")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<p><img src="/folder/image.png" alt="Test"/></p>`
+	output := mediaProxyFilter(r, input)
+	expected := `<p><img src="/folder/image.png" alt="Test"/></p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestProxyFilterWithUppercaseHttpSchemeHttpOnly(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "http-only")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<p><img src="HTTP://website/folder/image.png" alt="Test"/></p>`
+	output := mediaProxyFilter(r, input)
+	expected := `<p><img src="/proxy/SFRUUDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestProxyFilterWithMixedSrcsetHttpOnly(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "http-only")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<p><img src="https://website/folder/image.png" srcset="http://website/folder/image.png 1x, https://website/folder/image@2x.png 2x" alt="Test"/></p>`
+	output := mediaProxyFilter(r, input)
+	expected := `<p><img src="https://website/folder/image.png" srcset="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw== 1x, https://website/folder/image@2x.png 2x" alt="Test"/></p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
 func TestProxyFilterWithHttpNever(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "none")
@@ -192,7 +284,7 @@ func TestProxyFilterWithHttpNever(t *testing.T) {
 	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
+	output := mediaProxyFilter(r, input)
 	expected := input
 
 	if expected != output {
@@ -215,7 +307,7 @@ func TestProxyFilterWithHttpsNever(t *testing.T) {
 	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
+	output := mediaProxyFilter(r, input)
 	expected := input
 
 	if expected != output {
@@ -238,7 +330,7 @@ func TestProxyFilterWithHttpAlways(t *testing.T) {
 	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
+	output := mediaProxyFilter(r, input)
 	expected := `<p><img src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
 
 	if expected != output {
@@ -261,7 +353,7 @@ func TestProxyFilterWithHttpsAlways(t *testing.T) {
 	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
+	output := mediaProxyFilter(r, input)
 	expected := `<p><img src="/proxy/aHR0cHM6Ly93ZWJzaXRlL2ZvbGRlci9pbWFnZS5wbmc=" alt="Test"/></p>`
 
 	if expected != output {
@@ -269,6 +361,29 @@ func TestProxyFilterWithHttpsAlways(t *testing.T) {
 	}
 }
 
+func TestProxyFilterWithSrcset(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "all")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<p><img src="https://website/folder/image.png" srcset="https://website/folder/image.png 1x, https://website/folder/image@2x.png 2x" alt="Test"/></p>`
+	output := mediaProxyFilter(r, input)
+	expected := `<p><img src="/proxy/aHR0cHM6Ly93ZWJzaXRlL2ZvbGRlci9pbWFnZS5wbmc=" srcset="/proxy/aHR0cHM6Ly93ZWJzaXRlL2ZvbGRlci9pbWFnZS5wbmc= 1x, /proxy/aHR0cHM6Ly93ZWJzaXRlL2ZvbGRlci9pbWFnZUAyeC5wbmc= 2x" alt="Test"/></p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
 func TestProxyFilterWithHttpInvalid(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "invalid")
@@ -284,7 +399,7 @@ func TestProxyFilterWithHttpInvalid(t *testing.T) {
 	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
+	output := mediaProxyFilter(r, input)
 	expected := `<p><img src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
 
 	if expected != output {
@@ -307,7 +422,7 @@ func TestProxyFilterWithHttpsInvalid(t *testing.T) {
 	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
-	output := imageProxyFilter(r, input)
+	output := mediaProxyFilter(r, input)
 	expected := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
 
 	if expected != output {
@@ -315,6 +430,126 @@ func TestProxyFilterWithHttpsInvalid(t *testing.T) {
 	}
 }
 
+func TestProxyFilterWithAudioElement(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "http-only")
+	os.Setenv("PROXY_MEDIA_TYPES", "image,audio")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<audio src="http://website/folder/audio.mp3"></audio>`
+	output := mediaProxyFilter(r, input)
+	expected := `<audio src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2F1ZGlvLm1wMw=="></audio>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestProxyFilterWithAudioSourceElement(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "http-only")
+	os.Setenv("PROXY_MEDIA_TYPES", "audio")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<audio controls=""><source src="http://website/folder/audio.mp3" type="audio/mpeg"/></audio>`
+	output := mediaProxyFilter(r, input)
+	expected := `<audio controls=""><source src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2F1ZGlvLm1wMw==" type="audio/mpeg"/></audio>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestProxyFilterWithVideoElement(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "all")
+	os.Setenv("PROXY_MEDIA_TYPES", "image,video")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<video src="http://website/folder/video.mp4" poster="http://website/folder/poster.png"><source src="http://website/folder/video.mp4" type="video/mp4"/></video>`
+	output := mediaProxyFilter(r, input)
+	expected := `<video src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL3ZpZGVvLm1wNA==" poster="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL3Bvc3Rlci5wbmc="><source src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL3ZpZGVvLm1wNA==" type="video/mp4"/></video>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestProxyFilterWithVideoPosterOnly(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "http-only")
+	os.Setenv("PROXY_MEDIA_TYPES", "image")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<video src="http://website/folder/video.mp4" poster="http://website/folder/poster.png"></video>`
+	output := mediaProxyFilter(r, input)
+	expected := `<video src="http://website/folder/video.mp4" poster="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL3Bvc3Rlci5wbmc="></video>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestProxyFilterWithoutImageMediaType(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "all")
+	os.Setenv("PROXY_MEDIA_TYPES", "audio")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
+	output := mediaProxyFilter(r, input)
+	expected := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
 func TestFormatFileSize(t *testing.T) {
 	scenarios := []struct {
 		input    int64
@@ -81,6 +81,41 @@ func TestUpdateCategory(t *testing.T) {
 	}
 }
 
+func TestUpdateCategoryNotificationSettings(t *testing.T) {
+	client := createClient(t)
+	category, err := client.CreateCategory("My category")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	category.TelegramChatID = "-1001234"
+	category.WebhookURL = "https://example.org/hook"
+	category, err = client.UpdateCategorySettings(category.ID, category)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range categories {
+		if c.ID == category.ID {
+			if c.TelegramChatID != "-1001234" {
+				t.Errorf(`Invalid Telegram chat ID, got %q`, c.TelegramChatID)
+			}
+
+			if c.WebhookURL != "https://example.org/hook" {
+				t.Errorf(`Invalid webhook URL, got %q`, c.WebhookURL)
+			}
+			return
+		}
+	}
+
+	t.Fatalf(`The category #%d is missing`, category.ID)
+}
+
 func TestListCategories(t *testing.T) {
 	categoryName := "My category"
 	client := createClient(t)
@@ -151,3 +186,53 @@ func TestCannotDeleteCategoryOfAnotherUser(t *testing.T) {
 		t.Fatal(`Removing a category that belongs to another user should be forbidden`)
 	}
 }
+
+func TestReorderCategories(t *testing.T) {
+	client := createClient(t)
+
+	first, err := client.CreateCategory("A category")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := client.CreateCategory("B category")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.ReorderCategories([]int64{second.ID, first.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(categories) != 3 {
+		t.Fatalf(`Invalid number of categories, got "%v" instead of "%v"`, len(categories), 3)
+	}
+
+	expected := []string{"B category", "A category", "All"}
+	for i, category := range categories {
+		if category.Title != expected[i] {
+			t.Fatalf(`Invalid category at position %d, got "%v" instead of "%v"`, i, category.Title, expected[i])
+		}
+
+		if category.Position != i+1 {
+			t.Fatalf(`Invalid position for %q, got "%v" instead of "%v"`, category.Title, category.Position, i+1)
+		}
+	}
+}
+
+func TestReorderCategoriesWithDuplicates(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.ReorderCategories([]int64{categories[0].ID, categories[0].ID}); err == nil {
+		t.Fatal(`Duplicated IDs should not be allowed`)
+	}
+}
@@ -7,6 +7,9 @@
 package tests
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	miniflux "miniflux.app/client"
@@ -151,6 +154,111 @@ func TestSearchEntries(t *testing.T) {
 	if results.Total != 1 {
 		t.Fatalf(`We should have only one entry instead of %d`, results.Total)
 	}
+
+	if results.Entries[0].Snippet == "" {
+		t.Fatal(`The search results should contain a snippet`)
+	}
+}
+
+func TestSearchEntriesSnippet(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	results, err := client.Entries(&miniflux.Filter{Search: "miniflux"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total == 0 {
+		t.Fatal(`The search should return some entries`)
+	}
+
+	highlighted := false
+	for _, entry := range results.Entries {
+		if strings.Contains(entry.Snippet, "<mark>") {
+			highlighted = true
+		}
+
+		if strings.Contains(strings.Replace(strings.Replace(entry.Snippet, "<mark>", "", -1), "</mark>", "", -1), "<") {
+			t.Fatalf(`The snippet should not contain HTML tags, got %q`, entry.Snippet)
+		}
+	}
+
+	if !highlighted {
+		t.Fatal(`The snippets should highlight the search terms`)
+	}
+
+	results, err = client.Entries(&miniflux.Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range results.Entries {
+		if entry.Snippet != "" {
+			t.Fatalf(`The snippet should be empty without search query, got %q`, entry.Snippet)
+		}
+	}
+}
+
+func TestSearchEntriesWithinFeedOrCategory(t *testing.T) {
+	client := createClient(t)
+	firstFeed, _ := createFeed(t, client)
+
+	category, err := client.CreateCategory("Search within category")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The same entries are published by both feeds.
+	secondFeedID, err := client.CreateFeed(testFeedURL+"?copy=1", category.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := client.Entries(&miniflux.Filter{Search: "2.0.8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 2 {
+		t.Fatalf(`The term should be found in both feeds, got %d entries`, results.Total)
+	}
+
+	results, err = client.Entries(&miniflux.Filter{Search: "2.0.8", FeedID: firstFeed.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 1 || results.Entries[0].FeedID != firstFeed.ID {
+		t.Fatalf(`Only the entry of the feed #%d should be returned, got %d entries`, firstFeed.ID, results.Total)
+	}
+
+	results, err = client.Entries(&miniflux.Filter{Search: "2.0.8", CategoryID: category.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 1 || results.Entries[0].FeedID != secondFeedID {
+		t.Fatalf(`Only the entry of the category #%d should be returned, got %d entries`, category.ID, results.Total)
+	}
+
+	results, err = client.Entries(&miniflux.Filter{Search: "2.0.8", FeedID: secondFeedID, Starred: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 0 {
+		t.Fatalf(`The feed filter should compose with the starred filter, got %d entries`, results.Total)
+	}
+
+	results, err = client.Entries(&miniflux.Filter{Search: "2.0.8", FeedID: secondFeedID, Status: miniflux.EntryStatusUnread})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 1 {
+		t.Fatalf(`The feed filter should compose with the status filter, got %d entries`, results.Total)
+	}
 }
 
 func TestInvalidFilters(t *testing.T) {
@@ -230,6 +338,74 @@ func TestUpdateStatus(t *testing.T) {
 	}
 }
 
+func TestMarkEntriesAsRead(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 2, Status: miniflux.EntryStatusUnread})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryIDs := []int64{result.Entries[0].ID, result.Entries[1].ID}
+	updated, err := client.MarkEntriesAsRead(entryIDs, miniflux.ReadSourceManual)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated != 2 {
+		t.Fatalf(`Invalid number of updated entries, got %d instead of 2`, updated)
+	}
+
+	updated, err = client.MarkEntriesAsRead(entryIDs, miniflux.ReadSourceView)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated != 0 {
+		t.Fatalf(`The entries are already read, got %d updated entries`, updated)
+	}
+
+	if _, err := client.MarkEntriesAsRead(entryIDs, "invalid"); err == nil {
+		t.Fatal(`Invalid read source should not be accepted`)
+	}
+}
+
+func TestMarkEntriesAsReadOnScroll(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryIDs := []int64{result.Entries[0].ID}
+	if _, err := client.MarkEntriesAsRead(entryIDs, miniflux.ReadSourceScroll); err == nil {
+		t.Fatal(`Marking entries as read while scrolling should be disabled by default`)
+	}
+
+	user, err := client.Me()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enabled := true
+	adminClient := miniflux.New(testBaseURL, testAdminUsername, testAdminPassword)
+	if _, err := adminClient.UpdateUser(user.ID, &miniflux.UserModification{MarkReadOnScroll: &enabled}); err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := client.MarkEntriesAsRead(entryIDs, miniflux.ReadSourceScroll)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated != 1 {
+		t.Fatalf(`Invalid number of updated entries, got %d instead of 1`, updated)
+	}
+}
+
 func TestToggleBookmark(t *testing.T) {
 	client := createClient(t)
 	createFeed(t, client)
@@ -283,3 +459,89 @@ func TestHistoryOrder(t *testing.T) {
 		t.Fatal("The entry that we just read should be at the top of the history")
 	}
 }
+
+func TestGetFeedEntriesWithFeedSortOrder(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	sortOrder := miniflux.FeedSortOrderPublishedAsc
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{SortOrder: &sortOrder}); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := client.FeedEntries(feed.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 1; i < len(results.Entries); i++ {
+		if results.Entries[i].Date.Before(results.Entries[i-1].Date) {
+			t.Fatalf(`The entries should be sorted by publication date in ascending order`)
+		}
+	}
+
+	results, err = client.FeedEntries(feed.ID, &miniflux.Filter{Direction: "desc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 1; i < len(results.Entries); i++ {
+		if results.Entries[i].Date.After(results.Entries[i-1].Date) {
+			t.Fatalf(`An explicit direction should override the feed sort order`)
+		}
+	}
+}
+
+func TestGetEntryTranscripts(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?>
+			<rss version="2.0" xmlns:podcast="https://podcastindex.org/namespace/1.0">
+				<channel>
+					<title>Podcast</title>
+					<link>https://example.org/</link>
+					<item>
+						<title>Episode 1</title>
+						<link>https://example.org/episode1</link>
+						<guid>episode1</guid>
+						<podcast:transcript url="https://example.org/episode1.vtt" type="text/vtt" language="en" />
+					</item>
+				</channel>
+			</rss>`))
+	}))
+	defer ts.Close()
+
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreateFeed(ts.URL, categories[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.FeedEntries(feedID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Entries) != 1 {
+		t.Fatalf(`Invalid number of entries, got %d`, len(result.Entries))
+	}
+
+	entry, err := client.Entry(result.Entries[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entry.Transcripts) != 1 {
+		t.Fatalf(`Invalid number of transcripts, got %d`, len(entry.Transcripts))
+	}
+
+	transcript := entry.Transcripts[0]
+	if transcript.URL != "https://example.org/episode1.vtt" || transcript.MimeType != "text/vtt" || transcript.Language != "en" {
+		t.Errorf(`Unexpected transcript: %+v`, transcript)
+	}
+}
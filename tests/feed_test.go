@@ -7,6 +7,8 @@
 package tests
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -116,6 +118,39 @@ func TestUpdateFeedTitle(t *testing.T) {
 	}
 }
 
+func TestCreateFeedWithLanguage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/feed+json")
+		w.Write([]byte(`{
+			"version": "https://jsonfeed.org/version/1.1",
+			"title": "JSON Feed",
+			"language": "fr-FR",
+			"items": [{"id": "1", "url": "https://example.org/1", "content_text": "Bonjour"}]
+		}`))
+	}))
+	defer ts.Close()
+
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreateFeed(ts.URL, categories[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed, err := client.Feed(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Language != "fr-FR" {
+		t.Fatalf(`Wrong language, got %q instead of "fr-FR"`, feed.Language)
+	}
+}
+
 func TestUpdateFeedCrawler(t *testing.T) {
 	client := createClient(t)
 	feed, _ := createFeed(t, client)
@@ -126,8 +161,12 @@ func TestUpdateFeedCrawler(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if updatedFeed.Crawler != crawler {
-		t.Fatalf(`Wrong crawler value, got "%v" instead of "%v"`, updatedFeed.Crawler, crawler)
+	if updatedFeed.CrawlerMode != "enabled" {
+		t.Fatalf(`Wrong crawler mode, got "%v" instead of "enabled"`, updatedFeed.CrawlerMode)
+	}
+
+	if !updatedFeed.Crawler {
+		t.Fatalf(`The crawler flag should be derived from the crawler mode`)
 	}
 
 	if updatedFeed.Title != feed.Title {
@@ -140,8 +179,32 @@ func TestUpdateFeedCrawler(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if updatedFeed.Crawler != crawler {
-		t.Fatalf(`Wrong crawler value, got "%v" instead of "%v"`, updatedFeed.Crawler, crawler)
+	if updatedFeed.CrawlerMode != "disabled" {
+		t.Fatalf(`Wrong crawler mode, got "%v" instead of "disabled"`, updatedFeed.CrawlerMode)
+	}
+
+	if updatedFeed.Crawler {
+		t.Fatalf(`The crawler flag should be derived from the crawler mode`)
+	}
+}
+
+func TestUpdateFeedCrawlerMode(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	crawlerMode := "disabled"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{CrawlerMode: &crawlerMode})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.CrawlerMode != crawlerMode {
+		t.Fatalf(`Wrong crawler mode, got "%v" instead of "%v"`, updatedFeed.CrawlerMode, crawlerMode)
+	}
+
+	crawlerMode = "invalid"
+	if _, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{CrawlerMode: &crawlerMode}); err == nil {
+		t.Fatal(`Invalid crawler modes should be rejected`)
 	}
 }
 
@@ -220,6 +283,90 @@ func TestUpdateFeedUserAgent(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedProxyURL(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	proxyURL := "socks5://127.0.0.1:1080"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{ProxyURL: &proxyURL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.ProxyURL != proxyURL {
+		t.Fatalf(`Wrong ProxyURL value, got "%v" instead of "%v"`, updatedFeed.ProxyURL, proxyURL)
+	}
+
+	invalidProxyURL := "ftp://127.0.0.1:21"
+	if _, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{ProxyURL: &invalidProxyURL}); err == nil {
+		t.Fatal(`Invalid proxy URLs should be rejected`)
+	}
+
+	updatedFeed, err = client.Feed(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.ProxyURL != proxyURL {
+		t.Fatalf(`Invalid proxy URLs should not be stored, got "%v"`, updatedFeed.ProxyURL)
+	}
+
+	proxyURL = ""
+	updatedFeed, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{ProxyURL: &proxyURL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.ProxyURL != proxyURL {
+		t.Fatalf(`Wrong ProxyURL value, got "%v" instead of "%v"`, updatedFeed.ProxyURL, proxyURL)
+	}
+}
+
+func TestUpdateFeedWithInvalidIconURL(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	iconURL := "javascript:alert(1)"
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{IconURL: &iconURL}); err == nil {
+		t.Fatal(`Invalid icon URLs should be rejected`)
+	}
+}
+
+func TestUpdateFeedWithInvalidAuthScheme(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	authScheme := "digest"
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{AuthScheme: &authScheme}); err == nil {
+		t.Fatal(`Invalid authentication schemes should be rejected`)
+	}
+}
+
+func TestUpdateFeedTranslationLanguage(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	translationLanguage := "en-US"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{TranslationLanguage: &translationLanguage})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.TranslationLanguage != "en" {
+		t.Fatalf(`Wrong TranslationLanguage value, got "%v" instead of "%v"`, updatedFeed.TranslationLanguage, "en")
+	}
+
+	translationLanguage = ""
+	updatedFeed, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{TranslationLanguage: &translationLanguage})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.TranslationLanguage != "" {
+		t.Fatalf(`The translation should be disabled, got "%v"`, updatedFeed.TranslationLanguage)
+	}
+}
+
 func TestUpdateFeedUsername(t *testing.T) {
 	client := createClient(t)
 	feed, _ := createFeed(t, client)
@@ -299,6 +446,109 @@ func TestUpdateFeedCategory(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedSortOrder(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.SortOrder != "" {
+		t.Fatalf(`The default sort order should be empty, got %q`, feed.SortOrder)
+	}
+
+	sortOrder := miniflux.FeedSortOrderPublishedAsc
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{SortOrder: &sortOrder})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.SortOrder != sortOrder {
+		t.Fatalf(`Wrong sort order, got %q instead of %q`, updatedFeed.SortOrder, sortOrder)
+	}
+
+	invalidSortOrder := "title-asc"
+	if _, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{SortOrder: &invalidSortOrder}); err == nil {
+		t.Fatal(`An invalid sort order should be rejected`)
+	}
+}
+
+func TestUpdateFeedAuthorRules(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if feed.AuthorMatchMode != miniflux.AuthorMatchExact {
+		t.Fatalf(`The default author match mode should be exact, got %q`, feed.AuthorMatchMode)
+	}
+
+	blocklistRules := "John Doe\n\"\""
+	keeplistRules := "Jane"
+	matchMode := miniflux.AuthorMatchSubstring
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{
+		AuthorBlocklistRules: &blocklistRules,
+		AuthorKeeplistRules:  &keeplistRules,
+		AuthorMatchMode:      &matchMode,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.AuthorBlocklistRules != blocklistRules || updatedFeed.AuthorKeeplistRules != keeplistRules {
+		t.Fatalf(`Wrong author rules, got %q and %q`, updatedFeed.AuthorBlocklistRules, updatedFeed.AuthorKeeplistRules)
+	}
+
+	if updatedFeed.AuthorMatchMode != matchMode {
+		t.Fatalf(`Wrong author match mode, got %q instead of %q`, updatedFeed.AuthorMatchMode, matchMode)
+	}
+
+	invalidMatchMode := "regex"
+	if _, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{AuthorMatchMode: &invalidMatchMode}); err == nil {
+		t.Fatal(`An invalid author match mode should be rejected`)
+	}
+
+	invalidKeeplistRules := "Jane\n\"\""
+	if _, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{AuthorKeeplistRules: &invalidKeeplistRules}); err == nil {
+		t.Fatal(`The empty author rule should be rejected in the keep rules`)
+	}
+}
+
+func TestUpdateFeedMarkReadAfterDays(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	days := 7
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{MarkReadAfterDays: &days})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.MarkReadAfterDays != days {
+		t.Fatalf(`Wrong number of days, got %d instead of %d`, updatedFeed.MarkReadAfterDays, days)
+	}
+
+	invalidDays := -1
+	updatedFeed, err = client.UpdateFeed(feed.ID, &miniflux.FeedModification{MarkReadAfterDays: &invalidDays})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.MarkReadAfterDays != days {
+		t.Fatalf(`A negative number of days should be ignored, got %d`, updatedFeed.MarkReadAfterDays)
+	}
+}
+
+func TestUpdateFeedRefreshInterval(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	refreshInterval := 15
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{RefreshInterval: &refreshInterval})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.RefreshInterval != refreshInterval {
+		t.Fatalf(`Wrong refresh interval, got %d instead of %d`, updatedFeed.RefreshInterval, refreshInterval)
+	}
+}
+
 func TestDeleteFeed(t *testing.T) {
 	client := createClient(t)
 	feed, _ := createFeed(t, client)
@@ -372,6 +622,33 @@ func TestGetFeedIconNotFound(t *testing.T) {
 	}
 }
 
+func TestRefreshFeedIcon(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	for i := 0; i < 2; i++ {
+		feedIcon, err := client.RefreshFeedIcon(feed.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if feedIcon.ID == 0 {
+			t.Fatalf(`Invalid feed icon ID, got "%v"`, feedIcon.ID)
+		}
+
+		if feedIcon.MimeType != "image/x-icon" {
+			t.Fatalf(`Invalid feed icon mime type, got "%v" instead of "%v"`, feedIcon.MimeType, "image/x-icon")
+		}
+	}
+}
+
+func TestRefreshFeedIconNotFound(t *testing.T) {
+	client := createClient(t)
+	if _, err := client.RefreshFeedIcon(42); err == nil {
+		t.Fatalf(`Refreshing the icon of an unknown feed should fail`)
+	}
+}
+
 func TestGetFeeds(t *testing.T) {
 	client := createClient(t)
 	feed, category := createFeed(t, client)
@@ -413,3 +690,25 @@ func TestGetFeeds(t *testing.T) {
 		t.Fatalf(`Invalid feed category title, got "%v" instead of "%v"`, feeds[0].Category.Title, category.Title)
 	}
 }
+
+func TestReorderFeeds(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	if err := client.ReorderFeeds([]int64{feed.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	updatedFeed, err := client.Feed(feed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.Position != 1 {
+		t.Fatalf(`Invalid position, got "%v" instead of "%v"`, updatedFeed.Position, 1)
+	}
+
+	if err := client.ReorderFeeds([]int64{}); err == nil {
+		t.Fatal(`An empty list should not be allowed`)
+	}
+}
@@ -11,6 +11,9 @@ import (
 	"io/ioutil"
 	"strings"
 	"testing"
+	"time"
+
+	miniflux "miniflux.app/client"
 )
 
 func TestExport(t *testing.T) {
@@ -44,3 +47,107 @@ func TestImport(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestImportSummary(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<opml version="2.0">
+		<body>
+			<outline text="Summary Category">
+				<outline title="Existing" text="Existing" xmlUrl="` + feed.FeedURL + `"></outline>
+				<outline title="New" text="New" xmlUrl="https://example.org/feed.xml"></outline>
+				<outline title="Repeated" text="Repeated" xmlUrl="https://example.org/feed.xml"></outline>
+			</outline>
+		</body>
+	</opml>`
+
+	summary, err := client.ImportWithSummary(ioutil.NopCloser(bytes.NewReader([]byte(data))))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(summary.Created) != 1 || summary.Created[0].FeedURL != "https://example.org/feed.xml" {
+		t.Fatalf(`Only the new feed should be created, got %+v`, summary.Created)
+	}
+
+	if summary.Created[0].Category != "Summary Category" {
+		t.Errorf(`Unexpected category, got %q`, summary.Created[0].Category)
+	}
+
+	if len(summary.Skipped) != 2 {
+		t.Fatalf(`The existing and repeated feeds should be skipped, got %+v`, summary.Skipped)
+	}
+
+	if summary.Skipped[0].FeedURL != feed.FeedURL || summary.Skipped[1].Title != "Repeated" {
+		t.Errorf(`Unexpected skipped feeds: %+v, %+v`, summary.Skipped[0], summary.Skipped[1])
+	}
+
+	if len(summary.Failed) != 0 {
+		t.Errorf(`No feed should fail, got %+v`, summary.Failed)
+	}
+}
+
+func TestImportGoogleReader(t *testing.T) {
+	client := createClient(t)
+
+	data := `{
+		"subscriptions": [{"id": "feed/` + testFeedURL + `", "title": "Test", "htmlUrl": "` + testWebsiteURL + `", "categories": [{"id": "user/-/label/Test", "label": "Test Category"}]}],
+		"items": [{"categories": ["user/-/state/com.google/read"], "alternate": [{"href": "` + testWebsiteURL + `"}], "origin": {"streamId": "feed/` + testFeedURL + `"}}]
+	}`
+
+	b := bytes.NewReader([]byte(data))
+	if err := client.ImportGoogleReader(ioutil.NopCloser(b)); err != nil {
+		t.Fatal(err)
+	}
+
+	feeds, err := client.Feeds()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feeds) != 1 || feeds[0].FeedURL != testFeedURL {
+		t.Fatalf(`The feed should be created, got %v`, feeds)
+	}
+
+	if feeds[0].Category.Title != "Test Category" {
+		t.Fatalf(`Invalid category, got "%v" instead of "%v"`, feeds[0].Category.Title, "Test Category")
+	}
+}
+
+func TestImportJob(t *testing.T) {
+	client := createClient(t)
+
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<opml version="2.0">
+		<body>
+			<outline title="Test" text="Test" xmlUrl="` + testFeedURL + `" htmlUrl="` + testWebsiteURL + `"></outline>
+			<outline title="Duplicate" text="Duplicate" xmlUrl="` + testFeedURL + `" htmlUrl="` + testWebsiteURL + `"></outline>
+		</body>
+	</opml>`
+
+	job, err := client.CreateImportJob(ioutil.NopCloser(bytes.NewReader([]byte(data))))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if job.Total != 2 {
+		t.Fatalf(`Invalid number of subscriptions, got "%v" instead of "%v"`, job.Total, 2)
+	}
+
+	for i := 0; i < 30 && job.Status != miniflux.ImportJobStatusCompleted; i++ {
+		time.Sleep(time.Second)
+		if job, err = client.ImportJob(job.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if job.Status != miniflux.ImportJobStatusCompleted {
+		t.Fatalf(`The import job is not completed, got status "%v"`, job.Status)
+	}
+
+	if job.Created != 1 || job.Skipped != 1 || job.Failed != 0 {
+		t.Fatalf(`Invalid progress, got %d created, %d skipped and %d failed`, job.Created, job.Skipped, job.Failed)
+	}
+}
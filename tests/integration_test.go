@@ -0,0 +1,29 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSendTestNotificationWithoutSettings(t *testing.T) {
+	client := createClient(t)
+
+	err := client.SendTestNotification("webhook")
+	if err == nil || !strings.Contains(err.Error(), "missing webhook URL") {
+		t.Fatalf(`The error of the webhook should be returned, got %v`, err)
+	}
+}
+
+func TestSendTestNotificationWithUnsupportedProvider(t *testing.T) {
+	client := createClient(t)
+
+	if err := client.SendTestNotification("pocket"); err == nil {
+		t.Fatal(`An integration without notifications should not be tested`)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestCreateSavedSearch(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	search, err := client.CreateSavedSearch(&miniflux.SavedSearch{
+		Title:       "Unread releases",
+		SearchQuery: "release",
+		FeedID:      feed.ID,
+		Status:      miniflux.EntryStatusUnread,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if search.ID == 0 {
+		t.Fatalf(`Invalid saved search ID, got "%v"`, search.ID)
+	}
+
+	if search.FeedID != feed.ID {
+		t.Fatalf(`Invalid feed ID, got "%v" instead of "%v"`, search.FeedID, feed.ID)
+	}
+
+	if _, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Unread releases"}); err == nil {
+		t.Fatal(`Duplicated saved searches should not be accepted`)
+	}
+}
+
+func TestCreateSavedSearchWithInvalidFilters(t *testing.T) {
+	client := createClient(t)
+
+	scenarios := []*miniflux.SavedSearch{
+		{SearchQuery: "missing title"},
+		{Title: "Invalid status", Status: "invalid"},
+		{Title: "Unknown feed", FeedID: 123456789},
+		{Title: "Unknown category", CategoryID: 123456789},
+	}
+
+	for _, search := range scenarios {
+		if _, err := client.CreateSavedSearch(search); err == nil {
+			t.Fatalf(`The saved search %q should not be accepted`, search.Title)
+		}
+	}
+}
+
+func TestGetSavedSearches(t *testing.T) {
+	client := createClient(t)
+
+	search, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Starred", Starred: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	searches, err := client.SavedSearches()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(searches) != 1 || searches[0].ID != search.ID {
+		t.Fatalf(`Invalid list of saved searches: %v`, searches)
+	}
+
+	result, err := client.SavedSearch(search.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !result.Starred || result.Title != "Starred" {
+		t.Fatalf(`Invalid saved search: %v`, result)
+	}
+}
+
+func TestUpdateSavedSearch(t *testing.T) {
+	client := createClient(t)
+
+	search, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Before"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	search, err = client.UpdateSavedSearch(search.ID, &miniflux.SavedSearch{Title: "After", Status: miniflux.EntryStatusRead})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if search.Title != "After" || search.Status != miniflux.EntryStatusRead {
+		t.Fatalf(`The saved search should be updated: %v`, search)
+	}
+}
+
+func TestDeleteSavedSearch(t *testing.T) {
+	client := createClient(t)
+
+	search, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Temporary"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteSavedSearch(search.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.SavedSearch(search.ID); err == nil {
+		t.Fatal(`The saved search should be removed`)
+	}
+}
+
+func TestGetSavedSearchEntries(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	allEntries, err := client.FeedEntries(feed.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	search, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "All", FeedID: feed.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.SavedSearchEntries(search.ID, &miniflux.Filter{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total != allEntries.Total {
+		t.Fatalf(`Invalid number of entries, got %d instead of %d`, result.Total, allEntries.Total)
+	}
+
+	if len(result.Entries) != 2 {
+		t.Fatalf(`The limit should be applied, got %d entries`, len(result.Entries))
+	}
+
+	search, err = client.UpdateSavedSearch(search.ID, &miniflux.SavedSearch{Title: "All", FeedID: feed.ID, Starred: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = client.SavedSearchEntries(search.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total != 0 {
+		t.Fatalf(`No entry is starred, got %d entries`, result.Total)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestAddEntryTags(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryID := result.Entries[0].ID
+	tags, err := client.AddEntryTags(entryID, "Work", " to-read ", "work")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tags) != 2 || tags[0].Name != "to-read" || tags[1].Name != "work" {
+		t.Fatalf(`The tag names should be normalized and de-duplicated, got %v`, tags)
+	}
+
+	tags, err = client.AddEntryTags(entryID, "WORK")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tags) != 2 {
+		t.Fatalf(`Adding an existing tag should be ignored, got %v`, tags)
+	}
+
+	entry, err := client.Entry(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entry.Tags) != 2 || entry.Tags[0] != "to-read" || entry.Tags[1] != "work" {
+		t.Fatalf(`The entry should contain its tags, got %v`, entry.Tags)
+	}
+
+	if _, err := client.AddEntryTags(entryID, "  "); err == nil {
+		t.Fatal(`Empty tag names should not be accepted`)
+	}
+
+	if _, err := client.AddEntryTags(123456789, "work"); err == nil {
+		t.Fatal(`Tagging an unknown entry should fail`)
+	}
+}
+
+func TestFilterEntriesByTag(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.AddEntryTags(result.Entries[0].ID, "work"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.AddEntryTags(result.Entries[1].ID, "to-read"); err != nil {
+		t.Fatal(err)
+	}
+
+	filtered, err := client.Entries(&miniflux.Filter{Tag: "Work"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if filtered.Total != 1 || filtered.Entries[0].ID != result.Entries[0].ID {
+		t.Fatalf(`Only the entry tagged "work" should be returned, got %d entries`, filtered.Total)
+	}
+
+	filtered, err = client.Entries(&miniflux.Filter{Tag: "unknown"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if filtered.Total != 0 {
+		t.Fatalf(`No entry should be tagged "unknown", got %d entries`, filtered.Total)
+	}
+}
+
+func TestRemoveEntryTag(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryID := result.Entries[0].ID
+	tags, err := client.AddEntryTags(entryID, "work")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.RemoveEntryTag(entryID, tags[0].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	tags, err = client.EntryTags(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tags) != 0 {
+		t.Fatalf(`The tag should be removed from the entry, got %v`, tags)
+	}
+
+	userTags, err := client.Tags()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(userTags) != 1 || userTags[0].EntryCount != 0 {
+		t.Fatalf(`The tag should be kept without entries, got %v`, userTags)
+	}
+
+	if err := client.DeleteTag(userTags[0].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	userTags, err = client.Tags()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(userTags) != 0 {
+		t.Fatalf(`The tag should be removed, got %v`, userTags)
+	}
+}
@@ -37,7 +37,11 @@ func (h *handler) showEditCategoryPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	categoryForm := form.CategoryForm{
-		Title: category.Title,
+		Title:             category.Title,
+		Crawler:           category.Crawler,
+		MarkReadAfterDays: category.MarkReadAfterDays,
+		TelegramChatID:    category.TelegramChatID,
+		WebhookURL:        category.WebhookURL,
 	}
 
 	view.Set("form", categoryForm)
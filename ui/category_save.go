@@ -53,8 +53,12 @@ func (h *handler) saveCategory(w http.ResponseWriter, r *http.Request) {
 	}
 
 	category := model.Category{
-		Title:  categoryForm.Title,
-		UserID: user.ID,
+		Title:             categoryForm.Title,
+		Crawler:           categoryForm.Crawler,
+		MarkReadAfterDays: categoryForm.MarkReadAfterDays,
+		TelegramChatID:    categoryForm.TelegramChatID,
+		WebhookURL:        categoryForm.WebhookURL,
+		UserID:            user.ID,
 	}
 
 	if err = h.store.CreateCategory(&category); err != nil {
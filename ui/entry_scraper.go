@@ -30,6 +30,19 @@ func (h *handler) fetchContent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The entry query does not load the network settings and the credentials of the feed.
+	feed, err := h.store.FeedByID(entry.UserID, entry.FeedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	entry.Feed = feed
 	if err := processor.ProcessEntryWebPage(entry); err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -41,18 +41,36 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	feedForm := form.FeedForm{
-		SiteURL:         feed.SiteURL,
-		FeedURL:         feed.FeedURL,
-		Title:           feed.Title,
-		ScraperRules:    feed.ScraperRules,
-		RewriteRules:    feed.RewriteRules,
-		Crawler:         feed.Crawler,
-		UserAgent:       feed.UserAgent,
-		CategoryID:      feed.Category.ID,
-		Username:        feed.Username,
-		Password:        feed.Password,
-		IgnoreHTTPCache: feed.IgnoreHTTPCache,
-		Disabled:        feed.Disabled,
+		SiteURL:              feed.SiteURL,
+		FeedURL:              feed.FeedURL,
+		Title:                feed.Title,
+		ScraperRules:         feed.ScraperRules,
+		RewriteRules:         feed.RewriteRules,
+		BlocklistRules:       feed.BlocklistRules,
+		KeeplistRules:        feed.KeeplistRules,
+		AuthorBlocklistRules: feed.AuthorBlocklistRules,
+		AuthorKeeplistRules:  feed.AuthorKeeplistRules,
+		AuthorMatchMode:      feed.AuthorMatchMode,
+		DateLayouts:          feed.DateLayouts,
+		MaxEntries:           feed.MaxEntries,
+		MarkReadAfterDays:    feed.MarkReadAfterDays,
+		RefreshInterval:      feed.RefreshInterval,
+		RequestTimeout:       feed.RequestTimeout,
+		CrawlerMode:          feed.CrawlerMode,
+		UserAgent:            feed.UserAgent,
+		Cookie:               feed.Cookie,
+		ProxyURL:             feed.ProxyURL,
+		TranslationLanguage:  feed.TranslationLanguage,
+		CategoryID:           feed.Category.ID,
+		Username:             feed.Username,
+		Password:             feed.Password,
+		AuthScheme:           feed.AuthScheme,
+		IconURL:              feed.IconURL,
+		SortOrder:            feed.SortOrder,
+		IgnoreHTTPCache:      feed.IgnoreHTTPCache,
+		NotifyTelegram:       feed.NotifyTelegram,
+		SkipDuplicateGUIDs:   feed.SkipDuplicateGUIDs,
+		Disabled:             feed.Disabled,
 	}
 
 	sess := session.New(h.store, request.SessionID(r))
@@ -40,6 +40,7 @@ func (h *handler) showFeedEntriesPage(w http.ResponseWriter, r *http.Request) {
 	builder.WithStatus(model.EntryStatusUnread)
 	builder.WithOrder(model.DefaultSortingOrder)
 	builder.WithDirection(user.EntryDirection)
+	builder.WithFeedSortOrder(feed.SortOrder)
 	builder.WithOffset(offset)
 	builder.WithLimit(user.EntriesPerPage)
 
@@ -15,13 +15,27 @@ import (
 
 func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
-	if err := h.feedHandler.RefreshFeed(request.UserID(r), feedID); err != nil {
+	refresh := h.feedHandler.RefreshFeed
+	if request.QueryBoolParam(r, "force", false) {
+		refresh = h.feedHandler.ForceRefreshFeed
+	}
+
+	if err := refresh(r.Context(), request.UserID(r), feedID); err != nil {
 		logger.Error("[UI:RefreshFeed] %v", err)
 	}
 
 	html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feedID))
 }
 
+func (h *handler) reprocessFeedEntries(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	if err := h.feedHandler.ReprocessFeedEntries(r.Context(), request.UserID(r), feedID); err != nil {
+		logger.Error("[UI:ReprocessFeedEntries] %v", err)
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feedID))
+}
+
 func (h *handler) refreshAllFeeds(w http.ResponseWriter, r *http.Request) {
 	userID := request.UserID(r)
 	jobs, err := h.store.NewUserBatch(userID, h.store.CountFeeds(userID))
@@ -61,6 +61,8 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	iconChanged := feed.IconURL != feedForm.IconURL
+
 	err = h.store.UpdateFeed(feedForm.Merge(feed))
 	if err != nil {
 		logger.Error("[UI:UpdateFeed] %v", err)
@@ -69,5 +71,11 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if iconChanged {
+		if err := h.store.ExpireFeedIcon(feed.ID); err != nil {
+			logger.Error("[UI:UpdateFeed] %v", err)
+		}
+	}
+
 	html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feed.ID))
 }
@@ -6,6 +6,8 @@ package form // import "miniflux.app/ui/form"
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
@@ -13,7 +15,11 @@ import (
 
 // CategoryForm represents a feed form in the UI
 type CategoryForm struct {
-	Title string
+	Title             string
+	Crawler           bool
+	MarkReadAfterDays int
+	TelegramChatID    string
+	WebhookURL        string
 }
 
 // Validate makes sure the form values are valid.
@@ -27,12 +33,25 @@ func (c CategoryForm) Validate() error {
 // Merge update the given category fields.
 func (c CategoryForm) Merge(category *model.Category) *model.Category {
 	category.Title = c.Title
+	category.Crawler = c.Crawler
+	category.MarkReadAfterDays = c.MarkReadAfterDays
+	category.TelegramChatID = c.TelegramChatID
+	category.WebhookURL = c.WebhookURL
 	return category
 }
 
 // NewCategoryForm returns a new CategoryForm.
 func NewCategoryForm(r *http.Request) *CategoryForm {
+	markReadAfterDays, err := strconv.Atoi(r.FormValue("mark_read_after_days"))
+	if err != nil || markReadAfterDays < 0 {
+		markReadAfterDays = 0
+	}
+
 	return &CategoryForm{
-		Title: r.FormValue("title"),
+		Title:             r.FormValue("title"),
+		Crawler:           r.FormValue("crawler") == "1",
+		MarkReadAfterDays: markReadAfterDays,
+		TelegramChatID:    strings.TrimSpace(r.FormValue("telegram_chat_id")),
+		WebhookURL:        strings.TrimSpace(r.FormValue("webhook_url")),
 	}
 }
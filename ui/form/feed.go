@@ -7,25 +7,46 @@ package form // import "miniflux.app/ui/form"
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
+	"miniflux.app/reader/language"
 )
 
 // FeedForm represents a feed form in the UI
 type FeedForm struct {
-	FeedURL         string
-	SiteURL         string
-	Title           string
-	ScraperRules    string
-	RewriteRules    string
-	Crawler         bool
-	UserAgent       string
-	CategoryID      int64
-	Username        string
-	Password        string
-	IgnoreHTTPCache bool
-	Disabled        bool
+	FeedURL              string
+	SiteURL              string
+	Title                string
+	ScraperRules         string
+	RewriteRules         string
+	BlocklistRules       string
+	KeeplistRules        string
+	AuthorBlocklistRules string
+	AuthorKeeplistRules  string
+	AuthorMatchMode      string
+	DateLayouts          string
+	MaxEntries           int
+	MarkReadAfterDays    int
+	RefreshInterval      int
+	RequestTimeout       int
+	CrawlerMode          string
+	UserAgent            string
+	Cookie               string
+	ProxyURL             string
+	TranslationLanguage  string
+	CategoryID           int64
+	Username             string
+	Password             string
+	AuthScheme           string
+	AuthToken            string
+	IconURL              string
+	SortOrder            string
+	IgnoreHTTPCache      bool
+	NotifyTelegram       bool
+	SkipDuplicateGUIDs   bool
+	Disabled             bool
 }
 
 // ValidateModification validates FeedForm fields
@@ -33,6 +54,19 @@ func (f FeedForm) ValidateModification() error {
 	if f.FeedURL == "" || f.SiteURL == "" || f.Title == "" || f.CategoryID == 0 {
 		return errors.NewLocalizedError("error.fields_mandatory")
 	}
+
+	if !model.IsValidIconURL(f.IconURL) {
+		return errors.NewLocalizedError("error.invalid_icon_url")
+	}
+
+	if !model.IsValidProxyURL(f.ProxyURL) {
+		return errors.NewLocalizedError("error.invalid_proxy_url")
+	}
+
+	if !model.IsValidAuthorKeeplistRules(f.AuthorKeeplistRules) {
+		return errors.NewLocalizedError("error.invalid_author_keeplist_rules")
+	}
+
 	return nil
 }
 
@@ -44,14 +78,50 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.FeedURL = f.FeedURL
 	feed.ScraperRules = f.ScraperRules
 	feed.RewriteRules = f.RewriteRules
-	feed.Crawler = f.Crawler
+	feed.BlocklistRules = f.BlocklistRules
+	feed.KeeplistRules = f.KeeplistRules
+	feed.AuthorBlocklistRules = f.AuthorBlocklistRules
+	feed.AuthorKeeplistRules = f.AuthorKeeplistRules
+	if model.IsValidAuthorMatchMode(f.AuthorMatchMode) {
+		feed.AuthorMatchMode = f.AuthorMatchMode
+	}
+	feed.DateLayouts = f.DateLayouts
+	feed.MaxEntries = f.MaxEntries
+	feed.MarkReadAfterDays = f.MarkReadAfterDays
+	feed.RefreshInterval = f.RefreshInterval
+	feed.RequestTimeout = f.RequestTimeout
+	if model.IsValidCrawlerMode(f.CrawlerMode) {
+		feed.CrawlerMode = f.CrawlerMode
+	}
 	feed.UserAgent = f.UserAgent
+	feed.Cookie = f.Cookie
+	feed.ProxyURL = f.ProxyURL
+	feed.TranslationLanguage = language.Normalize(f.TranslationLanguage)
 	feed.ParsingErrorCount = 0
 	feed.ParsingErrorMsg = ""
 	feed.Username = f.Username
 	feed.Password = f.Password
+	if model.IsValidAuthScheme(f.AuthScheme) {
+		feed.AuthScheme = f.AuthScheme
+	}
+	if feed.AuthScheme != model.AuthSchemeBearer {
+		feed.AuthToken = ""
+	} else if f.AuthToken != "" {
+		// The token is never displayed, an empty field keeps the current one.
+		feed.AuthToken = f.AuthToken
+	}
+	feed.IconURL = f.IconURL
+	if model.IsValidFeedSortOrder(f.SortOrder) {
+		feed.SortOrder = f.SortOrder
+	}
 	feed.IgnoreHTTPCache = f.IgnoreHTTPCache
-	feed.Disabled = f.Disabled
+	feed.NotifyTelegram = f.NotifyTelegram
+	feed.SkipDuplicateGUIDs = f.SkipDuplicateGUIDs
+	if f.Disabled {
+		feed.Disabled = true
+	} else {
+		feed.Enable()
+	}
 	return feed
 }
 
@@ -62,18 +132,57 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		categoryID = 0
 	}
 
+	maxEntries, err := strconv.Atoi(r.FormValue("max_entries"))
+	if err != nil || maxEntries < 0 {
+		maxEntries = 0
+	}
+
+	markReadAfterDays, err := strconv.Atoi(r.FormValue("mark_read_after_days"))
+	if err != nil || markReadAfterDays < 0 {
+		markReadAfterDays = 0
+	}
+
+	refreshInterval, err := strconv.Atoi(r.FormValue("refresh_interval"))
+	if err != nil || refreshInterval < 0 {
+		refreshInterval = 0
+	}
+
+	requestTimeout, err := strconv.Atoi(r.FormValue("request_timeout"))
+	if err != nil || requestTimeout < 0 {
+		requestTimeout = 0
+	}
+
 	return &FeedForm{
-		FeedURL:         r.FormValue("feed_url"),
-		SiteURL:         r.FormValue("site_url"),
-		Title:           r.FormValue("title"),
-		ScraperRules:    r.FormValue("scraper_rules"),
-		UserAgent:       r.FormValue("user_agent"),
-		RewriteRules:    r.FormValue("rewrite_rules"),
-		Crawler:         r.FormValue("crawler") == "1",
-		CategoryID:      int64(categoryID),
-		Username:        r.FormValue("feed_username"),
-		Password:        r.FormValue("feed_password"),
-		IgnoreHTTPCache: r.FormValue("ignore_http_cache") == "1",
-		Disabled:        r.FormValue("disabled") == "1",
+		FeedURL:              r.FormValue("feed_url"),
+		SiteURL:              r.FormValue("site_url"),
+		Title:                r.FormValue("title"),
+		ScraperRules:         r.FormValue("scraper_rules"),
+		UserAgent:            r.FormValue("user_agent"),
+		Cookie:               r.FormValue("cookie"),
+		ProxyURL:             strings.TrimSpace(r.FormValue("proxy_url")),
+		TranslationLanguage:  r.FormValue("translation_language"),
+		RewriteRules:         r.FormValue("rewrite_rules"),
+		BlocklistRules:       r.FormValue("blocklist_rules"),
+		KeeplistRules:        r.FormValue("keeplist_rules"),
+		AuthorBlocklistRules: r.FormValue("author_blocklist_rules"),
+		AuthorKeeplistRules:  r.FormValue("author_keeplist_rules"),
+		AuthorMatchMode:      r.FormValue("author_match_mode"),
+		DateLayouts:          r.FormValue("date_layouts"),
+		MaxEntries:           maxEntries,
+		MarkReadAfterDays:    markReadAfterDays,
+		RefreshInterval:      refreshInterval,
+		RequestTimeout:       requestTimeout,
+		CrawlerMode:          r.FormValue("crawler_mode"),
+		CategoryID:           int64(categoryID),
+		Username:             r.FormValue("feed_username"),
+		Password:             r.FormValue("feed_password"),
+		AuthScheme:           r.FormValue("auth_scheme"),
+		AuthToken:            r.FormValue("auth_token"),
+		IconURL:              strings.TrimSpace(r.FormValue("icon_url")),
+		SortOrder:            r.FormValue("sort_order"),
+		IgnoreHTTPCache:      r.FormValue("ignore_http_cache") == "1",
+		NotifyTelegram:       r.FormValue("notify_telegram") == "1",
+		SkipDuplicateGUIDs:   r.FormValue("skip_duplicate_guids") == "1",
+		Disabled:             r.FormValue("disabled") == "1",
 	}
 }
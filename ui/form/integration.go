@@ -6,37 +6,73 @@ package form // import "miniflux.app/ui/form"
 
 import (
 	"net/http"
+	"strconv"
 
 	"miniflux.app/model"
 )
 
 // IntegrationForm represents user integration settings form.
 type IntegrationForm struct {
-	PinboardEnabled      bool
-	PinboardToken        string
-	PinboardTags         string
-	PinboardMarkAsUnread bool
-	InstapaperEnabled    bool
-	InstapaperUsername   string
-	InstapaperPassword   string
-	FeverEnabled         bool
-	FeverUsername        string
-	FeverPassword        string
-	WallabagEnabled      bool
-	WallabagURL          string
-	WallabagClientID     string
-	WallabagClientSecret string
-	WallabagUsername     string
-	WallabagPassword     string
-	NunuxKeeperEnabled   bool
-	NunuxKeeperURL       string
-	NunuxKeeperAPIKey    string
-	PocketEnabled        bool
-	PocketAccessToken    string
-	PocketConsumerKey    string
-	TelegramEnabled      bool
-	TelegramToken        string
-	TelegramChatID       string
+	PinboardEnabled           bool
+	PinboardToken             string
+	PinboardTags              string
+	PinboardMarkAsUnread      bool
+	PinboardMarkReadOnSave    bool
+	InstapaperEnabled         bool
+	InstapaperUsername        string
+	InstapaperPassword        string
+	InstapaperMarkReadOnSave  bool
+	FeverEnabled              bool
+	FeverUsername             string
+	FeverPassword             string
+	WallabagEnabled           bool
+	WallabagURL               string
+	WallabagClientID          string
+	WallabagClientSecret      string
+	WallabagUsername          string
+	WallabagPassword          string
+	WallabagMarkReadOnSave    bool
+	NunuxKeeperEnabled        bool
+	NunuxKeeperURL            string
+	NunuxKeeperAPIKey         string
+	NunuxKeeperMarkReadOnSave bool
+	PocketEnabled             bool
+	PocketAccessToken         string
+	PocketConsumerKey         string
+	PocketMarkReadOnSave      bool
+	ShaarliEnabled            bool
+	ShaarliURL                string
+	ShaarliAPISecret          string
+	ShaarliMarkReadOnSave     bool
+	TelegramEnabled           bool
+	TelegramToken             string
+	TelegramChatID            string
+	TelegramTopicID           string
+	DiscordEnabled            bool
+	DiscordWebhookURL         string
+	WebhookEnabled            bool
+	WebhookURL                string
+	WebhookSecret             string
+	IMAPEnabled               bool
+	IMAPServer                string
+	IMAPUsername              string
+	IMAPPassword              string
+	IMAPMailbox               string
+	IMAPSenderFilter          string
+	GotifyEnabled             bool
+	GotifyURL                 string
+	GotifyToken               string
+	GotifyPriority            int
+	NtfyEnabled               bool
+	NtfyURL                   string
+	NtfyTopic                 string
+	NtfyToken                 string
+	NtfyPriority              int
+	AppriseEnabled            bool
+	AppriseURL                string
+	AppriseServicesURL        string
+	AppriseConfigKey          string
+	AppriseTag                string
 }
 
 // Merge copy form values to the model.
@@ -45,9 +81,11 @@ func (i IntegrationForm) Merge(integration *model.Integration) {
 	integration.PinboardToken = i.PinboardToken
 	integration.PinboardTags = i.PinboardTags
 	integration.PinboardMarkAsUnread = i.PinboardMarkAsUnread
+	integration.PinboardMarkReadOnSave = i.PinboardMarkReadOnSave
 	integration.InstapaperEnabled = i.InstapaperEnabled
 	integration.InstapaperUsername = i.InstapaperUsername
 	integration.InstapaperPassword = i.InstapaperPassword
+	integration.InstapaperMarkReadOnSave = i.InstapaperMarkReadOnSave
 	integration.FeverEnabled = i.FeverEnabled
 	integration.FeverUsername = i.FeverUsername
 	integration.FeverPassword = i.FeverPassword
@@ -57,44 +95,122 @@ func (i IntegrationForm) Merge(integration *model.Integration) {
 	integration.WallabagClientSecret = i.WallabagClientSecret
 	integration.WallabagUsername = i.WallabagUsername
 	integration.WallabagPassword = i.WallabagPassword
+	integration.WallabagMarkReadOnSave = i.WallabagMarkReadOnSave
 	integration.NunuxKeeperEnabled = i.NunuxKeeperEnabled
 	integration.NunuxKeeperURL = i.NunuxKeeperURL
 	integration.NunuxKeeperAPIKey = i.NunuxKeeperAPIKey
+	integration.NunuxKeeperMarkReadOnSave = i.NunuxKeeperMarkReadOnSave
 	integration.PocketEnabled = i.PocketEnabled
 	integration.PocketAccessToken = i.PocketAccessToken
 	integration.PocketConsumerKey = i.PocketConsumerKey
+	integration.PocketMarkReadOnSave = i.PocketMarkReadOnSave
+	integration.ShaarliEnabled = i.ShaarliEnabled
+	integration.ShaarliURL = i.ShaarliURL
+	integration.ShaarliAPISecret = i.ShaarliAPISecret
+	integration.ShaarliMarkReadOnSave = i.ShaarliMarkReadOnSave
 	integration.TelegramEnabled = i.TelegramEnabled
 	integration.TelegramToken = i.TelegramToken
 	integration.TelegramChatID = i.TelegramChatID
+	integration.TelegramTopicID = i.TelegramTopicID
+	integration.DiscordEnabled = i.DiscordEnabled
+	integration.DiscordWebhookURL = i.DiscordWebhookURL
+	integration.WebhookEnabled = i.WebhookEnabled
+	integration.WebhookURL = i.WebhookURL
+	integration.WebhookSecret = i.WebhookSecret
+	integration.IMAPEnabled = i.IMAPEnabled
+	integration.IMAPServer = i.IMAPServer
+	integration.IMAPUsername = i.IMAPUsername
+	integration.IMAPPassword = i.IMAPPassword
+	integration.IMAPMailbox = i.IMAPMailbox
+	integration.IMAPSenderFilter = i.IMAPSenderFilter
+	integration.GotifyEnabled = i.GotifyEnabled
+	integration.GotifyURL = i.GotifyURL
+	integration.GotifyToken = i.GotifyToken
+	integration.GotifyPriority = i.GotifyPriority
+	integration.NtfyEnabled = i.NtfyEnabled
+	integration.NtfyURL = i.NtfyURL
+	integration.NtfyTopic = i.NtfyTopic
+	integration.NtfyToken = i.NtfyToken
+	integration.NtfyPriority = i.NtfyPriority
+	integration.AppriseEnabled = i.AppriseEnabled
+	integration.AppriseURL = i.AppriseURL
+	integration.AppriseServicesURL = i.AppriseServicesURL
+	integration.AppriseConfigKey = i.AppriseConfigKey
+	integration.AppriseTag = i.AppriseTag
 }
 
 // NewIntegrationForm returns a new AuthForm.
 func NewIntegrationForm(r *http.Request) *IntegrationForm {
+	gotifyPriority, err := strconv.Atoi(r.FormValue("gotify_priority"))
+	if err != nil {
+		gotifyPriority = 5
+	}
+
+	ntfyPriority, err := strconv.Atoi(r.FormValue("ntfy_priority"))
+	if err != nil {
+		ntfyPriority = 3
+	}
+
 	return &IntegrationForm{
-		PinboardEnabled:      r.FormValue("pinboard_enabled") == "1",
-		PinboardToken:        r.FormValue("pinboard_token"),
-		PinboardTags:         r.FormValue("pinboard_tags"),
-		PinboardMarkAsUnread: r.FormValue("pinboard_mark_as_unread") == "1",
-		InstapaperEnabled:    r.FormValue("instapaper_enabled") == "1",
-		InstapaperUsername:   r.FormValue("instapaper_username"),
-		InstapaperPassword:   r.FormValue("instapaper_password"),
-		FeverEnabled:         r.FormValue("fever_enabled") == "1",
-		FeverUsername:        r.FormValue("fever_username"),
-		FeverPassword:        r.FormValue("fever_password"),
-		WallabagEnabled:      r.FormValue("wallabag_enabled") == "1",
-		WallabagURL:          r.FormValue("wallabag_url"),
-		WallabagClientID:     r.FormValue("wallabag_client_id"),
-		WallabagClientSecret: r.FormValue("wallabag_client_secret"),
-		WallabagUsername:     r.FormValue("wallabag_username"),
-		WallabagPassword:     r.FormValue("wallabag_password"),
-		NunuxKeeperEnabled:   r.FormValue("nunux_keeper_enabled") == "1",
-		NunuxKeeperURL:       r.FormValue("nunux_keeper_url"),
-		NunuxKeeperAPIKey:    r.FormValue("nunux_keeper_api_key"),
-		PocketEnabled:        r.FormValue("pocket_enabled") == "1",
-		PocketAccessToken:    r.FormValue("pocket_access_token"),
-		PocketConsumerKey:    r.FormValue("pocket_consumer_key"),
-		TelegramEnabled:      r.FormValue("telegram_enabled") == "1",
-		TelegramToken:        r.FormValue("telegram_token"),
-		TelegramChatID:       r.FormValue("telegram_chat_id"),
+		PinboardEnabled:           r.FormValue("pinboard_enabled") == "1",
+		PinboardToken:             r.FormValue("pinboard_token"),
+		PinboardTags:              r.FormValue("pinboard_tags"),
+		PinboardMarkAsUnread:      r.FormValue("pinboard_mark_as_unread") == "1",
+		PinboardMarkReadOnSave:    r.FormValue("pinboard_mark_read_on_save") == "1",
+		InstapaperEnabled:         r.FormValue("instapaper_enabled") == "1",
+		InstapaperUsername:        r.FormValue("instapaper_username"),
+		InstapaperPassword:        r.FormValue("instapaper_password"),
+		InstapaperMarkReadOnSave:  r.FormValue("instapaper_mark_read_on_save") == "1",
+		FeverEnabled:              r.FormValue("fever_enabled") == "1",
+		FeverUsername:             r.FormValue("fever_username"),
+		FeverPassword:             r.FormValue("fever_password"),
+		WallabagEnabled:           r.FormValue("wallabag_enabled") == "1",
+		WallabagURL:               r.FormValue("wallabag_url"),
+		WallabagClientID:          r.FormValue("wallabag_client_id"),
+		WallabagClientSecret:      r.FormValue("wallabag_client_secret"),
+		WallabagUsername:          r.FormValue("wallabag_username"),
+		WallabagPassword:          r.FormValue("wallabag_password"),
+		WallabagMarkReadOnSave:    r.FormValue("wallabag_mark_read_on_save") == "1",
+		NunuxKeeperEnabled:        r.FormValue("nunux_keeper_enabled") == "1",
+		NunuxKeeperURL:            r.FormValue("nunux_keeper_url"),
+		NunuxKeeperAPIKey:         r.FormValue("nunux_keeper_api_key"),
+		NunuxKeeperMarkReadOnSave: r.FormValue("nunux_keeper_mark_read_on_save") == "1",
+		PocketEnabled:             r.FormValue("pocket_enabled") == "1",
+		PocketAccessToken:         r.FormValue("pocket_access_token"),
+		PocketConsumerKey:         r.FormValue("pocket_consumer_key"),
+		PocketMarkReadOnSave:      r.FormValue("pocket_mark_read_on_save") == "1",
+		ShaarliEnabled:            r.FormValue("shaarli_enabled") == "1",
+		ShaarliURL:                r.FormValue("shaarli_url"),
+		ShaarliAPISecret:          r.FormValue("shaarli_api_secret"),
+		ShaarliMarkReadOnSave:     r.FormValue("shaarli_mark_read_on_save") == "1",
+		TelegramEnabled:           r.FormValue("telegram_enabled") == "1",
+		TelegramToken:             r.FormValue("telegram_token"),
+		TelegramChatID:            r.FormValue("telegram_chat_id"),
+		TelegramTopicID:           r.FormValue("telegram_topic_id"),
+		DiscordEnabled:            r.FormValue("discord_enabled") == "1",
+		DiscordWebhookURL:         r.FormValue("discord_webhook_url"),
+		WebhookEnabled:            r.FormValue("webhook_enabled") == "1",
+		WebhookURL:                r.FormValue("webhook_url"),
+		WebhookSecret:             r.FormValue("webhook_secret"),
+		IMAPEnabled:               r.FormValue("imap_enabled") == "1",
+		IMAPServer:                r.FormValue("imap_server"),
+		IMAPUsername:              r.FormValue("imap_username"),
+		IMAPPassword:              r.FormValue("imap_password"),
+		IMAPMailbox:               r.FormValue("imap_mailbox"),
+		IMAPSenderFilter:          r.FormValue("imap_sender_filter"),
+		GotifyEnabled:             r.FormValue("gotify_enabled") == "1",
+		GotifyURL:                 r.FormValue("gotify_url"),
+		GotifyToken:               r.FormValue("gotify_token"),
+		GotifyPriority:            gotifyPriority,
+		NtfyEnabled:               r.FormValue("ntfy_enabled") == "1",
+		NtfyURL:                   r.FormValue("ntfy_url"),
+		NtfyTopic:                 r.FormValue("ntfy_topic"),
+		NtfyToken:                 r.FormValue("ntfy_token"),
+		NtfyPriority:              ntfyPriority,
+		AppriseEnabled:            r.FormValue("apprise_enabled") == "1",
+		AppriseURL:                r.FormValue("apprise_url"),
+		AppriseServicesURL:        r.FormValue("apprise_services_url"),
+		AppriseConfigKey:          r.FormValue("apprise_config_key"),
+		AppriseTag:                r.FormValue("apprise_tag"),
 	}
 }
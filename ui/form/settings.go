@@ -14,17 +14,19 @@ import (
 
 // SettingsForm represents the settings form.
 type SettingsForm struct {
-	Username          string
-	Password          string
-	Confirmation      string
-	Theme             string
-	Language          string
-	Timezone          string
-	EntryDirection    string
-	EntriesPerPage    int
-	KeyboardShortcuts bool
-	ShowReadingTime   bool
-	CustomCSS         string
+	Username           string
+	Password           string
+	Confirmation       string
+	Theme              string
+	Language           string
+	Timezone           string
+	EntryDirection     string
+	EntriesPerPage     int
+	KeyboardShortcuts  bool
+	ShowReadingTime    bool
+	EntryDeduplication string
+	MarkReadOnScroll   bool
+	CustomCSS          string
 }
 
 // Merge updates the fields of the given user.
@@ -37,6 +39,8 @@ func (s *SettingsForm) Merge(user *model.User) *model.User {
 	user.EntriesPerPage = s.EntriesPerPage
 	user.KeyboardShortcuts = s.KeyboardShortcuts
 	user.ShowReadingTime = s.ShowReadingTime
+	user.EntryDeduplication = s.EntryDeduplication
+	user.MarkReadOnScroll = s.MarkReadOnScroll
 	user.Extra["custom_css"] = s.CustomCSS
 
 	if s.Password != "" {
@@ -81,16 +85,18 @@ func NewSettingsForm(r *http.Request) *SettingsForm {
 		entriesPerPage = 0
 	}
 	return &SettingsForm{
-		Username:          r.FormValue("username"),
-		Password:          r.FormValue("password"),
-		Confirmation:      r.FormValue("confirmation"),
-		Theme:             r.FormValue("theme"),
-		Language:          r.FormValue("language"),
-		Timezone:          r.FormValue("timezone"),
-		EntryDirection:    r.FormValue("entry_direction"),
-		EntriesPerPage:    int(entriesPerPage),
-		KeyboardShortcuts: r.FormValue("keyboard_shortcuts") == "1",
-		ShowReadingTime:   r.FormValue("show_reading_time") == "1",
-		CustomCSS:         r.FormValue("custom_css"),
+		Username:           r.FormValue("username"),
+		Password:           r.FormValue("password"),
+		Confirmation:       r.FormValue("confirmation"),
+		Theme:              r.FormValue("theme"),
+		Language:           r.FormValue("language"),
+		Timezone:           r.FormValue("timezone"),
+		EntryDirection:     r.FormValue("entry_direction"),
+		EntriesPerPage:     int(entriesPerPage),
+		KeyboardShortcuts:  r.FormValue("keyboard_shortcuts") == "1",
+		ShowReadingTime:    r.FormValue("show_reading_time") == "1",
+		EntryDeduplication: r.FormValue("entry_deduplication"),
+		MarkReadOnScroll:   r.FormValue("mark_read_on_scroll") == "1",
+		CustomCSS:          r.FormValue("custom_css"),
 	}
 }
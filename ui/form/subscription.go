@@ -7,8 +7,10 @@ package form // import "miniflux.app/ui/form"
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"miniflux.app/errors"
+	"miniflux.app/model"
 )
 
 // SubscriptionForm represents the subscription form.
@@ -17,6 +19,8 @@ type SubscriptionForm struct {
 	CategoryID   int64
 	Crawler      bool
 	UserAgent    string
+	Cookie       string
+	ProxyURL     string
 	Username     string
 	Password     string
 	ScraperRules string
@@ -29,6 +33,10 @@ func (s *SubscriptionForm) Validate() error {
 		return errors.NewLocalizedError("error.feed_mandatory_fields")
 	}
 
+	if !model.IsValidProxyURL(s.ProxyURL) {
+		return errors.NewLocalizedError("error.invalid_proxy_url")
+	}
+
 	return nil
 }
 
@@ -44,6 +52,8 @@ func NewSubscriptionForm(r *http.Request) *SubscriptionForm {
 		Crawler:      r.FormValue("crawler") == "1",
 		CategoryID:   int64(categoryID),
 		UserAgent:    r.FormValue("user_agent"),
+		Cookie:       r.FormValue("cookie"),
+		ProxyURL:     strings.TrimSpace(r.FormValue("proxy_url")),
 		Username:     r.FormValue("feed_username"),
 		Password:     r.FormValue("feed_password"),
 		ScraperRules: r.FormValue("scraper_rules"),
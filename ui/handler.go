@@ -5,6 +5,7 @@
 package ui // import "miniflux.app/ui"
 
 import (
+	"miniflux.app/cache"
 	"miniflux.app/reader/feed"
 	"miniflux.app/storage"
 	"miniflux.app/template"
@@ -19,4 +20,5 @@ type handler struct {
 	tpl         *template.Engine
 	pool        *worker.Pool
 	feedHandler *feed.Handler
+	mediaCache  *cache.Cache
 }
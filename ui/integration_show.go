@@ -29,31 +29,66 @@ func (h *handler) showIntegrationPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	integrationForm := form.IntegrationForm{
-		PinboardEnabled:      integration.PinboardEnabled,
-		PinboardToken:        integration.PinboardToken,
-		PinboardTags:         integration.PinboardTags,
-		PinboardMarkAsUnread: integration.PinboardMarkAsUnread,
-		InstapaperEnabled:    integration.InstapaperEnabled,
-		InstapaperUsername:   integration.InstapaperUsername,
-		InstapaperPassword:   integration.InstapaperPassword,
-		FeverEnabled:         integration.FeverEnabled,
-		FeverUsername:        integration.FeverUsername,
-		FeverPassword:        integration.FeverPassword,
-		WallabagEnabled:      integration.WallabagEnabled,
-		WallabagURL:          integration.WallabagURL,
-		WallabagClientID:     integration.WallabagClientID,
-		WallabagClientSecret: integration.WallabagClientSecret,
-		WallabagUsername:     integration.WallabagUsername,
-		WallabagPassword:     integration.WallabagPassword,
-		NunuxKeeperEnabled:   integration.NunuxKeeperEnabled,
-		NunuxKeeperURL:       integration.NunuxKeeperURL,
-		NunuxKeeperAPIKey:    integration.NunuxKeeperAPIKey,
-		PocketEnabled:        integration.PocketEnabled,
-		PocketAccessToken:    integration.PocketAccessToken,
-		PocketConsumerKey:    integration.PocketConsumerKey,
-		TelegramEnabled:      integration.TelegramEnabled,
-		TelegramToken:        integration.TelegramToken,
-		TelegramChatID:       integration.TelegramChatID,
+		PinboardEnabled:           integration.PinboardEnabled,
+		PinboardToken:             integration.PinboardToken,
+		PinboardTags:              integration.PinboardTags,
+		PinboardMarkAsUnread:      integration.PinboardMarkAsUnread,
+		PinboardMarkReadOnSave:    integration.PinboardMarkReadOnSave,
+		InstapaperEnabled:         integration.InstapaperEnabled,
+		InstapaperUsername:        integration.InstapaperUsername,
+		InstapaperPassword:        integration.InstapaperPassword,
+		InstapaperMarkReadOnSave:  integration.InstapaperMarkReadOnSave,
+		FeverEnabled:              integration.FeverEnabled,
+		FeverUsername:             integration.FeverUsername,
+		FeverPassword:             integration.FeverPassword,
+		WallabagEnabled:           integration.WallabagEnabled,
+		WallabagURL:               integration.WallabagURL,
+		WallabagClientID:          integration.WallabagClientID,
+		WallabagClientSecret:      integration.WallabagClientSecret,
+		WallabagUsername:          integration.WallabagUsername,
+		WallabagPassword:          integration.WallabagPassword,
+		WallabagMarkReadOnSave:    integration.WallabagMarkReadOnSave,
+		NunuxKeeperEnabled:        integration.NunuxKeeperEnabled,
+		NunuxKeeperURL:            integration.NunuxKeeperURL,
+		NunuxKeeperAPIKey:         integration.NunuxKeeperAPIKey,
+		NunuxKeeperMarkReadOnSave: integration.NunuxKeeperMarkReadOnSave,
+		PocketEnabled:             integration.PocketEnabled,
+		PocketAccessToken:         integration.PocketAccessToken,
+		PocketConsumerKey:         integration.PocketConsumerKey,
+		PocketMarkReadOnSave:      integration.PocketMarkReadOnSave,
+		ShaarliEnabled:            integration.ShaarliEnabled,
+		ShaarliURL:                integration.ShaarliURL,
+		ShaarliAPISecret:          integration.ShaarliAPISecret,
+		ShaarliMarkReadOnSave:     integration.ShaarliMarkReadOnSave,
+		TelegramEnabled:           integration.TelegramEnabled,
+		TelegramToken:             integration.TelegramToken,
+		TelegramChatID:            integration.TelegramChatID,
+		TelegramTopicID:           integration.TelegramTopicID,
+		DiscordEnabled:            integration.DiscordEnabled,
+		DiscordWebhookURL:         integration.DiscordWebhookURL,
+		WebhookEnabled:            integration.WebhookEnabled,
+		WebhookURL:                integration.WebhookURL,
+		WebhookSecret:             integration.WebhookSecret,
+		GotifyEnabled:             integration.GotifyEnabled,
+		GotifyURL:                 integration.GotifyURL,
+		GotifyToken:               integration.GotifyToken,
+		GotifyPriority:            integration.GotifyPriority,
+		NtfyEnabled:               integration.NtfyEnabled,
+		NtfyURL:                   integration.NtfyURL,
+		NtfyTopic:                 integration.NtfyTopic,
+		NtfyToken:                 integration.NtfyToken,
+		NtfyPriority:              integration.NtfyPriority,
+		AppriseEnabled:            integration.AppriseEnabled,
+		AppriseURL:                integration.AppriseURL,
+		AppriseServicesURL:        integration.AppriseServicesURL,
+		AppriseConfigKey:          integration.AppriseConfigKey,
+		AppriseTag:                integration.AppriseTag,
+		IMAPEnabled:               integration.IMAPEnabled,
+		IMAPServer:                integration.IMAPServer,
+		IMAPUsername:              integration.IMAPUsername,
+		IMAPPassword:              integration.IMAPPassword,
+		IMAPMailbox:               integration.IMAPMailbox,
+		IMAPSenderFilter:          integration.IMAPSenderFilter,
 	}
 
 	sess := session.New(h.store, request.SessionID(r))
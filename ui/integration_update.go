@@ -47,6 +47,13 @@ func (h *handler) updateIntegration(w http.ResponseWriter, r *http.Request) {
 		integration.FeverToken = ""
 	}
 
+	if integration.IMAPEnabled {
+		if err := h.feedHandler.CreateMailboxFeed(integration); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+	}
+
 	err = h.store.UpdateIntegration(integration)
 	if err != nil {
 		html.ServerError(w, r, err)
@@ -11,6 +11,7 @@ import (
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
+	"miniflux.app/locale"
 	"miniflux.app/logger"
 	"miniflux.app/reader/opml"
 	"miniflux.app/ui/session"
@@ -52,12 +53,16 @@ func (h *handler) uploadOPML(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if impErr := opml.NewHandler(h.store).Import(user.ID, file); impErr != nil {
+	// The subscriptions are imported in the background, large files would exceed the request timeout.
+	job, impErr := opml.NewHandler(h.store).CreateImportJob(user.ID, file)
+	if impErr != nil {
 		view.Set("errorMessage", impErr)
 		html.OK(w, r, view.Render("import"))
 		return
 	}
 
+	sess.NewFlashMessage(locale.NewPrinter(request.UserLanguage(r)).Printf("alert.opml_import_started", job.Total))
+
 	html.Redirect(w, r, route.Path(h.router, "feeds"))
 }
 
@@ -95,11 +100,15 @@ func (h *handler) fetchOPML(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if impErr := opml.NewHandler(h.store).Import(user.ID, resp.Body); impErr != nil {
+	// The subscriptions are imported in the background, large files would exceed the request timeout.
+	job, impErr := opml.NewHandler(h.store).CreateImportJob(user.ID, resp.Body)
+	if impErr != nil {
 		view.Set("errorMessage", impErr)
 		html.OK(w, r, view.Render("import"))
 		return
 	}
 
+	sess.NewFlashMessage(locale.NewPrinter(request.UserLanguage(r)).Printf("alert.opml_import_started", job.Total))
+
 	html.Redirect(w, r, route.Path(h.router, "feeds"))
 }
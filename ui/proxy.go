@@ -5,11 +5,15 @@
 package ui // import "miniflux.app/ui"
 
 import (
+	"bytes"
 	"encoding/base64"
 	"errors"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"time"
 
+	"miniflux.app/cache"
 	"miniflux.app/config"
 	"miniflux.app/crypto"
 	"miniflux.app/http/client"
@@ -39,6 +43,16 @@ func (h *handler) imageProxy(w http.ResponseWriter, r *http.Request) {
 	}
 
 	imageURL := string(decodedURL)
+	etag := crypto.HashFromBytes(decodedURL)
+
+	if h.mediaCache != nil {
+		if media, found := h.mediaCache.Get(etag); found {
+			logger.Debug(`[Proxy] Serving %q from cache`, imageURL)
+			writeProxyResponse(w, r, etag, media.ContentType, media.Body)
+			return
+		}
+	}
+
 	logger.Debug(`[Proxy] Fetching %q`, imageURL)
 
 	req, err := http.NewRequest("GET", imageURL, nil)
@@ -65,11 +79,39 @@ func (h *handler) imageProxy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	etag := crypto.HashFromBytes(decodedURL)
+	contentType := resp.Header.Get("Content-Type")
+
+	if h.mediaCache == nil {
+		writeProxyResponse(w, r, etag, contentType, resp.Body)
+		return
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, config.Opts.HTTPClientMaxBodySize()+1))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	// Media larger than the maximum body size are streamed without being cached.
+	if int64(len(body)) > config.Opts.HTTPClientMaxBodySize() {
+		writeProxyResponse(w, r, etag, contentType, io.MultiReader(bytes.NewReader(body), resp.Body))
+		return
+	}
+
+	ttl := cache.TTL(resp.Header.Get("Cache-Control"), time.Duration(config.Opts.MediaCacheTTL())*time.Hour)
+	if ttl > 0 {
+		if err := h.mediaCache.Set(etag, contentType, body, ttl); err != nil {
+			logger.Error(`[Proxy] Unable to cache %q: %v`, imageURL, err)
+		}
+	}
+
+	writeProxyResponse(w, r, etag, contentType, body)
+}
 
+func writeProxyResponse(w http.ResponseWriter, r *http.Request, etag, contentType string, body interface{}) {
 	response.New(w, r).WithCaching(etag, 72*time.Hour, func(b *response.Builder) {
-		b.WithHeader("Content-Type", resp.Header.Get("Content-Type"))
-		b.WithBody(resp.Body)
+		b.WithHeader("Content-Type", contentType)
+		b.WithBody(body)
 		b.WithoutCompression()
 		b.Write()
 	})
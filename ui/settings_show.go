@@ -27,15 +27,17 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	settingsForm := form.SettingsForm{
-		Username:          user.Username,
-		Theme:             user.Theme,
-		Language:          user.Language,
-		Timezone:          user.Timezone,
-		EntryDirection:    user.EntryDirection,
-		EntriesPerPage:    user.EntriesPerPage,
-		KeyboardShortcuts: user.KeyboardShortcuts,
-		ShowReadingTime:   user.ShowReadingTime,
-		CustomCSS:         user.Extra["custom_css"],
+		Username:           user.Username,
+		Theme:              user.Theme,
+		Language:           user.Language,
+		Timezone:           user.Timezone,
+		EntryDirection:     user.EntryDirection,
+		EntriesPerPage:     user.EntriesPerPage,
+		KeyboardShortcuts:  user.KeyboardShortcuts,
+		ShowReadingTime:    user.ShowReadingTime,
+		EntryDeduplication: user.EntryDeduplication,
+		MarkReadOnScroll:   user.MarkReadOnScroll,
+		CustomCSS:          user.Extra["custom_css"],
 	}
 
 	timezones, err := h.store.Timezones()
@@ -47,12 +47,15 @@ func (h *handler) showChooseSubscriptionPage(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	feed, err := h.feedHandler.CreateFeed(
+	feed, subscriptions, err := h.feedHandler.CreateFeed(
+		r.Context(),
 		user.ID,
 		subscriptionForm.CategoryID,
 		subscriptionForm.URL,
 		subscriptionForm.Crawler,
 		subscriptionForm.UserAgent,
+		subscriptionForm.Cookie,
+		subscriptionForm.ProxyURL,
 		subscriptionForm.Username,
 		subscriptionForm.Password,
 		subscriptionForm.ScraperRules,
@@ -65,5 +68,12 @@ func (h *handler) showChooseSubscriptionPage(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if feed == nil {
+		view.Set("form", subscriptionForm)
+		view.Set("subscriptions", subscriptions)
+		html.OK(w, r, view.Render("choose_subscription"))
+		return
+	}
+
 	html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feed.ID))
 }
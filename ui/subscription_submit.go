@@ -52,6 +52,7 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 	subscriptions, findErr := subscription.FindSubscriptions(
 		subscriptionForm.URL,
 		subscriptionForm.UserAgent,
+		subscriptionForm.Cookie,
 		subscriptionForm.Username,
 		subscriptionForm.Password,
 	)
@@ -72,12 +73,15 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 		v.Set("errorMessage", "error.subscription_not_found")
 		html.OK(w, r, v.Render("add_subscription"))
 	case n == 1:
-		feed, err := h.feedHandler.CreateFeed(
+		feed, discoveredSubscriptions, err := h.feedHandler.CreateFeed(
+			r.Context(),
 			user.ID,
 			subscriptionForm.CategoryID,
 			subscriptions[0].URL,
 			subscriptionForm.Crawler,
 			subscriptionForm.UserAgent,
+			subscriptionForm.Cookie,
+			subscriptionForm.ProxyURL,
 			subscriptionForm.Username,
 			subscriptionForm.Password,
 			subscriptionForm.ScraperRules,
@@ -90,6 +94,13 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if feed == nil {
+			v.Set("form", subscriptionForm)
+			v.Set("subscriptions", discoveredSubscriptions)
+			html.OK(w, r, v.Render("choose_subscription"))
+			return
+		}
+
 		html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feed.ID))
 	case n > 1:
 		v := view.New(h.tpl, r, sess)
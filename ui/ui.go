@@ -7,6 +7,9 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/cache"
+	"miniflux.app/config"
+	"miniflux.app/logger"
 	"miniflux.app/reader/feed"
 	"miniflux.app/storage"
 	"miniflux.app/template"
@@ -18,7 +21,7 @@ import (
 // Serve declares all routes for the user interface.
 func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHandler *feed.Handler) {
 	middleware := newMiddleware(router, store)
-	handler := &handler{router, store, template.NewEngine(router), pool, feedHandler}
+	handler := &handler{router, store, template.NewEngine(router), pool, feedHandler, newMediaCache()}
 
 	uiRouter := router.NewRoute().Subrouter()
 	uiRouter.Use(middleware.handleUserSession)
@@ -61,6 +64,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 
 	// Individual feed pages.
 	uiRouter.HandleFunc("/feed/{feedID}/refresh", handler.refreshFeed).Name("refreshFeed").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/feed/{feedID}/reprocess", handler.reprocessFeedEntries).Name("reprocessFeedEntries").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/feed/{feedID}/edit", handler.showEditFeedPage).Name("editFeed").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/feed/{feedID}/remove", handler.removeFeed).Name("removeFeed").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/feed/{feedID}/update", handler.updateFeed).Name("updateFeed").Methods(http.MethodPost)
@@ -142,3 +146,17 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 		w.Write([]byte("User-agent: *\nDisallow: /"))
 	}).Name("robots")
 }
+
+func newMediaCache() *cache.Cache {
+	if config.Opts.MediaCacheDir() == "" {
+		return nil
+	}
+
+	mediaCache, err := cache.New(config.Opts.MediaCacheDir(), config.Opts.MediaCacheSize())
+	if err != nil {
+		logger.Error("[UI] Unable to initialize the media cache: %v", err)
+		return nil
+	}
+
+	return mediaCache
+}
@@ -11,6 +11,12 @@ import (
 	"strings"
 )
 
+// DefaultTrackingParameters is the comma separated list of query parameters used to track the visitors.
+// A parameter ending with "*" matches every parameter with the same prefix.
+const DefaultTrackingParameters = "utm_*,fbclid,gclid,dclid,msclkid,mc_cid,mc_eid,yclid,_hsenc,_hsmi,igshid"
+
+var defaultTrackingParameters = strings.Split(DefaultTrackingParameters, ",")
+
 // IsAbsoluteURL returns true if the link is absolute.
 func IsAbsoluteURL(link string) bool {
 	u, err := url.Parse(link)
@@ -20,6 +26,17 @@ func IsAbsoluteURL(link string) bool {
 	return u.IsAbs()
 }
 
+// IsHTTPURL returns true if the link is an absolute HTTP or HTTPS URL.
+func IsHTTPURL(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	return (scheme == "http" || scheme == "https") && u.Host != ""
+}
+
 // AbsoluteURL converts the input URL as absolute URL if necessary.
 func AbsoluteURL(baseURL, input string) (string, error) {
 	if strings.HasPrefix(input, "//") {
@@ -43,6 +60,22 @@ func AbsoluteURL(baseURL, input string) (string, error) {
 	return base.ResolveReference(u).String(), nil
 }
 
+// ResolveBaseURL returns the base URL declared by an element (xml:base) resolved against the base URL of its parent.
+// The parent base URL is returned when the element does not declare a valid base URL.
+func ResolveBaseURL(parentURL, baseURL string) string {
+	baseURL = strings.TrimSpace(baseURL)
+	if baseURL == "" {
+		return parentURL
+	}
+
+	absoluteURL, err := AbsoluteURL(parentURL, baseURL)
+	if err != nil {
+		return parentURL
+	}
+
+	return absoluteURL
+}
+
 // RootURL returns absolute URL without the path.
 func RootURL(websiteURL string) string {
 	if strings.HasPrefix(websiteURL, "//") {
@@ -62,6 +95,16 @@ func RootURL(websiteURL string) string {
 	return u.Scheme + "://" + u.Host + "/"
 }
 
+// IsHTTP returns true if the URL is using plain HTTP.
+func IsHTTP(websiteURL string) bool {
+	parsedURL, err := url.Parse(websiteURL)
+	if err != nil {
+		return false
+	}
+
+	return strings.ToLower(parsedURL.Scheme) == "http"
+}
+
 // IsHTTPS returns true if the URL is using HTTPS.
 func IsHTTPS(websiteURL string) bool {
 	parsedURL, err := url.Parse(websiteURL)
@@ -129,3 +172,45 @@ func RequestURI(websiteURL string) string {
 
 	return buf.String()
 }
+
+// Normalize returns a canonical version of the URL that can be used to compare links.
+// The scheme and host are lowercased, tracking parameters, fragment and trailing slash are removed.
+func Normalize(link string) string {
+	u, err := url.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return link
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+	u.Fragment = ""
+
+	queryValues := u.Query()
+	for key := range queryValues {
+		if IsTrackingParameter(key, defaultTrackingParameters) {
+			queryValues.Del(key)
+		}
+	}
+	u.RawQuery = queryValues.Encode()
+
+	return u.String()
+}
+
+// IsTrackingParameter returns true when the query parameter matches one of the given tracking parameters, ignoring the case.
+// A parameter ending with "*" matches every parameter with the same prefix.
+func IsTrackingParameter(key string, parameters []string) bool {
+	key = strings.ToLower(key)
+	for _, parameter := range parameters {
+		if strings.HasSuffix(parameter, "*") {
+			if strings.HasPrefix(key, strings.TrimSuffix(parameter, "*")) {
+				return true
+			}
+		} else if key == parameter {
+			return true
+		}
+	}
+
+	return false
+}
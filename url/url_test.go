@@ -21,6 +21,25 @@ func TestIsAbsoluteURL(t *testing.T) {
 	}
 }
 
+func TestIsHTTPURL(t *testing.T) {
+	scenarios := map[string]bool{
+		"https://example.org/hub": true,
+		"HTTP://example.org/hub":  true,
+		"ftp://example.org/hub":   false,
+		"mailto:hub@example.org":  false,
+		"/hub":                    false,
+		"invalid url":             false,
+		"https:///without-host":   false,
+	}
+
+	for input, expected := range scenarios {
+		actual := IsHTTPURL(input)
+		if actual != expected {
+			t.Errorf(`Unexpected result, got %v instead of %v for %q`, actual, expected, input)
+		}
+	}
+}
+
 func TestAbsoluteURL(t *testing.T) {
 	scenarios := [][]string{
 		[]string{"https://example.org/path/file.ext", "https://example.org/folder/", "/path/file.ext"},
@@ -28,6 +47,8 @@ func TestAbsoluteURL(t *testing.T) {
 		[]string{"https://example.org/path/file.ext", "https://example.org/folder", "path/file.ext"},
 		[]string{"https://example.org/path/file.ext", "https://example.org/folder/", "https://example.org/path/file.ext"},
 		[]string{"https://static.example.org/path/file.ext", "https://www.example.org/", "//static.example.org/path/file.ext"},
+		[]string{"https://static.example.org/path/file.ext", "http://www.example.org/", "//static.example.org/path/file.ext"},
+		[]string{"https://static.example.org/path/file.ext", "", "//static.example.org/path/file.ext"},
 		[]string{"magnet:?xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a", "https://www.example.org/", "magnet:?xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a"},
 		[]string{"magnet:?xt.1=urn:sha1:YNCKHTQCWBTRNJIV4WNAE52SJUQCZO5C&xt.2=urn:sha1:TXGCZQTH26NL6OUQAJJPFALHG2LTGBC7", "https://www.example.org/", "magnet:?xt.1=urn:sha1:YNCKHTQCWBTRNJIV4WNAE52SJUQCZO5C&xt.2=urn:sha1:TXGCZQTH26NL6OUQAJJPFALHG2LTGBC7"},
 	}
@@ -45,6 +66,24 @@ func TestAbsoluteURL(t *testing.T) {
 	}
 }
 
+func TestResolveBaseURL(t *testing.T) {
+	scenarios := [][]string{
+		[]string{"https://example.org/blog/", "https://example.org/", "/blog/"},
+		[]string{"https://example.org/folder/blog/", "https://example.org/folder/", "blog/"},
+		[]string{"https://cdn.example.org/", "https://example.org/", "https://cdn.example.org/"},
+		[]string{"https://example.org/", "https://example.org/", ""},
+		[]string{"https://example.org/", "https://example.org/", "   "},
+		[]string{"https://example.org/", "https://example.org/", "http://example|org/"},
+	}
+
+	for _, scenario := range scenarios {
+		actual := ResolveBaseURL(scenario[1], scenario[2])
+		if actual != scenario[0] {
+			t.Errorf(`Unexpected result, got %q instead of %q for (%q, %q)`, actual, scenario[0], scenario[1], scenario[2])
+		}
+	}
+}
+
 func TestRootURL(t *testing.T) {
 	scenarios := map[string]string{
 		"https://example.org/path/file.ext":  "https://example.org/",
@@ -60,6 +99,24 @@ func TestRootURL(t *testing.T) {
 	}
 }
 
+func TestIsHTTP(t *testing.T) {
+	scenarios := map[string]bool{
+		"http://example.org/":                true,
+		"HTTP://example.org/":                true,
+		"https://example.org/":               false,
+		"data:image/png;base64,iVBORw0KGgo=": false,
+		"/folder/image.png":                  false,
+		"http://example|org/":                false,
+	}
+
+	for input, expected := range scenarios {
+		actual := IsHTTP(input)
+		if actual != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, input, actual, expected)
+		}
+	}
+}
+
 func TestIsHTTPS(t *testing.T) {
 	scenarios := map[string]bool{
 		"https://example.org/": true,
@@ -110,3 +167,39 @@ func TestRequestURI(t *testing.T) {
 		}
 	}
 }
+
+func TestNormalize(t *testing.T) {
+	scenarios := map[string]string{
+		"https://example.org/article/":                                   "https://example.org/article",
+		"HTTPS://Example.ORG/Article":                                    "https://example.org/Article",
+		"https://example.org/article?utm_source=rss&utm_medium=feed":     "https://example.org/article",
+		"https://example.org/article?id=42&fbclid=abc":                   "https://example.org/article?id=42",
+		"https://example.org/article?b=2&a=1#comments":                   "https://example.org/article?a=1&b=2",
+		"https://example.org/":                                           "https://example.org",
+		"https://example.org/article?gclid=abc&UTM_CAMPAIGN=newsletter/": "https://example.org/article",
+	}
+
+	for input, expected := range scenarios {
+		actual := Normalize(input)
+		if actual != expected {
+			t.Errorf(`Unexpected result, got %q instead of %q for %q`, actual, expected, input)
+		}
+	}
+}
+
+func TestIsTrackingParameter(t *testing.T) {
+	parameters := []string{"utm_*", "fbclid"}
+	scenarios := map[string]bool{
+		"utm_source": true,
+		"UTM_Medium": true,
+		"fbclid":     true,
+		"fbclid2":    false,
+		"id":         false,
+	}
+
+	for key, expected := range scenarios {
+		if actual := IsTrackingParameter(key, parameters); actual != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, key, actual, expected)
+		}
+	}
+}
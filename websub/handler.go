@@ -0,0 +1,210 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websub // import "miniflux.app/websub"
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response"
+	"miniflux.app/http/response/html"
+	"miniflux.app/logger"
+	"miniflux.app/reader/feed"
+	"miniflux.app/storage"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// defaultLeaseSeconds is used when the hub doesn't tell the duration of the subscription.
+	defaultLeaseSeconds = 86400
+
+	// pushQueueSize is the number of pushed contents waiting for a worker, the hub is asked to retry when it is full.
+	pushQueueSize = 100
+)
+
+// Serve declares the callback routes of WebSub hubs.
+// The pushed contents are stored by a fixed number of workers.
+func Serve(router *mux.Router, store *storage.Storage, feedHandler *feed.Handler, nbWorkers int) {
+	handler := &handler{store, feedHandler, make(chan *pushedContent, pushQueueSize)}
+	for i := 0; i < nbWorkers; i++ {
+		go handler.storeContents()
+	}
+
+	router.HandleFunc("/websub/{feedID}/{token}", handler.verifyIntent).Name("webSubVerifyIntent").Methods(http.MethodGet)
+	router.HandleFunc("/websub/{feedID}/{token}", handler.receiveContent).Name("webSubReceiveContent").Methods(http.MethodPost)
+}
+
+type pushedContent struct {
+	userID int64
+	feedID int64
+	body   string
+}
+
+type handler struct {
+	store       *storage.Storage
+	feedHandler *feed.Handler
+	queue       chan *pushedContent
+}
+
+// verifyIntent confirms to the hub that the subscription has been requested by Miniflux.
+// Only the pending subscription request matching the callback token can be verified or denied.
+func (h *handler) verifyIntent(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	token := request.RouteStringParam(r, "token")
+	mode := r.URL.Query().Get("hub.mode")
+	topic := r.URL.Query().Get("hub.topic")
+	challenge := r.URL.Query().Get("hub.challenge")
+
+	subscription, err := h.store.WebSubSubscription(feedID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if subscription == nil || !subscription.IsPending(token) {
+		html.NotFound(w, r)
+		return
+	}
+
+	switch mode {
+	case "subscribe":
+		if challenge == "" {
+			html.NotFound(w, r)
+			return
+		}
+
+		originalFeed, err := h.store.FeedByID(subscription.UserID, feedID)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		if originalFeed == nil || originalFeed.TopicURL != topic {
+			html.NotFound(w, r)
+			return
+		}
+
+		leaseSeconds, err := strconv.Atoi(r.URL.Query().Get("hub.lease_seconds"))
+		if err != nil || leaseSeconds <= 0 {
+			leaseSeconds = defaultLeaseSeconds
+		}
+
+		if err := h.store.UpdateWebSubLease(feedID, leaseSeconds); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		logger.Debug("[WebSub] feed #%d: subscription verified for %d seconds", feedID, leaseSeconds)
+		writeChallenge(w, r, challenge)
+	case "unsubscribe":
+		// Miniflux never requests to unsubscribe, the hub stops pushing content once a removed feed replies "410 Gone".
+		html.NotFound(w, r)
+	case "denied":
+		logger.Info("[WebSub] feed #%d: subscription denied by the hub: %s", feedID, r.URL.Query().Get("hub.reason"))
+		if err := h.store.RemoveWebSubSubscription(feedID); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		html.OK(w, r, "")
+	default:
+		html.BadRequest(w, r, errors.New("Invalid hub.mode"))
+	}
+}
+
+// receiveContent queues the entries distributed by the hub.
+func (h *handler) receiveContent(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	token := request.RouteStringParam(r, "token")
+
+	subscription, err := h.store.WebSubSubscription(feedID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if subscription == nil || !subscription.HasToken(token) {
+		// Hubs stop distributing content to a subscriber replying with a "410 Gone".
+		response.New(w, r).WithStatus(http.StatusGone).Write()
+		return
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, config.Opts.HTTPClientMaxBodySize()))
+	if err != nil {
+		html.BadRequest(w, r, err)
+		return
+	}
+
+	// The specification requires to acknowledge invalid messages, they are only ignored.
+	if !isValidSignature(subscription.Secret, r.Header.Get("X-Hub-Signature"), body) {
+		logger.Error("[WebSub] feed #%d: invalid content signature", feedID)
+		response.New(w, r).WithStatus(http.StatusAccepted).Write()
+		return
+	}
+
+	select {
+	case h.queue <- &pushedContent{userID: subscription.UserID, feedID: feedID, body: string(body)}:
+		response.New(w, r).WithStatus(http.StatusAccepted).Write()
+	default:
+		logger.Error("[WebSub] feed #%d: the queue is full, the content is rejected", feedID)
+		response.New(w, r).WithStatus(http.StatusServiceUnavailable).Write()
+	}
+}
+
+func (h *handler) storeContents() {
+	for content := range h.queue {
+		if err := h.feedHandler.PushFeed(content.userID, content.feedID, content.body); err != nil {
+			logger.Error("[WebSub] feed #%d: %v", content.feedID, err)
+		}
+	}
+}
+
+func writeChallenge(w http.ResponseWriter, r *http.Request, challenge string) {
+	response.New(w, r).WithHeader("Content-Type", "text/plain; charset=utf-8").WithBody(challenge).Write()
+}
+
+// isValidSignature checks the HMAC signature of the content, the header looks like "sha1=<hexadecimal digest>".
+func isValidSignature(secret, signature string, body []byte) bool {
+	parts := strings.SplitN(signature, "=", 2)
+	if len(parts) != 2 {
+		return false
+	}
+
+	var hashFunc func() hash.Hash
+	switch strings.ToLower(parts[0]) {
+	case "sha1":
+		hashFunc = sha1.New
+	case "sha256":
+		hashFunc = sha256.New
+	case "sha384":
+		hashFunc = sha512.New384
+	case "sha512":
+		hashFunc = sha512.New
+	default:
+		return false
+	}
+
+	expected, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(hashFunc, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
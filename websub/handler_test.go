@@ -0,0 +1,29 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package websub // import "miniflux.app/websub"
+
+import "testing"
+
+func TestIsValidSignature(t *testing.T) {
+	body := []byte(`<?xml version="1.0" encoding="utf-8"?><feed xmlns="http://www.w3.org/2005/Atom"></feed>`)
+
+	scenarios := map[string]bool{
+		"sha1=19c2a95621953466f41ab7114ee1cd5ba25f3f0d":                                                                                           true,
+		"SHA256=800623d15fdff8c034f7e4a4c851797c1e35616423728b1b542c6ebca7e96c9b":                                                                 true,
+		"sha512=2ea7c36943176da71f7f91ffb47485ae0d89fbda32d34fb909e6e020aafe7469d0f46bb54ca7f762ea957a0f243a121a866d60f0c586e63484999cafecaa27a3": true,
+		"sha1=29c2a95621953466f41ab7114ee1cd5ba25f3f0d":                                                                                           false,
+		"sha256=19c2a95621953466f41ab7114ee1cd5ba25f3f0d":                                                                                         false,
+		"sha1=invalid":                         false,
+		"md5=7e07ad4b8d6acb7c8d8ad8cd4b3b4e62": false,
+		"":                                     false,
+		"sha1":                                 false,
+	}
+
+	for signature, expected := range scenarios {
+		if result := isValidSignature("secret", signature, body); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, signature, result, expected)
+		}
+	}
+}
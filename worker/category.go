@@ -0,0 +1,90 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package worker // import "miniflux.app/worker"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+	"miniflux.app/timer"
+)
+
+var (
+	errCategoryNotFound = "Category not found for this user"
+	errCategoryRefresh  = "Unable to refresh %d feeds: %s"
+)
+
+// RefreshCategory refreshes all the enabled feeds of a category and returns the number of succeeded and failed feeds.
+//
+// The feeds are refreshed by the workers, so the same host is never requested by more workers than the per-host limit.
+// A failure does not stop the refresh of the other feeds, all the errors are returned together at the end.
+// The remaining feeds are skipped when the context is done.
+func (p *Pool) RefreshCategory(ctx context.Context, store *storage.Storage, userID, categoryID int64) (succeeded, failed int, err error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Pool:RefreshCategory] categoryID=%d", categoryID))
+
+	if !store.CategoryExists(userID, categoryID) {
+		return 0, 0, errors.NewLocalizedError(errCategoryNotFound)
+	}
+
+	feeds, storeErr := store.FeedsByCategoryWithCounters(userID, categoryID)
+	if storeErr != nil {
+		return 0, 0, storeErr
+	}
+
+	var jobs model.JobList
+	for _, feed := range feeds {
+		if !feed.Disabled {
+			jobs = append(jobs, model.Job{UserID: feed.UserID, FeedID: feed.ID, FeedURL: feed.FeedURL})
+		}
+	}
+
+	return collectRefreshResults(ctx, feeds, len(jobs), p.Refresh(ctx, jobs))
+}
+
+// collectRefreshResults waits for the given number of refreshes and counts the succeeded and failed feeds.
+func collectRefreshResults(ctx context.Context, feeds model.Feeds, count int, events <-chan RefreshEvent) (succeeded, failed int, err error) {
+	titles := make(map[int64]string, len(feeds))
+	for _, feed := range feeds {
+		titles[feed.ID] = feed.Title
+	}
+
+	var messages []string
+	for count > 0 {
+		var event RefreshEvent
+		select {
+		case event = <-events:
+		case <-ctx.Done():
+			return succeeded, failed, ctx.Err()
+		}
+
+		if event.Started {
+			continue
+		}
+
+		count--
+		if event.Err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return succeeded, failed, ctxErr
+			}
+
+			failed++
+			messages = append(messages, fmt.Sprintf("%s: %v", titles[event.Job.FeedID], event.Err))
+			continue
+		}
+
+		succeeded++
+	}
+
+	if failed > 0 {
+		return succeeded, failed, errors.NewLocalizedError(errCategoryRefresh, failed, strings.Join(messages, "; "))
+	}
+
+	return succeeded, failed, nil
+}
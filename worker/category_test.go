@@ -0,0 +1,72 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package worker // import "miniflux.app/worker"
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func newRefreshEvents(events ...RefreshEvent) <-chan RefreshEvent {
+	c := make(chan RefreshEvent, len(events))
+	for _, event := range events {
+		c <- event
+	}
+	return c
+}
+
+func TestCollectRefreshResultsContinuesAfterFailure(t *testing.T) {
+	feeds := model.Feeds{
+		{ID: 1, UserID: 1, Title: "Feed 1"},
+		{ID: 2, UserID: 1, Title: "Feed 2"},
+		{ID: 3, UserID: 1, Title: "Feed 3"},
+	}
+
+	events := newRefreshEvents(
+		RefreshEvent{Job: model.Job{FeedID: 1}, Started: true},
+		RefreshEvent{Job: model.Job{FeedID: 2}, Err: errors.New("some error")},
+		RefreshEvent{Job: model.Job{FeedID: 1}},
+		RefreshEvent{Job: model.Job{FeedID: 3}},
+	)
+
+	succeeded, failed, err := collectRefreshResults(context.Background(), feeds, 3, events)
+	if succeeded != 2 || failed != 1 {
+		t.Errorf(`Unexpected counters, got %d succeeded and %d failed`, succeeded, failed)
+	}
+
+	if err == nil || !strings.Contains(err.Error(), "Feed 2: some error") {
+		t.Errorf(`The error should contain the failed feed, got %v`, err)
+	}
+}
+
+func TestCollectRefreshResultsWithoutFailure(t *testing.T) {
+	feeds := model.Feeds{{ID: 1, UserID: 1}, {ID: 2, UserID: 1}}
+	events := newRefreshEvents(RefreshEvent{Job: model.Job{FeedID: 1}}, RefreshEvent{Job: model.Job{FeedID: 2}})
+
+	succeeded, failed, err := collectRefreshResults(context.Background(), feeds, 2, events)
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if succeeded != 2 || failed != 0 {
+		t.Errorf(`Unexpected counters, got %d succeeded and %d failed`, succeeded, failed)
+	}
+}
+
+func TestCollectRefreshResultsStopsWhenContextIsCanceled(t *testing.T) {
+	feeds := model.Feeds{{ID: 1, UserID: 1}, {ID: 2, UserID: 1}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	events := newRefreshEvents(RefreshEvent{Job: model.Job{FeedID: 1}, Err: context.Canceled})
+
+	if _, _, err := collectRefreshResults(ctx, feeds, 2, events); err != context.Canceled {
+		t.Fatalf(`The context error should be returned, got %v`, err)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package worker // import "miniflux.app/worker"
+
+import (
+	"sync"
+
+	"miniflux.app/url"
+)
+
+// dispatcher limits the number of feeds refreshed concurrently for the same host.
+// The tasks of a busy host are queued instead of blocking the worker, and the hosts are forgotten once idle.
+type dispatcher struct {
+	mutex   sync.Mutex
+	limit   int
+	running map[string]int
+	pending map[string][]task
+}
+
+// Dispatch runs the refresh function when a slot is available for the feed's host.
+// Otherwise the task is queued and refreshed later by the worker that releases a slot for this host.
+func (d *dispatcher) Dispatch(t task, refresh func(t task)) {
+	host := url.Domain(t.job.FeedURL)
+	if !d.acquire(host, t) {
+		return
+	}
+
+	for {
+		refresh(t)
+
+		next, found := d.release(host)
+		if !found {
+			return
+		}
+		t = next
+	}
+}
+
+// acquire takes a slot for the host, or queues the task when all the slots are taken.
+// A feed already waiting for the host is not queued twice, unless the caller waits for the result.
+func (d *dispatcher) acquire(host string, t task) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.running[host] < d.limit {
+		d.running[host]++
+		return true
+	}
+
+	if t.events == nil {
+		for _, pendingTask := range d.pending[host] {
+			if pendingTask.job.FeedID == t.job.FeedID {
+				return false
+			}
+		}
+	}
+
+	d.pending[host] = append(d.pending[host], t)
+	return false
+}
+
+// release returns the next queued task of the host, keeping the slot for it, or frees the slot.
+func (d *dispatcher) release(host string) (task, bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if tasks := d.pending[host]; len(tasks) > 0 {
+		if len(tasks) == 1 {
+			delete(d.pending, host)
+		} else {
+			d.pending[host] = tasks[1:]
+		}
+		return tasks[0], true
+	}
+
+	if d.running[host]--; d.running[host] == 0 {
+		delete(d.running, host)
+	}
+
+	return task{}, false
+}
+
+func newDispatcher(limit int) *dispatcher {
+	if limit < 1 {
+		limit = 1
+	}
+
+	return &dispatcher{
+		limit:   limit,
+		running: make(map[string]int),
+		pending: make(map[string][]task),
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package worker // import "miniflux.app/worker"
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestDispatcherQueuesJobsForSameHost(t *testing.T) {
+	d := newDispatcher(1)
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	var order []int64
+	refresh := func(t task) {
+		order = append(order, t.job.FeedID)
+		if t.job.FeedID == 1 {
+			close(started)
+			<-release
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		d.Dispatch(task{job: model.Job{FeedID: 1, FeedURL: "https://example.org/feed1"}}, refresh)
+		close(done)
+	}()
+	<-started
+
+	// The host is busy: the jobs are queued and the calls return without waiting.
+	d.Dispatch(task{job: model.Job{FeedID: 2, FeedURL: "https://example.org/feed2"}}, refresh)
+	d.Dispatch(task{job: model.Job{FeedID: 3, FeedURL: "https://example.org/feed3"}}, refresh)
+	d.Dispatch(task{job: model.Job{FeedID: 2, FeedURL: "https://example.org/feed2"}}, refresh)
+
+	close(release)
+	<-done
+
+	if len(order) != 3 || order[0] != 1 || order[1] != 2 || order[2] != 3 {
+		t.Fatalf(`Jobs for the same host should be refreshed once and in order, got %v`, order)
+	}
+
+	if len(d.running) != 0 || len(d.pending) != 0 {
+		t.Fatalf(`Idle hosts should be forgotten, got %v and %v`, d.running, d.pending)
+	}
+}
+
+func TestDispatcherRunsDifferentHostsInParallel(t *testing.T) {
+	d := newDispatcher(1)
+	started := make(chan string, 2)
+	release := make(chan struct{})
+
+	refresh := func(t task) {
+		started <- "started"
+		<-release
+	}
+
+	var wg sync.WaitGroup
+	for i, feedURL := range []string{"https://example.org/feed", "https://example.com/feed"} {
+		wg.Add(1)
+		go func(feedID int64, feedURL string) {
+			defer wg.Done()
+			d.Dispatch(task{job: model.Job{FeedID: feedID, FeedURL: feedURL}}, refresh)
+		}(int64(i), feedURL)
+	}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatal(`Feeds from different hosts should be refreshed in parallel`)
+		}
+	}
+
+	close(release)
+	wg.Wait()
+}
+
+func TestDispatcherWithInvalidLimit(t *testing.T) {
+	d := newDispatcher(0)
+	if d.limit != 1 {
+		t.Fatalf(`The limit should be at least 1, got %d`, d.limit)
+	}
+}
+
+func TestDispatcherKeepsDuplicateTasksWithEvents(t *testing.T) {
+	d := newDispatcher(1)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	events := make(chan RefreshEvent, 2)
+
+	var count int
+	refresh := func(t task) {
+		count++
+		if t.events == nil {
+			close(started)
+			<-release
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		d.Dispatch(task{job: model.Job{FeedID: 1, FeedURL: "https://example.org/feed"}}, refresh)
+		close(done)
+	}()
+	<-started
+
+	// The caller waits for the result of these tasks, they must all be refreshed.
+	d.Dispatch(task{job: model.Job{FeedID: 1, FeedURL: "https://example.org/feed"}, events: events}, refresh)
+	d.Dispatch(task{job: model.Job{FeedID: 1, FeedURL: "https://example.org/feed"}, events: events}, refresh)
+
+	close(release)
+	<-done
+
+	if count != 3 {
+		t.Fatalf(`The tasks with events should not be deduplicated, got %d refreshes`, count)
+	}
+}
@@ -5,30 +5,78 @@
 package worker // import "miniflux.app/worker"
 
 import (
+	"context"
+
 	"miniflux.app/model"
 	"miniflux.app/reader/feed"
 )
 
 // Pool handles a pool of workers.
 type Pool struct {
-	queue chan model.Job
+	queue chan task
+}
+
+// RefreshEvent reports the progress of a job sent with Refresh.
+// A job sends a start event when a worker begins the refresh, and a finish event with the refresh error.
+type RefreshEvent struct {
+	Job     model.Job
+	Started bool
+	Err     error
+}
+
+// task is a job sent to the workers, with the context of the refresh and the channel receiving its progress, if any.
+type task struct {
+	job    model.Job
+	ctx    context.Context
+	events chan<- RefreshEvent
+}
+
+func (t task) start() {
+	if t.events != nil {
+		t.events <- RefreshEvent{Job: t.job, Started: true}
+	}
+}
+
+func (t task) finish(err error) {
+	if t.events != nil {
+		t.events <- RefreshEvent{Job: t.job, Err: err}
+	}
 }
 
 // Push send a list of jobs to the queue.
 func (p *Pool) Push(jobs model.JobList) {
 	for _, job := range jobs {
-		p.queue <- job
+		p.queue <- task{job: job, ctx: context.Background()}
 	}
 }
 
+// Refresh sends the jobs to the workers and returns the channel receiving the progress of each job.
+// The channel is buffered for all the events, so the workers never wait for the caller.
+// When the context is canceled, the jobs not refreshed yet are finished with the context error without being started.
+func (p *Pool) Refresh(ctx context.Context, jobs model.JobList) <-chan RefreshEvent {
+	events := make(chan RefreshEvent, 2*len(jobs))
+	go func() {
+		for _, job := range jobs {
+			select {
+			case p.queue <- task{job: job, ctx: ctx, events: events}:
+			case <-ctx.Done():
+				events <- RefreshEvent{Job: job, Err: ctx.Err()}
+			}
+		}
+	}()
+	return events
+}
+
 // NewPool creates a pool of background workers.
-func NewPool(feedHandler *feed.Handler, nbWorkers int) *Pool {
+// Feeds on the same host are refreshed by at most perHostLimit workers at the same time.
+func NewPool(feedHandler *feed.Handler, nbWorkers, perHostLimit int) *Pool {
 	workerPool := &Pool{
-		queue: make(chan model.Job),
+		queue: make(chan task),
 	}
 
+	dispatcher := newDispatcher(perHostLimit)
 	for i := 0; i < nbWorkers; i++ {
-		worker := &Worker{id: i, feedHandler: feedHandler}
+		worker := &Worker{id: i, feedHandler: feedHandler, dispatcher: dispatcher}
 		go worker.Run(workerPool.queue)
 	}
 
@@ -0,0 +1,49 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package worker // import "miniflux.app/worker"
+
+import (
+	"context"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestRefreshSendsJobsToWorkers(t *testing.T) {
+	pool := &Pool{queue: make(chan task)}
+	jobs := model.JobList{{UserID: 1, FeedID: 10}, {UserID: 1, FeedID: 20}}
+
+	events := pool.Refresh(context.Background(), jobs)
+	for _, job := range jobs {
+		received := <-pool.queue
+		if received.job != job || received.events == nil {
+			t.Fatalf(`Unexpected task, got %+v instead of %+v`, received.job, job)
+		}
+
+		received.start()
+		received.finish(nil)
+	}
+
+	for i := 0; i < 2*len(jobs); i++ {
+		event := <-events
+		if event.Started != (i%2 == 0) || event.Err != nil {
+			t.Fatalf(`Unexpected event #%d: %+v`, i, event)
+		}
+	}
+}
+
+func TestRefreshWithCanceledContext(t *testing.T) {
+	pool := &Pool{queue: make(chan task)}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	events := pool.Refresh(ctx, model.JobList{{UserID: 1, FeedID: 10}, {UserID: 1, FeedID: 20}})
+	for i := 0; i < 2; i++ {
+		event := <-events
+		if event.Started || event.Err != context.Canceled {
+			t.Fatalf(`The jobs should be finished with the context error, got %+v`, event)
+		}
+	}
+}
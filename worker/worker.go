@@ -6,7 +6,6 @@ package worker // import "miniflux.app/worker"
 
 import (
 	"miniflux.app/logger"
-	"miniflux.app/model"
 	"miniflux.app/reader/feed"
 )
 
@@ -14,19 +13,31 @@ import (
 type Worker struct {
 	id          int
 	feedHandler *feed.Handler
+	dispatcher  *dispatcher
 }
 
 // Run wait for a job and refresh the given feed.
-func (w *Worker) Run(c chan model.Job) {
+func (w *Worker) Run(c chan task) {
 	logger.Debug("[Worker] #%d started", w.id)
 
 	for {
-		job := <-c
-		logger.Debug("[Worker #%d] got userID=%d, feedID=%d", w.id, job.UserID, job.FeedID)
+		t := <-c
+		logger.Debug("[Worker #%d] got userID=%d, feedID=%d", w.id, t.job.UserID, t.job.FeedID)
+		w.dispatcher.Dispatch(t, w.refresh)
+	}
+}
+
+// refresh refreshes the feed of the task, unless the context of the task is already done.
+func (w *Worker) refresh(t task) {
+	if err := t.ctx.Err(); err != nil {
+		t.finish(err)
+		return
+	}
 
-		err := w.feedHandler.RefreshFeed(job.UserID, job.FeedID)
-		if err != nil {
-			logger.Error("[Worker] %v", err)
-		}
+	t.start()
+	err := w.feedHandler.RefreshFeed(t.ctx, t.job.UserID, t.job.FeedID)
+	if err != nil && t.events == nil {
+		logger.Error("[Worker] %v", err)
 	}
+	t.finish(err)
 }